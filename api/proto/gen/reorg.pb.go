@@ -23,6 +23,64 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type HealthStatus int32
+
+const (
+	HealthStatus_HEALTH_STATUS_UNSPECIFIED HealthStatus = 0
+	HealthStatus_HEALTH_STATUS_ON_TRACK    HealthStatus = 1
+	HealthStatus_HEALTH_STATUS_AT_RISK     HealthStatus = 2
+	HealthStatus_HEALTH_STATUS_STALLED     HealthStatus = 3
+	HealthStatus_HEALTH_STATUS_DONE        HealthStatus = 4
+	HealthStatus_HEALTH_STATUS_SOMEDAY     HealthStatus = 5
+)
+
+// Enum value maps for HealthStatus.
+var (
+	HealthStatus_name = map[int32]string{
+		0: "HEALTH_STATUS_UNSPECIFIED",
+		1: "HEALTH_STATUS_ON_TRACK",
+		2: "HEALTH_STATUS_AT_RISK",
+		3: "HEALTH_STATUS_STALLED",
+		4: "HEALTH_STATUS_DONE",
+		5: "HEALTH_STATUS_SOMEDAY",
+	}
+	HealthStatus_value = map[string]int32{
+		"HEALTH_STATUS_UNSPECIFIED": 0,
+		"HEALTH_STATUS_ON_TRACK":    1,
+		"HEALTH_STATUS_AT_RISK":     2,
+		"HEALTH_STATUS_STALLED":     3,
+		"HEALTH_STATUS_DONE":        4,
+		"HEALTH_STATUS_SOMEDAY":     5,
+	}
+)
+
+func (x HealthStatus) Enum() *HealthStatus {
+	p := new(HealthStatus)
+	*p = x
+	return p
+}
+
+func (x HealthStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HealthStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_reorg_proto_enumTypes[0].Descriptor()
+}
+
+func (HealthStatus) Type() protoreflect.EnumType {
+	return &file_reorg_proto_enumTypes[0]
+}
+
+func (x HealthStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HealthStatus.Descriptor instead.
+func (HealthStatus) EnumDescriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{0}
+}
+
 type ProjectStatus int32
 
 const (
@@ -31,6 +89,7 @@ const (
 	ProjectStatus_PROJECT_STATUS_ON_HOLD     ProjectStatus = 2
 	ProjectStatus_PROJECT_STATUS_COMPLETED   ProjectStatus = 3
 	ProjectStatus_PROJECT_STATUS_ARCHIVED    ProjectStatus = 4
+	ProjectStatus_PROJECT_STATUS_SOMEDAY     ProjectStatus = 5
 )
 
 // Enum value maps for ProjectStatus.
@@ -41,6 +100,7 @@ var (
 		2: "PROJECT_STATUS_ON_HOLD",
 		3: "PROJECT_STATUS_COMPLETED",
 		4: "PROJECT_STATUS_ARCHIVED",
+		5: "PROJECT_STATUS_SOMEDAY",
 	}
 	ProjectStatus_value = map[string]int32{
 		"PROJECT_STATUS_UNSPECIFIED": 0,
@@ -48,6 +108,7 @@ var (
 		"PROJECT_STATUS_ON_HOLD":     2,
 		"PROJECT_STATUS_COMPLETED":   3,
 		"PROJECT_STATUS_ARCHIVED":    4,
+		"PROJECT_STATUS_SOMEDAY":     5,
 	}
 )
 
@@ -62,11 +123,11 @@ func (x ProjectStatus) String() string {
 }
 
 func (ProjectStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_reorg_proto_enumTypes[0].Descriptor()
+	return file_reorg_proto_enumTypes[1].Descriptor()
 }
 
 func (ProjectStatus) Type() protoreflect.EnumType {
-	return &file_reorg_proto_enumTypes[0]
+	return &file_reorg_proto_enumTypes[1]
 }
 
 func (x ProjectStatus) Number() protoreflect.EnumNumber {
@@ -75,7 +136,7 @@ func (x ProjectStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProjectStatus.Descriptor instead.
 func (ProjectStatus) EnumDescriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{0}
+	return file_reorg_proto_rawDescGZIP(), []int{1}
 }
 
 type TaskStatus int32
@@ -87,6 +148,7 @@ const (
 	TaskStatus_TASK_STATUS_BLOCKED     TaskStatus = 3
 	TaskStatus_TASK_STATUS_DONE        TaskStatus = 4
 	TaskStatus_TASK_STATUS_CANCELLED   TaskStatus = 5
+	TaskStatus_TASK_STATUS_WAITING     TaskStatus = 6 // Delegated, waiting on the assignee
 )
 
 // Enum value maps for TaskStatus.
@@ -98,6 +160,7 @@ var (
 		3: "TASK_STATUS_BLOCKED",
 		4: "TASK_STATUS_DONE",
 		5: "TASK_STATUS_CANCELLED",
+		6: "TASK_STATUS_WAITING",
 	}
 	TaskStatus_value = map[string]int32{
 		"TASK_STATUS_UNSPECIFIED": 0,
@@ -106,6 +169,7 @@ var (
 		"TASK_STATUS_BLOCKED":     3,
 		"TASK_STATUS_DONE":        4,
 		"TASK_STATUS_CANCELLED":   5,
+		"TASK_STATUS_WAITING":     6,
 	}
 )
 
@@ -120,11 +184,11 @@ func (x TaskStatus) String() string {
 }
 
 func (TaskStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_reorg_proto_enumTypes[1].Descriptor()
+	return file_reorg_proto_enumTypes[2].Descriptor()
 }
 
 func (TaskStatus) Type() protoreflect.EnumType {
-	return &file_reorg_proto_enumTypes[1]
+	return &file_reorg_proto_enumTypes[2]
 }
 
 func (x TaskStatus) Number() protoreflect.EnumNumber {
@@ -133,7 +197,7 @@ func (x TaskStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TaskStatus.Descriptor instead.
 func (TaskStatus) EnumDescriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{1}
+	return file_reorg_proto_rawDescGZIP(), []int{2}
 }
 
 type Priority int32
@@ -175,11 +239,11 @@ func (x Priority) String() string {
 }
 
 func (Priority) Descriptor() protoreflect.EnumDescriptor {
-	return file_reorg_proto_enumTypes[2].Descriptor()
+	return file_reorg_proto_enumTypes[3].Descriptor()
 }
 
 func (Priority) Type() protoreflect.EnumType {
-	return &file_reorg_proto_enumTypes[2]
+	return &file_reorg_proto_enumTypes[3]
 }
 
 func (x Priority) Number() protoreflect.EnumNumber {
@@ -188,7 +252,7 @@ func (x Priority) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Priority.Descriptor instead.
 func (Priority) EnumDescriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{2}
+	return file_reorg_proto_rawDescGZIP(), []int{3}
 }
 
 type Area struct {
@@ -199,6 +263,11 @@ type Area struct {
 	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Priority      Priority               `protobuf:"varint,7,opt,name=priority,proto3,enum=reorg.v1.Priority" json:"priority,omitempty"`
+	Review        string                 `protobuf:"bytes,8,opt,name=review,proto3" json:"review,omitempty"` // Review cadence: weekly, biweekly, monthly, quarterly or yearly
+	LastReviewed  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_reviewed,json=lastReviewed,proto3" json:"last_reviewed,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SortOrder     *int32                 `protobuf:"varint,11,opt,name=sort_order,json=sortOrder,proto3,oneof" json:"sort_order,omitempty"` // Position among the areas; kept on update when unset
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -275,20 +344,63 @@ func (x *Area) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Area) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *Area) GetReview() string {
+	if x != nil {
+		return x.Review
+	}
+	return ""
+}
+
+func (x *Area) GetLastReviewed() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReviewed
+	}
+	return nil
+}
+
+func (x *Area) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Area) GetSortOrder() int32 {
+	if x != nil && x.SortOrder != nil {
+		return *x.SortOrder
+	}
+	return 0
+}
+
 type Project struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	AreaId        string                 `protobuf:"bytes,3,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	Status        ProjectStatus          `protobuf:"varint,5,opt,name=status,proto3,enum=reorg.v1.ProjectStatus" json:"status,omitempty"`
-	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	AreaId          string                 `protobuf:"bytes,3,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
+	Content         string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Status          ProjectStatus          `protobuf:"varint,5,opt,name=status,proto3,enum=reorg.v1.ProjectStatus" json:"status,omitempty"`
+	Tags            []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	DueDate         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Notify          string                 `protobuf:"bytes,11,opt,name=notify,proto3" json:"notify,omitempty"` // Notification channel: desktop, none, or a webhook URL
+	Health          *ProjectHealth         `protobuf:"bytes,12,opt,name=health,proto3" json:"health,omitempty"` // Derived from the project's tasks, ignored on update
+	Metadata        map[string]string      `protobuf:"bytes,13,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExternalRef     *ExternalRef           `protobuf:"bytes,14,opt,name=external_ref,json=externalRef,proto3" json:"external_ref,omitempty"`
+	GoalId          string                 `protobuf:"bytes,15,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	ParentProjectId string                 `protobuf:"bytes,16,opt,name=parent_project_id,json=parentProjectId,proto3" json:"parent_project_id,omitempty"` // Set for sub-projects
+	Revision        string                 `protobuf:"bytes,17,opt,name=revision,proto3" json:"revision,omitempty"`                                        // Stored version; updates that send it fail with ABORTED if the project changed since
+	Priority        Priority               `protobuf:"varint,18,opt,name=priority,proto3,enum=reorg.v1.Priority" json:"priority,omitempty"`                // Kept on update when unspecified
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Project) Reset() {
@@ -391,43 +503,87 @@ func (x *Project) GetCompletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type Task struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	ProjectId        string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	AreaId           string                 `protobuf:"bytes,4,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
-	Content          string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	Status           TaskStatus             `protobuf:"varint,6,opt,name=status,proto3,enum=reorg.v1.TaskStatus" json:"status,omitempty"`
-	Priority         Priority               `protobuf:"varint,7,opt,name=priority,proto3,enum=reorg.v1.Priority" json:"priority,omitempty"`
-	Tags             []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
-	DueDate          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	ScheduledDate    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"`
-	Dependencies     []string               `protobuf:"bytes,11,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
-	EstimatedMinutes int32                  `protobuf:"varint,12,opt,name=estimated_minutes,json=estimatedMinutes,proto3" json:"estimated_minutes,omitempty"`
-	ActualMinutes    int32                  `protobuf:"varint,13,opt,name=actual_minutes,json=actualMinutes,proto3" json:"actual_minutes,omitempty"`
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	StartedAt        *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	CompletedAt      *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *Project) GetNotify() string {
+	if x != nil {
+		return x.Notify
+	}
+	return ""
 }
 
-func (x *Task) Reset() {
-	*x = Task{}
+func (x *Project) GetHealth() *ProjectHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *Project) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Project) GetExternalRef() *ExternalRef {
+	if x != nil {
+		return x.ExternalRef
+	}
+	return nil
+}
+
+func (x *Project) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+func (x *Project) GetParentProjectId() string {
+	if x != nil {
+		return x.ParentProjectId
+	}
+	return ""
+}
+
+func (x *Project) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *Project) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+type Note struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId      string                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // ID of the project or task
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Note) Reset() {
+	*x = Note{}
 	mi := &file_reorg_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) String() string {
+func (x *Note) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task) ProtoMessage() {}
+func (*Note) ProtoMessage() {}
 
-func (x *Task) ProtoReflect() protoreflect.Message {
+func (x *Note) ProtoReflect() protoreflect.Message {
 	mi := &file_reorg_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -439,154 +595,723 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task.ProtoReflect.Descriptor instead.
-func (*Task) Descriptor() ([]byte, []int) {
+// Deprecated: Use Note.ProtoReflect.Descriptor instead.
+func (*Note) Descriptor() ([]byte, []int) {
 	return file_reorg_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Task) GetId() string {
+func (x *Note) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Task) GetTitle() string {
+func (x *Note) GetParentId() string {
 	if x != nil {
-		return x.Title
+		return x.ParentId
 	}
 	return ""
 }
 
-func (x *Task) GetProjectId() string {
+func (x *Note) GetContent() string {
 	if x != nil {
-		return x.ProjectId
+		return x.Content
 	}
 	return ""
 }
 
-func (x *Task) GetAreaId() string {
+func (x *Note) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AreaId
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *Task) GetContent() string {
+func (x *Note) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Content
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *Task) GetStatus() TaskStatus {
+type Goal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`   // active, achieved or dropped
+	Quarter       string                 `protobuf:"bytes,5,opt,name=quarter,proto3" json:"quarter,omitempty"` // e.g. 2025-Q1
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Progress      *GoalProgress          `protobuf:"bytes,11,opt,name=progress,proto3" json:"progress,omitempty"` // Derived from the linked projects, ignored on update
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Goal) Reset() {
+	*x = Goal{}
+	mi := &file_reorg_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Goal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Goal) ProtoMessage() {}
+
+func (x *Goal) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[3]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return TaskStatus_TASK_STATUS_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *Task) GetPriority() Priority {
+// Deprecated: Use Goal.ProtoReflect.Descriptor instead.
+func (*Goal) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Goal) GetId() string {
 	if x != nil {
-		return x.Priority
+		return x.Id
 	}
-	return Priority_PRIORITY_UNSPECIFIED
+	return ""
 }
 
-func (x *Task) GetTags() []string {
+func (x *Goal) GetTitle() string {
 	if x != nil {
-		return x.Tags
+		return x.Title
 	}
-	return nil
+	return ""
 }
 
-func (x *Task) GetDueDate() *timestamppb.Timestamp {
+func (x *Goal) GetContent() string {
 	if x != nil {
-		return x.DueDate
+		return x.Content
 	}
-	return nil
+	return ""
 }
 
-func (x *Task) GetScheduledDate() *timestamppb.Timestamp {
+func (x *Goal) GetStatus() string {
 	if x != nil {
-		return x.ScheduledDate
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *Task) GetDependencies() []string {
+func (x *Goal) GetQuarter() string {
 	if x != nil {
-		return x.Dependencies
+		return x.Quarter
 	}
-	return nil
+	return ""
 }
 
-func (x *Task) GetEstimatedMinutes() int32 {
+func (x *Goal) GetDueDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EstimatedMinutes
+		return x.DueDate
 	}
-	return 0
+	return nil
 }
 
-func (x *Task) GetActualMinutes() int32 {
+func (x *Goal) GetTags() []string {
 	if x != nil {
-		return x.ActualMinutes
+		return x.Tags
 	}
-	return 0
+	return nil
 }
 
-func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Goal) GetMetadata() map[string]string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Metadata
 	}
 	return nil
 }
 
-func (x *Task) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Goal) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Task) GetStartedAt() *timestamppb.Timestamp {
+func (x *Goal) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.StartedAt
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (x *Task) GetCompletedAt() *timestamppb.Timestamp {
+func (x *Goal) GetProgress() *GoalProgress {
 	if x != nil {
-		return x.CompletedAt
+		return x.Progress
 	}
 	return nil
 }
 
-type CreateAreaRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GoalProgress struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Projects          int32                  `protobuf:"varint,1,opt,name=projects,proto3" json:"projects,omitempty"`
+	CompletedProjects int32                  `protobuf:"varint,2,opt,name=completed_projects,json=completedProjects,proto3" json:"completed_projects,omitempty"`
+	TotalTasks        int32                  `protobuf:"varint,3,opt,name=total_tasks,json=totalTasks,proto3" json:"total_tasks,omitempty"`
+	CompletedTasks    int32                  `protobuf:"varint,4,opt,name=completed_tasks,json=completedTasks,proto3" json:"completed_tasks,omitempty"`
+	PercentComplete   int32                  `protobuf:"varint,5,opt,name=percent_complete,json=percentComplete,proto3" json:"percent_complete,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *CreateAreaRequest) Reset() {
-	*x = CreateAreaRequest{}
-	mi := &file_reorg_proto_msgTypes[3]
+func (x *GoalProgress) Reset() {
+	*x = GoalProgress{}
+	mi := &file_reorg_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAreaRequest) String() string {
+func (x *GoalProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAreaRequest) ProtoMessage() {}
+func (*GoalProgress) ProtoMessage() {}
 
-func (x *CreateAreaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[3]
+func (x *GoalProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalProgress.ProtoReflect.Descriptor instead.
+func (*GoalProgress) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GoalProgress) GetProjects() int32 {
+	if x != nil {
+		return x.Projects
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetCompletedProjects() int32 {
+	if x != nil {
+		return x.CompletedProjects
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetTotalTasks() int32 {
+	if x != nil {
+		return x.TotalTasks
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetCompletedTasks() int32 {
+	if x != nil {
+		return x.CompletedTasks
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetPercentComplete() int32 {
+	if x != nil {
+		return x.PercentComplete
+	}
+	return 0
+}
+
+// Link to the item a task or project mirrors in another system
+type ExternalRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // e.g. things, taskwarrior, jira
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalRef) Reset() {
+	*x = ExternalRef{}
+	mi := &file_reorg_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalRef) ProtoMessage() {}
+
+func (x *ExternalRef) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalRef.ProtoReflect.Descriptor instead.
+func (*ExternalRef) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExternalRef) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ExternalRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExternalRef) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ProjectHealth struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TotalTasks        int32                  `protobuf:"varint,1,opt,name=total_tasks,json=totalTasks,proto3" json:"total_tasks,omitempty"`
+	CompletedTasks    int32                  `protobuf:"varint,2,opt,name=completed_tasks,json=completedTasks,proto3" json:"completed_tasks,omitempty"`
+	OverdueTasks      int32                  `protobuf:"varint,3,opt,name=overdue_tasks,json=overdueTasks,proto3" json:"overdue_tasks,omitempty"`
+	PercentComplete   int32                  `protobuf:"varint,4,opt,name=percent_complete,json=percentComplete,proto3" json:"percent_complete,omitempty"`
+	LastActivity      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_activity,json=lastActivity,proto3" json:"last_activity,omitempty"`
+	DaysSinceActivity int32                  `protobuf:"varint,6,opt,name=days_since_activity,json=daysSinceActivity,proto3" json:"days_since_activity,omitempty"`
+	Status            HealthStatus           `protobuf:"varint,7,opt,name=status,proto3,enum=reorg.v1.HealthStatus" json:"status,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProjectHealth) Reset() {
+	*x = ProjectHealth{}
+	mi := &file_reorg_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectHealth) ProtoMessage() {}
+
+func (x *ProjectHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectHealth.ProtoReflect.Descriptor instead.
+func (*ProjectHealth) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProjectHealth) GetTotalTasks() int32 {
+	if x != nil {
+		return x.TotalTasks
+	}
+	return 0
+}
+
+func (x *ProjectHealth) GetCompletedTasks() int32 {
+	if x != nil {
+		return x.CompletedTasks
+	}
+	return 0
+}
+
+func (x *ProjectHealth) GetOverdueTasks() int32 {
+	if x != nil {
+		return x.OverdueTasks
+	}
+	return 0
+}
+
+func (x *ProjectHealth) GetPercentComplete() int32 {
+	if x != nil {
+		return x.PercentComplete
+	}
+	return 0
+}
+
+func (x *ProjectHealth) GetLastActivity() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivity
+	}
+	return nil
+}
+
+func (x *ProjectHealth) GetDaysSinceActivity() int32 {
+	if x != nil {
+		return x.DaysSinceActivity
+	}
+	return 0
+}
+
+func (x *ProjectHealth) GetStatus() HealthStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HealthStatus_HEALTH_STATUS_UNSPECIFIED
+}
+
+type Task struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ProjectId        string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AreaId           string                 `protobuf:"bytes,4,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
+	Content          string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Status           TaskStatus             `protobuf:"varint,6,opt,name=status,proto3,enum=reorg.v1.TaskStatus" json:"status,omitempty"`
+	Priority         Priority               `protobuf:"varint,7,opt,name=priority,proto3,enum=reorg.v1.Priority" json:"priority,omitempty"`
+	Tags             []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	DueDate          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`                    // The deadline; midnight UTC of the day if all_day
+	ScheduledDate    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"` // The day to start on, as midnight UTC
+	Dependencies     []string               `protobuf:"bytes,11,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	EstimatedMinutes int32                  `protobuf:"varint,12,opt,name=estimated_minutes,json=estimatedMinutes,proto3" json:"estimated_minutes,omitempty"`
+	ActualMinutes    int32                  `protobuf:"varint,13,opt,name=actual_minutes,json=actualMinutes,proto3" json:"actual_minutes,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	StartedAt        *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt      *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	TimerStartedAt   *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=timer_started_at,json=timerStartedAt,proto3" json:"timer_started_at,omitempty"` // Set while the task timer is running
+	Attachments      []string               `protobuf:"bytes,19,rep,name=attachments,proto3" json:"attachments,omitempty"`                               // URLs or project-relative asset paths
+	Metadata         map[string]string      `protobuf:"bytes,20,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExternalRef      *ExternalRef           `protobuf:"bytes,21,opt,name=external_ref,json=externalRef,proto3" json:"external_ref,omitempty"`
+	Context          string                 `protobuf:"bytes,22,opt,name=context,proto3" json:"context,omitempty"`                // Where or how the task can be done, without the @
+	Effort           string                 `protobuf:"bytes,23,opt,name=effort,proto3" json:"effort,omitempty"`                  // small, medium, large or empty
+	TimeLog          []*TimeSession         `protobuf:"bytes,24,rep,name=time_log,json=timeLog,proto3" json:"time_log,omitempty"` // Timer sessions, the running one without an end
+	Revision         string                 `protobuf:"bytes,25,opt,name=revision,proto3" json:"revision,omitempty"`              // Stored version; updates that send it fail with ABORTED if the task changed since
+	AllDay           bool                   `protobuf:"varint,26,opt,name=all_day,json=allDay,proto3" json:"all_day,omitempty"`   // The due date is a whole day rather than a moment
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_reorg_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Task) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Task) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Task) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *Task) GetAreaId() string {
+	if x != nil {
+		return x.AreaId
+	}
+	return ""
+}
+
+func (x *Task) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Task) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *Task) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *Task) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Task) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *Task) GetScheduledDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledDate
+	}
+	return nil
+}
+
+func (x *Task) GetDependencies() []string {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *Task) GetEstimatedMinutes() int32 {
+	if x != nil {
+		return x.EstimatedMinutes
+	}
+	return 0
+}
+
+func (x *Task) GetActualMinutes() int32 {
+	if x != nil {
+		return x.ActualMinutes
+	}
+	return 0
+}
+
+func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Task) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Task) GetTimerStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimerStartedAt
+	}
+	return nil
+}
+
+func (x *Task) GetAttachments() []string {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Task) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Task) GetExternalRef() *ExternalRef {
+	if x != nil {
+		return x.ExternalRef
+	}
+	return nil
+}
+
+func (x *Task) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+func (x *Task) GetEffort() string {
+	if x != nil {
+		return x.Effort
+	}
+	return ""
+}
+
+func (x *Task) GetTimeLog() []*TimeSession {
+	if x != nil {
+		return x.TimeLog
+	}
+	return nil
+}
+
+func (x *Task) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *Task) GetAllDay() bool {
+	if x != nil {
+		return x.AllDay
+	}
+	return false
+}
+
+type TimeSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeSession) Reset() {
+	*x = TimeSession{}
+	mi := &file_reorg_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSession) ProtoMessage() {}
+
+func (x *TimeSession) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSession.ProtoReflect.Descriptor instead.
+func (*TimeSession) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TimeSession) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *TimeSession) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type CreateAreaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Priority      Priority               `protobuf:"varint,4,opt,name=priority,proto3,enum=reorg.v1.Priority" json:"priority,omitempty"`
+	Review        string                 `protobuf:"bytes,5,opt,name=review,proto3" json:"review,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SortOrder     int32                  `protobuf:"varint,7,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAreaRequest) Reset() {
+	*x = CreateAreaRequest{}
+	mi := &file_reorg_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAreaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAreaRequest) ProtoMessage() {}
+
+func (x *CreateAreaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,52 +1324,2668 @@ func (x *CreateAreaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAreaRequest.ProtoReflect.Descriptor instead.
 func (*CreateAreaRequest) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{3}
+	return file_reorg_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateAreaRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateAreaRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateAreaRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateAreaRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *CreateAreaRequest) GetReview() string {
+	if x != nil {
+		return x.Review
+	}
+	return ""
+}
+
+func (x *CreateAreaRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreateAreaRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+type CreateAreaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Area          *Area                  `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAreaResponse) Reset() {
+	*x = CreateAreaResponse{}
+	mi := &file_reorg_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAreaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAreaResponse) ProtoMessage() {}
+
+func (x *CreateAreaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAreaResponse.ProtoReflect.Descriptor instead.
+func (*CreateAreaResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateAreaResponse) GetArea() *Area {
+	if x != nil {
+		return x.Area
+	}
+	return nil
+}
+
+type GetAreaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAreaRequest) Reset() {
+	*x = GetAreaRequest{}
+	mi := &file_reorg_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAreaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAreaRequest) ProtoMessage() {}
+
+func (x *GetAreaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAreaRequest.ProtoReflect.Descriptor instead.
+func (*GetAreaRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetAreaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetAreaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Area          *Area                  `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAreaResponse) Reset() {
+	*x = GetAreaResponse{}
+	mi := &file_reorg_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAreaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAreaResponse) ProtoMessage() {}
+
+func (x *GetAreaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAreaResponse.ProtoReflect.Descriptor instead.
+func (*GetAreaResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetAreaResponse) GetArea() *Area {
+	if x != nil {
+		return x.Area
+	}
+	return nil
+}
+
+type ListAreasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAreasRequest) Reset() {
+	*x = ListAreasRequest{}
+	mi := &file_reorg_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAreasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAreasRequest) ProtoMessage() {}
+
+func (x *ListAreasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAreasRequest.ProtoReflect.Descriptor instead.
+func (*ListAreasRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{13}
+}
+
+type ListAreasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Areas         []*Area                `protobuf:"bytes,1,rep,name=areas,proto3" json:"areas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAreasResponse) Reset() {
+	*x = ListAreasResponse{}
+	mi := &file_reorg_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAreasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAreasResponse) ProtoMessage() {}
+
+func (x *ListAreasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAreasResponse.ProtoReflect.Descriptor instead.
+func (*ListAreasResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListAreasResponse) GetAreas() []*Area {
+	if x != nil {
+		return x.Areas
+	}
+	return nil
+}
+
+type UpdateAreaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Area          *Area                  `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAreaRequest) Reset() {
+	*x = UpdateAreaRequest{}
+	mi := &file_reorg_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAreaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAreaRequest) ProtoMessage() {}
+
+func (x *UpdateAreaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAreaRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAreaRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateAreaRequest) GetArea() *Area {
+	if x != nil {
+		return x.Area
+	}
+	return nil
+}
+
+type UpdateAreaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Area          *Area                  `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAreaResponse) Reset() {
+	*x = UpdateAreaResponse{}
+	mi := &file_reorg_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAreaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAreaResponse) ProtoMessage() {}
+
+func (x *UpdateAreaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAreaResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAreaResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateAreaResponse) GetArea() *Area {
+	if x != nil {
+		return x.Area
+	}
+	return nil
+}
+
+type DeleteAreaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAreaRequest) Reset() {
+	*x = DeleteAreaRequest{}
+	mi := &file_reorg_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAreaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAreaRequest) ProtoMessage() {}
+
+func (x *DeleteAreaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAreaRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAreaRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteAreaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteAreaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAreaResponse) Reset() {
+	*x = DeleteAreaResponse{}
+	mi := &file_reorg_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAreaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAreaResponse) ProtoMessage() {}
+
+func (x *DeleteAreaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAreaResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAreaResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{18}
+}
+
+type CreateProjectRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	AreaId          string                 `protobuf:"bytes,2,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
+	Content         string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Tags            []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	DueDate         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Notify          string                 `protobuf:"bytes,6,opt,name=notify,proto3" json:"notify,omitempty"`
+	Metadata        map[string]string      `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExternalRef     *ExternalRef           `protobuf:"bytes,8,opt,name=external_ref,json=externalRef,proto3" json:"external_ref,omitempty"`
+	GoalId          string                 `protobuf:"bytes,9,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	ParentProjectId string                 `protobuf:"bytes,10,opt,name=parent_project_id,json=parentProjectId,proto3" json:"parent_project_id,omitempty"`
+	Priority        Priority               `protobuf:"varint,11,opt,name=priority,proto3,enum=reorg.v1.Priority" json:"priority,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateProjectRequest) Reset() {
+	*x = CreateProjectRequest{}
+	mi := &file_reorg_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectRequest) ProtoMessage() {}
+
+func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateProjectRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetAreaId() string {
+	if x != nil {
+		return x.AreaId
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateProjectRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *CreateProjectRequest) GetNotify() string {
+	if x != nil {
+		return x.Notify
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreateProjectRequest) GetExternalRef() *ExternalRef {
+	if x != nil {
+		return x.ExternalRef
+	}
+	return nil
+}
+
+func (x *CreateProjectRequest) GetGoalId() string {
+	if x != nil {
+		return x.GoalId
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetParentProjectId() string {
+	if x != nil {
+		return x.ParentProjectId
+	}
+	return ""
+}
+
+func (x *CreateProjectRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+type CreateProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProjectResponse) Reset() {
+	*x = CreateProjectResponse{}
+	mi := &file_reorg_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProjectResponse) ProtoMessage() {}
+
+func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
+func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type GetProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectRequest) Reset() {
+	*x = GetProjectRequest{}
+	mi := &file_reorg_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectRequest) ProtoMessage() {}
+
+func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetProjectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectResponse) Reset() {
+	*x = GetProjectResponse{}
+	mi := &file_reorg_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectResponse) ProtoMessage() {}
+
+func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type ListProjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AreaId        string                 `protobuf:"bytes,1,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"` // Optional: filter by area
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`                 // Optional: query expression, e.g. "status:active tag:client"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsRequest) Reset() {
+	*x = ListProjectsRequest{}
+	mi := &file_reorg_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsRequest) ProtoMessage() {}
+
+func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListProjectsRequest) GetAreaId() string {
+	if x != nil {
+		return x.AreaId
+	}
+	return ""
+}
+
+func (x *ListProjectsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type ListProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsResponse) Reset() {
+	*x = ListProjectsResponse{}
+	mi := &file_reorg_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsResponse) ProtoMessage() {}
+
+func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+type UpdateProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectRequest) Reset() {
+	*x = UpdateProjectRequest{}
+	mi := &file_reorg_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectRequest) ProtoMessage() {}
+
+func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateProjectRequest) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type UpdateProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProjectResponse) Reset() {
+	*x = UpdateProjectResponse{}
+	mi := &file_reorg_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProjectResponse) ProtoMessage() {}
+
+func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProjectResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type DeleteProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectRequest) Reset() {
+	*x = DeleteProjectRequest{}
+	mi := &file_reorg_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectRequest) ProtoMessage() {}
+
+func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteProjectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectResponse) Reset() {
+	*x = DeleteProjectResponse{}
+	mi := &file_reorg_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectResponse) ProtoMessage() {}
+
+func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{28}
+}
+
+type CompleteProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteProjectRequest) Reset() {
+	*x = CompleteProjectRequest{}
+	mi := &file_reorg_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteProjectRequest) ProtoMessage() {}
+
+func (x *CompleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*CompleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CompleteProjectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CompleteProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteProjectResponse) Reset() {
+	*x = CompleteProjectResponse{}
+	mi := &file_reorg_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteProjectResponse) ProtoMessage() {}
+
+func (x *CompleteProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteProjectResponse.ProtoReflect.Descriptor instead.
+func (*CompleteProjectResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CompleteProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type MoveProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AreaId        string                 `protobuf:"bytes,2,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveProjectRequest) Reset() {
+	*x = MoveProjectRequest{}
+	mi := &file_reorg_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveProjectRequest) ProtoMessage() {}
+
+func (x *MoveProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveProjectRequest.ProtoReflect.Descriptor instead.
+func (*MoveProjectRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MoveProjectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveProjectRequest) GetAreaId() string {
+	if x != nil {
+		return x.AreaId
+	}
+	return ""
+}
+
+type MoveProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveProjectResponse) Reset() {
+	*x = MoveProjectResponse{}
+	mi := &file_reorg_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveProjectResponse) ProtoMessage() {}
+
+func (x *MoveProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveProjectResponse.ProtoReflect.Descriptor instead.
+func (*MoveProjectResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MoveProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type FindProjectByExternalRefRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindProjectByExternalRefRequest) Reset() {
+	*x = FindProjectByExternalRefRequest{}
+	mi := &file_reorg_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindProjectByExternalRefRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindProjectByExternalRefRequest) ProtoMessage() {}
+
+func (x *FindProjectByExternalRefRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindProjectByExternalRefRequest.ProtoReflect.Descriptor instead.
+func (*FindProjectByExternalRefRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FindProjectByExternalRefRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *FindProjectByExternalRefRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type FindProjectByExternalRefResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindProjectByExternalRefResponse) Reset() {
+	*x = FindProjectByExternalRefResponse{}
+	mi := &file_reorg_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindProjectByExternalRefResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindProjectByExternalRefResponse) ProtoMessage() {}
+
+func (x *FindProjectByExternalRefResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindProjectByExternalRefResponse.ProtoReflect.Descriptor instead.
+func (*FindProjectByExternalRefResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FindProjectByExternalRefResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type CreateTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AreaId        string                 `protobuf:"bytes,3,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Priority      Priority               `protobuf:"varint,5,opt,name=priority,proto3,enum=reorg.v1.Priority" json:"priority,omitempty"`
+	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExternalRef   *ExternalRef           `protobuf:"bytes,9,opt,name=external_ref,json=externalRef,proto3" json:"external_ref,omitempty"`
+	Context       string                 `protobuf:"bytes,10,opt,name=context,proto3" json:"context,omitempty"`
+	Effort        string                 `protobuf:"bytes,11,opt,name=effort,proto3" json:"effort,omitempty"`
+	ScheduledDate *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"`
+	AllDay        bool                   `protobuf:"varint,13,opt,name=all_day,json=allDay,proto3" json:"all_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskRequest) Reset() {
+	*x = CreateTaskRequest{}
+	mi := &file_reorg_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskRequest) ProtoMessage() {}
+
+func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateTaskRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetAreaId() string {
+	if x != nil {
+		return x.AreaId
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *CreateTaskRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetExternalRef() *ExternalRef {
+	if x != nil {
+		return x.ExternalRef
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetEffort() string {
+	if x != nil {
+		return x.Effort
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetScheduledDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledDate
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetAllDay() bool {
+	if x != nil {
+		return x.AllDay
+	}
+	return false
+}
+
+type CreateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskResponse) Reset() {
+	*x = CreateTaskResponse{}
+	mi := &file_reorg_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskResponse) ProtoMessage() {}
+
+func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type GetTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskRequest) Reset() {
+	*x = GetTaskRequest{}
+	mi := &file_reorg_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskRequest) ProtoMessage() {}
+
+func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskResponse) Reset() {
+	*x = GetTaskResponse{}
+	mi := &file_reorg_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskResponse) ProtoMessage() {}
+
+func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type ListTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"` // Optional: filter by project
+	AreaId        string                 `protobuf:"bytes,2,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`          // Optional: filter by area
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`                          // Optional: query expression, e.g. "status:pending priority>=high"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_reorg_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListTasksRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetAreaId() string {
+	if x != nil {
+		return x.AreaId
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type ListTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_reorg_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type UpdateTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_reorg_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateTaskRequest) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type UpdateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskResponse) Reset() {
+	*x = UpdateTaskResponse{}
+	mi := &file_reorg_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskResponse) ProtoMessage() {}
+
+func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdateTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type DeleteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_reorg_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskRequest) ProtoMessage() {}
+
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeleteTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskResponse) Reset() {
+	*x = DeleteTaskResponse{}
+	mi := &file_reorg_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskResponse) ProtoMessage() {}
+
+func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{44}
+}
+
+type StartTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTaskRequest) Reset() {
+	*x = StartTaskRequest{}
+	mi := &file_reorg_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTaskRequest) ProtoMessage() {}
+
+func (x *StartTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTaskRequest.ProtoReflect.Descriptor instead.
+func (*StartTaskRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *StartTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StartTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTaskResponse) Reset() {
+	*x = StartTaskResponse{}
+	mi := &file_reorg_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTaskResponse) ProtoMessage() {}
+
+func (x *StartTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTaskResponse.ProtoReflect.Descriptor instead.
+func (*StartTaskResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *StartTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type CompleteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTaskRequest) Reset() {
+	*x = CompleteTaskRequest{}
+	mi := &file_reorg_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTaskRequest) ProtoMessage() {}
+
+func (x *CompleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*CompleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CompleteTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CompleteTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTaskResponse) Reset() {
+	*x = CompleteTaskResponse{}
+	mi := &file_reorg_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTaskResponse) ProtoMessage() {}
+
+func (x *CompleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*CompleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CompleteTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type MoveTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTaskRequest) Reset() {
+	*x = MoveTaskRequest{}
+	mi := &file_reorg_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTaskRequest) ProtoMessage() {}
+
+func (x *MoveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTaskRequest.ProtoReflect.Descriptor instead.
+func (*MoveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MoveTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveTaskRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type MoveTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTaskResponse) Reset() {
+	*x = MoveTaskResponse{}
+	mi := &file_reorg_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTaskResponse) ProtoMessage() {}
+
+func (x *MoveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTaskResponse.ProtoReflect.Descriptor instead.
+func (*MoveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MoveTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type StartTaskTimerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTaskTimerRequest) Reset() {
+	*x = StartTaskTimerRequest{}
+	mi := &file_reorg_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTaskTimerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTaskTimerRequest) ProtoMessage() {}
+
+func (x *StartTaskTimerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTaskTimerRequest.ProtoReflect.Descriptor instead.
+func (*StartTaskTimerRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *StartTaskTimerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StartTaskTimerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTaskTimerResponse) Reset() {
+	*x = StartTaskTimerResponse{}
+	mi := &file_reorg_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTaskTimerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTaskTimerResponse) ProtoMessage() {}
+
+func (x *StartTaskTimerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTaskTimerResponse.ProtoReflect.Descriptor instead.
+func (*StartTaskTimerResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *StartTaskTimerResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type StopTaskTimerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopTaskTimerRequest) Reset() {
+	*x = StopTaskTimerRequest{}
+	mi := &file_reorg_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopTaskTimerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopTaskTimerRequest) ProtoMessage() {}
+
+func (x *StopTaskTimerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopTaskTimerRequest.ProtoReflect.Descriptor instead.
+func (*StopTaskTimerRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *StopTaskTimerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type StopTaskTimerResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Task           *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	ElapsedSeconds int64                  `protobuf:"varint,2,opt,name=elapsed_seconds,json=elapsedSeconds,proto3" json:"elapsed_seconds,omitempty"` // Length of the session that was stopped
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StopTaskTimerResponse) Reset() {
+	*x = StopTaskTimerResponse{}
+	mi := &file_reorg_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopTaskTimerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopTaskTimerResponse) ProtoMessage() {}
+
+func (x *StopTaskTimerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopTaskTimerResponse.ProtoReflect.Descriptor instead.
+func (*StopTaskTimerResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *StopTaskTimerResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *StopTaskTimerResponse) GetElapsedSeconds() int64 {
+	if x != nil {
+		return x.ElapsedSeconds
+	}
+	return 0
+}
+
+type TaskFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AreaId        string                 `protobuf:"bytes,2,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
+	Status        TaskStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=reorg.v1.TaskStatus" json:"status,omitempty"`   // Unspecified matches any status
+	Priority      Priority               `protobuf:"varint,4,opt,name=priority,proto3,enum=reorg.v1.Priority" json:"priority,omitempty"` // Unspecified matches any priority
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`                                 // Tasks must have all tags
+	Overdue       *bool                  `protobuf:"varint,6,opt,name=overdue,proto3,oneof" json:"overdue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskFilter) Reset() {
+	*x = TaskFilter{}
+	mi := &file_reorg_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAreaRequest) GetTitle() string {
+func (x *TaskFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskFilter) ProtoMessage() {}
+
+func (x *TaskFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[55]
 	if x != nil {
-		return x.Title
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskFilter.ProtoReflect.Descriptor instead.
+func (*TaskFilter) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TaskFilter) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *CreateAreaRequest) GetContent() string {
+func (x *TaskFilter) GetAreaId() string {
 	if x != nil {
-		return x.Content
+		return x.AreaId
 	}
 	return ""
 }
 
-func (x *CreateAreaRequest) GetTags() []string {
+func (x *TaskFilter) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *TaskFilter) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *TaskFilter) GetTags() []string {
 	if x != nil {
 		return x.Tags
 	}
 	return nil
 }
 
-type CreateAreaResponse struct {
+func (x *TaskFilter) GetOverdue() bool {
+	if x != nil && x.Overdue != nil {
+		return *x.Overdue
+	}
+	return false
+}
+
+type TaskUpdate struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Status             TaskStatus             `protobuf:"varint,1,opt,name=status,proto3,enum=reorg.v1.TaskStatus" json:"status,omitempty"`   // Unspecified leaves status unchanged
+	Priority           Priority               `protobuf:"varint,2,opt,name=priority,proto3,enum=reorg.v1.Priority" json:"priority,omitempty"` // Unspecified leaves priority unchanged
+	ProjectId          string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`      // Empty leaves the task in its project
+	DueDate            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	ClearDueDate       bool                   `protobuf:"varint,5,opt,name=clear_due_date,json=clearDueDate,proto3" json:"clear_due_date,omitempty"`
+	AddTags            []string               `protobuf:"bytes,6,rep,name=add_tags,json=addTags,proto3" json:"add_tags,omitempty"`
+	RemoveTags         []string               `protobuf:"bytes,7,rep,name=remove_tags,json=removeTags,proto3" json:"remove_tags,omitempty"`
+	Metadata           map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Empty values remove the key
+	Context            *string                `protobuf:"bytes,9,opt,name=context,proto3,oneof" json:"context,omitempty"`                                                                       // Unset leaves it unchanged, empty clears it
+	Effort             *string                `protobuf:"bytes,10,opt,name=effort,proto3,oneof" json:"effort,omitempty"`                                                                        // Unset leaves it unchanged, empty clears it
+	ScheduledDate      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"`
+	ClearScheduledDate bool                   `protobuf:"varint,12,opt,name=clear_scheduled_date,json=clearScheduledDate,proto3" json:"clear_scheduled_date,omitempty"`
+	DueAllDay          bool                   `protobuf:"varint,13,opt,name=due_all_day,json=dueAllDay,proto3" json:"due_all_day,omitempty"` // due_date is a whole day rather than a moment
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TaskUpdate) Reset() {
+	*x = TaskUpdate{}
+	mi := &file_reorg_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskUpdate) ProtoMessage() {}
+
+func (x *TaskUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskUpdate.ProtoReflect.Descriptor instead.
+func (*TaskUpdate) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TaskUpdate) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *TaskUpdate) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *TaskUpdate) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *TaskUpdate) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *TaskUpdate) GetClearDueDate() bool {
+	if x != nil {
+		return x.ClearDueDate
+	}
+	return false
+}
+
+func (x *TaskUpdate) GetAddTags() []string {
+	if x != nil {
+		return x.AddTags
+	}
+	return nil
+}
+
+func (x *TaskUpdate) GetRemoveTags() []string {
+	if x != nil {
+		return x.RemoveTags
+	}
+	return nil
+}
+
+func (x *TaskUpdate) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *TaskUpdate) GetContext() string {
+	if x != nil && x.Context != nil {
+		return *x.Context
+	}
+	return ""
+}
+
+func (x *TaskUpdate) GetEffort() string {
+	if x != nil && x.Effort != nil {
+		return *x.Effort
+	}
+	return ""
+}
+
+func (x *TaskUpdate) GetScheduledDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledDate
+	}
+	return nil
+}
+
+func (x *TaskUpdate) GetClearScheduledDate() bool {
+	if x != nil {
+		return x.ClearScheduledDate
+	}
+	return false
+}
+
+func (x *TaskUpdate) GetDueAllDay() bool {
+	if x != nil {
+		return x.DueAllDay
+	}
+	return false
+}
+
+type BulkUpdateTasksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Area          *Area                  `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	Filter        *TaskFilter            `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Update        *TaskUpdate            `protobuf:"bytes,2,opt,name=update,proto3" json:"update,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAreaResponse) Reset() {
-	*x = CreateAreaResponse{}
-	mi := &file_reorg_proto_msgTypes[4]
+func (x *BulkUpdateTasksRequest) Reset() {
+	*x = BulkUpdateTasksRequest{}
+	mi := &file_reorg_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateTasksRequest) ProtoMessage() {}
+
+func (x *BulkUpdateTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateTasksRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateTasksRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *BulkUpdateTasksRequest) GetFilter() *TaskFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *BulkUpdateTasksRequest) GetUpdate() *TaskUpdate {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+type BulkUpdateTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateTasksResponse) Reset() {
+	*x = BulkUpdateTasksResponse{}
+	mi := &file_reorg_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateTasksResponse) ProtoMessage() {}
+
+func (x *BulkUpdateTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateTasksResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateTasksResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *BulkUpdateTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type BatchCreateTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"` // IDs are kept, or generated when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateTasksRequest) Reset() {
+	*x = BatchCreateTasksRequest{}
+	mi := &file_reorg_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateTasksRequest) ProtoMessage() {}
+
+func (x *BatchCreateTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateTasksRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateTasksRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *BatchCreateTasksRequest) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type BatchCreateTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchTaskResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // In the order of the request
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateTasksResponse) Reset() {
+	*x = BatchCreateTasksResponse{}
+	mi := &file_reorg_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateTasksResponse) ProtoMessage() {}
+
+func (x *BatchCreateTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateTasksResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateTasksResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *BatchCreateTasksResponse) GetResults() []*BatchTaskResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchUpdateTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateTasksRequest) Reset() {
+	*x = BatchUpdateTasksRequest{}
+	mi := &file_reorg_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAreaResponse) String() string {
+func (x *BatchUpdateTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAreaResponse) ProtoMessage() {}
+func (*BatchUpdateTasksRequest) ProtoMessage() {}
 
-func (x *CreateAreaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[4]
+func (x *BatchUpdateTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -655,40 +3996,40 @@ func (x *CreateAreaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAreaResponse.ProtoReflect.Descriptor instead.
-func (*CreateAreaResponse) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use BatchUpdateTasksRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateTasksRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *CreateAreaResponse) GetArea() *Area {
+func (x *BatchUpdateTasksRequest) GetTasks() []*Task {
 	if x != nil {
-		return x.Area
+		return x.Tasks
 	}
 	return nil
 }
 
-type GetAreaRequest struct {
+type BatchUpdateTasksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Results       []*BatchTaskResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // In the order of the request
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAreaRequest) Reset() {
-	*x = GetAreaRequest{}
-	mi := &file_reorg_proto_msgTypes[5]
+func (x *BatchUpdateTasksResponse) Reset() {
+	*x = BatchUpdateTasksResponse{}
+	mi := &file_reorg_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAreaRequest) String() string {
+func (x *BatchUpdateTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAreaRequest) ProtoMessage() {}
+func (*BatchUpdateTasksResponse) ProtoMessage() {}
 
-func (x *GetAreaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[5]
+func (x *BatchUpdateTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -699,40 +4040,42 @@ func (x *GetAreaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAreaRequest.ProtoReflect.Descriptor instead.
-func (*GetAreaRequest) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use BatchUpdateTasksResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateTasksResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetAreaRequest) GetId() string {
+func (x *BatchUpdateTasksResponse) GetResults() []*BatchTaskResult {
 	if x != nil {
-		return x.Id
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-type GetAreaResponse struct {
+// The outcome for one task of a batch call
+type BatchTaskResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Area          *Area                  `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`   // The task as saved, unless it failed
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // Why the task wasn't saved
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAreaResponse) Reset() {
-	*x = GetAreaResponse{}
-	mi := &file_reorg_proto_msgTypes[6]
+func (x *BatchTaskResult) Reset() {
+	*x = BatchTaskResult{}
+	mi := &file_reorg_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAreaResponse) String() string {
+func (x *BatchTaskResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAreaResponse) ProtoMessage() {}
+func (*BatchTaskResult) ProtoMessage() {}
 
-func (x *GetAreaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[6]
+func (x *BatchTaskResult) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,39 +4086,48 @@ func (x *GetAreaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAreaResponse.ProtoReflect.Descriptor instead.
-func (*GetAreaResponse) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use BatchTaskResult.ProtoReflect.Descriptor instead.
+func (*BatchTaskResult) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetAreaResponse) GetArea() *Area {
+func (x *BatchTaskResult) GetTask() *Task {
 	if x != nil {
-		return x.Area
+		return x.Task
 	}
 	return nil
 }
 
-type ListAreasRequest struct {
+func (x *BatchTaskResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AttachToTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Attachment    string                 `protobuf:"bytes,2,opt,name=attachment,proto3" json:"attachment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAreasRequest) Reset() {
-	*x = ListAreasRequest{}
-	mi := &file_reorg_proto_msgTypes[7]
+func (x *AttachToTaskRequest) Reset() {
+	*x = AttachToTaskRequest{}
+	mi := &file_reorg_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAreasRequest) String() string {
+func (x *AttachToTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAreasRequest) ProtoMessage() {}
+func (*AttachToTaskRequest) ProtoMessage() {}
 
-func (x *ListAreasRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[7]
+func (x *AttachToTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,33 +4138,47 @@ func (x *ListAreasRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAreasRequest.ProtoReflect.Descriptor instead.
-func (*ListAreasRequest) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use AttachToTaskRequest.ProtoReflect.Descriptor instead.
+func (*AttachToTaskRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{64}
 }
 
-type ListAreasResponse struct {
+func (x *AttachToTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttachToTaskRequest) GetAttachment() string {
+	if x != nil {
+		return x.Attachment
+	}
+	return ""
+}
+
+type AttachToTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Areas         []*Area                `protobuf:"bytes,1,rep,name=areas,proto3" json:"areas,omitempty"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAreasResponse) Reset() {
-	*x = ListAreasResponse{}
-	mi := &file_reorg_proto_msgTypes[8]
+func (x *AttachToTaskResponse) Reset() {
+	*x = AttachToTaskResponse{}
+	mi := &file_reorg_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAreasResponse) String() string {
+func (x *AttachToTaskResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAreasResponse) ProtoMessage() {}
+func (*AttachToTaskResponse) ProtoMessage() {}
 
-func (x *ListAreasResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[8]
+func (x *AttachToTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,40 +4189,42 @@ func (x *ListAreasResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAreasResponse.ProtoReflect.Descriptor instead.
-func (*ListAreasResponse) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use AttachToTaskResponse.ProtoReflect.Descriptor instead.
+func (*AttachToTaskResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ListAreasResponse) GetAreas() []*Area {
+func (x *AttachToTaskResponse) GetTask() *Task {
 	if x != nil {
-		return x.Areas
+		return x.Task
 	}
 	return nil
 }
 
-type UpdateAreaRequest struct {
+type AddTaskCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Area          *Area                  `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Author        string                 `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAreaRequest) Reset() {
-	*x = UpdateAreaRequest{}
-	mi := &file_reorg_proto_msgTypes[9]
+func (x *AddTaskCommentRequest) Reset() {
+	*x = AddTaskCommentRequest{}
+	mi := &file_reorg_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAreaRequest) String() string {
+func (x *AddTaskCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAreaRequest) ProtoMessage() {}
+func (*AddTaskCommentRequest) ProtoMessage() {}
 
-func (x *UpdateAreaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[9]
+func (x *AddTaskCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -867,40 +4235,54 @@ func (x *UpdateAreaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAreaRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAreaRequest) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use AddTaskCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddTaskCommentRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *UpdateAreaRequest) GetArea() *Area {
+func (x *AddTaskCommentRequest) GetId() string {
 	if x != nil {
-		return x.Area
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type UpdateAreaResponse struct {
+func (x *AddTaskCommentRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *AddTaskCommentRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type AddTaskCommentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Area          *Area                  `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAreaResponse) Reset() {
-	*x = UpdateAreaResponse{}
-	mi := &file_reorg_proto_msgTypes[10]
+func (x *AddTaskCommentResponse) Reset() {
+	*x = AddTaskCommentResponse{}
+	mi := &file_reorg_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAreaResponse) String() string {
+func (x *AddTaskCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAreaResponse) ProtoMessage() {}
+func (*AddTaskCommentResponse) ProtoMessage() {}
 
-func (x *UpdateAreaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[10]
+func (x *AddTaskCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -911,40 +4293,41 @@ func (x *UpdateAreaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAreaResponse.ProtoReflect.Descriptor instead.
-func (*UpdateAreaResponse) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use AddTaskCommentResponse.ProtoReflect.Descriptor instead.
+func (*AddTaskCommentResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *UpdateAreaResponse) GetArea() *Area {
+func (x *AddTaskCommentResponse) GetTask() *Task {
 	if x != nil {
-		return x.Area
+		return x.Task
 	}
 	return nil
 }
 
-type DeleteAreaRequest struct {
+type FindTaskByExternalRefRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAreaRequest) Reset() {
-	*x = DeleteAreaRequest{}
-	mi := &file_reorg_proto_msgTypes[11]
+func (x *FindTaskByExternalRefRequest) Reset() {
+	*x = FindTaskByExternalRefRequest{}
+	mi := &file_reorg_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAreaRequest) String() string {
+func (x *FindTaskByExternalRefRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAreaRequest) ProtoMessage() {}
+func (*FindTaskByExternalRefRequest) ProtoMessage() {}
 
-func (x *DeleteAreaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[11]
+func (x *FindTaskByExternalRefRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -955,39 +4338,47 @@ func (x *DeleteAreaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAreaRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAreaRequest) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use FindTaskByExternalRefRequest.ProtoReflect.Descriptor instead.
+func (*FindTaskByExternalRefRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *DeleteAreaRequest) GetId() string {
+func (x *FindTaskByExternalRefRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *FindTaskByExternalRefRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteAreaResponse struct {
+type FindTaskByExternalRefResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAreaResponse) Reset() {
-	*x = DeleteAreaResponse{}
-	mi := &file_reorg_proto_msgTypes[12]
+func (x *FindTaskByExternalRefResponse) Reset() {
+	*x = FindTaskByExternalRefResponse{}
+	mi := &file_reorg_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAreaResponse) String() string {
+func (x *FindTaskByExternalRefResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAreaResponse) ProtoMessage() {}
+func (*FindTaskByExternalRefResponse) ProtoMessage() {}
 
-func (x *DeleteAreaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[12]
+func (x *FindTaskByExternalRefResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -998,37 +4389,40 @@ func (x *DeleteAreaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAreaResponse.ProtoReflect.Descriptor instead.
-func (*DeleteAreaResponse) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use FindTaskByExternalRefResponse.ProtoReflect.Descriptor instead.
+func (*FindTaskByExternalRefResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{69}
 }
 
-type CreateProjectRequest struct {
+func (x *FindTaskByExternalRefResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type UpsertTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	AreaId        string                 `protobuf:"bytes,2,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"` // Must have an external_ref; the ID is ignored
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProjectRequest) Reset() {
-	*x = CreateProjectRequest{}
-	mi := &file_reorg_proto_msgTypes[13]
+func (x *UpsertTaskRequest) Reset() {
+	*x = UpsertTaskRequest{}
+	mi := &file_reorg_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProjectRequest) String() string {
+func (x *UpsertTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProjectRequest) ProtoMessage() {}
+func (*UpsertTaskRequest) ProtoMessage() {}
 
-func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[13]
+func (x *UpsertTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1039,68 +4433,41 @@ func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
-func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *CreateProjectRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *CreateProjectRequest) GetAreaId() string {
-	if x != nil {
-		return x.AreaId
-	}
-	return ""
-}
-
-func (x *CreateProjectRequest) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
-}
-
-func (x *CreateProjectRequest) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
+// Deprecated: Use UpsertTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpsertTaskRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *CreateProjectRequest) GetDueDate() *timestamppb.Timestamp {
+func (x *UpsertTaskRequest) GetTask() *Task {
 	if x != nil {
-		return x.DueDate
+		return x.Task
 	}
 	return nil
 }
 
-type CreateProjectResponse struct {
+type UpsertTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Created       bool                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProjectResponse) Reset() {
-	*x = CreateProjectResponse{}
-	mi := &file_reorg_proto_msgTypes[14]
+func (x *UpsertTaskResponse) Reset() {
+	*x = UpsertTaskResponse{}
+	mi := &file_reorg_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProjectResponse) String() string {
+func (x *UpsertTaskResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProjectResponse) ProtoMessage() {}
+func (*UpsertTaskResponse) ProtoMessage() {}
 
-func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[14]
+func (x *UpsertTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,40 +4478,48 @@ func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
-func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use UpsertTaskResponse.ProtoReflect.Descriptor instead.
+func (*UpsertTaskResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *CreateProjectResponse) GetProject() *Project {
+func (x *UpsertTaskResponse) GetTask() *Task {
 	if x != nil {
-		return x.Project
+		return x.Task
 	}
 	return nil
 }
 
-type GetProjectRequest struct {
+func (x *UpsertTaskResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type AddNoteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId      string                 `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProjectRequest) Reset() {
-	*x = GetProjectRequest{}
-	mi := &file_reorg_proto_msgTypes[15]
+func (x *AddNoteRequest) Reset() {
+	*x = AddNoteRequest{}
+	mi := &file_reorg_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProjectRequest) String() string {
+func (x *AddNoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProjectRequest) ProtoMessage() {}
+func (*AddNoteRequest) ProtoMessage() {}
 
-func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[15]
+func (x *AddNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1155,40 +4530,47 @@ func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
-func (*GetProjectRequest) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use AddNoteRequest.ProtoReflect.Descriptor instead.
+func (*AddNoteRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *GetProjectRequest) GetId() string {
+func (x *AddNoteRequest) GetParentId() string {
 	if x != nil {
-		return x.Id
+		return x.ParentId
 	}
 	return ""
 }
 
-type GetProjectResponse struct {
+func (x *AddNoteRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type AddNoteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Note          *Note                  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProjectResponse) Reset() {
-	*x = GetProjectResponse{}
-	mi := &file_reorg_proto_msgTypes[16]
+func (x *AddNoteResponse) Reset() {
+	*x = AddNoteResponse{}
+	mi := &file_reorg_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProjectResponse) String() string {
+func (x *AddNoteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProjectResponse) ProtoMessage() {}
+func (*AddNoteResponse) ProtoMessage() {}
 
-func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[16]
+func (x *AddNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1199,40 +4581,41 @@ func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProjectResponse.ProtoReflect.Descriptor instead.
-func (*GetProjectResponse) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use AddNoteResponse.ProtoReflect.Descriptor instead.
+func (*AddNoteResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetProjectResponse) GetProject() *Project {
+func (x *AddNoteResponse) GetNote() *Note {
 	if x != nil {
-		return x.Project
+		return x.Note
 	}
 	return nil
 }
 
-type ListProjectsRequest struct {
+type ListNotesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AreaId        string                 `protobuf:"bytes,1,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"` // Optional: filter by area
+	ParentId      string                 `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // Notes of one project or task
+	Search        string                 `protobuf:"bytes,2,opt,name=search,proto3" json:"search,omitempty"`                     // Or every note containing this text
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProjectsRequest) Reset() {
-	*x = ListProjectsRequest{}
-	mi := &file_reorg_proto_msgTypes[17]
+func (x *ListNotesRequest) Reset() {
+	*x = ListNotesRequest{}
+	mi := &file_reorg_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProjectsRequest) String() string {
+func (x *ListNotesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectsRequest) ProtoMessage() {}
+func (*ListNotesRequest) ProtoMessage() {}
 
-func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[17]
+func (x *ListNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1243,40 +4626,47 @@ func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
-func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListNotesRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *ListProjectsRequest) GetAreaId() string {
+func (x *ListNotesRequest) GetParentId() string {
 	if x != nil {
-		return x.AreaId
+		return x.ParentId
 	}
 	return ""
 }
 
-type ListProjectsResponse struct {
+func (x *ListNotesRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+type ListNotesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	Notes         []*Note                `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProjectsResponse) Reset() {
-	*x = ListProjectsResponse{}
-	mi := &file_reorg_proto_msgTypes[18]
+func (x *ListNotesResponse) Reset() {
+	*x = ListNotesResponse{}
+	mi := &file_reorg_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProjectsResponse) String() string {
+func (x *ListNotesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectsResponse) ProtoMessage() {}
+func (*ListNotesResponse) ProtoMessage() {}
 
-func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[18]
+func (x *ListNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,40 +4677,40 @@ func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
-func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListNotesResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *ListProjectsResponse) GetProjects() []*Project {
+func (x *ListNotesResponse) GetNotes() []*Note {
 	if x != nil {
-		return x.Projects
+		return x.Notes
 	}
 	return nil
 }
 
-type UpdateProjectRequest struct {
+type DeleteNoteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProjectRequest) Reset() {
-	*x = UpdateProjectRequest{}
-	mi := &file_reorg_proto_msgTypes[19]
+func (x *DeleteNoteRequest) Reset() {
+	*x = DeleteNoteRequest{}
+	mi := &file_reorg_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProjectRequest) String() string {
+func (x *DeleteNoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProjectRequest) ProtoMessage() {}
+func (*DeleteNoteRequest) ProtoMessage() {}
 
-func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[19]
+func (x *DeleteNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1331,40 +4721,39 @@ func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use DeleteNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNoteRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *UpdateProjectRequest) GetProject() *Project {
+func (x *DeleteNoteRequest) GetId() string {
 	if x != nil {
-		return x.Project
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type UpdateProjectResponse struct {
+type DeleteNoteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProjectResponse) Reset() {
-	*x = UpdateProjectResponse{}
-	mi := &file_reorg_proto_msgTypes[20]
+func (x *DeleteNoteResponse) Reset() {
+	*x = DeleteNoteResponse{}
+	mi := &file_reorg_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProjectResponse) String() string {
+func (x *DeleteNoteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProjectResponse) ProtoMessage() {}
+func (*DeleteNoteResponse) ProtoMessage() {}
 
-func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[20]
+func (x *DeleteNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1375,40 +4764,38 @@ func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProjectResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *UpdateProjectResponse) GetProject() *Project {
-	if x != nil {
-		return x.Project
-	}
-	return nil
+// Deprecated: Use DeleteNoteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNoteResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{77}
 }
 
-type DeleteProjectRequest struct {
+type CreateGoalRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Quarter       string                 `protobuf:"bytes,3,opt,name=quarter,proto3" json:"quarter,omitempty"`
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProjectRequest) Reset() {
-	*x = DeleteProjectRequest{}
-	mi := &file_reorg_proto_msgTypes[21]
+func (x *CreateGoalRequest) Reset() {
+	*x = CreateGoalRequest{}
+	mi := &file_reorg_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProjectRequest) String() string {
+func (x *CreateGoalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProjectRequest) ProtoMessage() {}
+func (*CreateGoalRequest) ProtoMessage() {}
 
-func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[21]
+func (x *CreateGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1419,39 +4806,75 @@ func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use CreateGoalRequest.ProtoReflect.Descriptor instead.
+func (*CreateGoalRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *DeleteProjectRequest) GetId() string {
+func (x *CreateGoalRequest) GetTitle() string {
 	if x != nil {
-		return x.Id
+		return x.Title
 	}
 	return ""
 }
 
-type DeleteProjectResponse struct {
+func (x *CreateGoalRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateGoalRequest) GetQuarter() string {
+	if x != nil {
+		return x.Quarter
+	}
+	return ""
+}
+
+func (x *CreateGoalRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *CreateGoalRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateGoalRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type CreateGoalResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goal          *Goal                  `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProjectResponse) Reset() {
-	*x = DeleteProjectResponse{}
-	mi := &file_reorg_proto_msgTypes[22]
+func (x *CreateGoalResponse) Reset() {
+	*x = CreateGoalResponse{}
+	mi := &file_reorg_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProjectResponse) String() string {
+func (x *CreateGoalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProjectResponse) ProtoMessage() {}
+func (*CreateGoalResponse) ProtoMessage() {}
 
-func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[22]
+func (x *CreateGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1462,33 +4885,40 @@ func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use CreateGoalResponse.ProtoReflect.Descriptor instead.
+func (*CreateGoalResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{79}
 }
 
-type CompleteProjectRequest struct {
+func (x *CreateGoalResponse) GetGoal() *Goal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+type GetGoalRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompleteProjectRequest) Reset() {
-	*x = CompleteProjectRequest{}
-	mi := &file_reorg_proto_msgTypes[23]
+func (x *GetGoalRequest) Reset() {
+	*x = GetGoalRequest{}
+	mi := &file_reorg_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompleteProjectRequest) String() string {
+func (x *GetGoalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteProjectRequest) ProtoMessage() {}
+func (*GetGoalRequest) ProtoMessage() {}
 
-func (x *CompleteProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[23]
+func (x *GetGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1499,40 +4929,40 @@ func (x *CompleteProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteProjectRequest.ProtoReflect.Descriptor instead.
-func (*CompleteProjectRequest) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetGoalRequest.ProtoReflect.Descriptor instead.
+func (*GetGoalRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *CompleteProjectRequest) GetId() string {
+func (x *GetGoalRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type CompleteProjectResponse struct {
+type GetGoalResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Goal          *Goal                  `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompleteProjectResponse) Reset() {
-	*x = CompleteProjectResponse{}
-	mi := &file_reorg_proto_msgTypes[24]
+func (x *GetGoalResponse) Reset() {
+	*x = GetGoalResponse{}
+	mi := &file_reorg_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompleteProjectResponse) String() string {
+func (x *GetGoalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteProjectResponse) ProtoMessage() {}
+func (*GetGoalResponse) ProtoMessage() {}
 
-func (x *CompleteProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[24]
+func (x *GetGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1543,46 +4973,39 @@ func (x *CompleteProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteProjectResponse.ProtoReflect.Descriptor instead.
-func (*CompleteProjectResponse) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetGoalResponse.ProtoReflect.Descriptor instead.
+func (*GetGoalResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *CompleteProjectResponse) GetProject() *Project {
+func (x *GetGoalResponse) GetGoal() *Goal {
 	if x != nil {
-		return x.Project
+		return x.Goal
 	}
 	return nil
 }
 
-type CreateTaskRequest struct {
+type ListGoalsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	AreaId        string                 `protobuf:"bytes,3,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	Priority      Priority               `protobuf:"varint,5,opt,name=priority,proto3,enum=reorg.v1.Priority" json:"priority,omitempty"`
-	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskRequest) Reset() {
-	*x = CreateTaskRequest{}
-	mi := &file_reorg_proto_msgTypes[25]
+func (x *ListGoalsRequest) Reset() {
+	*x = ListGoalsRequest{}
+	mi := &file_reorg_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskRequest) String() string {
+func (x *ListGoalsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskRequest) ProtoMessage() {}
+func (*ListGoalsRequest) ProtoMessage() {}
 
-func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[25]
+func (x *ListGoalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1593,82 +5016,77 @@ func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
-func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ListGoalsRequest.ProtoReflect.Descriptor instead.
+func (*ListGoalsRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *CreateTaskRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+type ListGoalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goals         []*Goal                `protobuf:"bytes,1,rep,name=goals,proto3" json:"goals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskRequest) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
+func (x *ListGoalsResponse) Reset() {
+	*x = ListGoalsResponse{}
+	mi := &file_reorg_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskRequest) GetAreaId() string {
-	if x != nil {
-		return x.AreaId
-	}
-	return ""
+func (x *ListGoalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateTaskRequest) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
-}
+func (*ListGoalsResponse) ProtoMessage() {}
 
-func (x *CreateTaskRequest) GetPriority() Priority {
+func (x *ListGoalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[83]
 	if x != nil {
-		return x.Priority
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return Priority_PRIORITY_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *CreateTaskRequest) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
+// Deprecated: Use ListGoalsResponse.ProtoReflect.Descriptor instead.
+func (*ListGoalsResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *CreateTaskRequest) GetDueDate() *timestamppb.Timestamp {
+func (x *ListGoalsResponse) GetGoals() []*Goal {
 	if x != nil {
-		return x.DueDate
+		return x.Goals
 	}
 	return nil
 }
 
-type CreateTaskResponse struct {
+type UpdateGoalRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Goal          *Goal                  `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskResponse) Reset() {
-	*x = CreateTaskResponse{}
-	mi := &file_reorg_proto_msgTypes[26]
+func (x *UpdateGoalRequest) Reset() {
+	*x = UpdateGoalRequest{}
+	mi := &file_reorg_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskResponse) String() string {
+func (x *UpdateGoalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskResponse) ProtoMessage() {}
+func (*UpdateGoalRequest) ProtoMessage() {}
 
-func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[26]
+func (x *UpdateGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1679,40 +5097,40 @@ func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
-func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use UpdateGoalRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGoalRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *CreateTaskResponse) GetTask() *Task {
+func (x *UpdateGoalRequest) GetGoal() *Goal {
 	if x != nil {
-		return x.Task
+		return x.Goal
 	}
 	return nil
 }
 
-type GetTaskRequest struct {
+type UpdateGoalResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Goal          *Goal                  `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskRequest) Reset() {
-	*x = GetTaskRequest{}
-	mi := &file_reorg_proto_msgTypes[27]
+func (x *UpdateGoalResponse) Reset() {
+	*x = UpdateGoalResponse{}
+	mi := &file_reorg_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskRequest) String() string {
+func (x *UpdateGoalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskRequest) ProtoMessage() {}
+func (*UpdateGoalResponse) ProtoMessage() {}
 
-func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[27]
+func (x *UpdateGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1723,40 +5141,40 @@ func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
-func (*GetTaskRequest) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use UpdateGoalResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGoalResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *GetTaskRequest) GetId() string {
+func (x *UpdateGoalResponse) GetGoal() *Goal {
 	if x != nil {
-		return x.Id
+		return x.Goal
 	}
-	return ""
+	return nil
 }
 
-type GetTaskResponse struct {
+type DeleteGoalRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskResponse) Reset() {
-	*x = GetTaskResponse{}
-	mi := &file_reorg_proto_msgTypes[28]
+func (x *DeleteGoalRequest) Reset() {
+	*x = DeleteGoalRequest{}
+	mi := &file_reorg_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskResponse) String() string {
+func (x *DeleteGoalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskResponse) ProtoMessage() {}
+func (*DeleteGoalRequest) ProtoMessage() {}
 
-func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[28]
+func (x *DeleteGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1767,41 +5185,39 @@ func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
-func (*GetTaskResponse) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use DeleteGoalRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGoalRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *GetTaskResponse) GetTask() *Task {
+func (x *DeleteGoalRequest) GetId() string {
 	if x != nil {
-		return x.Task
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ListTasksRequest struct {
+type DeleteGoalResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"` // Optional: filter by project
-	AreaId        string                 `protobuf:"bytes,2,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`          // Optional: filter by area
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTasksRequest) Reset() {
-	*x = ListTasksRequest{}
-	mi := &file_reorg_proto_msgTypes[29]
+func (x *DeleteGoalResponse) Reset() {
+	*x = DeleteGoalResponse{}
+	mi := &file_reorg_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTasksRequest) String() string {
+func (x *DeleteGoalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTasksRequest) ProtoMessage() {}
+func (*DeleteGoalResponse) ProtoMessage() {}
 
-func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[29]
+func (x *DeleteGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1812,47 +5228,32 @@ func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
-func (*ListTasksRequest) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *ListTasksRequest) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
-}
-
-func (x *ListTasksRequest) GetAreaId() string {
-	if x != nil {
-		return x.AreaId
-	}
-	return ""
+// Deprecated: Use DeleteGoalResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGoalResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{87}
 }
 
-type ListTasksResponse struct {
+type WatchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTasksResponse) Reset() {
-	*x = ListTasksResponse{}
-	mi := &file_reorg_proto_msgTypes[30]
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	mi := &file_reorg_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTasksResponse) String() string {
+func (x *WatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTasksResponse) ProtoMessage() {}
+func (*WatchRequest) ProtoMessage() {}
 
-func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[30]
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1863,40 +5264,35 @@ func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
-func (*ListTasksResponse) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *ListTasksResponse) GetTasks() []*Task {
-	if x != nil {
-		return x.Tasks
-	}
-	return nil
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{88}
 }
 
-type UpdateTaskRequest struct {
+// A batch of edits to the data directory
+type WatchEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Paths         []string               `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"` // Relative to the data directory
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskRequest) Reset() {
-	*x = UpdateTaskRequest{}
-	mi := &file_reorg_proto_msgTypes[31]
+func (x *WatchEvent) Reset() {
+	*x = WatchEvent{}
+	mi := &file_reorg_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskRequest) String() string {
+func (x *WatchEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskRequest) ProtoMessage() {}
+func (*WatchEvent) ProtoMessage() {}
 
-func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[31]
+func (x *WatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1907,40 +5303,46 @@ func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use WatchEvent.ProtoReflect.Descriptor instead.
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *UpdateTaskRequest) GetTask() *Task {
+func (x *WatchEvent) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Task
+		return x.Time
 	}
 	return nil
 }
 
-type UpdateTaskResponse struct {
+func (x *WatchEvent) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type GetOverviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskResponse) Reset() {
-	*x = UpdateTaskResponse{}
-	mi := &file_reorg_proto_msgTypes[32]
+func (x *GetOverviewRequest) Reset() {
+	*x = GetOverviewRequest{}
+	mi := &file_reorg_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskResponse) String() string {
+func (x *GetOverviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskResponse) ProtoMessage() {}
+func (*GetOverviewRequest) ProtoMessage() {}
 
-func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[32]
+func (x *GetOverviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1951,40 +5353,33 @@ func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *UpdateTaskResponse) GetTask() *Task {
-	if x != nil {
-		return x.Task
-	}
-	return nil
+// Deprecated: Use GetOverviewRequest.ProtoReflect.Descriptor instead.
+func (*GetOverviewRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{90}
 }
 
-type DeleteTaskRequest struct {
+type GetOverviewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Areas         []*AreaOverview        `protobuf:"bytes,1,rep,name=areas,proto3" json:"areas,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskRequest) Reset() {
-	*x = DeleteTaskRequest{}
-	mi := &file_reorg_proto_msgTypes[33]
+func (x *GetOverviewResponse) Reset() {
+	*x = GetOverviewResponse{}
+	mi := &file_reorg_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskRequest) String() string {
+func (x *GetOverviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskRequest) ProtoMessage() {}
+func (*GetOverviewResponse) ProtoMessage() {}
 
-func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[33]
+func (x *GetOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1995,39 +5390,42 @@ func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use GetOverviewResponse.ProtoReflect.Descriptor instead.
+func (*GetOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *DeleteTaskRequest) GetId() string {
+func (x *GetOverviewResponse) GetAreas() []*AreaOverview {
 	if x != nil {
-		return x.Id
+		return x.Areas
 	}
-	return ""
+	return nil
 }
 
-type DeleteTaskResponse struct {
+// An area with its projects
+type AreaOverview struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Area          *Area                  `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	Projects      []*ProjectOverview     `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskResponse) Reset() {
-	*x = DeleteTaskResponse{}
-	mi := &file_reorg_proto_msgTypes[34]
+func (x *AreaOverview) Reset() {
+	*x = AreaOverview{}
+	mi := &file_reorg_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskResponse) String() string {
+func (x *AreaOverview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskResponse) ProtoMessage() {}
+func (*AreaOverview) ProtoMessage() {}
 
-func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[34]
+func (x *AreaOverview) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2038,33 +5436,49 @@ func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use AreaOverview.ProtoReflect.Descriptor instead.
+func (*AreaOverview) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{92}
 }
 
-type StartTaskRequest struct {
+func (x *AreaOverview) GetArea() *Area {
+	if x != nil {
+		return x.Area
+	}
+	return nil
+}
+
+func (x *AreaOverview) GetProjects() []*ProjectOverview {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+// A project, with its health, and its tasks
+type ProjectOverview struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Tasks         []*Task                `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartTaskRequest) Reset() {
-	*x = StartTaskRequest{}
-	mi := &file_reorg_proto_msgTypes[35]
+func (x *ProjectOverview) Reset() {
+	*x = ProjectOverview{}
+	mi := &file_reorg_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartTaskRequest) String() string {
+func (x *ProjectOverview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartTaskRequest) ProtoMessage() {}
+func (*ProjectOverview) ProtoMessage() {}
 
-func (x *StartTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[35]
+func (x *ProjectOverview) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2075,40 +5489,47 @@ func (x *StartTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartTaskRequest.ProtoReflect.Descriptor instead.
-func (*StartTaskRequest) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ProjectOverview.ProtoReflect.Descriptor instead.
+func (*ProjectOverview) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *StartTaskRequest) GetId() string {
+func (x *ProjectOverview) GetProject() *Project {
 	if x != nil {
-		return x.Id
+		return x.Project
 	}
-	return ""
+	return nil
 }
 
-type StartTaskResponse struct {
+func (x *ProjectOverview) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type ListTasksWithRefsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // Optional: query expression, e.g. "status:pending project:website"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartTaskResponse) Reset() {
-	*x = StartTaskResponse{}
-	mi := &file_reorg_proto_msgTypes[36]
+func (x *ListTasksWithRefsRequest) Reset() {
+	*x = ListTasksWithRefsRequest{}
+	mi := &file_reorg_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartTaskResponse) String() string {
+func (x *ListTasksWithRefsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartTaskResponse) ProtoMessage() {}
+func (*ListTasksWithRefsRequest) ProtoMessage() {}
 
-func (x *StartTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[36]
+func (x *ListTasksWithRefsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2119,40 +5540,40 @@ func (x *StartTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartTaskResponse.ProtoReflect.Descriptor instead.
-func (*StartTaskResponse) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ListTasksWithRefsRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksWithRefsRequest) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *StartTaskResponse) GetTask() *Task {
+func (x *ListTasksWithRefsRequest) GetQuery() string {
 	if x != nil {
-		return x.Task
+		return x.Query
 	}
-	return nil
+	return ""
 }
 
-type CompleteTaskRequest struct {
+type ListTasksWithRefsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tasks         []*TaskWithRefs        `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompleteTaskRequest) Reset() {
-	*x = CompleteTaskRequest{}
-	mi := &file_reorg_proto_msgTypes[37]
+func (x *ListTasksWithRefsResponse) Reset() {
+	*x = ListTasksWithRefsResponse{}
+	mi := &file_reorg_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompleteTaskRequest) String() string {
+func (x *ListTasksWithRefsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteTaskRequest) ProtoMessage() {}
+func (*ListTasksWithRefsResponse) ProtoMessage() {}
 
-func (x *CompleteTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[37]
+func (x *ListTasksWithRefsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2163,40 +5584,43 @@ func (x *CompleteTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteTaskRequest.ProtoReflect.Descriptor instead.
-func (*CompleteTaskRequest) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ListTasksWithRefsResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksWithRefsResponse) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *CompleteTaskRequest) GetId() string {
+func (x *ListTasksWithRefsResponse) GetTasks() []*TaskWithRefs {
 	if x != nil {
-		return x.Id
+		return x.Tasks
 	}
-	return ""
+	return nil
 }
 
-type CompleteTaskResponse struct {
+// A task with the project and area it belongs to
+type TaskWithRefs struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Project       *Project               `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	Area          *Area                  `protobuf:"bytes,3,opt,name=area,proto3" json:"area,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompleteTaskResponse) Reset() {
-	*x = CompleteTaskResponse{}
-	mi := &file_reorg_proto_msgTypes[38]
+func (x *TaskWithRefs) Reset() {
+	*x = TaskWithRefs{}
+	mi := &file_reorg_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompleteTaskResponse) String() string {
+func (x *TaskWithRefs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteTaskResponse) ProtoMessage() {}
+func (*TaskWithRefs) ProtoMessage() {}
 
-func (x *CompleteTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_reorg_proto_msgTypes[38]
+func (x *TaskWithRefs) ProtoReflect() protoreflect.Message {
+	mi := &file_reorg_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2207,23 +5631,37 @@ func (x *CompleteTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteTaskResponse.ProtoReflect.Descriptor instead.
-func (*CompleteTaskResponse) Descriptor() ([]byte, []int) {
-	return file_reorg_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use TaskWithRefs.ProtoReflect.Descriptor instead.
+func (*TaskWithRefs) Descriptor() ([]byte, []int) {
+	return file_reorg_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *CompleteTaskResponse) GetTask() *Task {
+func (x *TaskWithRefs) GetTask() *Task {
 	if x != nil {
 		return x.Task
 	}
 	return nil
 }
 
+func (x *TaskWithRefs) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *TaskWithRefs) GetArea() *Area {
+	if x != nil {
+		return x.Area
+	}
+	return nil
+}
+
 var File_reorg_proto protoreflect.FileDescriptor
 
 const file_reorg_proto_rawDesc = "" +
 	"\n" +
-	"\vreorg.proto\x12\breorg.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\xd0\x01\n" +
+	"\vreorg.proto\x12\breorg.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\x83\x04\n" +
 	"\x04Area\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
@@ -2232,7 +5670,18 @@ const file_reorg_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x93\x03\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12.\n" +
+	"\bpriority\x18\a \x01(\x0e2\x12.reorg.v1.PriorityR\bpriority\x12\x16\n" +
+	"\x06review\x18\b \x01(\tR\x06review\x12?\n" +
+	"\rlast_reviewed\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\flastReviewed\x128\n" +
+	"\bmetadata\x18\n" +
+	" \x03(\v2\x1c.reorg.v1.Area.MetadataEntryR\bmetadata\x12\"\n" +
+	"\n" +
+	"sort_order\x18\v \x01(\x05H\x00R\tsortOrder\x88\x01\x01\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\r\n" +
+	"\v_sort_order\"\xa1\x06\n" +
 	"\aProject\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x17\n" +
@@ -2246,7 +5695,64 @@ const file_reorg_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
 	"\fcompleted_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\xd2\x05\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x16\n" +
+	"\x06notify\x18\v \x01(\tR\x06notify\x12/\n" +
+	"\x06health\x18\f \x01(\v2\x17.reorg.v1.ProjectHealthR\x06health\x12;\n" +
+	"\bmetadata\x18\r \x03(\v2\x1f.reorg.v1.Project.MetadataEntryR\bmetadata\x128\n" +
+	"\fexternal_ref\x18\x0e \x01(\v2\x15.reorg.v1.ExternalRefR\vexternalRef\x12\x17\n" +
+	"\agoal_id\x18\x0f \x01(\tR\x06goalId\x12*\n" +
+	"\x11parent_project_id\x18\x10 \x01(\tR\x0fparentProjectId\x12\x1a\n" +
+	"\brevision\x18\x11 \x01(\tR\brevision\x12.\n" +
+	"\bpriority\x18\x12 \x01(\x0e2\x12.reorg.v1.PriorityR\bpriority\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc3\x01\n" +
+	"\x04Note\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe4\x03\n" +
+	"\x04Goal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x18\n" +
+	"\aquarter\x18\x05 \x01(\tR\aquarter\x125\n" +
+	"\bdue_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x128\n" +
+	"\bmetadata\x18\b \x03(\v2\x1c.reorg.v1.Goal.MetadataEntryR\bmetadata\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x122\n" +
+	"\bprogress\x18\v \x01(\v2\x16.reorg.v1.GoalProgressR\bprogress\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xce\x01\n" +
+	"\fGoalProgress\x12\x1a\n" +
+	"\bprojects\x18\x01 \x01(\x05R\bprojects\x12-\n" +
+	"\x12completed_projects\x18\x02 \x01(\x05R\x11completedProjects\x12\x1f\n" +
+	"\vtotal_tasks\x18\x03 \x01(\x05R\n" +
+	"totalTasks\x12'\n" +
+	"\x0fcompleted_tasks\x18\x04 \x01(\x05R\x0ecompletedTasks\x12)\n" +
+	"\x10percent_complete\x18\x05 \x01(\x05R\x0fpercentComplete\"G\n" +
+	"\vExternalRef\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\"\xca\x02\n" +
+	"\rProjectHealth\x12\x1f\n" +
+	"\vtotal_tasks\x18\x01 \x01(\x05R\n" +
+	"totalTasks\x12'\n" +
+	"\x0fcompleted_tasks\x18\x02 \x01(\x05R\x0ecompletedTasks\x12#\n" +
+	"\roverdue_tasks\x18\x03 \x01(\x05R\foverdueTasks\x12)\n" +
+	"\x10percent_complete\x18\x04 \x01(\x05R\x0fpercentComplete\x12?\n" +
+	"\rlast_activity\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\flastActivity\x12.\n" +
+	"\x13days_since_activity\x18\x06 \x01(\x05R\x11daysSinceActivity\x12.\n" +
+	"\x06status\x18\a \x01(\x0e2\x16.reorg.v1.HealthStatusR\x06status\"\x84\t\n" +
 	"\x04Task\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1d\n" +
@@ -2269,11 +5775,34 @@ const file_reorg_proto_rawDesc = "" +
 	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
 	"\n" +
 	"started_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
-	"\fcompleted_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"W\n" +
+	"\fcompleted_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12D\n" +
+	"\x10timer_started_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\x0etimerStartedAt\x12 \n" +
+	"\vattachments\x18\x13 \x03(\tR\vattachments\x128\n" +
+	"\bmetadata\x18\x14 \x03(\v2\x1c.reorg.v1.Task.MetadataEntryR\bmetadata\x128\n" +
+	"\fexternal_ref\x18\x15 \x01(\v2\x15.reorg.v1.ExternalRefR\vexternalRef\x12\x18\n" +
+	"\acontext\x18\x16 \x01(\tR\acontext\x12\x16\n" +
+	"\x06effort\x18\x17 \x01(\tR\x06effort\x120\n" +
+	"\btime_log\x18\x18 \x03(\v2\x15.reorg.v1.TimeSessionR\atimeLog\x12\x1a\n" +
+	"\brevision\x18\x19 \x01(\tR\brevision\x12\x17\n" +
+	"\aall_day\x18\x1a \x01(\bR\x06allDay\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"m\n" +
+	"\vTimeSession\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"\xc2\x02\n" +
 	"\x11CreateAreaRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x12\n" +
-	"\x04tags\x18\x03 \x03(\tR\x04tags\"8\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12.\n" +
+	"\bpriority\x18\x04 \x01(\x0e2\x12.reorg.v1.PriorityR\bpriority\x12\x16\n" +
+	"\x06review\x18\x05 \x01(\tR\x06review\x12E\n" +
+	"\bmetadata\x18\x06 \x03(\v2).reorg.v1.CreateAreaRequest.MetadataEntryR\bmetadata\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\a \x01(\x05R\tsortOrder\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"8\n" +
 	"\x12CreateAreaResponse\x12\"\n" +
 	"\x04area\x18\x01 \x01(\v2\x0e.reorg.v1.AreaR\x04area\" \n" +
 	"\x0eGetAreaRequest\x12\x0e\n" +
@@ -2289,21 +5818,32 @@ const file_reorg_proto_rawDesc = "" +
 	"\x04area\x18\x01 \x01(\v2\x0e.reorg.v1.AreaR\x04area\"#\n" +
 	"\x11DeleteAreaRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
-	"\x12DeleteAreaResponse\"\xaa\x01\n" +
+	"\x12DeleteAreaResponse\"\xf8\x03\n" +
 	"\x14CreateProjectRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x17\n" +
 	"\aarea_id\x18\x02 \x01(\tR\x06areaId\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x12\x12\n" +
 	"\x04tags\x18\x04 \x03(\tR\x04tags\x125\n" +
-	"\bdue_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\"D\n" +
+	"\bdue_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x16\n" +
+	"\x06notify\x18\x06 \x01(\tR\x06notify\x12H\n" +
+	"\bmetadata\x18\a \x03(\v2,.reorg.v1.CreateProjectRequest.MetadataEntryR\bmetadata\x128\n" +
+	"\fexternal_ref\x18\b \x01(\v2\x15.reorg.v1.ExternalRefR\vexternalRef\x12\x17\n" +
+	"\agoal_id\x18\t \x01(\tR\x06goalId\x12*\n" +
+	"\x11parent_project_id\x18\n" +
+	" \x01(\tR\x0fparentProjectId\x12.\n" +
+	"\bpriority\x18\v \x01(\x0e2\x12.reorg.v1.PriorityR\bpriority\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"D\n" +
 	"\x15CreateProjectResponse\x12+\n" +
 	"\aproject\x18\x01 \x01(\v2\x11.reorg.v1.ProjectR\aproject\"#\n" +
 	"\x11GetProjectRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"A\n" +
 	"\x12GetProjectResponse\x12+\n" +
-	"\aproject\x18\x01 \x01(\v2\x11.reorg.v1.ProjectR\aproject\".\n" +
+	"\aproject\x18\x01 \x01(\v2\x11.reorg.v1.ProjectR\aproject\"D\n" +
 	"\x13ListProjectsRequest\x12\x17\n" +
-	"\aarea_id\x18\x01 \x01(\tR\x06areaId\"E\n" +
+	"\aarea_id\x18\x01 \x01(\tR\x06areaId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\"E\n" +
 	"\x14ListProjectsResponse\x12-\n" +
 	"\bprojects\x18\x01 \x03(\v2\x11.reorg.v1.ProjectR\bprojects\"C\n" +
 	"\x14UpdateProjectRequest\x12+\n" +
@@ -2316,7 +5856,17 @@ const file_reorg_proto_rawDesc = "" +
 	"\x16CompleteProjectRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"F\n" +
 	"\x17CompleteProjectResponse\x12+\n" +
-	"\aproject\x18\x01 \x01(\v2\x11.reorg.v1.ProjectR\aproject\"\xf6\x01\n" +
+	"\aproject\x18\x01 \x01(\v2\x11.reorg.v1.ProjectR\aproject\"=\n" +
+	"\x12MoveProjectRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aarea_id\x18\x02 \x01(\tR\x06areaId\"B\n" +
+	"\x13MoveProjectResponse\x12+\n" +
+	"\aproject\x18\x01 \x01(\v2\x11.reorg.v1.ProjectR\aproject\"I\n" +
+	"\x1fFindProjectByExternalRefRequest\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"O\n" +
+	" FindProjectByExternalRefResponse\x12+\n" +
+	"\aproject\x18\x01 \x01(\v2\x11.reorg.v1.ProjectR\aproject\"\xc2\x04\n" +
 	"\x11CreateTaskRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1d\n" +
 	"\n" +
@@ -2325,17 +5875,28 @@ const file_reorg_proto_rawDesc = "" +
 	"\acontent\x18\x04 \x01(\tR\acontent\x12.\n" +
 	"\bpriority\x18\x05 \x01(\x0e2\x12.reorg.v1.PriorityR\bpriority\x12\x12\n" +
 	"\x04tags\x18\x06 \x03(\tR\x04tags\x125\n" +
-	"\bdue_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\"8\n" +
+	"\bdue_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12E\n" +
+	"\bmetadata\x18\b \x03(\v2).reorg.v1.CreateTaskRequest.MetadataEntryR\bmetadata\x128\n" +
+	"\fexternal_ref\x18\t \x01(\v2\x15.reorg.v1.ExternalRefR\vexternalRef\x12\x18\n" +
+	"\acontext\x18\n" +
+	" \x01(\tR\acontext\x12\x16\n" +
+	"\x06effort\x18\v \x01(\tR\x06effort\x12A\n" +
+	"\x0escheduled_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\rscheduledDate\x12\x17\n" +
+	"\aall_day\x18\r \x01(\bR\x06allDay\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"8\n" +
 	"\x12CreateTaskResponse\x12\"\n" +
 	"\x04task\x18\x01 \x01(\v2\x0e.reorg.v1.TaskR\x04task\" \n" +
 	"\x0eGetTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"5\n" +
 	"\x0fGetTaskResponse\x12\"\n" +
-	"\x04task\x18\x01 \x01(\v2\x0e.reorg.v1.TaskR\x04task\"J\n" +
+	"\x04task\x18\x01 \x01(\v2\x0e.reorg.v1.TaskR\x04task\"`\n" +
 	"\x10ListTasksRequest\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x17\n" +
-	"\aarea_id\x18\x02 \x01(\tR\x06areaId\"9\n" +
+	"\aarea_id\x18\x02 \x01(\tR\x06areaId\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\"9\n" +
 	"\x11ListTasksResponse\x12$\n" +
 	"\x05tasks\x18\x01 \x03(\v2\x0e.reorg.v1.TaskR\x05tasks\"7\n" +
 	"\x11UpdateTaskRequest\x12\"\n" +
@@ -2352,13 +5913,171 @@ const file_reorg_proto_rawDesc = "" +
 	"\x13CompleteTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\":\n" +
 	"\x14CompleteTaskResponse\x12\"\n" +
-	"\x04task\x18\x01 \x01(\v2\x0e.reorg.v1.TaskR\x04task*\xa1\x01\n" +
+	"\x04task\x18\x01 \x01(\v2\x0e.reorg.v1.TaskR\x04task\"@\n" +
+	"\x0fMoveTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\"6\n" +
+	"\x10MoveTaskResponse\x12\"\n" +
+	"\x04task\x18\x01 \x01(\v2\x0e.reorg.v1.TaskR\x04task\"'\n" +
+	"\x15StartTaskTimerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"<\n" +
+	"\x16StartTaskTimerResponse\x12\"\n" +
+	"\x04task\x18\x01 \x01(\v2\x0e.reorg.v1.TaskR\x04task\"&\n" +
+	"\x14StopTaskTimerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"d\n" +
+	"\x15StopTaskTimerResponse\x12\"\n" +
+	"\x04task\x18\x01 \x01(\v2\x0e.reorg.v1.TaskR\x04task\x12'\n" +
+	"\x0felapsed_seconds\x18\x02 \x01(\x03R\x0eelapsedSeconds\"\xe1\x01\n" +
+	"\n" +
+	"TaskFilter\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x17\n" +
+	"\aarea_id\x18\x02 \x01(\tR\x06areaId\x12,\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x14.reorg.v1.TaskStatusR\x06status\x12.\n" +
+	"\bpriority\x18\x04 \x01(\x0e2\x12.reorg.v1.PriorityR\bpriority\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x1d\n" +
+	"\aoverdue\x18\x06 \x01(\bH\x00R\aoverdue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_overdue\"\x87\x05\n" +
+	"\n" +
+	"TaskUpdate\x12,\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x14.reorg.v1.TaskStatusR\x06status\x12.\n" +
+	"\bpriority\x18\x02 \x01(\x0e2\x12.reorg.v1.PriorityR\bpriority\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\tR\tprojectId\x125\n" +
+	"\bdue_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12$\n" +
+	"\x0eclear_due_date\x18\x05 \x01(\bR\fclearDueDate\x12\x19\n" +
+	"\badd_tags\x18\x06 \x03(\tR\aaddTags\x12\x1f\n" +
+	"\vremove_tags\x18\a \x03(\tR\n" +
+	"removeTags\x12>\n" +
+	"\bmetadata\x18\b \x03(\v2\".reorg.v1.TaskUpdate.MetadataEntryR\bmetadata\x12\x1d\n" +
+	"\acontext\x18\t \x01(\tH\x00R\acontext\x88\x01\x01\x12\x1b\n" +
+	"\x06effort\x18\n" +
+	" \x01(\tH\x01R\x06effort\x88\x01\x01\x12A\n" +
+	"\x0escheduled_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\rscheduledDate\x120\n" +
+	"\x14clear_scheduled_date\x18\f \x01(\bR\x12clearScheduledDate\x12\x1e\n" +
+	"\vdue_all_day\x18\r \x01(\bR\tdueAllDay\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\n" +
+	"\n" +
+	"\b_contextB\t\n" +
+	"\a_effort\"t\n" +
+	"\x16BulkUpdateTasksRequest\x12,\n" +
+	"\x06filter\x18\x01 \x01(\v2\x14.reorg.v1.TaskFilterR\x06filter\x12,\n" +
+	"\x06update\x18\x02 \x01(\v2\x14.reorg.v1.TaskUpdateR\x06update\"?\n" +
+	"\x17BulkUpdateTasksResponse\x12$\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x0e.reorg.v1.TaskR\x05tasks\"?\n" +
+	"\x17BatchCreateTasksRequest\x12$\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x0e.reorg.v1.TaskR\x05tasks\"O\n" +
+	"\x18BatchCreateTasksResponse\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.reorg.v1.BatchTaskResultR\aresults\"?\n" +
+	"\x17BatchUpdateTasksRequest\x12$\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x0e.reorg.v1.TaskR\x05tasks\"O\n" +
+	"\x18BatchUpdateTasksResponse\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.reorg.v1.BatchTaskResultR\aresults\"K\n" +
+	"\x0fBatchTaskResult\x12\"\n" +
+	"\x04task\x18\x01 \x01(\v2\x0e.reorg.v1.TaskR\x04task\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"E\n" +
+	"\x13AttachToTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
+	"\n" +
+	"attachment\x18\x02 \x01(\tR\n" +
+	"attachment\":\n" +
+	"\x14AttachToTaskResponse\x12\"\n" +
+	"\x04task\x18\x01 \x01(\v2\x0e.reorg.v1.TaskR\x04task\"S\n" +
+	"\x15AddTaskCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06author\x18\x02 \x01(\tR\x06author\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"<\n" +
+	"\x16AddTaskCommentResponse\x12\"\n" +
+	"\x04task\x18\x01 \x01(\v2\x0e.reorg.v1.TaskR\x04task\"F\n" +
+	"\x1cFindTaskByExternalRefRequest\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"C\n" +
+	"\x1dFindTaskByExternalRefResponse\x12\"\n" +
+	"\x04task\x18\x01 \x01(\v2\x0e.reorg.v1.TaskR\x04task\"7\n" +
+	"\x11UpsertTaskRequest\x12\"\n" +
+	"\x04task\x18\x01 \x01(\v2\x0e.reorg.v1.TaskR\x04task\"R\n" +
+	"\x12UpsertTaskResponse\x12\"\n" +
+	"\x04task\x18\x01 \x01(\v2\x0e.reorg.v1.TaskR\x04task\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\"G\n" +
+	"\x0eAddNoteRequest\x12\x1b\n" +
+	"\tparent_id\x18\x01 \x01(\tR\bparentId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"5\n" +
+	"\x0fAddNoteResponse\x12\"\n" +
+	"\x04note\x18\x01 \x01(\v2\x0e.reorg.v1.NoteR\x04note\"G\n" +
+	"\x10ListNotesRequest\x12\x1b\n" +
+	"\tparent_id\x18\x01 \x01(\tR\bparentId\x12\x16\n" +
+	"\x06search\x18\x02 \x01(\tR\x06search\"9\n" +
+	"\x11ListNotesResponse\x12$\n" +
+	"\x05notes\x18\x01 \x03(\v2\x0e.reorg.v1.NoteR\x05notes\"#\n" +
+	"\x11DeleteNoteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12DeleteNoteResponse\"\xac\x02\n" +
+	"\x11CreateGoalRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x18\n" +
+	"\aquarter\x18\x03 \x01(\tR\aquarter\x125\n" +
+	"\bdue_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12E\n" +
+	"\bmetadata\x18\x06 \x03(\v2).reorg.v1.CreateGoalRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"8\n" +
+	"\x12CreateGoalResponse\x12\"\n" +
+	"\x04goal\x18\x01 \x01(\v2\x0e.reorg.v1.GoalR\x04goal\" \n" +
+	"\x0eGetGoalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"5\n" +
+	"\x0fGetGoalResponse\x12\"\n" +
+	"\x04goal\x18\x01 \x01(\v2\x0e.reorg.v1.GoalR\x04goal\"\x12\n" +
+	"\x10ListGoalsRequest\"9\n" +
+	"\x11ListGoalsResponse\x12$\n" +
+	"\x05goals\x18\x01 \x03(\v2\x0e.reorg.v1.GoalR\x05goals\"7\n" +
+	"\x11UpdateGoalRequest\x12\"\n" +
+	"\x04goal\x18\x01 \x01(\v2\x0e.reorg.v1.GoalR\x04goal\"8\n" +
+	"\x12UpdateGoalResponse\x12\"\n" +
+	"\x04goal\x18\x01 \x01(\v2\x0e.reorg.v1.GoalR\x04goal\"#\n" +
+	"\x11DeleteGoalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12DeleteGoalResponse\"\x0e\n" +
+	"\fWatchRequest\"R\n" +
+	"\n" +
+	"WatchEvent\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x14\n" +
+	"\x05paths\x18\x02 \x03(\tR\x05paths\"\x14\n" +
+	"\x12GetOverviewRequest\"C\n" +
+	"\x13GetOverviewResponse\x12,\n" +
+	"\x05areas\x18\x01 \x03(\v2\x16.reorg.v1.AreaOverviewR\x05areas\"i\n" +
+	"\fAreaOverview\x12\"\n" +
+	"\x04area\x18\x01 \x01(\v2\x0e.reorg.v1.AreaR\x04area\x125\n" +
+	"\bprojects\x18\x02 \x03(\v2\x19.reorg.v1.ProjectOverviewR\bprojects\"d\n" +
+	"\x0fProjectOverview\x12+\n" +
+	"\aproject\x18\x01 \x01(\v2\x11.reorg.v1.ProjectR\aproject\x12$\n" +
+	"\x05tasks\x18\x02 \x03(\v2\x0e.reorg.v1.TaskR\x05tasks\"0\n" +
+	"\x18ListTasksWithRefsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\"I\n" +
+	"\x19ListTasksWithRefsResponse\x12,\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x16.reorg.v1.TaskWithRefsR\x05tasks\"\x83\x01\n" +
+	"\fTaskWithRefs\x12\"\n" +
+	"\x04task\x18\x01 \x01(\v2\x0e.reorg.v1.TaskR\x04task\x12+\n" +
+	"\aproject\x18\x02 \x01(\v2\x11.reorg.v1.ProjectR\aproject\x12\"\n" +
+	"\x04area\x18\x03 \x01(\v2\x0e.reorg.v1.AreaR\x04area*\xb2\x01\n" +
+	"\fHealthStatus\x12\x1d\n" +
+	"\x19HEALTH_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16HEALTH_STATUS_ON_TRACK\x10\x01\x12\x19\n" +
+	"\x15HEALTH_STATUS_AT_RISK\x10\x02\x12\x19\n" +
+	"\x15HEALTH_STATUS_STALLED\x10\x03\x12\x16\n" +
+	"\x12HEALTH_STATUS_DONE\x10\x04\x12\x19\n" +
+	"\x15HEALTH_STATUS_SOMEDAY\x10\x05*\xbd\x01\n" +
 	"\rProjectStatus\x12\x1e\n" +
 	"\x1aPROJECT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15PROJECT_STATUS_ACTIVE\x10\x01\x12\x1a\n" +
 	"\x16PROJECT_STATUS_ON_HOLD\x10\x02\x12\x1c\n" +
 	"\x18PROJECT_STATUS_COMPLETED\x10\x03\x12\x1b\n" +
-	"\x17PROJECT_STATUS_ARCHIVED\x10\x04*\xa6\x01\n" +
+	"\x17PROJECT_STATUS_ARCHIVED\x10\x04\x12\x1a\n" +
+	"\x16PROJECT_STATUS_SOMEDAY\x10\x05*\xbf\x01\n" +
 	"\n" +
 	"TaskStatus\x12\x1b\n" +
 	"\x17TASK_STATUS_UNSPECIFIED\x10\x00\x12\x14\n" +
@@ -2366,13 +6085,14 @@ const file_reorg_proto_rawDesc = "" +
 	"\x17TASK_STATUS_IN_PROGRESS\x10\x02\x12\x17\n" +
 	"\x13TASK_STATUS_BLOCKED\x10\x03\x12\x14\n" +
 	"\x10TASK_STATUS_DONE\x10\x04\x12\x19\n" +
-	"\x15TASK_STATUS_CANCELLED\x10\x05*s\n" +
+	"\x15TASK_STATUS_CANCELLED\x10\x05\x12\x17\n" +
+	"\x13TASK_STATUS_WAITING\x10\x06*s\n" +
 	"\bPriority\x12\x18\n" +
 	"\x14PRIORITY_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fPRIORITY_LOW\x10\x01\x12\x13\n" +
 	"\x0fPRIORITY_MEDIUM\x10\x02\x12\x11\n" +
 	"\rPRIORITY_HIGH\x10\x03\x12\x13\n" +
-	"\x0fPRIORITY_URGENT\x10\x042\xab\x0e\n" +
+	"\x0fPRIORITY_URGENT\x10\x042\xe9!\n" +
 	"\fReorgService\x12]\n" +
 	"\n" +
 	"CreateArea\x12\x1b.reorg.v1.CreateAreaRequest\x1a\x1c.reorg.v1.CreateAreaResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/areas\x12V\n" +
@@ -2388,7 +6108,9 @@ const file_reorg_proto_rawDesc = "" +
 	"\fListProjects\x12\x1d.reorg.v1.ListProjectsRequest\x1a\x1e.reorg.v1.ListProjectsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/projects\x12v\n" +
 	"\rUpdateProject\x12\x1e.reorg.v1.UpdateProjectRequest\x1a\x1f.reorg.v1.UpdateProjectResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/v1/projects/{project.id}\x12k\n" +
 	"\rDeleteProject\x12\x1e.reorg.v1.DeleteProjectRequest\x1a\x1f.reorg.v1.DeleteProjectResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/projects/{id}\x12z\n" +
-	"\x0fCompleteProject\x12 .reorg.v1.CompleteProjectRequest\x1a!.reorg.v1.CompleteProjectResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x1a/v1/projects/{id}/complete\x12]\n" +
+	"\x0fCompleteProject\x12 .reorg.v1.CompleteProjectRequest\x1a!.reorg.v1.CompleteProjectResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x1a/v1/projects/{id}/complete\x12m\n" +
+	"\vMoveProject\x12\x1c.reorg.v1.MoveProjectRequest\x1a\x1d.reorg.v1.MoveProjectResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/projects/{id}/move\x12\x95\x01\n" +
+	"\x18FindProjectByExternalRef\x12).reorg.v1.FindProjectByExternalRefRequest\x1a*.reorg.v1.FindProjectByExternalRefResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/projects:byExternalRef\x12]\n" +
 	"\n" +
 	"CreateTask\x12\x1b.reorg.v1.CreateTaskRequest\x1a\x1c.reorg.v1.CreateTaskResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/tasks\x12V\n" +
 	"\aGetTask\x12\x18.reorg.v1.GetTaskRequest\x1a\x19.reorg.v1.GetTaskResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/tasks/{id}\x12W\n" +
@@ -2398,7 +6120,33 @@ const file_reorg_proto_rawDesc = "" +
 	"\n" +
 	"DeleteTask\x12\x1b.reorg.v1.DeleteTaskRequest\x1a\x1c.reorg.v1.DeleteTaskResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/tasks/{id}\x12b\n" +
 	"\tStartTask\x12\x1a.reorg.v1.StartTaskRequest\x1a\x1b.reorg.v1.StartTaskResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x14/v1/tasks/{id}/start\x12n\n" +
-	"\fCompleteTask\x12\x1d.reorg.v1.CompleteTaskRequest\x1a\x1e.reorg.v1.CompleteTaskResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\"\x17/v1/tasks/{id}/completeB)Z'github.com/beng/reorg/api/proto/reorgpbb\x06proto3"
+	"\fCompleteTask\x12\x1d.reorg.v1.CompleteTaskRequest\x1a\x1e.reorg.v1.CompleteTaskResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\"\x17/v1/tasks/{id}/complete\x12w\n" +
+	"\x0eStartTaskTimer\x12\x1f.reorg.v1.StartTaskTimerRequest\x1a .reorg.v1.StartTaskTimerResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x1a/v1/tasks/{id}/timer:start\x12s\n" +
+	"\rStopTaskTimer\x12\x1e.reorg.v1.StopTaskTimerRequest\x1a\x1f.reorg.v1.StopTaskTimerResponse\"!\x82\xd3\xe4\x93\x02\x1b\"\x19/v1/tasks/{id}/timer:stop\x12a\n" +
+	"\bMoveTask\x12\x19.reorg.v1.MoveTaskRequest\x1a\x1a.reorg.v1.MoveTaskResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/tasks/{id}/move\x12w\n" +
+	"\x0fBulkUpdateTasks\x12 .reorg.v1.BulkUpdateTasksRequest\x1a!.reorg.v1.BulkUpdateTasksResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/tasks:bulkUpdate\x12{\n" +
+	"\x10BatchCreateTasks\x12!.reorg.v1.BatchCreateTasksRequest\x1a\".reorg.v1.BatchCreateTasksResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/tasks:batchCreate\x12{\n" +
+	"\x10BatchUpdateTasks\x12!.reorg.v1.BatchUpdateTasksRequest\x1a\".reorg.v1.BatchUpdateTasksResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/tasks:batchUpdate\x12t\n" +
+	"\fAttachToTask\x12\x1d.reorg.v1.AttachToTaskRequest\x1a\x1e.reorg.v1.AttachToTaskResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/tasks/{id}/attachments\x12w\n" +
+	"\x0eAddTaskComment\x12\x1f.reorg.v1.AddTaskCommentRequest\x1a .reorg.v1.AddTaskCommentResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/tasks/{id}/comments\x12\x89\x01\n" +
+	"\x15FindTaskByExternalRef\x12&.reorg.v1.FindTaskByExternalRefRequest\x1a'.reorg.v1.FindTaskByExternalRefResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/tasks:byExternalRef\x12d\n" +
+	"\n" +
+	"UpsertTask\x12\x1b.reorg.v1.UpsertTaskRequest\x1a\x1c.reorg.v1.UpsertTaskResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/tasks:upsert\x12T\n" +
+	"\aAddNote\x12\x18.reorg.v1.AddNoteRequest\x1a\x19.reorg.v1.AddNoteResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/notes\x12W\n" +
+	"\tListNotes\x12\x1a.reorg.v1.ListNotesRequest\x1a\x1b.reorg.v1.ListNotesResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/notes\x12_\n" +
+	"\n" +
+	"DeleteNote\x12\x1b.reorg.v1.DeleteNoteRequest\x1a\x1c.reorg.v1.DeleteNoteResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/notes/{id}\x12]\n" +
+	"\n" +
+	"CreateGoal\x12\x1b.reorg.v1.CreateGoalRequest\x1a\x1c.reorg.v1.CreateGoalResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/goals\x12V\n" +
+	"\aGetGoal\x12\x18.reorg.v1.GetGoalRequest\x1a\x19.reorg.v1.GetGoalResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/goals/{id}\x12W\n" +
+	"\tListGoals\x12\x1a.reorg.v1.ListGoalsRequest\x1a\x1b.reorg.v1.ListGoalsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/goals\x12g\n" +
+	"\n" +
+	"UpdateGoal\x12\x1b.reorg.v1.UpdateGoalRequest\x1a\x1c.reorg.v1.UpdateGoalResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v1/goals/{goal.id}\x12_\n" +
+	"\n" +
+	"DeleteGoal\x12\x1b.reorg.v1.DeleteGoalRequest\x1a\x1c.reorg.v1.DeleteGoalResponse\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/goals/{id}\x12`\n" +
+	"\vGetOverview\x12\x1c.reorg.v1.GetOverviewRequest\x1a\x1d.reorg.v1.GetOverviewResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/overview\x12x\n" +
+	"\x11ListTasksWithRefs\x12\".reorg.v1.ListTasksWithRefsRequest\x1a#.reorg.v1.ListTasksWithRefsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/tasks:withRefs\x12J\n" +
+	"\x05Watch\x12\x16.reorg.v1.WatchRequest\x1a\x14.reorg.v1.WatchEvent\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/watch0\x01B0Z.github.com/ihavespoons/reorg/api/proto/reorgpbb\x06proto3"
 
 var (
 	file_reorg_proto_rawDescOnce sync.Once
@@ -2412,131 +6160,319 @@ func file_reorg_proto_rawDescGZIP() []byte {
 	return file_reorg_proto_rawDescData
 }
 
-var file_reorg_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_reorg_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_reorg_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_reorg_proto_msgTypes = make([]protoimpl.MessageInfo, 106)
 var file_reorg_proto_goTypes = []any{
-	(ProjectStatus)(0),              // 0: reorg.v1.ProjectStatus
-	(TaskStatus)(0),                 // 1: reorg.v1.TaskStatus
-	(Priority)(0),                   // 2: reorg.v1.Priority
-	(*Area)(nil),                    // 3: reorg.v1.Area
-	(*Project)(nil),                 // 4: reorg.v1.Project
-	(*Task)(nil),                    // 5: reorg.v1.Task
-	(*CreateAreaRequest)(nil),       // 6: reorg.v1.CreateAreaRequest
-	(*CreateAreaResponse)(nil),      // 7: reorg.v1.CreateAreaResponse
-	(*GetAreaRequest)(nil),          // 8: reorg.v1.GetAreaRequest
-	(*GetAreaResponse)(nil),         // 9: reorg.v1.GetAreaResponse
-	(*ListAreasRequest)(nil),        // 10: reorg.v1.ListAreasRequest
-	(*ListAreasResponse)(nil),       // 11: reorg.v1.ListAreasResponse
-	(*UpdateAreaRequest)(nil),       // 12: reorg.v1.UpdateAreaRequest
-	(*UpdateAreaResponse)(nil),      // 13: reorg.v1.UpdateAreaResponse
-	(*DeleteAreaRequest)(nil),       // 14: reorg.v1.DeleteAreaRequest
-	(*DeleteAreaResponse)(nil),      // 15: reorg.v1.DeleteAreaResponse
-	(*CreateProjectRequest)(nil),    // 16: reorg.v1.CreateProjectRequest
-	(*CreateProjectResponse)(nil),   // 17: reorg.v1.CreateProjectResponse
-	(*GetProjectRequest)(nil),       // 18: reorg.v1.GetProjectRequest
-	(*GetProjectResponse)(nil),      // 19: reorg.v1.GetProjectResponse
-	(*ListProjectsRequest)(nil),     // 20: reorg.v1.ListProjectsRequest
-	(*ListProjectsResponse)(nil),    // 21: reorg.v1.ListProjectsResponse
-	(*UpdateProjectRequest)(nil),    // 22: reorg.v1.UpdateProjectRequest
-	(*UpdateProjectResponse)(nil),   // 23: reorg.v1.UpdateProjectResponse
-	(*DeleteProjectRequest)(nil),    // 24: reorg.v1.DeleteProjectRequest
-	(*DeleteProjectResponse)(nil),   // 25: reorg.v1.DeleteProjectResponse
-	(*CompleteProjectRequest)(nil),  // 26: reorg.v1.CompleteProjectRequest
-	(*CompleteProjectResponse)(nil), // 27: reorg.v1.CompleteProjectResponse
-	(*CreateTaskRequest)(nil),       // 28: reorg.v1.CreateTaskRequest
-	(*CreateTaskResponse)(nil),      // 29: reorg.v1.CreateTaskResponse
-	(*GetTaskRequest)(nil),          // 30: reorg.v1.GetTaskRequest
-	(*GetTaskResponse)(nil),         // 31: reorg.v1.GetTaskResponse
-	(*ListTasksRequest)(nil),        // 32: reorg.v1.ListTasksRequest
-	(*ListTasksResponse)(nil),       // 33: reorg.v1.ListTasksResponse
-	(*UpdateTaskRequest)(nil),       // 34: reorg.v1.UpdateTaskRequest
-	(*UpdateTaskResponse)(nil),      // 35: reorg.v1.UpdateTaskResponse
-	(*DeleteTaskRequest)(nil),       // 36: reorg.v1.DeleteTaskRequest
-	(*DeleteTaskResponse)(nil),      // 37: reorg.v1.DeleteTaskResponse
-	(*StartTaskRequest)(nil),        // 38: reorg.v1.StartTaskRequest
-	(*StartTaskResponse)(nil),       // 39: reorg.v1.StartTaskResponse
-	(*CompleteTaskRequest)(nil),     // 40: reorg.v1.CompleteTaskRequest
-	(*CompleteTaskResponse)(nil),    // 41: reorg.v1.CompleteTaskResponse
-	(*timestamppb.Timestamp)(nil),   // 42: google.protobuf.Timestamp
+	(HealthStatus)(0),                        // 0: reorg.v1.HealthStatus
+	(ProjectStatus)(0),                       // 1: reorg.v1.ProjectStatus
+	(TaskStatus)(0),                          // 2: reorg.v1.TaskStatus
+	(Priority)(0),                            // 3: reorg.v1.Priority
+	(*Area)(nil),                             // 4: reorg.v1.Area
+	(*Project)(nil),                          // 5: reorg.v1.Project
+	(*Note)(nil),                             // 6: reorg.v1.Note
+	(*Goal)(nil),                             // 7: reorg.v1.Goal
+	(*GoalProgress)(nil),                     // 8: reorg.v1.GoalProgress
+	(*ExternalRef)(nil),                      // 9: reorg.v1.ExternalRef
+	(*ProjectHealth)(nil),                    // 10: reorg.v1.ProjectHealth
+	(*Task)(nil),                             // 11: reorg.v1.Task
+	(*TimeSession)(nil),                      // 12: reorg.v1.TimeSession
+	(*CreateAreaRequest)(nil),                // 13: reorg.v1.CreateAreaRequest
+	(*CreateAreaResponse)(nil),               // 14: reorg.v1.CreateAreaResponse
+	(*GetAreaRequest)(nil),                   // 15: reorg.v1.GetAreaRequest
+	(*GetAreaResponse)(nil),                  // 16: reorg.v1.GetAreaResponse
+	(*ListAreasRequest)(nil),                 // 17: reorg.v1.ListAreasRequest
+	(*ListAreasResponse)(nil),                // 18: reorg.v1.ListAreasResponse
+	(*UpdateAreaRequest)(nil),                // 19: reorg.v1.UpdateAreaRequest
+	(*UpdateAreaResponse)(nil),               // 20: reorg.v1.UpdateAreaResponse
+	(*DeleteAreaRequest)(nil),                // 21: reorg.v1.DeleteAreaRequest
+	(*DeleteAreaResponse)(nil),               // 22: reorg.v1.DeleteAreaResponse
+	(*CreateProjectRequest)(nil),             // 23: reorg.v1.CreateProjectRequest
+	(*CreateProjectResponse)(nil),            // 24: reorg.v1.CreateProjectResponse
+	(*GetProjectRequest)(nil),                // 25: reorg.v1.GetProjectRequest
+	(*GetProjectResponse)(nil),               // 26: reorg.v1.GetProjectResponse
+	(*ListProjectsRequest)(nil),              // 27: reorg.v1.ListProjectsRequest
+	(*ListProjectsResponse)(nil),             // 28: reorg.v1.ListProjectsResponse
+	(*UpdateProjectRequest)(nil),             // 29: reorg.v1.UpdateProjectRequest
+	(*UpdateProjectResponse)(nil),            // 30: reorg.v1.UpdateProjectResponse
+	(*DeleteProjectRequest)(nil),             // 31: reorg.v1.DeleteProjectRequest
+	(*DeleteProjectResponse)(nil),            // 32: reorg.v1.DeleteProjectResponse
+	(*CompleteProjectRequest)(nil),           // 33: reorg.v1.CompleteProjectRequest
+	(*CompleteProjectResponse)(nil),          // 34: reorg.v1.CompleteProjectResponse
+	(*MoveProjectRequest)(nil),               // 35: reorg.v1.MoveProjectRequest
+	(*MoveProjectResponse)(nil),              // 36: reorg.v1.MoveProjectResponse
+	(*FindProjectByExternalRefRequest)(nil),  // 37: reorg.v1.FindProjectByExternalRefRequest
+	(*FindProjectByExternalRefResponse)(nil), // 38: reorg.v1.FindProjectByExternalRefResponse
+	(*CreateTaskRequest)(nil),                // 39: reorg.v1.CreateTaskRequest
+	(*CreateTaskResponse)(nil),               // 40: reorg.v1.CreateTaskResponse
+	(*GetTaskRequest)(nil),                   // 41: reorg.v1.GetTaskRequest
+	(*GetTaskResponse)(nil),                  // 42: reorg.v1.GetTaskResponse
+	(*ListTasksRequest)(nil),                 // 43: reorg.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),                // 44: reorg.v1.ListTasksResponse
+	(*UpdateTaskRequest)(nil),                // 45: reorg.v1.UpdateTaskRequest
+	(*UpdateTaskResponse)(nil),               // 46: reorg.v1.UpdateTaskResponse
+	(*DeleteTaskRequest)(nil),                // 47: reorg.v1.DeleteTaskRequest
+	(*DeleteTaskResponse)(nil),               // 48: reorg.v1.DeleteTaskResponse
+	(*StartTaskRequest)(nil),                 // 49: reorg.v1.StartTaskRequest
+	(*StartTaskResponse)(nil),                // 50: reorg.v1.StartTaskResponse
+	(*CompleteTaskRequest)(nil),              // 51: reorg.v1.CompleteTaskRequest
+	(*CompleteTaskResponse)(nil),             // 52: reorg.v1.CompleteTaskResponse
+	(*MoveTaskRequest)(nil),                  // 53: reorg.v1.MoveTaskRequest
+	(*MoveTaskResponse)(nil),                 // 54: reorg.v1.MoveTaskResponse
+	(*StartTaskTimerRequest)(nil),            // 55: reorg.v1.StartTaskTimerRequest
+	(*StartTaskTimerResponse)(nil),           // 56: reorg.v1.StartTaskTimerResponse
+	(*StopTaskTimerRequest)(nil),             // 57: reorg.v1.StopTaskTimerRequest
+	(*StopTaskTimerResponse)(nil),            // 58: reorg.v1.StopTaskTimerResponse
+	(*TaskFilter)(nil),                       // 59: reorg.v1.TaskFilter
+	(*TaskUpdate)(nil),                       // 60: reorg.v1.TaskUpdate
+	(*BulkUpdateTasksRequest)(nil),           // 61: reorg.v1.BulkUpdateTasksRequest
+	(*BulkUpdateTasksResponse)(nil),          // 62: reorg.v1.BulkUpdateTasksResponse
+	(*BatchCreateTasksRequest)(nil),          // 63: reorg.v1.BatchCreateTasksRequest
+	(*BatchCreateTasksResponse)(nil),         // 64: reorg.v1.BatchCreateTasksResponse
+	(*BatchUpdateTasksRequest)(nil),          // 65: reorg.v1.BatchUpdateTasksRequest
+	(*BatchUpdateTasksResponse)(nil),         // 66: reorg.v1.BatchUpdateTasksResponse
+	(*BatchTaskResult)(nil),                  // 67: reorg.v1.BatchTaskResult
+	(*AttachToTaskRequest)(nil),              // 68: reorg.v1.AttachToTaskRequest
+	(*AttachToTaskResponse)(nil),             // 69: reorg.v1.AttachToTaskResponse
+	(*AddTaskCommentRequest)(nil),            // 70: reorg.v1.AddTaskCommentRequest
+	(*AddTaskCommentResponse)(nil),           // 71: reorg.v1.AddTaskCommentResponse
+	(*FindTaskByExternalRefRequest)(nil),     // 72: reorg.v1.FindTaskByExternalRefRequest
+	(*FindTaskByExternalRefResponse)(nil),    // 73: reorg.v1.FindTaskByExternalRefResponse
+	(*UpsertTaskRequest)(nil),                // 74: reorg.v1.UpsertTaskRequest
+	(*UpsertTaskResponse)(nil),               // 75: reorg.v1.UpsertTaskResponse
+	(*AddNoteRequest)(nil),                   // 76: reorg.v1.AddNoteRequest
+	(*AddNoteResponse)(nil),                  // 77: reorg.v1.AddNoteResponse
+	(*ListNotesRequest)(nil),                 // 78: reorg.v1.ListNotesRequest
+	(*ListNotesResponse)(nil),                // 79: reorg.v1.ListNotesResponse
+	(*DeleteNoteRequest)(nil),                // 80: reorg.v1.DeleteNoteRequest
+	(*DeleteNoteResponse)(nil),               // 81: reorg.v1.DeleteNoteResponse
+	(*CreateGoalRequest)(nil),                // 82: reorg.v1.CreateGoalRequest
+	(*CreateGoalResponse)(nil),               // 83: reorg.v1.CreateGoalResponse
+	(*GetGoalRequest)(nil),                   // 84: reorg.v1.GetGoalRequest
+	(*GetGoalResponse)(nil),                  // 85: reorg.v1.GetGoalResponse
+	(*ListGoalsRequest)(nil),                 // 86: reorg.v1.ListGoalsRequest
+	(*ListGoalsResponse)(nil),                // 87: reorg.v1.ListGoalsResponse
+	(*UpdateGoalRequest)(nil),                // 88: reorg.v1.UpdateGoalRequest
+	(*UpdateGoalResponse)(nil),               // 89: reorg.v1.UpdateGoalResponse
+	(*DeleteGoalRequest)(nil),                // 90: reorg.v1.DeleteGoalRequest
+	(*DeleteGoalResponse)(nil),               // 91: reorg.v1.DeleteGoalResponse
+	(*WatchRequest)(nil),                     // 92: reorg.v1.WatchRequest
+	(*WatchEvent)(nil),                       // 93: reorg.v1.WatchEvent
+	(*GetOverviewRequest)(nil),               // 94: reorg.v1.GetOverviewRequest
+	(*GetOverviewResponse)(nil),              // 95: reorg.v1.GetOverviewResponse
+	(*AreaOverview)(nil),                     // 96: reorg.v1.AreaOverview
+	(*ProjectOverview)(nil),                  // 97: reorg.v1.ProjectOverview
+	(*ListTasksWithRefsRequest)(nil),         // 98: reorg.v1.ListTasksWithRefsRequest
+	(*ListTasksWithRefsResponse)(nil),        // 99: reorg.v1.ListTasksWithRefsResponse
+	(*TaskWithRefs)(nil),                     // 100: reorg.v1.TaskWithRefs
+	nil,                                      // 101: reorg.v1.Area.MetadataEntry
+	nil,                                      // 102: reorg.v1.Project.MetadataEntry
+	nil,                                      // 103: reorg.v1.Goal.MetadataEntry
+	nil,                                      // 104: reorg.v1.Task.MetadataEntry
+	nil,                                      // 105: reorg.v1.CreateAreaRequest.MetadataEntry
+	nil,                                      // 106: reorg.v1.CreateProjectRequest.MetadataEntry
+	nil,                                      // 107: reorg.v1.CreateTaskRequest.MetadataEntry
+	nil,                                      // 108: reorg.v1.TaskUpdate.MetadataEntry
+	nil,                                      // 109: reorg.v1.CreateGoalRequest.MetadataEntry
+	(*timestamppb.Timestamp)(nil),            // 110: google.protobuf.Timestamp
 }
 var file_reorg_proto_depIdxs = []int32{
-	42, // 0: reorg.v1.Area.created_at:type_name -> google.protobuf.Timestamp
-	42, // 1: reorg.v1.Area.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: reorg.v1.Project.status:type_name -> reorg.v1.ProjectStatus
-	42, // 3: reorg.v1.Project.due_date:type_name -> google.protobuf.Timestamp
-	42, // 4: reorg.v1.Project.created_at:type_name -> google.protobuf.Timestamp
-	42, // 5: reorg.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
-	42, // 6: reorg.v1.Project.completed_at:type_name -> google.protobuf.Timestamp
-	1,  // 7: reorg.v1.Task.status:type_name -> reorg.v1.TaskStatus
-	2,  // 8: reorg.v1.Task.priority:type_name -> reorg.v1.Priority
-	42, // 9: reorg.v1.Task.due_date:type_name -> google.protobuf.Timestamp
-	42, // 10: reorg.v1.Task.scheduled_date:type_name -> google.protobuf.Timestamp
-	42, // 11: reorg.v1.Task.created_at:type_name -> google.protobuf.Timestamp
-	42, // 12: reorg.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
-	42, // 13: reorg.v1.Task.started_at:type_name -> google.protobuf.Timestamp
-	42, // 14: reorg.v1.Task.completed_at:type_name -> google.protobuf.Timestamp
-	3,  // 15: reorg.v1.CreateAreaResponse.area:type_name -> reorg.v1.Area
-	3,  // 16: reorg.v1.GetAreaResponse.area:type_name -> reorg.v1.Area
-	3,  // 17: reorg.v1.ListAreasResponse.areas:type_name -> reorg.v1.Area
-	3,  // 18: reorg.v1.UpdateAreaRequest.area:type_name -> reorg.v1.Area
-	3,  // 19: reorg.v1.UpdateAreaResponse.area:type_name -> reorg.v1.Area
-	42, // 20: reorg.v1.CreateProjectRequest.due_date:type_name -> google.protobuf.Timestamp
-	4,  // 21: reorg.v1.CreateProjectResponse.project:type_name -> reorg.v1.Project
-	4,  // 22: reorg.v1.GetProjectResponse.project:type_name -> reorg.v1.Project
-	4,  // 23: reorg.v1.ListProjectsResponse.projects:type_name -> reorg.v1.Project
-	4,  // 24: reorg.v1.UpdateProjectRequest.project:type_name -> reorg.v1.Project
-	4,  // 25: reorg.v1.UpdateProjectResponse.project:type_name -> reorg.v1.Project
-	4,  // 26: reorg.v1.CompleteProjectResponse.project:type_name -> reorg.v1.Project
-	2,  // 27: reorg.v1.CreateTaskRequest.priority:type_name -> reorg.v1.Priority
-	42, // 28: reorg.v1.CreateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
-	5,  // 29: reorg.v1.CreateTaskResponse.task:type_name -> reorg.v1.Task
-	5,  // 30: reorg.v1.GetTaskResponse.task:type_name -> reorg.v1.Task
-	5,  // 31: reorg.v1.ListTasksResponse.tasks:type_name -> reorg.v1.Task
-	5,  // 32: reorg.v1.UpdateTaskRequest.task:type_name -> reorg.v1.Task
-	5,  // 33: reorg.v1.UpdateTaskResponse.task:type_name -> reorg.v1.Task
-	5,  // 34: reorg.v1.StartTaskResponse.task:type_name -> reorg.v1.Task
-	5,  // 35: reorg.v1.CompleteTaskResponse.task:type_name -> reorg.v1.Task
-	6,  // 36: reorg.v1.ReorgService.CreateArea:input_type -> reorg.v1.CreateAreaRequest
-	8,  // 37: reorg.v1.ReorgService.GetArea:input_type -> reorg.v1.GetAreaRequest
-	10, // 38: reorg.v1.ReorgService.ListAreas:input_type -> reorg.v1.ListAreasRequest
-	12, // 39: reorg.v1.ReorgService.UpdateArea:input_type -> reorg.v1.UpdateAreaRequest
-	14, // 40: reorg.v1.ReorgService.DeleteArea:input_type -> reorg.v1.DeleteAreaRequest
-	16, // 41: reorg.v1.ReorgService.CreateProject:input_type -> reorg.v1.CreateProjectRequest
-	18, // 42: reorg.v1.ReorgService.GetProject:input_type -> reorg.v1.GetProjectRequest
-	20, // 43: reorg.v1.ReorgService.ListProjects:input_type -> reorg.v1.ListProjectsRequest
-	22, // 44: reorg.v1.ReorgService.UpdateProject:input_type -> reorg.v1.UpdateProjectRequest
-	24, // 45: reorg.v1.ReorgService.DeleteProject:input_type -> reorg.v1.DeleteProjectRequest
-	26, // 46: reorg.v1.ReorgService.CompleteProject:input_type -> reorg.v1.CompleteProjectRequest
-	28, // 47: reorg.v1.ReorgService.CreateTask:input_type -> reorg.v1.CreateTaskRequest
-	30, // 48: reorg.v1.ReorgService.GetTask:input_type -> reorg.v1.GetTaskRequest
-	32, // 49: reorg.v1.ReorgService.ListTasks:input_type -> reorg.v1.ListTasksRequest
-	34, // 50: reorg.v1.ReorgService.UpdateTask:input_type -> reorg.v1.UpdateTaskRequest
-	36, // 51: reorg.v1.ReorgService.DeleteTask:input_type -> reorg.v1.DeleteTaskRequest
-	38, // 52: reorg.v1.ReorgService.StartTask:input_type -> reorg.v1.StartTaskRequest
-	40, // 53: reorg.v1.ReorgService.CompleteTask:input_type -> reorg.v1.CompleteTaskRequest
-	7,  // 54: reorg.v1.ReorgService.CreateArea:output_type -> reorg.v1.CreateAreaResponse
-	9,  // 55: reorg.v1.ReorgService.GetArea:output_type -> reorg.v1.GetAreaResponse
-	11, // 56: reorg.v1.ReorgService.ListAreas:output_type -> reorg.v1.ListAreasResponse
-	13, // 57: reorg.v1.ReorgService.UpdateArea:output_type -> reorg.v1.UpdateAreaResponse
-	15, // 58: reorg.v1.ReorgService.DeleteArea:output_type -> reorg.v1.DeleteAreaResponse
-	17, // 59: reorg.v1.ReorgService.CreateProject:output_type -> reorg.v1.CreateProjectResponse
-	19, // 60: reorg.v1.ReorgService.GetProject:output_type -> reorg.v1.GetProjectResponse
-	21, // 61: reorg.v1.ReorgService.ListProjects:output_type -> reorg.v1.ListProjectsResponse
-	23, // 62: reorg.v1.ReorgService.UpdateProject:output_type -> reorg.v1.UpdateProjectResponse
-	25, // 63: reorg.v1.ReorgService.DeleteProject:output_type -> reorg.v1.DeleteProjectResponse
-	27, // 64: reorg.v1.ReorgService.CompleteProject:output_type -> reorg.v1.CompleteProjectResponse
-	29, // 65: reorg.v1.ReorgService.CreateTask:output_type -> reorg.v1.CreateTaskResponse
-	31, // 66: reorg.v1.ReorgService.GetTask:output_type -> reorg.v1.GetTaskResponse
-	33, // 67: reorg.v1.ReorgService.ListTasks:output_type -> reorg.v1.ListTasksResponse
-	35, // 68: reorg.v1.ReorgService.UpdateTask:output_type -> reorg.v1.UpdateTaskResponse
-	37, // 69: reorg.v1.ReorgService.DeleteTask:output_type -> reorg.v1.DeleteTaskResponse
-	39, // 70: reorg.v1.ReorgService.StartTask:output_type -> reorg.v1.StartTaskResponse
-	41, // 71: reorg.v1.ReorgService.CompleteTask:output_type -> reorg.v1.CompleteTaskResponse
-	54, // [54:72] is the sub-list for method output_type
-	36, // [36:54] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	110, // 0: reorg.v1.Area.created_at:type_name -> google.protobuf.Timestamp
+	110, // 1: reorg.v1.Area.updated_at:type_name -> google.protobuf.Timestamp
+	3,   // 2: reorg.v1.Area.priority:type_name -> reorg.v1.Priority
+	110, // 3: reorg.v1.Area.last_reviewed:type_name -> google.protobuf.Timestamp
+	101, // 4: reorg.v1.Area.metadata:type_name -> reorg.v1.Area.MetadataEntry
+	1,   // 5: reorg.v1.Project.status:type_name -> reorg.v1.ProjectStatus
+	110, // 6: reorg.v1.Project.due_date:type_name -> google.protobuf.Timestamp
+	110, // 7: reorg.v1.Project.created_at:type_name -> google.protobuf.Timestamp
+	110, // 8: reorg.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
+	110, // 9: reorg.v1.Project.completed_at:type_name -> google.protobuf.Timestamp
+	10,  // 10: reorg.v1.Project.health:type_name -> reorg.v1.ProjectHealth
+	102, // 11: reorg.v1.Project.metadata:type_name -> reorg.v1.Project.MetadataEntry
+	9,   // 12: reorg.v1.Project.external_ref:type_name -> reorg.v1.ExternalRef
+	3,   // 13: reorg.v1.Project.priority:type_name -> reorg.v1.Priority
+	110, // 14: reorg.v1.Note.created_at:type_name -> google.protobuf.Timestamp
+	110, // 15: reorg.v1.Note.updated_at:type_name -> google.protobuf.Timestamp
+	110, // 16: reorg.v1.Goal.due_date:type_name -> google.protobuf.Timestamp
+	103, // 17: reorg.v1.Goal.metadata:type_name -> reorg.v1.Goal.MetadataEntry
+	110, // 18: reorg.v1.Goal.created_at:type_name -> google.protobuf.Timestamp
+	110, // 19: reorg.v1.Goal.updated_at:type_name -> google.protobuf.Timestamp
+	8,   // 20: reorg.v1.Goal.progress:type_name -> reorg.v1.GoalProgress
+	110, // 21: reorg.v1.ProjectHealth.last_activity:type_name -> google.protobuf.Timestamp
+	0,   // 22: reorg.v1.ProjectHealth.status:type_name -> reorg.v1.HealthStatus
+	2,   // 23: reorg.v1.Task.status:type_name -> reorg.v1.TaskStatus
+	3,   // 24: reorg.v1.Task.priority:type_name -> reorg.v1.Priority
+	110, // 25: reorg.v1.Task.due_date:type_name -> google.protobuf.Timestamp
+	110, // 26: reorg.v1.Task.scheduled_date:type_name -> google.protobuf.Timestamp
+	110, // 27: reorg.v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	110, // 28: reorg.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
+	110, // 29: reorg.v1.Task.started_at:type_name -> google.protobuf.Timestamp
+	110, // 30: reorg.v1.Task.completed_at:type_name -> google.protobuf.Timestamp
+	110, // 31: reorg.v1.Task.timer_started_at:type_name -> google.protobuf.Timestamp
+	104, // 32: reorg.v1.Task.metadata:type_name -> reorg.v1.Task.MetadataEntry
+	9,   // 33: reorg.v1.Task.external_ref:type_name -> reorg.v1.ExternalRef
+	12,  // 34: reorg.v1.Task.time_log:type_name -> reorg.v1.TimeSession
+	110, // 35: reorg.v1.TimeSession.start:type_name -> google.protobuf.Timestamp
+	110, // 36: reorg.v1.TimeSession.end:type_name -> google.protobuf.Timestamp
+	3,   // 37: reorg.v1.CreateAreaRequest.priority:type_name -> reorg.v1.Priority
+	105, // 38: reorg.v1.CreateAreaRequest.metadata:type_name -> reorg.v1.CreateAreaRequest.MetadataEntry
+	4,   // 39: reorg.v1.CreateAreaResponse.area:type_name -> reorg.v1.Area
+	4,   // 40: reorg.v1.GetAreaResponse.area:type_name -> reorg.v1.Area
+	4,   // 41: reorg.v1.ListAreasResponse.areas:type_name -> reorg.v1.Area
+	4,   // 42: reorg.v1.UpdateAreaRequest.area:type_name -> reorg.v1.Area
+	4,   // 43: reorg.v1.UpdateAreaResponse.area:type_name -> reorg.v1.Area
+	110, // 44: reorg.v1.CreateProjectRequest.due_date:type_name -> google.protobuf.Timestamp
+	106, // 45: reorg.v1.CreateProjectRequest.metadata:type_name -> reorg.v1.CreateProjectRequest.MetadataEntry
+	9,   // 46: reorg.v1.CreateProjectRequest.external_ref:type_name -> reorg.v1.ExternalRef
+	3,   // 47: reorg.v1.CreateProjectRequest.priority:type_name -> reorg.v1.Priority
+	5,   // 48: reorg.v1.CreateProjectResponse.project:type_name -> reorg.v1.Project
+	5,   // 49: reorg.v1.GetProjectResponse.project:type_name -> reorg.v1.Project
+	5,   // 50: reorg.v1.ListProjectsResponse.projects:type_name -> reorg.v1.Project
+	5,   // 51: reorg.v1.UpdateProjectRequest.project:type_name -> reorg.v1.Project
+	5,   // 52: reorg.v1.UpdateProjectResponse.project:type_name -> reorg.v1.Project
+	5,   // 53: reorg.v1.CompleteProjectResponse.project:type_name -> reorg.v1.Project
+	5,   // 54: reorg.v1.MoveProjectResponse.project:type_name -> reorg.v1.Project
+	5,   // 55: reorg.v1.FindProjectByExternalRefResponse.project:type_name -> reorg.v1.Project
+	3,   // 56: reorg.v1.CreateTaskRequest.priority:type_name -> reorg.v1.Priority
+	110, // 57: reorg.v1.CreateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
+	107, // 58: reorg.v1.CreateTaskRequest.metadata:type_name -> reorg.v1.CreateTaskRequest.MetadataEntry
+	9,   // 59: reorg.v1.CreateTaskRequest.external_ref:type_name -> reorg.v1.ExternalRef
+	110, // 60: reorg.v1.CreateTaskRequest.scheduled_date:type_name -> google.protobuf.Timestamp
+	11,  // 61: reorg.v1.CreateTaskResponse.task:type_name -> reorg.v1.Task
+	11,  // 62: reorg.v1.GetTaskResponse.task:type_name -> reorg.v1.Task
+	11,  // 63: reorg.v1.ListTasksResponse.tasks:type_name -> reorg.v1.Task
+	11,  // 64: reorg.v1.UpdateTaskRequest.task:type_name -> reorg.v1.Task
+	11,  // 65: reorg.v1.UpdateTaskResponse.task:type_name -> reorg.v1.Task
+	11,  // 66: reorg.v1.StartTaskResponse.task:type_name -> reorg.v1.Task
+	11,  // 67: reorg.v1.CompleteTaskResponse.task:type_name -> reorg.v1.Task
+	11,  // 68: reorg.v1.MoveTaskResponse.task:type_name -> reorg.v1.Task
+	11,  // 69: reorg.v1.StartTaskTimerResponse.task:type_name -> reorg.v1.Task
+	11,  // 70: reorg.v1.StopTaskTimerResponse.task:type_name -> reorg.v1.Task
+	2,   // 71: reorg.v1.TaskFilter.status:type_name -> reorg.v1.TaskStatus
+	3,   // 72: reorg.v1.TaskFilter.priority:type_name -> reorg.v1.Priority
+	2,   // 73: reorg.v1.TaskUpdate.status:type_name -> reorg.v1.TaskStatus
+	3,   // 74: reorg.v1.TaskUpdate.priority:type_name -> reorg.v1.Priority
+	110, // 75: reorg.v1.TaskUpdate.due_date:type_name -> google.protobuf.Timestamp
+	108, // 76: reorg.v1.TaskUpdate.metadata:type_name -> reorg.v1.TaskUpdate.MetadataEntry
+	110, // 77: reorg.v1.TaskUpdate.scheduled_date:type_name -> google.protobuf.Timestamp
+	59,  // 78: reorg.v1.BulkUpdateTasksRequest.filter:type_name -> reorg.v1.TaskFilter
+	60,  // 79: reorg.v1.BulkUpdateTasksRequest.update:type_name -> reorg.v1.TaskUpdate
+	11,  // 80: reorg.v1.BulkUpdateTasksResponse.tasks:type_name -> reorg.v1.Task
+	11,  // 81: reorg.v1.BatchCreateTasksRequest.tasks:type_name -> reorg.v1.Task
+	67,  // 82: reorg.v1.BatchCreateTasksResponse.results:type_name -> reorg.v1.BatchTaskResult
+	11,  // 83: reorg.v1.BatchUpdateTasksRequest.tasks:type_name -> reorg.v1.Task
+	67,  // 84: reorg.v1.BatchUpdateTasksResponse.results:type_name -> reorg.v1.BatchTaskResult
+	11,  // 85: reorg.v1.BatchTaskResult.task:type_name -> reorg.v1.Task
+	11,  // 86: reorg.v1.AttachToTaskResponse.task:type_name -> reorg.v1.Task
+	11,  // 87: reorg.v1.AddTaskCommentResponse.task:type_name -> reorg.v1.Task
+	11,  // 88: reorg.v1.FindTaskByExternalRefResponse.task:type_name -> reorg.v1.Task
+	11,  // 89: reorg.v1.UpsertTaskRequest.task:type_name -> reorg.v1.Task
+	11,  // 90: reorg.v1.UpsertTaskResponse.task:type_name -> reorg.v1.Task
+	6,   // 91: reorg.v1.AddNoteResponse.note:type_name -> reorg.v1.Note
+	6,   // 92: reorg.v1.ListNotesResponse.notes:type_name -> reorg.v1.Note
+	110, // 93: reorg.v1.CreateGoalRequest.due_date:type_name -> google.protobuf.Timestamp
+	109, // 94: reorg.v1.CreateGoalRequest.metadata:type_name -> reorg.v1.CreateGoalRequest.MetadataEntry
+	7,   // 95: reorg.v1.CreateGoalResponse.goal:type_name -> reorg.v1.Goal
+	7,   // 96: reorg.v1.GetGoalResponse.goal:type_name -> reorg.v1.Goal
+	7,   // 97: reorg.v1.ListGoalsResponse.goals:type_name -> reorg.v1.Goal
+	7,   // 98: reorg.v1.UpdateGoalRequest.goal:type_name -> reorg.v1.Goal
+	7,   // 99: reorg.v1.UpdateGoalResponse.goal:type_name -> reorg.v1.Goal
+	110, // 100: reorg.v1.WatchEvent.time:type_name -> google.protobuf.Timestamp
+	96,  // 101: reorg.v1.GetOverviewResponse.areas:type_name -> reorg.v1.AreaOverview
+	4,   // 102: reorg.v1.AreaOverview.area:type_name -> reorg.v1.Area
+	97,  // 103: reorg.v1.AreaOverview.projects:type_name -> reorg.v1.ProjectOverview
+	5,   // 104: reorg.v1.ProjectOverview.project:type_name -> reorg.v1.Project
+	11,  // 105: reorg.v1.ProjectOverview.tasks:type_name -> reorg.v1.Task
+	100, // 106: reorg.v1.ListTasksWithRefsResponse.tasks:type_name -> reorg.v1.TaskWithRefs
+	11,  // 107: reorg.v1.TaskWithRefs.task:type_name -> reorg.v1.Task
+	5,   // 108: reorg.v1.TaskWithRefs.project:type_name -> reorg.v1.Project
+	4,   // 109: reorg.v1.TaskWithRefs.area:type_name -> reorg.v1.Area
+	13,  // 110: reorg.v1.ReorgService.CreateArea:input_type -> reorg.v1.CreateAreaRequest
+	15,  // 111: reorg.v1.ReorgService.GetArea:input_type -> reorg.v1.GetAreaRequest
+	17,  // 112: reorg.v1.ReorgService.ListAreas:input_type -> reorg.v1.ListAreasRequest
+	19,  // 113: reorg.v1.ReorgService.UpdateArea:input_type -> reorg.v1.UpdateAreaRequest
+	21,  // 114: reorg.v1.ReorgService.DeleteArea:input_type -> reorg.v1.DeleteAreaRequest
+	23,  // 115: reorg.v1.ReorgService.CreateProject:input_type -> reorg.v1.CreateProjectRequest
+	25,  // 116: reorg.v1.ReorgService.GetProject:input_type -> reorg.v1.GetProjectRequest
+	27,  // 117: reorg.v1.ReorgService.ListProjects:input_type -> reorg.v1.ListProjectsRequest
+	29,  // 118: reorg.v1.ReorgService.UpdateProject:input_type -> reorg.v1.UpdateProjectRequest
+	31,  // 119: reorg.v1.ReorgService.DeleteProject:input_type -> reorg.v1.DeleteProjectRequest
+	33,  // 120: reorg.v1.ReorgService.CompleteProject:input_type -> reorg.v1.CompleteProjectRequest
+	35,  // 121: reorg.v1.ReorgService.MoveProject:input_type -> reorg.v1.MoveProjectRequest
+	37,  // 122: reorg.v1.ReorgService.FindProjectByExternalRef:input_type -> reorg.v1.FindProjectByExternalRefRequest
+	39,  // 123: reorg.v1.ReorgService.CreateTask:input_type -> reorg.v1.CreateTaskRequest
+	41,  // 124: reorg.v1.ReorgService.GetTask:input_type -> reorg.v1.GetTaskRequest
+	43,  // 125: reorg.v1.ReorgService.ListTasks:input_type -> reorg.v1.ListTasksRequest
+	45,  // 126: reorg.v1.ReorgService.UpdateTask:input_type -> reorg.v1.UpdateTaskRequest
+	47,  // 127: reorg.v1.ReorgService.DeleteTask:input_type -> reorg.v1.DeleteTaskRequest
+	49,  // 128: reorg.v1.ReorgService.StartTask:input_type -> reorg.v1.StartTaskRequest
+	51,  // 129: reorg.v1.ReorgService.CompleteTask:input_type -> reorg.v1.CompleteTaskRequest
+	55,  // 130: reorg.v1.ReorgService.StartTaskTimer:input_type -> reorg.v1.StartTaskTimerRequest
+	57,  // 131: reorg.v1.ReorgService.StopTaskTimer:input_type -> reorg.v1.StopTaskTimerRequest
+	53,  // 132: reorg.v1.ReorgService.MoveTask:input_type -> reorg.v1.MoveTaskRequest
+	61,  // 133: reorg.v1.ReorgService.BulkUpdateTasks:input_type -> reorg.v1.BulkUpdateTasksRequest
+	63,  // 134: reorg.v1.ReorgService.BatchCreateTasks:input_type -> reorg.v1.BatchCreateTasksRequest
+	65,  // 135: reorg.v1.ReorgService.BatchUpdateTasks:input_type -> reorg.v1.BatchUpdateTasksRequest
+	68,  // 136: reorg.v1.ReorgService.AttachToTask:input_type -> reorg.v1.AttachToTaskRequest
+	70,  // 137: reorg.v1.ReorgService.AddTaskComment:input_type -> reorg.v1.AddTaskCommentRequest
+	72,  // 138: reorg.v1.ReorgService.FindTaskByExternalRef:input_type -> reorg.v1.FindTaskByExternalRefRequest
+	74,  // 139: reorg.v1.ReorgService.UpsertTask:input_type -> reorg.v1.UpsertTaskRequest
+	76,  // 140: reorg.v1.ReorgService.AddNote:input_type -> reorg.v1.AddNoteRequest
+	78,  // 141: reorg.v1.ReorgService.ListNotes:input_type -> reorg.v1.ListNotesRequest
+	80,  // 142: reorg.v1.ReorgService.DeleteNote:input_type -> reorg.v1.DeleteNoteRequest
+	82,  // 143: reorg.v1.ReorgService.CreateGoal:input_type -> reorg.v1.CreateGoalRequest
+	84,  // 144: reorg.v1.ReorgService.GetGoal:input_type -> reorg.v1.GetGoalRequest
+	86,  // 145: reorg.v1.ReorgService.ListGoals:input_type -> reorg.v1.ListGoalsRequest
+	88,  // 146: reorg.v1.ReorgService.UpdateGoal:input_type -> reorg.v1.UpdateGoalRequest
+	90,  // 147: reorg.v1.ReorgService.DeleteGoal:input_type -> reorg.v1.DeleteGoalRequest
+	94,  // 148: reorg.v1.ReorgService.GetOverview:input_type -> reorg.v1.GetOverviewRequest
+	98,  // 149: reorg.v1.ReorgService.ListTasksWithRefs:input_type -> reorg.v1.ListTasksWithRefsRequest
+	92,  // 150: reorg.v1.ReorgService.Watch:input_type -> reorg.v1.WatchRequest
+	14,  // 151: reorg.v1.ReorgService.CreateArea:output_type -> reorg.v1.CreateAreaResponse
+	16,  // 152: reorg.v1.ReorgService.GetArea:output_type -> reorg.v1.GetAreaResponse
+	18,  // 153: reorg.v1.ReorgService.ListAreas:output_type -> reorg.v1.ListAreasResponse
+	20,  // 154: reorg.v1.ReorgService.UpdateArea:output_type -> reorg.v1.UpdateAreaResponse
+	22,  // 155: reorg.v1.ReorgService.DeleteArea:output_type -> reorg.v1.DeleteAreaResponse
+	24,  // 156: reorg.v1.ReorgService.CreateProject:output_type -> reorg.v1.CreateProjectResponse
+	26,  // 157: reorg.v1.ReorgService.GetProject:output_type -> reorg.v1.GetProjectResponse
+	28,  // 158: reorg.v1.ReorgService.ListProjects:output_type -> reorg.v1.ListProjectsResponse
+	30,  // 159: reorg.v1.ReorgService.UpdateProject:output_type -> reorg.v1.UpdateProjectResponse
+	32,  // 160: reorg.v1.ReorgService.DeleteProject:output_type -> reorg.v1.DeleteProjectResponse
+	34,  // 161: reorg.v1.ReorgService.CompleteProject:output_type -> reorg.v1.CompleteProjectResponse
+	36,  // 162: reorg.v1.ReorgService.MoveProject:output_type -> reorg.v1.MoveProjectResponse
+	38,  // 163: reorg.v1.ReorgService.FindProjectByExternalRef:output_type -> reorg.v1.FindProjectByExternalRefResponse
+	40,  // 164: reorg.v1.ReorgService.CreateTask:output_type -> reorg.v1.CreateTaskResponse
+	42,  // 165: reorg.v1.ReorgService.GetTask:output_type -> reorg.v1.GetTaskResponse
+	44,  // 166: reorg.v1.ReorgService.ListTasks:output_type -> reorg.v1.ListTasksResponse
+	46,  // 167: reorg.v1.ReorgService.UpdateTask:output_type -> reorg.v1.UpdateTaskResponse
+	48,  // 168: reorg.v1.ReorgService.DeleteTask:output_type -> reorg.v1.DeleteTaskResponse
+	50,  // 169: reorg.v1.ReorgService.StartTask:output_type -> reorg.v1.StartTaskResponse
+	52,  // 170: reorg.v1.ReorgService.CompleteTask:output_type -> reorg.v1.CompleteTaskResponse
+	56,  // 171: reorg.v1.ReorgService.StartTaskTimer:output_type -> reorg.v1.StartTaskTimerResponse
+	58,  // 172: reorg.v1.ReorgService.StopTaskTimer:output_type -> reorg.v1.StopTaskTimerResponse
+	54,  // 173: reorg.v1.ReorgService.MoveTask:output_type -> reorg.v1.MoveTaskResponse
+	62,  // 174: reorg.v1.ReorgService.BulkUpdateTasks:output_type -> reorg.v1.BulkUpdateTasksResponse
+	64,  // 175: reorg.v1.ReorgService.BatchCreateTasks:output_type -> reorg.v1.BatchCreateTasksResponse
+	66,  // 176: reorg.v1.ReorgService.BatchUpdateTasks:output_type -> reorg.v1.BatchUpdateTasksResponse
+	69,  // 177: reorg.v1.ReorgService.AttachToTask:output_type -> reorg.v1.AttachToTaskResponse
+	71,  // 178: reorg.v1.ReorgService.AddTaskComment:output_type -> reorg.v1.AddTaskCommentResponse
+	73,  // 179: reorg.v1.ReorgService.FindTaskByExternalRef:output_type -> reorg.v1.FindTaskByExternalRefResponse
+	75,  // 180: reorg.v1.ReorgService.UpsertTask:output_type -> reorg.v1.UpsertTaskResponse
+	77,  // 181: reorg.v1.ReorgService.AddNote:output_type -> reorg.v1.AddNoteResponse
+	79,  // 182: reorg.v1.ReorgService.ListNotes:output_type -> reorg.v1.ListNotesResponse
+	81,  // 183: reorg.v1.ReorgService.DeleteNote:output_type -> reorg.v1.DeleteNoteResponse
+	83,  // 184: reorg.v1.ReorgService.CreateGoal:output_type -> reorg.v1.CreateGoalResponse
+	85,  // 185: reorg.v1.ReorgService.GetGoal:output_type -> reorg.v1.GetGoalResponse
+	87,  // 186: reorg.v1.ReorgService.ListGoals:output_type -> reorg.v1.ListGoalsResponse
+	89,  // 187: reorg.v1.ReorgService.UpdateGoal:output_type -> reorg.v1.UpdateGoalResponse
+	91,  // 188: reorg.v1.ReorgService.DeleteGoal:output_type -> reorg.v1.DeleteGoalResponse
+	95,  // 189: reorg.v1.ReorgService.GetOverview:output_type -> reorg.v1.GetOverviewResponse
+	99,  // 190: reorg.v1.ReorgService.ListTasksWithRefs:output_type -> reorg.v1.ListTasksWithRefsResponse
+	93,  // 191: reorg.v1.ReorgService.Watch:output_type -> reorg.v1.WatchEvent
+	151, // [151:192] is the sub-list for method output_type
+	110, // [110:151] is the sub-list for method input_type
+	110, // [110:110] is the sub-list for extension type_name
+	110, // [110:110] is the sub-list for extension extendee
+	0,   // [0:110] is the sub-list for field type_name
 }
 
 func init() { file_reorg_proto_init() }
@@ -2544,13 +6480,16 @@ func file_reorg_proto_init() {
 	if File_reorg_proto != nil {
 		return
 	}
+	file_reorg_proto_msgTypes[0].OneofWrappers = []any{}
+	file_reorg_proto_msgTypes[55].OneofWrappers = []any{}
+	file_reorg_proto_msgTypes[56].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reorg_proto_rawDesc), len(file_reorg_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   39,
+			NumEnums:      4,
+			NumMessages:   106,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,24 +19,47 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ReorgService_CreateArea_FullMethodName      = "/reorg.v1.ReorgService/CreateArea"
-	ReorgService_GetArea_FullMethodName         = "/reorg.v1.ReorgService/GetArea"
-	ReorgService_ListAreas_FullMethodName       = "/reorg.v1.ReorgService/ListAreas"
-	ReorgService_UpdateArea_FullMethodName      = "/reorg.v1.ReorgService/UpdateArea"
-	ReorgService_DeleteArea_FullMethodName      = "/reorg.v1.ReorgService/DeleteArea"
-	ReorgService_CreateProject_FullMethodName   = "/reorg.v1.ReorgService/CreateProject"
-	ReorgService_GetProject_FullMethodName      = "/reorg.v1.ReorgService/GetProject"
-	ReorgService_ListProjects_FullMethodName    = "/reorg.v1.ReorgService/ListProjects"
-	ReorgService_UpdateProject_FullMethodName   = "/reorg.v1.ReorgService/UpdateProject"
-	ReorgService_DeleteProject_FullMethodName   = "/reorg.v1.ReorgService/DeleteProject"
-	ReorgService_CompleteProject_FullMethodName = "/reorg.v1.ReorgService/CompleteProject"
-	ReorgService_CreateTask_FullMethodName      = "/reorg.v1.ReorgService/CreateTask"
-	ReorgService_GetTask_FullMethodName         = "/reorg.v1.ReorgService/GetTask"
-	ReorgService_ListTasks_FullMethodName       = "/reorg.v1.ReorgService/ListTasks"
-	ReorgService_UpdateTask_FullMethodName      = "/reorg.v1.ReorgService/UpdateTask"
-	ReorgService_DeleteTask_FullMethodName      = "/reorg.v1.ReorgService/DeleteTask"
-	ReorgService_StartTask_FullMethodName       = "/reorg.v1.ReorgService/StartTask"
-	ReorgService_CompleteTask_FullMethodName    = "/reorg.v1.ReorgService/CompleteTask"
+	ReorgService_CreateArea_FullMethodName               = "/reorg.v1.ReorgService/CreateArea"
+	ReorgService_GetArea_FullMethodName                  = "/reorg.v1.ReorgService/GetArea"
+	ReorgService_ListAreas_FullMethodName                = "/reorg.v1.ReorgService/ListAreas"
+	ReorgService_UpdateArea_FullMethodName               = "/reorg.v1.ReorgService/UpdateArea"
+	ReorgService_DeleteArea_FullMethodName               = "/reorg.v1.ReorgService/DeleteArea"
+	ReorgService_CreateProject_FullMethodName            = "/reorg.v1.ReorgService/CreateProject"
+	ReorgService_GetProject_FullMethodName               = "/reorg.v1.ReorgService/GetProject"
+	ReorgService_ListProjects_FullMethodName             = "/reorg.v1.ReorgService/ListProjects"
+	ReorgService_UpdateProject_FullMethodName            = "/reorg.v1.ReorgService/UpdateProject"
+	ReorgService_DeleteProject_FullMethodName            = "/reorg.v1.ReorgService/DeleteProject"
+	ReorgService_CompleteProject_FullMethodName          = "/reorg.v1.ReorgService/CompleteProject"
+	ReorgService_MoveProject_FullMethodName              = "/reorg.v1.ReorgService/MoveProject"
+	ReorgService_FindProjectByExternalRef_FullMethodName = "/reorg.v1.ReorgService/FindProjectByExternalRef"
+	ReorgService_CreateTask_FullMethodName               = "/reorg.v1.ReorgService/CreateTask"
+	ReorgService_GetTask_FullMethodName                  = "/reorg.v1.ReorgService/GetTask"
+	ReorgService_ListTasks_FullMethodName                = "/reorg.v1.ReorgService/ListTasks"
+	ReorgService_UpdateTask_FullMethodName               = "/reorg.v1.ReorgService/UpdateTask"
+	ReorgService_DeleteTask_FullMethodName               = "/reorg.v1.ReorgService/DeleteTask"
+	ReorgService_StartTask_FullMethodName                = "/reorg.v1.ReorgService/StartTask"
+	ReorgService_CompleteTask_FullMethodName             = "/reorg.v1.ReorgService/CompleteTask"
+	ReorgService_StartTaskTimer_FullMethodName           = "/reorg.v1.ReorgService/StartTaskTimer"
+	ReorgService_StopTaskTimer_FullMethodName            = "/reorg.v1.ReorgService/StopTaskTimer"
+	ReorgService_MoveTask_FullMethodName                 = "/reorg.v1.ReorgService/MoveTask"
+	ReorgService_BulkUpdateTasks_FullMethodName          = "/reorg.v1.ReorgService/BulkUpdateTasks"
+	ReorgService_BatchCreateTasks_FullMethodName         = "/reorg.v1.ReorgService/BatchCreateTasks"
+	ReorgService_BatchUpdateTasks_FullMethodName         = "/reorg.v1.ReorgService/BatchUpdateTasks"
+	ReorgService_AttachToTask_FullMethodName             = "/reorg.v1.ReorgService/AttachToTask"
+	ReorgService_AddTaskComment_FullMethodName           = "/reorg.v1.ReorgService/AddTaskComment"
+	ReorgService_FindTaskByExternalRef_FullMethodName    = "/reorg.v1.ReorgService/FindTaskByExternalRef"
+	ReorgService_UpsertTask_FullMethodName               = "/reorg.v1.ReorgService/UpsertTask"
+	ReorgService_AddNote_FullMethodName                  = "/reorg.v1.ReorgService/AddNote"
+	ReorgService_ListNotes_FullMethodName                = "/reorg.v1.ReorgService/ListNotes"
+	ReorgService_DeleteNote_FullMethodName               = "/reorg.v1.ReorgService/DeleteNote"
+	ReorgService_CreateGoal_FullMethodName               = "/reorg.v1.ReorgService/CreateGoal"
+	ReorgService_GetGoal_FullMethodName                  = "/reorg.v1.ReorgService/GetGoal"
+	ReorgService_ListGoals_FullMethodName                = "/reorg.v1.ReorgService/ListGoals"
+	ReorgService_UpdateGoal_FullMethodName               = "/reorg.v1.ReorgService/UpdateGoal"
+	ReorgService_DeleteGoal_FullMethodName               = "/reorg.v1.ReorgService/DeleteGoal"
+	ReorgService_GetOverview_FullMethodName              = "/reorg.v1.ReorgService/GetOverview"
+	ReorgService_ListTasksWithRefs_FullMethodName        = "/reorg.v1.ReorgService/ListTasksWithRefs"
+	ReorgService_Watch_FullMethodName                    = "/reorg.v1.ReorgService/Watch"
 )
 
 // ReorgServiceClient is the client API for ReorgService service.
@@ -58,6 +81,8 @@ type ReorgServiceClient interface {
 	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
 	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
 	CompleteProject(ctx context.Context, in *CompleteProjectRequest, opts ...grpc.CallOption) (*CompleteProjectResponse, error)
+	MoveProject(ctx context.Context, in *MoveProjectRequest, opts ...grpc.CallOption) (*MoveProjectResponse, error)
+	FindProjectByExternalRef(ctx context.Context, in *FindProjectByExternalRefRequest, opts ...grpc.CallOption) (*FindProjectByExternalRefResponse, error)
 	// Task operations
 	CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*CreateTaskResponse, error)
 	GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*GetTaskResponse, error)
@@ -66,6 +91,34 @@ type ReorgServiceClient interface {
 	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*DeleteTaskResponse, error)
 	StartTask(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskResponse, error)
 	CompleteTask(ctx context.Context, in *CompleteTaskRequest, opts ...grpc.CallOption) (*CompleteTaskResponse, error)
+	StartTaskTimer(ctx context.Context, in *StartTaskTimerRequest, opts ...grpc.CallOption) (*StartTaskTimerResponse, error)
+	StopTaskTimer(ctx context.Context, in *StopTaskTimerRequest, opts ...grpc.CallOption) (*StopTaskTimerResponse, error)
+	MoveTask(ctx context.Context, in *MoveTaskRequest, opts ...grpc.CallOption) (*MoveTaskResponse, error)
+	BulkUpdateTasks(ctx context.Context, in *BulkUpdateTasksRequest, opts ...grpc.CallOption) (*BulkUpdateTasksResponse, error)
+	// Create or update many tasks in one call, for imports. Each task
+	// succeeds or fails on its own.
+	BatchCreateTasks(ctx context.Context, in *BatchCreateTasksRequest, opts ...grpc.CallOption) (*BatchCreateTasksResponse, error)
+	BatchUpdateTasks(ctx context.Context, in *BatchUpdateTasksRequest, opts ...grpc.CallOption) (*BatchUpdateTasksResponse, error)
+	AttachToTask(ctx context.Context, in *AttachToTaskRequest, opts ...grpc.CallOption) (*AttachToTaskResponse, error)
+	AddTaskComment(ctx context.Context, in *AddTaskCommentRequest, opts ...grpc.CallOption) (*AddTaskCommentResponse, error)
+	FindTaskByExternalRef(ctx context.Context, in *FindTaskByExternalRefRequest, opts ...grpc.CallOption) (*FindTaskByExternalRefResponse, error)
+	UpsertTask(ctx context.Context, in *UpsertTaskRequest, opts ...grpc.CallOption) (*UpsertTaskResponse, error)
+	// Note operations
+	AddNote(ctx context.Context, in *AddNoteRequest, opts ...grpc.CallOption) (*AddNoteResponse, error)
+	ListNotes(ctx context.Context, in *ListNotesRequest, opts ...grpc.CallOption) (*ListNotesResponse, error)
+	DeleteNote(ctx context.Context, in *DeleteNoteRequest, opts ...grpc.CallOption) (*DeleteNoteResponse, error)
+	// Goal operations
+	CreateGoal(ctx context.Context, in *CreateGoalRequest, opts ...grpc.CallOption) (*CreateGoalResponse, error)
+	GetGoal(ctx context.Context, in *GetGoalRequest, opts ...grpc.CallOption) (*GetGoalResponse, error)
+	ListGoals(ctx context.Context, in *ListGoalsRequest, opts ...grpc.CallOption) (*ListGoalsResponse, error)
+	UpdateGoal(ctx context.Context, in *UpdateGoalRequest, opts ...grpc.CallOption) (*UpdateGoalResponse, error)
+	DeleteGoal(ctx context.Context, in *DeleteGoalRequest, opts ...grpc.CallOption) (*DeleteGoalResponse, error)
+	// Aggregate operations, returning joined data in one call
+	GetOverview(ctx context.Context, in *GetOverviewRequest, opts ...grpc.CallOption) (*GetOverviewResponse, error)
+	ListTasksWithRefs(ctx context.Context, in *ListTasksWithRefsRequest, opts ...grpc.CallOption) (*ListTasksWithRefsResponse, error)
+	// Changes to the data, made through any client or outside reorg, as
+	// they happen
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEvent], error)
 }
 
 type reorgServiceClient struct {
@@ -186,6 +239,26 @@ func (c *reorgServiceClient) CompleteProject(ctx context.Context, in *CompletePr
 	return out, nil
 }
 
+func (c *reorgServiceClient) MoveProject(ctx context.Context, in *MoveProjectRequest, opts ...grpc.CallOption) (*MoveProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveProjectResponse)
+	err := c.cc.Invoke(ctx, ReorgService_MoveProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) FindProjectByExternalRef(ctx context.Context, in *FindProjectByExternalRefRequest, opts ...grpc.CallOption) (*FindProjectByExternalRefResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindProjectByExternalRefResponse)
+	err := c.cc.Invoke(ctx, ReorgService_FindProjectByExternalRef_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reorgServiceClient) CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*CreateTaskResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateTaskResponse)
@@ -256,6 +329,225 @@ func (c *reorgServiceClient) CompleteTask(ctx context.Context, in *CompleteTaskR
 	return out, nil
 }
 
+func (c *reorgServiceClient) StartTaskTimer(ctx context.Context, in *StartTaskTimerRequest, opts ...grpc.CallOption) (*StartTaskTimerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartTaskTimerResponse)
+	err := c.cc.Invoke(ctx, ReorgService_StartTaskTimer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) StopTaskTimer(ctx context.Context, in *StopTaskTimerRequest, opts ...grpc.CallOption) (*StopTaskTimerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopTaskTimerResponse)
+	err := c.cc.Invoke(ctx, ReorgService_StopTaskTimer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) MoveTask(ctx context.Context, in *MoveTaskRequest, opts ...grpc.CallOption) (*MoveTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveTaskResponse)
+	err := c.cc.Invoke(ctx, ReorgService_MoveTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) BulkUpdateTasks(ctx context.Context, in *BulkUpdateTasksRequest, opts ...grpc.CallOption) (*BulkUpdateTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateTasksResponse)
+	err := c.cc.Invoke(ctx, ReorgService_BulkUpdateTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) BatchCreateTasks(ctx context.Context, in *BatchCreateTasksRequest, opts ...grpc.CallOption) (*BatchCreateTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateTasksResponse)
+	err := c.cc.Invoke(ctx, ReorgService_BatchCreateTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) BatchUpdateTasks(ctx context.Context, in *BatchUpdateTasksRequest, opts ...grpc.CallOption) (*BatchUpdateTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateTasksResponse)
+	err := c.cc.Invoke(ctx, ReorgService_BatchUpdateTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) AttachToTask(ctx context.Context, in *AttachToTaskRequest, opts ...grpc.CallOption) (*AttachToTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachToTaskResponse)
+	err := c.cc.Invoke(ctx, ReorgService_AttachToTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) AddTaskComment(ctx context.Context, in *AddTaskCommentRequest, opts ...grpc.CallOption) (*AddTaskCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTaskCommentResponse)
+	err := c.cc.Invoke(ctx, ReorgService_AddTaskComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) FindTaskByExternalRef(ctx context.Context, in *FindTaskByExternalRefRequest, opts ...grpc.CallOption) (*FindTaskByExternalRefResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindTaskByExternalRefResponse)
+	err := c.cc.Invoke(ctx, ReorgService_FindTaskByExternalRef_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) UpsertTask(ctx context.Context, in *UpsertTaskRequest, opts ...grpc.CallOption) (*UpsertTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertTaskResponse)
+	err := c.cc.Invoke(ctx, ReorgService_UpsertTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) AddNote(ctx context.Context, in *AddNoteRequest, opts ...grpc.CallOption) (*AddNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddNoteResponse)
+	err := c.cc.Invoke(ctx, ReorgService_AddNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) ListNotes(ctx context.Context, in *ListNotesRequest, opts ...grpc.CallOption) (*ListNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotesResponse)
+	err := c.cc.Invoke(ctx, ReorgService_ListNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) DeleteNote(ctx context.Context, in *DeleteNoteRequest, opts ...grpc.CallOption) (*DeleteNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteNoteResponse)
+	err := c.cc.Invoke(ctx, ReorgService_DeleteNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) CreateGoal(ctx context.Context, in *CreateGoalRequest, opts ...grpc.CallOption) (*CreateGoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGoalResponse)
+	err := c.cc.Invoke(ctx, ReorgService_CreateGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) GetGoal(ctx context.Context, in *GetGoalRequest, opts ...grpc.CallOption) (*GetGoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGoalResponse)
+	err := c.cc.Invoke(ctx, ReorgService_GetGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) ListGoals(ctx context.Context, in *ListGoalsRequest, opts ...grpc.CallOption) (*ListGoalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGoalsResponse)
+	err := c.cc.Invoke(ctx, ReorgService_ListGoals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) UpdateGoal(ctx context.Context, in *UpdateGoalRequest, opts ...grpc.CallOption) (*UpdateGoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGoalResponse)
+	err := c.cc.Invoke(ctx, ReorgService_UpdateGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) DeleteGoal(ctx context.Context, in *DeleteGoalRequest, opts ...grpc.CallOption) (*DeleteGoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGoalResponse)
+	err := c.cc.Invoke(ctx, ReorgService_DeleteGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) GetOverview(ctx context.Context, in *GetOverviewRequest, opts ...grpc.CallOption) (*GetOverviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOverviewResponse)
+	err := c.cc.Invoke(ctx, ReorgService_GetOverview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) ListTasksWithRefs(ctx context.Context, in *ListTasksWithRefsRequest, opts ...grpc.CallOption) (*ListTasksWithRefsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTasksWithRefsResponse)
+	err := c.cc.Invoke(ctx, ReorgService_ListTasksWithRefs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reorgServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ReorgService_ServiceDesc.Streams[0], ReorgService_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchRequest, WatchEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReorgService_WatchClient = grpc.ServerStreamingClient[WatchEvent]
+
 // ReorgServiceServer is the server API for ReorgService service.
 // All implementations must embed UnimplementedReorgServiceServer
 // for forward compatibility.
@@ -275,6 +567,8 @@ type ReorgServiceServer interface {
 	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
 	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
 	CompleteProject(context.Context, *CompleteProjectRequest) (*CompleteProjectResponse, error)
+	MoveProject(context.Context, *MoveProjectRequest) (*MoveProjectResponse, error)
+	FindProjectByExternalRef(context.Context, *FindProjectByExternalRefRequest) (*FindProjectByExternalRefResponse, error)
 	// Task operations
 	CreateTask(context.Context, *CreateTaskRequest) (*CreateTaskResponse, error)
 	GetTask(context.Context, *GetTaskRequest) (*GetTaskResponse, error)
@@ -283,6 +577,34 @@ type ReorgServiceServer interface {
 	DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error)
 	StartTask(context.Context, *StartTaskRequest) (*StartTaskResponse, error)
 	CompleteTask(context.Context, *CompleteTaskRequest) (*CompleteTaskResponse, error)
+	StartTaskTimer(context.Context, *StartTaskTimerRequest) (*StartTaskTimerResponse, error)
+	StopTaskTimer(context.Context, *StopTaskTimerRequest) (*StopTaskTimerResponse, error)
+	MoveTask(context.Context, *MoveTaskRequest) (*MoveTaskResponse, error)
+	BulkUpdateTasks(context.Context, *BulkUpdateTasksRequest) (*BulkUpdateTasksResponse, error)
+	// Create or update many tasks in one call, for imports. Each task
+	// succeeds or fails on its own.
+	BatchCreateTasks(context.Context, *BatchCreateTasksRequest) (*BatchCreateTasksResponse, error)
+	BatchUpdateTasks(context.Context, *BatchUpdateTasksRequest) (*BatchUpdateTasksResponse, error)
+	AttachToTask(context.Context, *AttachToTaskRequest) (*AttachToTaskResponse, error)
+	AddTaskComment(context.Context, *AddTaskCommentRequest) (*AddTaskCommentResponse, error)
+	FindTaskByExternalRef(context.Context, *FindTaskByExternalRefRequest) (*FindTaskByExternalRefResponse, error)
+	UpsertTask(context.Context, *UpsertTaskRequest) (*UpsertTaskResponse, error)
+	// Note operations
+	AddNote(context.Context, *AddNoteRequest) (*AddNoteResponse, error)
+	ListNotes(context.Context, *ListNotesRequest) (*ListNotesResponse, error)
+	DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error)
+	// Goal operations
+	CreateGoal(context.Context, *CreateGoalRequest) (*CreateGoalResponse, error)
+	GetGoal(context.Context, *GetGoalRequest) (*GetGoalResponse, error)
+	ListGoals(context.Context, *ListGoalsRequest) (*ListGoalsResponse, error)
+	UpdateGoal(context.Context, *UpdateGoalRequest) (*UpdateGoalResponse, error)
+	DeleteGoal(context.Context, *DeleteGoalRequest) (*DeleteGoalResponse, error)
+	// Aggregate operations, returning joined data in one call
+	GetOverview(context.Context, *GetOverviewRequest) (*GetOverviewResponse, error)
+	ListTasksWithRefs(context.Context, *ListTasksWithRefsRequest) (*ListTasksWithRefsResponse, error)
+	// Changes to the data, made through any client or outside reorg, as
+	// they happen
+	Watch(*WatchRequest, grpc.ServerStreamingServer[WatchEvent]) error
 	mustEmbedUnimplementedReorgServiceServer()
 }
 
@@ -326,6 +648,12 @@ func (UnimplementedReorgServiceServer) DeleteProject(context.Context, *DeletePro
 func (UnimplementedReorgServiceServer) CompleteProject(context.Context, *CompleteProjectRequest) (*CompleteProjectResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CompleteProject not implemented")
 }
+func (UnimplementedReorgServiceServer) MoveProject(context.Context, *MoveProjectRequest) (*MoveProjectResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveProject not implemented")
+}
+func (UnimplementedReorgServiceServer) FindProjectByExternalRef(context.Context, *FindProjectByExternalRefRequest) (*FindProjectByExternalRefResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FindProjectByExternalRef not implemented")
+}
 func (UnimplementedReorgServiceServer) CreateTask(context.Context, *CreateTaskRequest) (*CreateTaskResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateTask not implemented")
 }
@@ -347,6 +675,69 @@ func (UnimplementedReorgServiceServer) StartTask(context.Context, *StartTaskRequ
 func (UnimplementedReorgServiceServer) CompleteTask(context.Context, *CompleteTaskRequest) (*CompleteTaskResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CompleteTask not implemented")
 }
+func (UnimplementedReorgServiceServer) StartTaskTimer(context.Context, *StartTaskTimerRequest) (*StartTaskTimerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartTaskTimer not implemented")
+}
+func (UnimplementedReorgServiceServer) StopTaskTimer(context.Context, *StopTaskTimerRequest) (*StopTaskTimerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StopTaskTimer not implemented")
+}
+func (UnimplementedReorgServiceServer) MoveTask(context.Context, *MoveTaskRequest) (*MoveTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveTask not implemented")
+}
+func (UnimplementedReorgServiceServer) BulkUpdateTasks(context.Context, *BulkUpdateTasksRequest) (*BulkUpdateTasksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkUpdateTasks not implemented")
+}
+func (UnimplementedReorgServiceServer) BatchCreateTasks(context.Context, *BatchCreateTasksRequest) (*BatchCreateTasksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchCreateTasks not implemented")
+}
+func (UnimplementedReorgServiceServer) BatchUpdateTasks(context.Context, *BatchUpdateTasksRequest) (*BatchUpdateTasksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchUpdateTasks not implemented")
+}
+func (UnimplementedReorgServiceServer) AttachToTask(context.Context, *AttachToTaskRequest) (*AttachToTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AttachToTask not implemented")
+}
+func (UnimplementedReorgServiceServer) AddTaskComment(context.Context, *AddTaskCommentRequest) (*AddTaskCommentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddTaskComment not implemented")
+}
+func (UnimplementedReorgServiceServer) FindTaskByExternalRef(context.Context, *FindTaskByExternalRefRequest) (*FindTaskByExternalRefResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FindTaskByExternalRef not implemented")
+}
+func (UnimplementedReorgServiceServer) UpsertTask(context.Context, *UpsertTaskRequest) (*UpsertTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpsertTask not implemented")
+}
+func (UnimplementedReorgServiceServer) AddNote(context.Context, *AddNoteRequest) (*AddNoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddNote not implemented")
+}
+func (UnimplementedReorgServiceServer) ListNotes(context.Context, *ListNotesRequest) (*ListNotesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNotes not implemented")
+}
+func (UnimplementedReorgServiceServer) DeleteNote(context.Context, *DeleteNoteRequest) (*DeleteNoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteNote not implemented")
+}
+func (UnimplementedReorgServiceServer) CreateGoal(context.Context, *CreateGoalRequest) (*CreateGoalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateGoal not implemented")
+}
+func (UnimplementedReorgServiceServer) GetGoal(context.Context, *GetGoalRequest) (*GetGoalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGoal not implemented")
+}
+func (UnimplementedReorgServiceServer) ListGoals(context.Context, *ListGoalsRequest) (*ListGoalsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGoals not implemented")
+}
+func (UnimplementedReorgServiceServer) UpdateGoal(context.Context, *UpdateGoalRequest) (*UpdateGoalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateGoal not implemented")
+}
+func (UnimplementedReorgServiceServer) DeleteGoal(context.Context, *DeleteGoalRequest) (*DeleteGoalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGoal not implemented")
+}
+func (UnimplementedReorgServiceServer) GetOverview(context.Context, *GetOverviewRequest) (*GetOverviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOverview not implemented")
+}
+func (UnimplementedReorgServiceServer) ListTasksWithRefs(context.Context, *ListTasksWithRefsRequest) (*ListTasksWithRefsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTasksWithRefs not implemented")
+}
+func (UnimplementedReorgServiceServer) Watch(*WatchRequest, grpc.ServerStreamingServer[WatchEvent]) error {
+	return status.Error(codes.Unimplemented, "method Watch not implemented")
+}
 func (UnimplementedReorgServiceServer) mustEmbedUnimplementedReorgServiceServer() {}
 func (UnimplementedReorgServiceServer) testEmbeddedByValue()                      {}
 
@@ -566,6 +957,42 @@ func _ReorgService_CompleteProject_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReorgService_MoveProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).MoveProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_MoveProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).MoveProject(ctx, req.(*MoveProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_FindProjectByExternalRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindProjectByExternalRefRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).FindProjectByExternalRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_FindProjectByExternalRef_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).FindProjectByExternalRef(ctx, req.(*FindProjectByExternalRefRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ReorgService_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateTaskRequest)
 	if err := dec(in); err != nil {
@@ -692,48 +1119,419 @@ func _ReorgService_CompleteTask_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-// ReorgService_ServiceDesc is the grpc.ServiceDesc for ReorgService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ReorgService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "reorg.v1.ReorgService",
-	HandlerType: (*ReorgServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateArea",
-			Handler:    _ReorgService_CreateArea_Handler,
-		},
-		{
-			MethodName: "GetArea",
-			Handler:    _ReorgService_GetArea_Handler,
-		},
-		{
-			MethodName: "ListAreas",
-			Handler:    _ReorgService_ListAreas_Handler,
-		},
-		{
-			MethodName: "UpdateArea",
-			Handler:    _ReorgService_UpdateArea_Handler,
-		},
-		{
-			MethodName: "DeleteArea",
-			Handler:    _ReorgService_DeleteArea_Handler,
-		},
-		{
-			MethodName: "CreateProject",
-			Handler:    _ReorgService_CreateProject_Handler,
-		},
-		{
-			MethodName: "GetProject",
-			Handler:    _ReorgService_GetProject_Handler,
-		},
-		{
-			MethodName: "ListProjects",
-			Handler:    _ReorgService_ListProjects_Handler,
-		},
-		{
-			MethodName: "UpdateProject",
-			Handler:    _ReorgService_UpdateProject_Handler,
+func _ReorgService_StartTaskTimer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTaskTimerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).StartTaskTimer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_StartTaskTimer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).StartTaskTimer(ctx, req.(*StartTaskTimerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_StopTaskTimer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopTaskTimerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).StopTaskTimer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_StopTaskTimer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).StopTaskTimer(ctx, req.(*StopTaskTimerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_MoveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).MoveTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_MoveTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).MoveTask(ctx, req.(*MoveTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_BulkUpdateTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).BulkUpdateTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_BulkUpdateTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).BulkUpdateTasks(ctx, req.(*BulkUpdateTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_BatchCreateTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).BatchCreateTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_BatchCreateTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).BatchCreateTasks(ctx, req.(*BatchCreateTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_BatchUpdateTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).BatchUpdateTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_BatchUpdateTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).BatchUpdateTasks(ctx, req.(*BatchUpdateTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_AttachToTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachToTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).AttachToTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_AttachToTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).AttachToTask(ctx, req.(*AttachToTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_AddTaskComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTaskCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).AddTaskComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_AddTaskComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).AddTaskComment(ctx, req.(*AddTaskCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_FindTaskByExternalRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindTaskByExternalRefRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).FindTaskByExternalRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_FindTaskByExternalRef_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).FindTaskByExternalRef(ctx, req.(*FindTaskByExternalRefRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_UpsertTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).UpsertTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_UpsertTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).UpsertTask(ctx, req.(*UpsertTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_AddNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).AddNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_AddNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).AddNote(ctx, req.(*AddNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_ListNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).ListNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_ListNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).ListNotes(ctx, req.(*ListNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_DeleteNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).DeleteNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_DeleteNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).DeleteNote(ctx, req.(*DeleteNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_CreateGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).CreateGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_CreateGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).CreateGoal(ctx, req.(*CreateGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_GetGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).GetGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_GetGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).GetGoal(ctx, req.(*GetGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_ListGoals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGoalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).ListGoals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_ListGoals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).ListGoals(ctx, req.(*ListGoalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_UpdateGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).UpdateGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_UpdateGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).UpdateGoal(ctx, req.(*UpdateGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_DeleteGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).DeleteGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_DeleteGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).DeleteGoal(ctx, req.(*DeleteGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_GetOverview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOverviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).GetOverview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_GetOverview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).GetOverview(ctx, req.(*GetOverviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_ListTasksWithRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksWithRefsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReorgServiceServer).ListTasksWithRefs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReorgService_ListTasksWithRefs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReorgServiceServer).ListTasksWithRefs(ctx, req.(*ListTasksWithRefsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReorgService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReorgServiceServer).Watch(m, &grpc.GenericServerStream[WatchRequest, WatchEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReorgService_WatchServer = grpc.ServerStreamingServer[WatchEvent]
+
+// ReorgService_ServiceDesc is the grpc.ServiceDesc for ReorgService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReorgService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "reorg.v1.ReorgService",
+	HandlerType: (*ReorgServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateArea",
+			Handler:    _ReorgService_CreateArea_Handler,
+		},
+		{
+			MethodName: "GetArea",
+			Handler:    _ReorgService_GetArea_Handler,
+		},
+		{
+			MethodName: "ListAreas",
+			Handler:    _ReorgService_ListAreas_Handler,
+		},
+		{
+			MethodName: "UpdateArea",
+			Handler:    _ReorgService_UpdateArea_Handler,
+		},
+		{
+			MethodName: "DeleteArea",
+			Handler:    _ReorgService_DeleteArea_Handler,
+		},
+		{
+			MethodName: "CreateProject",
+			Handler:    _ReorgService_CreateProject_Handler,
+		},
+		{
+			MethodName: "GetProject",
+			Handler:    _ReorgService_GetProject_Handler,
+		},
+		{
+			MethodName: "ListProjects",
+			Handler:    _ReorgService_ListProjects_Handler,
+		},
+		{
+			MethodName: "UpdateProject",
+			Handler:    _ReorgService_UpdateProject_Handler,
 		},
 		{
 			MethodName: "DeleteProject",
@@ -743,6 +1541,14 @@ var ReorgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CompleteProject",
 			Handler:    _ReorgService_CompleteProject_Handler,
 		},
+		{
+			MethodName: "MoveProject",
+			Handler:    _ReorgService_MoveProject_Handler,
+		},
+		{
+			MethodName: "FindProjectByExternalRef",
+			Handler:    _ReorgService_FindProjectByExternalRef_Handler,
+		},
 		{
 			MethodName: "CreateTask",
 			Handler:    _ReorgService_CreateTask_Handler,
@@ -771,7 +1577,93 @@ var ReorgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CompleteTask",
 			Handler:    _ReorgService_CompleteTask_Handler,
 		},
+		{
+			MethodName: "StartTaskTimer",
+			Handler:    _ReorgService_StartTaskTimer_Handler,
+		},
+		{
+			MethodName: "StopTaskTimer",
+			Handler:    _ReorgService_StopTaskTimer_Handler,
+		},
+		{
+			MethodName: "MoveTask",
+			Handler:    _ReorgService_MoveTask_Handler,
+		},
+		{
+			MethodName: "BulkUpdateTasks",
+			Handler:    _ReorgService_BulkUpdateTasks_Handler,
+		},
+		{
+			MethodName: "BatchCreateTasks",
+			Handler:    _ReorgService_BatchCreateTasks_Handler,
+		},
+		{
+			MethodName: "BatchUpdateTasks",
+			Handler:    _ReorgService_BatchUpdateTasks_Handler,
+		},
+		{
+			MethodName: "AttachToTask",
+			Handler:    _ReorgService_AttachToTask_Handler,
+		},
+		{
+			MethodName: "AddTaskComment",
+			Handler:    _ReorgService_AddTaskComment_Handler,
+		},
+		{
+			MethodName: "FindTaskByExternalRef",
+			Handler:    _ReorgService_FindTaskByExternalRef_Handler,
+		},
+		{
+			MethodName: "UpsertTask",
+			Handler:    _ReorgService_UpsertTask_Handler,
+		},
+		{
+			MethodName: "AddNote",
+			Handler:    _ReorgService_AddNote_Handler,
+		},
+		{
+			MethodName: "ListNotes",
+			Handler:    _ReorgService_ListNotes_Handler,
+		},
+		{
+			MethodName: "DeleteNote",
+			Handler:    _ReorgService_DeleteNote_Handler,
+		},
+		{
+			MethodName: "CreateGoal",
+			Handler:    _ReorgService_CreateGoal_Handler,
+		},
+		{
+			MethodName: "GetGoal",
+			Handler:    _ReorgService_GetGoal_Handler,
+		},
+		{
+			MethodName: "ListGoals",
+			Handler:    _ReorgService_ListGoals_Handler,
+		},
+		{
+			MethodName: "UpdateGoal",
+			Handler:    _ReorgService_UpdateGoal_Handler,
+		},
+		{
+			MethodName: "DeleteGoal",
+			Handler:    _ReorgService_DeleteGoal_Handler,
+		},
+		{
+			MethodName: "GetOverview",
+			Handler:    _ReorgService_GetOverview_Handler,
+		},
+		{
+			MethodName: "ListTasksWithRefs",
+			Handler:    _ReorgService_ListTasksWithRefs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _ReorgService_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "reorg.proto",
 }
@@ -0,0 +1,262 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/tracing"
+)
+
+// Options configure how a remote client connects to the server and how it
+// handles slow and failed calls. The zero value connects without TLS, with
+// no deadlines, retries or keepalive pings.
+type Options struct {
+	// Token is sent with every call, for servers hosting several users
+	Token string
+
+	// Actor names what makes the calls, such as the CLI command, for the
+	// server's audit log
+	Actor string
+
+	TLS TLSOptions
+
+	// Timeout is the deadline of each call that doesn't have one, and of
+	// each of its attempts
+	Timeout time.Duration
+
+	// Retries is how many times a call that only reads is tried again when
+	// the server can't be reached, waiting RetryBackoff before the first
+	// retry and twice as long before each one after
+	Retries      int
+	RetryBackoff time.Duration
+
+	// KeepaliveTime is how long a connection may be idle before the client
+	// pings the server, and KeepaliveTimeout how long it waits for the
+	// answer before dropping the connection. Zero sends no pings.
+	KeepaliveTime    time.Duration
+	KeepaliveTimeout time.Duration
+
+	// Location is the timezone dates are read into, the system's if nil
+	Location *time.Location
+}
+
+// TLSOptions configure TLS to the server
+type TLSOptions struct {
+	Enabled bool
+	CAFile  string // CA certificates to verify the server with, the system's if empty
+
+	// CertFile and KeyFile are a client certificate, for servers that
+	// require one
+	CertFile string
+	KeyFile  string
+
+	ServerName         string // name to verify the certificate against, the address's host if empty
+	InsecureSkipVerify bool   // don't verify the server's certificate, for testing
+}
+
+// maxRetryBackoff caps the wait between retries
+const maxRetryBackoff = 5 * time.Second
+
+// dialOptions returns the gRPC options for the client options
+func (o Options) dialOptions() ([]grpc.DialOption, error) {
+	creds := insecure.NewCredentials()
+	if o.TLS.Enabled {
+		config, err := o.TLS.config()
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(config)
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+
+	if o.Token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerToken(o.Token)))
+	}
+	if o.KeepaliveTime > 0 {
+		opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                o.KeepaliveTime,
+			Timeout:             o.KeepaliveTimeout,
+			PermitWithoutStream: true,
+		}))
+	}
+
+	// Errors are converted last, so retries see the status codes, and
+	// retries go before the deadline, so it applies to each attempt
+	interceptors := []grpc.UnaryClientInterceptor{domainErrors, tracing.UnaryClientInterceptor}
+	if o.Actor != "" {
+		interceptors = append(interceptors, o.actor)
+	}
+	if o.Retries > 0 {
+		interceptors = append(interceptors, o.retry)
+	}
+	if o.Timeout > 0 {
+		interceptors = append(interceptors, o.deadline)
+	}
+	opts = append(opts, grpc.WithChainUnaryInterceptor(interceptors...))
+	return opts, nil
+}
+
+// config builds the TLS configuration, loading the CA and client
+// certificate files
+func (t TLSOptions) config() (*tls.Config, error) {
+	config := &tls.Config{
+		ServerName:         t.ServerName,
+		InsecureSkipVerify: t.InsecureSkipVerify,
+		MinVersion:         tls.VersionTLS12,
+	}
+	if t.CAFile != "" {
+		pem, err := os.ReadFile(t.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates in CA file %s", t.CAFile)
+		}
+		config.RootCAs = pool
+	}
+	if t.CertFile != "" || t.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+// actorHeader is the metadata naming the actor, as the server reads it
+const actorHeader = "reorg-actor"
+
+// actor sends the actor with a call
+func (o Options) actor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(metadata.AppendToOutgoingContext(ctx, actorHeader, o.Actor), method, req, reply, cc, opts...)
+}
+
+// deadline gives calls without a deadline the configured timeout
+func (o Options) deadline(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.Timeout)
+		defer cancel()
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// retry tries calls that only read again, with exponential backoff, while
+// the server is unavailable or an attempt runs out of time. Calls that
+// change data are never retried, as the first attempt may have gone
+// through.
+func (o Options) retry(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if !isRead(method) {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	backoff := o.RetryBackoff
+	if backoff <= 0 {
+		backoff = 100 * time.Millisecond
+	}
+	for attempt := 0; ; attempt++ {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err == nil || attempt == o.Retries || !retryable(err) || ctx.Err() != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxRetryBackoff)
+	}
+}
+
+// isRead returns true for the calls that only read: those named Get, List
+// or Find, as the server's read-only mode has it
+func isRead(method string) bool {
+	name := path.Base(method)
+	for _, prefix := range []string{"Get", "List", "Find"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// retryable returns true for errors another attempt may not hit
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+// errorDomain is the domain of the server's ErrorInfo details
+const errorDomain = "reorg"
+
+// domainErrors turns the server's errors into domain errors of the kind the
+// server reported, so callers handle them as in embedded mode
+func domainErrors(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	if kind := statusKind(st); kind != nil {
+		return &remoteError{kind: kind, status: st}
+	}
+	return err
+}
+
+// statusKind returns the kind of domain error of a status: the one in its
+// ErrorInfo detail, or else the one its code stands for
+func statusKind(st *status.Status) error {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Domain == errorDomain {
+			if kind := domain.KindOf(strings.ToLower(info.Reason)); kind != nil {
+				return kind
+			}
+		}
+	}
+	switch st.Code() {
+	case codes.NotFound:
+		return domain.ErrNotFound
+	case codes.InvalidArgument:
+		return domain.ErrInvalid
+	case codes.AlreadyExists:
+		return domain.ErrAlreadyExists
+	case codes.Aborted:
+		return domain.ErrConflict
+	}
+	return nil
+}
+
+// remoteError is an error of a kind from the server, with its message
+type remoteError struct {
+	kind   error
+	status *status.Status
+}
+
+func (e *remoteError) Error() string              { return e.status.Message() }
+func (e *remoteError) Unwrap() error              { return e.kind }
+func (e *remoteError) GRPCStatus() *status.Status { return e.status }
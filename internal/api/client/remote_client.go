@@ -2,36 +2,63 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/ihavespoons/reorg/api/proto/gen"
 	"github.com/ihavespoons/reorg/internal/domain"
 	"github.com/ihavespoons/reorg/internal/service"
+	"github.com/ihavespoons/reorg/internal/storage"
 )
 
 // RemoteClient implements ReorgClient by connecting via gRPC
 type RemoteClient struct {
 	conn   *grpc.ClientConn
 	client pb.ReorgServiceClient
+	loc    *time.Location
 }
 
-// NewRemoteClient creates a new remote client connected to the given address
-func NewRemoteClient(address string) (*RemoteClient, error) {
-	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewRemoteClient creates a new remote client for the server at address,
+// connecting as the options say
+func NewRemoteClient(address string, options Options) (*RemoteClient, error) {
+	opts, err := options.dialOptions()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.NewClient(address, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to server: %w", err)
 	}
 
+	loc := options.Location
+	if loc == nil {
+		loc = time.Local
+	}
 	return &RemoteClient{
 		conn:   conn,
 		client: pb.NewReorgServiceClient(conn),
+		loc:    loc,
 	}, nil
 }
 
+// bearerToken sends a token in the authorization metadata of each call
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity is false, so tokens also work with servers
+// reached without TLS
+func (t bearerToken) RequireTransportSecurity() bool {
+	return false
+}
+
 // Close closes the gRPC connection
 func (c *RemoteClient) Close() error {
 	return c.conn.Close()
@@ -41,8 +68,13 @@ func (c *RemoteClient) Close() error {
 
 func (c *RemoteClient) CreateArea(ctx context.Context, area *domain.Area) (*domain.Area, error) {
 	resp, err := c.client.CreateArea(ctx, &pb.CreateAreaRequest{
-		Title:   area.Title,
-		Content: area.Content,
+		Title:     area.Title,
+		Content:   area.Content,
+		Tags:      area.Tags,
+		Priority:  priorityToProto(area.Priority),
+		Review:    string(area.Review),
+		Metadata:  area.Metadata,
+		SortOrder: int32(area.SortOrder),
 	})
 	if err != nil {
 		return nil, err
@@ -102,20 +134,26 @@ func (c *RemoteClient) DeleteArea(ctx context.Context, id string) error {
 
 func (c *RemoteClient) CreateProject(ctx context.Context, project *domain.Project) (*domain.Project, error) {
 	req := &pb.CreateProjectRequest{
-		Title:   project.Title,
-		AreaId:  project.AreaID,
-		Content: project.Content,
-		Tags:    project.Tags,
+		Title:           project.Title,
+		AreaId:          project.AreaID,
+		Content:         project.Content,
+		Tags:            project.Tags,
+		Notify:          project.Notify,
+		Priority:        priorityToProto(project.Priority),
+		Metadata:        project.Metadata,
+		ExternalRef:     externalRefToProto(project.ExternalRef),
+		GoalId:          project.GoalID,
+		ParentProjectId: project.ParentProjectID,
 	}
 	if project.DueDate != nil {
-		req.DueDate = timestamppb.New(*project.DueDate)
+		req.DueDate = dayToProto(*project.DueDate)
 	}
 
 	resp, err := c.client.CreateProject(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	return protoToProject(resp.Project), nil
+	return protoToProject(resp.Project, c.loc), nil
 }
 
 func (c *RemoteClient) GetProject(ctx context.Context, id string) (*domain.Project, error) {
@@ -123,7 +161,7 @@ func (c *RemoteClient) GetProject(ctx context.Context, id string) (*domain.Proje
 	if err != nil {
 		return nil, err
 	}
-	return protoToProject(resp.Project), nil
+	return protoToProject(resp.Project, c.loc), nil
 }
 
 func (c *RemoteClient) GetProjectBySlug(ctx context.Context, areaID, slug string) (*domain.Project, error) {
@@ -148,7 +186,20 @@ func (c *RemoteClient) ListProjects(ctx context.Context, areaID string) ([]*doma
 
 	projects := make([]*domain.Project, len(resp.Projects))
 	for i, p := range resp.Projects {
-		projects[i] = protoToProject(p)
+		projects[i] = protoToProject(p, c.loc)
+	}
+	return projects, nil
+}
+
+func (c *RemoteClient) QueryProjects(ctx context.Context, query string) ([]*domain.Project, error) {
+	resp, err := c.client.ListProjects(ctx, &pb.ListProjectsRequest{Query: query})
+	if err != nil {
+		return nil, err
+	}
+
+	projects := make([]*domain.Project, len(resp.Projects))
+	for i, p := range resp.Projects {
+		projects[i] = protoToProject(p, c.loc)
 	}
 	return projects, nil
 }
@@ -161,16 +212,20 @@ func (c *RemoteClient) ListAllProjects(ctx context.Context) ([]*domain.Project,
 
 	projects := make([]*domain.Project, len(resp.Projects))
 	for i, p := range resp.Projects {
-		projects[i] = protoToProject(p)
+		projects[i] = protoToProject(p, c.loc)
 	}
 	return projects, nil
 }
 
 func (c *RemoteClient) UpdateProject(ctx context.Context, project *domain.Project) error {
-	_, err := c.client.UpdateProject(ctx, &pb.UpdateProjectRequest{
+	resp, err := c.client.UpdateProject(ctx, &pb.UpdateProjectRequest{
 		Project: projectToProto(project),
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	project.Revision = resp.GetProject().GetRevision()
+	return nil
 }
 
 func (c *RemoteClient) DeleteProject(ctx context.Context, id string) error {
@@ -183,26 +238,47 @@ func (c *RemoteClient) CompleteProject(ctx context.Context, id string) error {
 	return err
 }
 
+func (c *RemoteClient) MoveProject(ctx context.Context, id, areaID string) error {
+	_, err := c.client.MoveProject(ctx, &pb.MoveProjectRequest{Id: id, AreaId: areaID})
+	return err
+}
+
+func (c *RemoteClient) FindProjectByExternalRef(ctx context.Context, source, id string) (*domain.Project, error) {
+	resp, err := c.client.FindProjectByExternalRef(ctx, &pb.FindProjectByExternalRefRequest{Source: source, Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return protoToProject(resp.Project, c.loc), nil
+}
+
 // TaskService implementation
 
 func (c *RemoteClient) CreateTask(ctx context.Context, task *domain.Task) (*domain.Task, error) {
 	req := &pb.CreateTaskRequest{
-		Title:     task.Title,
-		ProjectId: task.ProjectID,
-		AreaId:    task.AreaID,
-		Content:   task.Content,
-		Priority:  priorityToProto(task.Priority),
-		Tags:      task.Tags,
+		Title:       task.Title,
+		ProjectId:   task.ProjectID,
+		AreaId:      task.AreaID,
+		Content:     task.Content,
+		Priority:    priorityToProto(task.Priority),
+		Tags:        task.Tags,
+		Metadata:    task.Metadata,
+		ExternalRef: externalRefToProto(task.ExternalRef),
+		Context:     task.Context,
+		Effort:      string(task.Effort),
 	}
 	if task.DueDate != nil {
-		req.DueDate = timestamppb.New(*task.DueDate)
+		req.DueDate = dueToProto(*task.DueDate, task.AllDay)
+		req.AllDay = task.AllDay
+	}
+	if task.ScheduledDate != nil {
+		req.ScheduledDate = dayToProto(*task.ScheduledDate)
 	}
 
 	resp, err := c.client.CreateTask(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	return protoToTask(resp.Task), nil
+	return protoToTask(resp.Task, c.loc), nil
 }
 
 func (c *RemoteClient) GetTask(ctx context.Context, id string) (*domain.Task, error) {
@@ -210,7 +286,7 @@ func (c *RemoteClient) GetTask(ctx context.Context, id string) (*domain.Task, er
 	if err != nil {
 		return nil, err
 	}
-	return protoToTask(resp.Task), nil
+	return protoToTask(resp.Task, c.loc), nil
 }
 
 func (c *RemoteClient) GetTaskBySlug(ctx context.Context, projectID, slug string) (*domain.Task, error) {
@@ -234,7 +310,7 @@ func (c *RemoteClient) ListTasks(ctx context.Context, projectID string) ([]*doma
 
 	tasks := make([]*domain.Task, len(resp.Tasks))
 	for i, t := range resp.Tasks {
-		tasks[i] = protoToTask(t)
+		tasks[i] = protoToTask(t, c.loc)
 	}
 	return tasks, nil
 }
@@ -247,7 +323,20 @@ func (c *RemoteClient) ListTasksByArea(ctx context.Context, areaID string) ([]*d
 
 	tasks := make([]*domain.Task, len(resp.Tasks))
 	for i, t := range resp.Tasks {
-		tasks[i] = protoToTask(t)
+		tasks[i] = protoToTask(t, c.loc)
+	}
+	return tasks, nil
+}
+
+func (c *RemoteClient) QueryTasks(ctx context.Context, query string) ([]*domain.Task, error) {
+	resp, err := c.client.ListTasks(ctx, &pb.ListTasksRequest{Query: query})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*domain.Task, len(resp.Tasks))
+	for i, t := range resp.Tasks {
+		tasks[i] = protoToTask(t, c.loc)
 	}
 	return tasks, nil
 }
@@ -260,16 +349,20 @@ func (c *RemoteClient) ListAllTasks(ctx context.Context) ([]*domain.Task, error)
 
 	tasks := make([]*domain.Task, len(resp.Tasks))
 	for i, t := range resp.Tasks {
-		tasks[i] = protoToTask(t)
+		tasks[i] = protoToTask(t, c.loc)
 	}
 	return tasks, nil
 }
 
 func (c *RemoteClient) UpdateTask(ctx context.Context, task *domain.Task) error {
-	_, err := c.client.UpdateTask(ctx, &pb.UpdateTaskRequest{
+	resp, err := c.client.UpdateTask(ctx, &pb.UpdateTaskRequest{
 		Task: taskToProto(task),
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	task.Revision = resp.GetTask().GetRevision()
+	return nil
 }
 
 func (c *RemoteClient) DeleteTask(ctx context.Context, id string) error {
@@ -287,69 +380,431 @@ func (c *RemoteClient) CompleteTask(ctx context.Context, id string) error {
 	return err
 }
 
+func (c *RemoteClient) StartTaskTimer(ctx context.Context, id string) error {
+	_, err := c.client.StartTaskTimer(ctx, &pb.StartTaskTimerRequest{Id: id})
+	return err
+}
+
+func (c *RemoteClient) StopTaskTimer(ctx context.Context, id string) (time.Duration, error) {
+	resp, err := c.client.StopTaskTimer(ctx, &pb.StopTaskTimerRequest{Id: id})
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(resp.ElapsedSeconds) * time.Second, nil
+}
+
+func (c *RemoteClient) MoveTask(ctx context.Context, id, projectID string) error {
+	_, err := c.client.MoveTask(ctx, &pb.MoveTaskRequest{Id: id, ProjectId: projectID})
+	return err
+}
+
+func (c *RemoteClient) FindTaskByExternalRef(ctx context.Context, source, id string) (*domain.Task, error) {
+	resp, err := c.client.FindTaskByExternalRef(ctx, &pb.FindTaskByExternalRefRequest{Source: source, Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return protoToTask(resp.Task, c.loc), nil
+}
+
+func (c *RemoteClient) UpsertTask(ctx context.Context, task *domain.Task) (*domain.Task, bool, error) {
+	resp, err := c.client.UpsertTask(ctx, &pb.UpsertTaskRequest{Task: taskToProto(task)})
+	if err != nil {
+		return nil, false, err
+	}
+	return protoToTask(resp.Task, c.loc), resp.Created, nil
+}
+
+func (c *RemoteClient) BulkUpdateTasks(ctx context.Context, filter storage.TaskFilter, update service.TaskUpdate) ([]*domain.Task, error) {
+	resp, err := c.client.BulkUpdateTasks(ctx, &pb.BulkUpdateTasksRequest{
+		Filter: taskFilterToProto(filter),
+		Update: taskUpdateToProto(update),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*domain.Task, len(resp.Tasks))
+	for i, t := range resp.Tasks {
+		tasks[i] = protoToTask(t, c.loc)
+	}
+	return tasks, nil
+}
+
+func (c *RemoteClient) BatchCreateTasks(ctx context.Context, tasks []*domain.Task) ([]service.BatchResult, error) {
+	req := &pb.BatchCreateTasksRequest{Tasks: make([]*pb.Task, len(tasks))}
+	for i, t := range tasks {
+		req.Tasks[i] = taskToProto(t)
+	}
+	resp, err := c.client.BatchCreateTasks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return protoToBatchResults(resp.Results, c.loc), nil
+}
+
+func (c *RemoteClient) BatchUpdateTasks(ctx context.Context, tasks []*domain.Task) ([]service.BatchResult, error) {
+	req := &pb.BatchUpdateTasksRequest{Tasks: make([]*pb.Task, len(tasks))}
+	for i, t := range tasks {
+		req.Tasks[i] = taskToProto(t)
+	}
+	resp, err := c.client.BatchUpdateTasks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return protoToBatchResults(resp.Results, c.loc), nil
+}
+
+func (c *RemoteClient) AttachToTask(ctx context.Context, id, attachment string) (*domain.Task, error) {
+	if !domain.IsLink(attachment) {
+		return nil, fmt.Errorf("only links can be attached in remote mode")
+	}
+
+	resp, err := c.client.AttachToTask(ctx, &pb.AttachToTaskRequest{Id: id, Attachment: attachment})
+	if err != nil {
+		return nil, err
+	}
+	return protoToTask(resp.Task, c.loc), nil
+}
+
+func (c *RemoteClient) AddTaskComment(ctx context.Context, id, author, text string) (*domain.Task, error) {
+	resp, err := c.client.AddTaskComment(ctx, &pb.AddTaskCommentRequest{Id: id, Author: author, Text: text})
+	if err != nil {
+		return nil, err
+	}
+	return protoToTask(resp.Task, c.loc), nil
+}
+
+// NoteService implementation
+
+func (c *RemoteClient) AddNote(ctx context.Context, note *domain.Note) (*domain.Note, error) {
+	resp, err := c.client.AddNote(ctx, &pb.AddNoteRequest{
+		ParentId: note.ParentID,
+		Content:  note.Content,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return protoToNote(resp.Note), nil
+}
+
+func (c *RemoteClient) ListNotes(ctx context.Context, parentID string) ([]*domain.Note, error) {
+	return c.listNotes(ctx, &pb.ListNotesRequest{ParentId: parentID})
+}
+
+func (c *RemoteClient) SearchNotes(ctx context.Context, text string) ([]*domain.Note, error) {
+	return c.listNotes(ctx, &pb.ListNotesRequest{Search: text})
+}
+
+func (c *RemoteClient) listNotes(ctx context.Context, req *pb.ListNotesRequest) ([]*domain.Note, error) {
+	resp, err := c.client.ListNotes(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	notes := make([]*domain.Note, len(resp.Notes))
+	for i, n := range resp.Notes {
+		notes[i] = protoToNote(n)
+	}
+	return notes, nil
+}
+
+func (c *RemoteClient) DeleteNote(ctx context.Context, id string) error {
+	_, err := c.client.DeleteNote(ctx, &pb.DeleteNoteRequest{Id: id})
+	return err
+}
+
+// Aggregate operations
+
+// GoalService implementation
+
+func (c *RemoteClient) CreateGoal(ctx context.Context, goal *domain.Goal) (*domain.Goal, error) {
+	req := &pb.CreateGoalRequest{
+		Title:    goal.Title,
+		Content:  goal.Content,
+		Quarter:  goal.Quarter,
+		Tags:     goal.Tags,
+		Metadata: goal.Metadata,
+	}
+	if goal.DueDate != nil {
+		req.DueDate = dayToProto(*goal.DueDate)
+	}
+
+	resp, err := c.client.CreateGoal(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return protoToGoal(resp.Goal, c.loc), nil
+}
+
+func (c *RemoteClient) GetGoal(ctx context.Context, id string) (*domain.Goal, error) {
+	resp, err := c.client.GetGoal(ctx, &pb.GetGoalRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return protoToGoal(resp.Goal, c.loc), nil
+}
+
+func (c *RemoteClient) GetGoalBySlug(ctx context.Context, slug string) (*domain.Goal, error) {
+	goals, err := c.ListGoals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, goal := range goals {
+		if goal.Slug() == slug {
+			return goal, nil
+		}
+	}
+	return nil, fmt.Errorf("goal not found: %s", slug)
+}
+
+func (c *RemoteClient) ListGoals(ctx context.Context) ([]*domain.Goal, error) {
+	resp, err := c.client.ListGoals(ctx, &pb.ListGoalsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	goals := make([]*domain.Goal, len(resp.Goals))
+	for i, g := range resp.Goals {
+		goals[i] = protoToGoal(g, c.loc)
+	}
+	return goals, nil
+}
+
+func (c *RemoteClient) UpdateGoal(ctx context.Context, goal *domain.Goal) error {
+	_, err := c.client.UpdateGoal(ctx, &pb.UpdateGoalRequest{Goal: goalToProto(goal)})
+	return err
+}
+
+func (c *RemoteClient) DeleteGoal(ctx context.Context, id string) error {
+	_, err := c.client.DeleteGoal(ctx, &pb.DeleteGoalRequest{Id: id})
+	return err
+}
+
+func (c *RemoteClient) GetOverview(ctx context.Context) (*service.Overview, error) {
+	resp, err := c.client.GetOverview(ctx, &pb.GetOverviewRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	overview := &service.Overview{Areas: make([]*service.AreaOverview, len(resp.Areas))}
+	for i, ao := range resp.Areas {
+		area := &service.AreaOverview{Area: protoToArea(ao.Area), Projects: make([]*service.ProjectOverview, len(ao.Projects))}
+		for j, po := range ao.Projects {
+			project := &service.ProjectOverview{Project: protoToProject(po.Project, c.loc), Tasks: make([]*domain.Task, len(po.Tasks))}
+			for k, t := range po.Tasks {
+				project.Tasks[k] = protoToTask(t, c.loc)
+			}
+			area.Projects[j] = project
+		}
+		overview.Areas[i] = area
+	}
+	return overview, nil
+}
+
+func (c *RemoteClient) ListTasksWithRefs(ctx context.Context, query string) ([]*service.TaskRef, error) {
+	resp, err := c.client.ListTasksWithRefs(ctx, &pb.ListTasksWithRefsRequest{Query: query})
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]*service.TaskRef, len(resp.Tasks))
+	for i, t := range resp.Tasks {
+		ref := &service.TaskRef{Task: protoToTask(t.Task, c.loc)}
+		if t.Project != nil {
+			ref.Project = protoToProject(t.Project, c.loc)
+		}
+		if t.Area != nil {
+			ref.Area = protoToArea(t.Area)
+		}
+		refs[i] = ref
+	}
+	return refs, nil
+}
+
 // Conversion helpers
 
+func goalToProto(g *domain.Goal) *pb.Goal {
+	goal := &pb.Goal{
+		Id:        g.ID,
+		Title:     g.Title,
+		Content:   g.Content,
+		Status:    string(g.Status),
+		Quarter:   g.Quarter,
+		Tags:      g.Tags,
+		Metadata:  g.Metadata,
+		CreatedAt: timestamppb.New(g.Created),
+		UpdatedAt: timestamppb.New(g.Updated),
+	}
+	if g.DueDate != nil {
+		goal.DueDate = dayToProto(*g.DueDate)
+	}
+	return goal
+}
+
+func protoToGoal(p *pb.Goal, loc *time.Location) *domain.Goal {
+	goal := &domain.Goal{
+		ID:       p.Id,
+		Title:    p.Title,
+		Type:     "goal",
+		Content:  p.Content,
+		Status:   domain.GoalStatus(p.Status),
+		Quarter:  p.Quarter,
+		Tags:     p.Tags,
+		Metadata: metadataFromProto(p.Metadata),
+		Timestamps: domain.Timestamps{
+			Created: p.CreatedAt.AsTime(),
+			Updated: p.UpdatedAt.AsTime(),
+		},
+	}
+	if p.DueDate != nil {
+		due := protoToDay(p.DueDate, loc)
+		goal.DueDate = &due
+	}
+	if pp := p.Progress; pp != nil {
+		goal.Progress = &domain.GoalProgress{
+			Projects:          int(pp.Projects),
+			CompletedProjects: int(pp.CompletedProjects),
+			TotalTasks:        int(pp.TotalTasks),
+			CompletedTasks:    int(pp.CompletedTasks),
+			PercentComplete:   int(pp.PercentComplete),
+		}
+	}
+	return goal
+}
+
+func protoToNote(p *pb.Note) *domain.Note {
+	return &domain.Note{
+		ID:       p.Id,
+		Type:     "note",
+		ParentID: p.ParentId,
+		Content:  p.Content,
+		Timestamps: domain.Timestamps{
+			Created: p.CreatedAt.AsTime(),
+			Updated: p.UpdatedAt.AsTime(),
+		},
+	}
+}
+
 func areaToProto(a *domain.Area) *pb.Area {
-	return &pb.Area{
+	area := &pb.Area{
 		Id:        a.ID,
 		Title:     a.Title,
 		Content:   a.Content,
+		Tags:      a.Tags,
+		Priority:  priorityToProto(a.Priority),
+		Review:    string(a.Review),
+		Metadata:  a.Metadata,
+		SortOrder: proto.Int32(int32(a.SortOrder)),
 		CreatedAt: timestamppb.New(a.Created),
 		UpdatedAt: timestamppb.New(a.Updated),
 	}
+	if a.LastReviewed != nil {
+		area.LastReviewed = timestamppb.New(*a.LastReviewed)
+	}
+	return area
 }
 
 func protoToArea(p *pb.Area) *domain.Area {
-	return &domain.Area{
-		ID:      p.Id,
-		Title:   p.Title,
-		Type:    "area",
-		Content: p.Content,
+	area := &domain.Area{
+		ID:        p.Id,
+		Title:     p.Title,
+		Type:      "area",
+		Content:   p.Content,
+		Tags:      p.Tags,
+		Priority:  protoPriorityToDomain(p.Priority),
+		Review:    domain.ReviewCadence(p.Review),
+		Metadata:  metadataFromProto(p.Metadata),
+		SortOrder: int(p.GetSortOrder()),
 		Timestamps: domain.Timestamps{
 			Created: p.CreatedAt.AsTime(),
 			Updated: p.UpdatedAt.AsTime(),
 		},
 	}
+	if p.LastReviewed != nil {
+		reviewed := p.LastReviewed.AsTime()
+		area.LastReviewed = &reviewed
+	}
+	return area
 }
 
 func projectToProto(p *domain.Project) *pb.Project {
 	proj := &pb.Project{
-		Id:        p.ID,
-		Title:     p.Title,
-		AreaId:    p.AreaID,
-		Content:   p.Content,
-		Status:    projectStatusToProto(p.Status),
-		Tags:      p.Tags,
-		CreatedAt: timestamppb.New(p.Created),
-		UpdatedAt: timestamppb.New(p.Updated),
+		Id:              p.ID,
+		Title:           p.Title,
+		AreaId:          p.AreaID,
+		Content:         p.Content,
+		Status:          projectStatusToProto(p.Status),
+		Priority:        priorityToProto(p.Priority),
+		Tags:            p.Tags,
+		Notify:          p.Notify,
+		Metadata:        p.Metadata,
+		ExternalRef:     externalRefToProto(p.ExternalRef),
+		GoalId:          p.GoalID,
+		ParentProjectId: p.ParentProjectID,
+		Revision:        p.Revision,
+		CreatedAt:       timestamppb.New(p.Created),
+		UpdatedAt:       timestamppb.New(p.Updated),
 	}
 	if p.DueDate != nil {
-		proj.DueDate = timestamppb.New(*p.DueDate)
+		proj.DueDate = dayToProto(*p.DueDate)
 	}
 	return proj
 }
 
-func protoToProject(p *pb.Project) *domain.Project {
+func protoToProject(p *pb.Project, loc *time.Location) *domain.Project {
 	proj := &domain.Project{
-		ID:      p.Id,
-		Title:   p.Title,
-		Type:    "project",
-		AreaID:  p.AreaId,
-		Content: p.Content,
-		Status:  protoProjectStatusToDomain(p.Status),
-		Tags:    p.Tags,
+		ID:              p.Id,
+		Title:           p.Title,
+		Type:            "project",
+		AreaID:          p.AreaId,
+		Content:         p.Content,
+		Status:          protoProjectStatusToDomain(p.Status),
+		Priority:        protoPriorityToDomain(p.Priority),
+		Tags:            p.Tags,
+		Notify:          p.Notify,
+		Metadata:        metadataFromProto(p.Metadata),
+		ExternalRef:     protoToExternalRef(p.ExternalRef),
+		GoalID:          p.GoalId,
+		ParentProjectID: p.ParentProjectId,
+		Revision:        p.Revision,
 		Timestamps: domain.Timestamps{
 			Created: p.CreatedAt.AsTime(),
 			Updated: p.UpdatedAt.AsTime(),
 		},
 	}
 	if p.DueDate != nil {
-		due := p.DueDate.AsTime()
+		due := protoToDay(p.DueDate, loc)
 		proj.DueDate = &due
 	}
+	if p.Health != nil {
+		proj.Health = protoToHealth(p.Health)
+	}
 	return proj
 }
 
+func protoToHealth(p *pb.ProjectHealth) *domain.ProjectHealth {
+	health := &domain.ProjectHealth{
+		TotalTasks:        int(p.TotalTasks),
+		CompletedTasks:    int(p.CompletedTasks),
+		OverdueTasks:      int(p.OverdueTasks),
+		PercentComplete:   int(p.PercentComplete),
+		DaysSinceActivity: int(p.DaysSinceActivity),
+		LastActivity:      p.LastActivity.AsTime(),
+		Status:            domain.HealthOnTrack,
+	}
+	switch p.Status {
+	case pb.HealthStatus_HEALTH_STATUS_AT_RISK:
+		health.Status = domain.HealthAtRisk
+	case pb.HealthStatus_HEALTH_STATUS_STALLED:
+		health.Status = domain.HealthStalled
+	case pb.HealthStatus_HEALTH_STATUS_DONE:
+		health.Status = domain.HealthDone
+	case pb.HealthStatus_HEALTH_STATUS_SOMEDAY:
+		health.Status = domain.HealthSomeday
+	}
+	return health
+}
+
 func taskToProto(t *domain.Task) *pb.Task {
 	task := &pb.Task{
 		Id:           t.ID,
@@ -361,16 +816,82 @@ func taskToProto(t *domain.Task) *pb.Task {
 		Priority:     priorityToProto(t.Priority),
 		Tags:         t.Tags,
 		Dependencies: t.Dependencies,
+		Attachments:  t.Attachments,
+		Metadata:     t.Metadata,
+		ExternalRef:  externalRefToProto(t.ExternalRef),
+		Context:      t.Context,
+		Effort:       string(t.Effort),
+		Revision:     t.Revision,
 		CreatedAt:    timestamppb.New(t.Created),
 		UpdatedAt:    timestamppb.New(t.Updated),
 	}
 	if t.DueDate != nil {
-		task.DueDate = timestamppb.New(*t.DueDate)
+		task.DueDate = dueToProto(*t.DueDate, t.AllDay)
+		task.AllDay = t.AllDay
+	}
+	if t.ScheduledDate != nil {
+		task.ScheduledDate = dayToProto(*t.ScheduledDate)
+	}
+	if estimate, ok := t.Estimate(); ok {
+		task.EstimatedMinutes = int32(estimate.Minutes())
+	}
+	if spent, err := domain.ParseTimeSpec(t.TimeSpent); err == nil {
+		task.ActualMinutes = int32(spent.Minutes())
+	}
+	if session := t.ActiveSession(); session != nil {
+		task.TimerStartedAt = timestamppb.New(session.Start)
+	}
+	for _, session := range t.TimeLog {
+		pbSession := &pb.TimeSession{Start: timestamppb.New(session.Start)}
+		if session.End != nil {
+			pbSession.End = timestamppb.New(*session.End)
+		}
+		task.TimeLog = append(task.TimeLog, pbSession)
 	}
 	return task
 }
 
-func protoToTask(p *pb.Task) *domain.Task {
+func protoToBatchResults(results []*pb.BatchTaskResult, loc *time.Location) []service.BatchResult {
+	out := make([]service.BatchResult, len(results))
+	for i, r := range results {
+		if r.Error != "" {
+			out[i].Err = errors.New(r.Error)
+		} else {
+			out[i].Task = protoToTask(r.Task, loc)
+		}
+	}
+	return out
+}
+
+// dayToProto sends a calendar day as its midnight in UTC, so it reads as
+// the same day in any timezone
+func dayToProto(t time.Time) *timestamppb.Timestamp {
+	return timestamppb.New(domain.Day(t, time.UTC))
+}
+
+// protoToDay reads a calendar day sent as its midnight in UTC, as its
+// midnight in loc
+func protoToDay(ts *timestamppb.Timestamp, loc *time.Location) time.Time {
+	return domain.Day(ts.AsTime(), loc)
+}
+
+// dueToProto sends a due date: a calendar day if all-day, else a moment
+func dueToProto(t time.Time, allDay bool) *timestamppb.Timestamp {
+	if allDay {
+		return dayToProto(t)
+	}
+	return timestamppb.New(t)
+}
+
+// protoToDue reads a due date sent by dueToProto into loc
+func protoToDue(ts *timestamppb.Timestamp, allDay bool, loc *time.Location) time.Time {
+	if allDay {
+		return protoToDay(ts, loc)
+	}
+	return ts.AsTime().In(loc)
+}
+
+func protoToTask(p *pb.Task, loc *time.Location) *domain.Task {
 	task := &domain.Task{
 		ID:           p.Id,
 		Title:        p.Title,
@@ -382,18 +903,118 @@ func protoToTask(p *pb.Task) *domain.Task {
 		Priority:     protoPriorityToDomain(p.Priority),
 		Tags:         p.Tags,
 		Dependencies: p.Dependencies,
+		Attachments:  p.Attachments,
+		Metadata:     metadataFromProto(p.Metadata),
+		ExternalRef:  protoToExternalRef(p.ExternalRef),
+		Context:      p.Context,
+		Effort:       domain.Effort(p.Effort),
+		Revision:     p.Revision,
 		Timestamps: domain.Timestamps{
 			Created: p.CreatedAt.AsTime(),
 			Updated: p.UpdatedAt.AsTime(),
 		},
 	}
 	if p.DueDate != nil {
-		due := p.DueDate.AsTime()
+		due := protoToDue(p.DueDate, p.AllDay, loc)
 		task.DueDate = &due
+		task.AllDay = p.AllDay
+	}
+	if p.ScheduledDate != nil {
+		scheduled := protoToDay(p.ScheduledDate, loc)
+		task.ScheduledDate = &scheduled
+	}
+	if p.EstimatedMinutes > 0 {
+		task.TimeEstimate = domain.FormatTimeSpec(time.Duration(p.EstimatedMinutes) * time.Minute)
+	}
+	if p.ActualMinutes > 0 {
+		task.TimeSpent = domain.FormatTimeSpec(time.Duration(p.ActualMinutes) * time.Minute)
+	}
+	for _, session := range p.TimeLog {
+		ts := domain.TimeSession{Start: session.Start.AsTime()}
+		if session.End != nil {
+			end := session.End.AsTime()
+			ts.End = &end
+		}
+		task.TimeLog = append(task.TimeLog, ts)
+	}
+	// Peers from before time_log only send the running session
+	if len(p.TimeLog) == 0 && p.TimerStartedAt != nil {
+		task.TimeLog = []domain.TimeSession{{Start: p.TimerStartedAt.AsTime()}}
 	}
 	return task
 }
 
+func taskFilterToProto(f storage.TaskFilter) *pb.TaskFilter {
+	filter := &pb.TaskFilter{
+		ProjectId: f.ProjectID,
+		AreaId:    f.AreaID,
+		Tags:      f.Tags,
+		Overdue:   f.Overdue,
+	}
+	if f.Status != nil {
+		filter.Status = taskStatusToProto(*f.Status)
+	}
+	if f.Priority != nil {
+		filter.Priority = priorityToProto(*f.Priority)
+	}
+	return filter
+}
+
+func taskUpdateToProto(u service.TaskUpdate) *pb.TaskUpdate {
+	update := &pb.TaskUpdate{
+		ClearDueDate:       u.ClearDueDate,
+		ClearScheduledDate: u.ClearScheduledDate,
+		AddTags:            u.AddTags,
+		RemoveTags:         u.RemoveTags,
+		Metadata:           u.Metadata,
+		Context:            u.Context,
+	}
+	if u.Status != nil {
+		update.Status = taskStatusToProto(*u.Status)
+	}
+	if u.Priority != nil {
+		update.Priority = priorityToProto(*u.Priority)
+	}
+	if u.ProjectID != nil {
+		update.ProjectId = *u.ProjectID
+	}
+	if u.DueDate != nil {
+		update.DueDate = dueToProto(*u.DueDate, u.DueAllDay)
+		update.DueAllDay = u.DueAllDay
+	}
+	if u.ScheduledDate != nil {
+		update.ScheduledDate = dayToProto(*u.ScheduledDate)
+	}
+	if u.Effort != nil {
+		effort := string(*u.Effort)
+		update.Effort = &effort
+	}
+	return update
+}
+
+func externalRefToProto(r *domain.ExternalRef) *pb.ExternalRef {
+	if r == nil {
+		return nil
+	}
+	return &pb.ExternalRef{Source: r.Source, Id: r.ID, Url: r.URL}
+}
+
+func protoToExternalRef(p *pb.ExternalRef) *domain.ExternalRef {
+	if p == nil || (p.Source == "" && p.Id == "") {
+		return nil
+	}
+	return &domain.ExternalRef{Source: p.Source, ID: p.Id, URL: p.Url}
+}
+
+// metadataFromProto returns an empty map for unset metadata, as the domain
+// constructors do
+func metadataFromProto(m map[string]string) map[string]string {
+	if m == nil {
+		return make(map[string]string)
+	}
+	return m
+}
+
 func projectStatusToProto(s domain.ProjectStatus) pb.ProjectStatus {
 	switch s {
 	case domain.ProjectStatusActive:
@@ -404,6 +1025,8 @@ func projectStatusToProto(s domain.ProjectStatus) pb.ProjectStatus {
 		return pb.ProjectStatus_PROJECT_STATUS_COMPLETED
 	case domain.ProjectStatusArchived:
 		return pb.ProjectStatus_PROJECT_STATUS_ARCHIVED
+	case domain.ProjectStatusSomeday:
+		return pb.ProjectStatus_PROJECT_STATUS_SOMEDAY
 	default:
 		return pb.ProjectStatus_PROJECT_STATUS_UNSPECIFIED
 	}
@@ -419,6 +1042,8 @@ func protoProjectStatusToDomain(s pb.ProjectStatus) domain.ProjectStatus {
 		return domain.ProjectStatusCompleted
 	case pb.ProjectStatus_PROJECT_STATUS_ARCHIVED:
 		return domain.ProjectStatusArchived
+	case pb.ProjectStatus_PROJECT_STATUS_SOMEDAY:
+		return domain.ProjectStatusSomeday
 	default:
 		return domain.ProjectStatusActive
 	}
@@ -436,6 +1061,8 @@ func taskStatusToProto(s domain.TaskStatus) pb.TaskStatus {
 		return pb.TaskStatus_TASK_STATUS_DONE
 	case domain.TaskStatusCancelled:
 		return pb.TaskStatus_TASK_STATUS_CANCELLED
+	case domain.TaskStatusWaiting:
+		return pb.TaskStatus_TASK_STATUS_WAITING
 	default:
 		return pb.TaskStatus_TASK_STATUS_UNSPECIFIED
 	}
@@ -453,6 +1080,8 @@ func protoTaskStatusToDomain(s pb.TaskStatus) domain.TaskStatus {
 		return domain.TaskStatusCompleted
 	case pb.TaskStatus_TASK_STATUS_CANCELLED:
 		return domain.TaskStatusCancelled
+	case pb.TaskStatus_TASK_STATUS_WAITING:
+		return domain.TaskStatusWaiting
 	default:
 		return domain.TaskStatusPending
 	}
@@ -490,3 +1119,46 @@ func protoPriorityToDomain(p pb.Priority) domain.Priority {
 
 // Ensure RemoteClient implements ReorgClient
 var _ service.ReorgClient = (*RemoteClient)(nil)
+
+// ChangeService implementation
+
+// Changes streams the server's changes, connecting again with backoff when
+// the stream breaks. After a reconnect it sends a Change without paths, as
+// changes may have been missed in between.
+func (c *RemoteClient) Changes(ctx context.Context) (<-chan service.Change, error) {
+	out := make(chan service.Change, 16)
+	go func() {
+		defer close(out)
+		backoff := time.Second
+		for reconnect := false; ctx.Err() == nil; reconnect = true {
+			stream, err := c.client.Watch(ctx, &pb.WatchRequest{})
+			if err == nil {
+				if reconnect {
+					select {
+					case out <- service.Change{Time: time.Now()}:
+					default:
+					}
+				}
+				for {
+					event, err := stream.Recv()
+					if err != nil {
+						break
+					}
+					backoff = time.Second
+					select {
+					case out <- service.Change{Time: event.Time.AsTime(), Paths: event.Paths}:
+					default:
+					}
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(backoff*2, 30*time.Second)
+		}
+	}()
+	return out, nil
+}
@@ -0,0 +1,46 @@
+package grpc
+
+import (
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// ErrorDomain is the domain of the ErrorInfo detail on errors of a kind
+const ErrorDomain = "reorg"
+
+// kindCodes are the status codes of the kinds of domain error
+var kindCodes = map[error]codes.Code{
+	domain.ErrNotFound:      codes.NotFound,
+	domain.ErrInvalid:       codes.InvalidArgument,
+	domain.ErrAlreadyExists: codes.AlreadyExists,
+	domain.ErrConflict:      codes.Aborted,
+}
+
+// errorStatus returns the status for a failed call, with prefix before the
+// message if set. Errors of a kind get its code and an ErrorInfo detail
+// whose reason is the kind's code in capitals, such as NOT_FOUND; others
+// get fallback.
+func errorStatus(fallback codes.Code, prefix string, err error) error {
+	msg := err.Error()
+	if prefix != "" {
+		msg = prefix + ": " + msg
+	}
+
+	kind := domain.Kind(err)
+	if kind == nil {
+		return status.Error(fallback, msg)
+	}
+	st := status.New(kindCodes[kind], msg)
+	if detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: strings.ToUpper(domain.Code(err)),
+		Domain: ErrorDomain,
+	}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
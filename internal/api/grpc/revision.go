@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Tasks and projects carry the revision they were read at, which update
+// calls check before writing. REST clients can use HTTP instead: reads
+// return the revision as an ETag header, and updates take it in If-Match.
+
+// ifMatch returns the revision in the If-Match header of a REST call, or
+// revision when it's already set
+func ifMatch(ctx context.Context, revision string) string {
+	if revision != "" {
+		return revision
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("grpcgateway-if-match")
+	if len(values) == 0 {
+		return ""
+	}
+	value := strings.TrimPrefix(strings.TrimSpace(values[0]), "W/")
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		return unquoted
+	}
+	return value
+}
+
+// setETag sends a revision back as the ETag header of a REST call
+func setETag(ctx context.Context, revision string) {
+	if revision != "" {
+		_ = grpc.SetHeader(ctx, metadata.Pairs("etag", strconv.Quote(revision)))
+	}
+}
@@ -2,23 +2,43 @@ package grpc
 
 import (
 	"context"
+	"crypto/subtle"
+	"crypto/tls"
 	"fmt"
 	"net"
+	"path"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/ihavespoons/reorg/api/proto/gen"
+	"github.com/ihavespoons/reorg/internal/audit"
 	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/query"
 	"github.com/ihavespoons/reorg/internal/service"
+	"github.com/ihavespoons/reorg/internal/storage"
+	"github.com/ihavespoons/reorg/internal/tracing"
 )
 
 // Server implements the gRPC ReorgService
 type Server struct {
 	pb.UnimplementedReorgServiceServer
-	client service.ReorgClient
+	client   service.ReorgClient
+	readOnly bool
+	tls      *tls.Config
+
+	// With users, every call needs one of their tokens and goes to that
+	// user's client
+	users  map[string]service.ReorgClient
+	tokens map[string]string // token to user
 }
 
 // NewServer creates a new gRPC server
@@ -33,39 +53,200 @@ func (s *Server) Start(address string) error {
 		return fmt.Errorf("failed to listen: %w", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	interceptors := []grpc.UnaryServerInterceptor{tracing.UnaryServerInterceptor}
+	var streamInterceptors []grpc.StreamServerInterceptor
+	if len(s.tokens) > 0 {
+		interceptors = append(interceptors, s.authenticate)
+		streamInterceptors = append(streamInterceptors, s.authenticateStream)
+	}
+	if s.readOnly {
+		interceptors = append(interceptors, rejectWrites)
+	}
+	interceptors = append(interceptors, withActor)
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+		// Let clients keep idle connections open with pings
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             10 * time.Second,
+			PermitWithoutStream: true,
+		}),
+	}
+	if s.tls != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(s.tls)))
+	}
+	grpcServer := grpc.NewServer(opts...)
 	pb.RegisterReorgServiceServer(grpcServer, s)
 
 	return grpcServer.Serve(lis)
 }
 
+// SetTLS makes the server accept only TLS connections. It must be called
+// before Start.
+func (s *Server) SetTLS(config *tls.Config) {
+	s.tls = config
+}
+
+// SetReadOnly makes the server reject every call that could change data.
+// It must be called before Start.
+func (s *Server) SetReadOnly(readOnly bool) {
+	s.readOnly = readOnly
+}
+
+// AddUser lets the holder of token make calls as user, which go to client.
+// Once a user is added, calls without a known token are refused. It must be
+// called before Start.
+func (s *Server) AddUser(user, token string, client service.ReorgClient) {
+	if s.users == nil {
+		s.users = make(map[string]service.ReorgClient)
+		s.tokens = make(map[string]string)
+	}
+	s.users[user] = client
+	s.tokens[token] = user
+}
+
+// authenticate finds the user whose bearer token is in the call's
+// authorization metadata and adds them to the context
+func (s *Server) authenticate(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	user, ok := s.userFor(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "a valid token is required")
+	}
+	return handler(service.WithUser(ctx, user), req)
+}
+
+// authenticateStream does what authenticate does for streaming calls
+func (s *Server) authenticateStream(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	user, ok := s.userFor(stream.Context())
+	if !ok {
+		return status.Error(codes.Unauthenticated, "a valid token is required")
+	}
+	return handler(srv, userStream{stream, service.WithUser(stream.Context(), user)})
+}
+
+// userFor returns the user whose bearer token is in the authorization
+// metadata of ctx
+func (s *Server) userFor(ctx context.Context) (string, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		if user, ok := s.userForToken(strings.TrimPrefix(value, "Bearer ")); ok {
+			return user, true
+		}
+	}
+	return "", false
+}
+
+// userForToken returns the user holding token
+func (s *Server) userForToken(given string) (string, bool) {
+	for token, user := range s.tokens {
+		if subtle.ConstantTimeCompare([]byte(given), []byte(token)) == 1 {
+			return user, true
+		}
+	}
+	return "", false
+}
+
+// ClientForToken returns the client that calls made with token go to, for
+// handlers served next to the API, such as the calendar feed. Without
+// users every caller gets the server's client; with them, a token that
+// isn't a user's gets none.
+func (s *Server) ClientForToken(token string) (service.ReorgClient, bool) {
+	if len(s.tokens) == 0 {
+		return s.client, true
+	}
+	user, ok := s.userForToken(token)
+	if !ok {
+		return nil, false
+	}
+	return s.users[user], true
+}
+
+// userStream is a stream whose context names the user it was opened by
+type userStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s userStream) Context() context.Context {
+	return s.ctx
+}
+
+// clientFor returns the client of the user a call is made for
+func (s *Server) clientFor(ctx context.Context) service.ReorgClient {
+	if client, ok := s.users[service.UserFromContext(ctx)]; ok {
+		return client
+	}
+	return s.client
+}
+
+// ActorHeader is the metadata in which clients name what makes a call, such
+// as a CLI command, for the audit log
+const ActorHeader = "reorg-actor"
+
+// withActor adds what makes a call to its context for the audit log: the
+// actor the client names, or else the API and the method
+func withActor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	actor := "api:" + path.Base(info.FullMethod)
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(ActorHeader); len(values) > 0 && values[0] != "" {
+		actor = values[0]
+	}
+	return handler(audit.WithActor(ctx, actor), req)
+}
+
+// rejectWrites lets through only calls that read: those named Get, List or
+// Find, so methods added later are refused until they are known to be safe
+func rejectWrites(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	method := path.Base(info.FullMethod)
+	for _, prefix := range []string{"Get", "List", "Find"} {
+		if strings.HasPrefix(method, prefix) {
+			return handler(ctx, req)
+		}
+	}
+	return nil, status.Errorf(codes.PermissionDenied, "%s is not allowed: the server is read-only", method)
+}
+
 // Area operations
 
 func (s *Server) CreateArea(ctx context.Context, req *pb.CreateAreaRequest) (*pb.CreateAreaResponse, error) {
+	review, err := domain.ParseReviewCadence(req.Review)
+	if err != nil {
+		return nil, errorStatus(codes.InvalidArgument, "", err)
+	}
+
 	area := domain.NewArea(req.Title)
 	area.Content = req.Content
+	area.Review = review
+	if req.Priority != pb.Priority_PRIORITY_UNSPECIFIED {
+		area.Priority = protoPriorityToDomain(req.Priority)
+	}
+	area.SortOrder = int(req.SortOrder)
+	for _, tag := range req.Tags {
+		area.AddTag(tag)
+	}
+	domain.SetMetadata(&area.Metadata, req.Metadata)
 
-	created, err := s.client.CreateArea(ctx, area)
+	created, err := s.clientFor(ctx).CreateArea(ctx, area)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create area: %v", err)
+		return nil, errorStatus(codes.Internal, "failed to create area", err)
 	}
 
 	return &pb.CreateAreaResponse{Area: areaToProto(created)}, nil
 }
 
 func (s *Server) GetArea(ctx context.Context, req *pb.GetAreaRequest) (*pb.GetAreaResponse, error) {
-	area, err := s.client.GetArea(ctx, req.Id)
+	area, err := s.clientFor(ctx).GetArea(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "area not found: %v", err)
+		return nil, errorStatus(codes.NotFound, "", err)
 	}
 
 	return &pb.GetAreaResponse{Area: areaToProto(area)}, nil
 }
 
 func (s *Server) ListAreas(ctx context.Context, req *pb.ListAreasRequest) (*pb.ListAreasResponse, error) {
-	areas, err := s.client.ListAreas(ctx)
+	areas, err := s.clientFor(ctx).ListAreas(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list areas: %v", err)
+		return nil, errorStatus(codes.Internal, "failed to list areas", err)
 	}
 
 	pbAreas := make([]*pb.Area, len(areas))
@@ -78,21 +259,32 @@ func (s *Server) ListAreas(ctx context.Context, req *pb.ListAreasRequest) (*pb.L
 
 func (s *Server) UpdateArea(ctx context.Context, req *pb.UpdateAreaRequest) (*pb.UpdateAreaResponse, error) {
 	area := protoToArea(req.Area)
-	if err := s.client.UpdateArea(ctx, area); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update area: %v", err)
+
+	// The proto doesn't carry colors and icons, so keep them, and the
+	// order unless it was given
+	if existing, err := s.clientFor(ctx).GetArea(ctx, area.ID); err == nil {
+		area.Color = existing.Color
+		area.Icon = existing.Icon
+		if req.Area.SortOrder == nil {
+			area.SortOrder = existing.SortOrder
+		}
 	}
 
-	updated, err := s.client.GetArea(ctx, area.ID)
+	if err := s.clientFor(ctx).UpdateArea(ctx, area); err != nil {
+		return nil, errorStatus(codes.Internal, "failed to update area", err)
+	}
+
+	updated, err := s.clientFor(ctx).GetArea(ctx, area.ID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get updated area: %v", err)
+		return nil, errorStatus(codes.Internal, "failed to get updated area", err)
 	}
 
 	return &pb.UpdateAreaResponse{Area: areaToProto(updated)}, nil
 }
 
 func (s *Server) DeleteArea(ctx context.Context, req *pb.DeleteAreaRequest) (*pb.DeleteAreaResponse, error) {
-	if err := s.client.DeleteArea(ctx, req.Id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete area: %v", err)
+	if err := s.clientFor(ctx).DeleteArea(ctx, req.Id); err != nil {
+		return nil, errorStatus(codes.Internal, "failed to delete area", err)
 	}
 
 	return &pb.DeleteAreaResponse{}, nil
@@ -103,28 +295,37 @@ func (s *Server) DeleteArea(ctx context.Context, req *pb.DeleteAreaRequest) (*pb
 func (s *Server) CreateProject(ctx context.Context, req *pb.CreateProjectRequest) (*pb.CreateProjectResponse, error) {
 	project := domain.NewProject(req.Title, req.AreaId)
 	project.Content = req.Content
+	project.Notify = req.Notify
+	if req.Priority != pb.Priority_PRIORITY_UNSPECIFIED {
+		project.Priority = protoPriorityToDomain(req.Priority)
+	}
 	for _, tag := range req.Tags {
 		project.AddTag(tag)
 	}
+	domain.SetMetadata(&project.Metadata, req.Metadata)
+	project.ExternalRef = protoToExternalRef(req.ExternalRef)
+	project.GoalID = req.GoalId
+	project.ParentProjectID = req.ParentProjectId
 	if req.DueDate != nil {
-		due := req.DueDate.AsTime()
+		due := protoToDay(req.DueDate)
 		project.DueDate = &due
 	}
 
-	created, err := s.client.CreateProject(ctx, project)
+	created, err := s.clientFor(ctx).CreateProject(ctx, project)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create project: %v", err)
+		return nil, errorStatus(codes.Internal, "failed to create project", err)
 	}
 
 	return &pb.CreateProjectResponse{Project: projectToProto(created)}, nil
 }
 
 func (s *Server) GetProject(ctx context.Context, req *pb.GetProjectRequest) (*pb.GetProjectResponse, error) {
-	project, err := s.client.GetProject(ctx, req.Id)
+	project, err := s.clientFor(ctx).GetProject(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "project not found: %v", err)
+		return nil, errorStatus(codes.NotFound, "", err)
 	}
 
+	setETag(ctx, project.Revision)
 	return &pb.GetProjectResponse{Project: projectToProto(project)}, nil
 }
 
@@ -132,14 +333,29 @@ func (s *Server) ListProjects(ctx context.Context, req *pb.ListProjectsRequest)
 	var projects []*domain.Project
 	var err error
 
-	if req.AreaId != "" {
-		projects, err = s.client.ListProjects(ctx, req.AreaId)
+	if req.Query != "" {
+		if _, err := query.Parse(req.Query, query.KindProject, time.Now()); err != nil {
+			return nil, errorStatus(codes.InvalidArgument, "invalid query", err)
+		}
+		projects, err = s.clientFor(ctx).QueryProjects(ctx, req.Query)
+	} else if req.AreaId != "" {
+		projects, err = s.clientFor(ctx).ListProjects(ctx, req.AreaId)
 	} else {
-		projects, err = s.client.ListAllProjects(ctx)
+		projects, err = s.clientFor(ctx).ListAllProjects(ctx)
 	}
 
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list projects: %v", err)
+		return nil, errorStatus(codes.Internal, "failed to list projects", err)
+	}
+
+	if req.Query != "" && req.AreaId != "" {
+		var inArea []*domain.Project
+		for _, p := range projects {
+			if p.AreaID == req.AreaId {
+				inArea = append(inArea, p)
+			}
+		}
+		projects = inArea
 	}
 
 	pbProjects := make([]*pb.Project, len(projects))
@@ -152,39 +368,70 @@ func (s *Server) ListProjects(ctx context.Context, req *pb.ListProjectsRequest)
 
 func (s *Server) UpdateProject(ctx context.Context, req *pb.UpdateProjectRequest) (*pb.UpdateProjectResponse, error) {
 	project := protoToProject(req.Project)
-	if err := s.client.UpdateProject(ctx, project); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update project: %v", err)
+	project.Revision = ifMatch(ctx, project.Revision)
+
+	// Clients from before projects had priorities don't send one
+	if req.Project.Priority == pb.Priority_PRIORITY_UNSPECIFIED {
+		if existing, err := s.clientFor(ctx).GetProject(ctx, project.ID); err == nil {
+			project.Priority = existing.Priority
+		}
+	}
+	if err := s.clientFor(ctx).UpdateProject(ctx, project); err != nil {
+		return nil, errorStatus(codes.Internal, "failed to update project", err)
 	}
 
-	updated, err := s.client.GetProject(ctx, project.ID)
+	updated, err := s.clientFor(ctx).GetProject(ctx, project.ID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get updated project: %v", err)
+		return nil, errorStatus(codes.Internal, "failed to get updated project", err)
 	}
+	setETag(ctx, updated.Revision)
 
 	return &pb.UpdateProjectResponse{Project: projectToProto(updated)}, nil
 }
 
 func (s *Server) DeleteProject(ctx context.Context, req *pb.DeleteProjectRequest) (*pb.DeleteProjectResponse, error) {
-	if err := s.client.DeleteProject(ctx, req.Id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete project: %v", err)
+	if err := s.clientFor(ctx).DeleteProject(ctx, req.Id); err != nil {
+		return nil, errorStatus(codes.Internal, "failed to delete project", err)
 	}
 
 	return &pb.DeleteProjectResponse{}, nil
 }
 
 func (s *Server) CompleteProject(ctx context.Context, req *pb.CompleteProjectRequest) (*pb.CompleteProjectResponse, error) {
-	if err := s.client.CompleteProject(ctx, req.Id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to complete project: %v", err)
+	if err := s.clientFor(ctx).CompleteProject(ctx, req.Id); err != nil {
+		return nil, errorStatus(codes.Internal, "failed to complete project", err)
 	}
 
-	project, err := s.client.GetProject(ctx, req.Id)
+	project, err := s.clientFor(ctx).GetProject(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get completed project: %v", err)
+		return nil, errorStatus(codes.Internal, "failed to get completed project", err)
 	}
 
 	return &pb.CompleteProjectResponse{Project: projectToProto(project)}, nil
 }
 
+func (s *Server) MoveProject(ctx context.Context, req *pb.MoveProjectRequest) (*pb.MoveProjectResponse, error) {
+	if err := s.clientFor(ctx).MoveProject(ctx, req.Id, req.AreaId); err != nil {
+		return nil, errorStatus(codes.Internal, "failed to move project", err)
+	}
+
+	project, err := s.clientFor(ctx).GetProject(ctx, req.Id)
+	if err != nil {
+		return nil, errorStatus(codes.Internal, "failed to get moved project", err)
+	}
+
+	return &pb.MoveProjectResponse{Project: projectToProto(project)}, nil
+}
+
+func (s *Server) FindProjectByExternalRef(ctx context.Context, req *pb.FindProjectByExternalRefRequest) (*pb.FindProjectByExternalRefResponse, error) {
+	project, err := s.clientFor(ctx).FindProjectByExternalRef(ctx, req.Source, req.Id)
+	if err != nil {
+		return nil, errorStatus(codes.NotFound, "", err)
+	}
+
+	return &pb.FindProjectByExternalRefResponse{Project: projectToProto(project)}, nil
+}
+
 // Task operations
 
 func (s *Server) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.CreateTaskResponse, error) {
@@ -194,25 +441,39 @@ func (s *Server) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb
 	for _, tag := range req.Tags {
 		task.AddTag(tag)
 	}
+	domain.SetMetadata(&task.Metadata, req.Metadata)
+	task.ExternalRef = protoToExternalRef(req.ExternalRef)
+	task.Context = domain.NormalizeContext(req.Context)
+	effort, err := domain.ParseEffort(req.Effort)
+	if err != nil {
+		return nil, errorStatus(codes.InvalidArgument, "", err)
+	}
+	task.Effort = effort
 	if req.DueDate != nil {
-		due := req.DueDate.AsTime()
+		due := protoToDue(req.DueDate, req.AllDay)
 		task.DueDate = &due
+		task.AllDay = req.AllDay
+	}
+	if req.ScheduledDate != nil {
+		scheduled := protoToDay(req.ScheduledDate)
+		task.ScheduledDate = &scheduled
 	}
 
-	created, err := s.client.CreateTask(ctx, task)
+	created, err := s.clientFor(ctx).CreateTask(ctx, task)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create task: %v", err)
+		return nil, errorStatus(codes.Internal, "failed to create task", err)
 	}
 
 	return &pb.CreateTaskResponse{Task: taskToProto(created)}, nil
 }
 
 func (s *Server) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.GetTaskResponse, error) {
-	task, err := s.client.GetTask(ctx, req.Id)
+	task, err := s.clientFor(ctx).GetTask(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "task not found: %v", err)
+		return nil, errorStatus(codes.NotFound, "", err)
 	}
 
+	setETag(ctx, task.Revision)
 	return &pb.GetTaskResponse{Task: taskToProto(task)}, nil
 }
 
@@ -220,16 +481,31 @@ func (s *Server) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.L
 	var tasks []*domain.Task
 	var err error
 
-	if req.ProjectId != "" {
-		tasks, err = s.client.ListTasks(ctx, req.ProjectId)
+	if req.Query != "" {
+		if _, err := query.Parse(req.Query, query.KindTask, time.Now()); err != nil {
+			return nil, errorStatus(codes.InvalidArgument, "invalid query", err)
+		}
+		tasks, err = s.clientFor(ctx).QueryTasks(ctx, req.Query)
+	} else if req.ProjectId != "" {
+		tasks, err = s.clientFor(ctx).ListTasks(ctx, req.ProjectId)
 	} else if req.AreaId != "" {
-		tasks, err = s.client.ListTasksByArea(ctx, req.AreaId)
+		tasks, err = s.clientFor(ctx).ListTasksByArea(ctx, req.AreaId)
 	} else {
-		tasks, err = s.client.ListAllTasks(ctx)
+		tasks, err = s.clientFor(ctx).ListAllTasks(ctx)
 	}
 
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list tasks: %v", err)
+		return nil, errorStatus(codes.Internal, "failed to list tasks", err)
+	}
+
+	if req.Query != "" && (req.ProjectId != "" || req.AreaId != "") {
+		var scoped []*domain.Task
+		for _, t := range tasks {
+			if (req.ProjectId == "" || t.ProjectID == req.ProjectId) && (req.AreaId == "" || t.AreaID == req.AreaId) {
+				scoped = append(scoped, t)
+			}
+		}
+		tasks = scoped
 	}
 
 	pbTasks := make([]*pb.Task, len(tasks))
@@ -242,115 +518,601 @@ func (s *Server) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.L
 
 func (s *Server) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.UpdateTaskResponse, error) {
 	task := protoToTask(req.Task)
-	if err := s.client.UpdateTask(ctx, task); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update task: %v", err)
+	task.Revision = ifMatch(ctx, task.Revision)
+	// Clients from before time_log don't send the finished sessions, which
+	// would otherwise be lost on every update
+	if len(req.Task.GetTimeLog()) == 0 {
+		if stored, err := s.clientFor(ctx).GetTask(ctx, task.ID); err == nil {
+			task.TimeLog = stored.TimeLog
+		}
+	}
+	if err := s.clientFor(ctx).UpdateTask(ctx, task); err != nil {
+		return nil, errorStatus(codes.Internal, "failed to update task", err)
 	}
 
-	updated, err := s.client.GetTask(ctx, task.ID)
+	updated, err := s.clientFor(ctx).GetTask(ctx, task.ID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get updated task: %v", err)
+		return nil, errorStatus(codes.Internal, "failed to get updated task", err)
 	}
+	setETag(ctx, updated.Revision)
 
 	return &pb.UpdateTaskResponse{Task: taskToProto(updated)}, nil
 }
 
 func (s *Server) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest) (*pb.DeleteTaskResponse, error) {
-	if err := s.client.DeleteTask(ctx, req.Id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete task: %v", err)
+	if err := s.clientFor(ctx).DeleteTask(ctx, req.Id); err != nil {
+		return nil, errorStatus(codes.Internal, "failed to delete task", err)
 	}
 
 	return &pb.DeleteTaskResponse{}, nil
 }
 
 func (s *Server) StartTask(ctx context.Context, req *pb.StartTaskRequest) (*pb.StartTaskResponse, error) {
-	if err := s.client.StartTask(ctx, req.Id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to start task: %v", err)
+	if err := s.clientFor(ctx).StartTask(ctx, req.Id); err != nil {
+		return nil, errorStatus(codes.Internal, "failed to start task", err)
 	}
 
-	task, err := s.client.GetTask(ctx, req.Id)
+	task, err := s.clientFor(ctx).GetTask(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get started task: %v", err)
+		return nil, errorStatus(codes.Internal, "failed to get started task", err)
 	}
 
 	return &pb.StartTaskResponse{Task: taskToProto(task)}, nil
 }
 
 func (s *Server) CompleteTask(ctx context.Context, req *pb.CompleteTaskRequest) (*pb.CompleteTaskResponse, error) {
-	if err := s.client.CompleteTask(ctx, req.Id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to complete task: %v", err)
+	if err := s.clientFor(ctx).CompleteTask(ctx, req.Id); err != nil {
+		return nil, errorStatus(codes.Internal, "failed to complete task", err)
 	}
 
-	task, err := s.client.GetTask(ctx, req.Id)
+	task, err := s.clientFor(ctx).GetTask(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get completed task: %v", err)
+		return nil, errorStatus(codes.Internal, "failed to get completed task", err)
 	}
 
 	return &pb.CompleteTaskResponse{Task: taskToProto(task)}, nil
 }
 
+func (s *Server) StartTaskTimer(ctx context.Context, req *pb.StartTaskTimerRequest) (*pb.StartTaskTimerResponse, error) {
+	if err := s.clientFor(ctx).StartTaskTimer(ctx, req.Id); err != nil {
+		return nil, errorStatus(codes.FailedPrecondition, "failed to start timer", err)
+	}
+
+	task, err := s.clientFor(ctx).GetTask(ctx, req.Id)
+	if err != nil {
+		return nil, errorStatus(codes.Internal, "failed to get task", err)
+	}
+
+	return &pb.StartTaskTimerResponse{Task: taskToProto(task)}, nil
+}
+
+func (s *Server) StopTaskTimer(ctx context.Context, req *pb.StopTaskTimerRequest) (*pb.StopTaskTimerResponse, error) {
+	elapsed, err := s.clientFor(ctx).StopTaskTimer(ctx, req.Id)
+	if err != nil {
+		return nil, errorStatus(codes.FailedPrecondition, "failed to stop timer", err)
+	}
+
+	task, err := s.clientFor(ctx).GetTask(ctx, req.Id)
+	if err != nil {
+		return nil, errorStatus(codes.Internal, "failed to get task", err)
+	}
+
+	return &pb.StopTaskTimerResponse{Task: taskToProto(task), ElapsedSeconds: int64(elapsed.Seconds())}, nil
+}
+
+func (s *Server) MoveTask(ctx context.Context, req *pb.MoveTaskRequest) (*pb.MoveTaskResponse, error) {
+	if err := s.clientFor(ctx).MoveTask(ctx, req.Id, req.ProjectId); err != nil {
+		return nil, errorStatus(codes.Internal, "failed to move task", err)
+	}
+
+	task, err := s.clientFor(ctx).GetTask(ctx, req.Id)
+	if err != nil {
+		return nil, errorStatus(codes.Internal, "failed to get moved task", err)
+	}
+
+	return &pb.MoveTaskResponse{Task: taskToProto(task)}, nil
+}
+
+func (s *Server) BatchCreateTasks(ctx context.Context, req *pb.BatchCreateTasksRequest) (*pb.BatchCreateTasksResponse, error) {
+	tasks := make([]*domain.Task, len(req.Tasks))
+	for i, p := range req.Tasks {
+		task := protoToTask(p)
+		if task.ID == "" {
+			task.ID = domain.NewTask(task.Title, task.ProjectID, task.AreaID).ID
+		}
+		task.SetCreated()
+		tasks[i] = task
+	}
+
+	results, err := s.clientFor(ctx).BatchCreateTasks(ctx, tasks)
+	if err != nil {
+		return nil, errorStatus(codes.Internal, "failed to create tasks", err)
+	}
+	return &pb.BatchCreateTasksResponse{Results: batchResultsToProto(results)}, nil
+}
+
+func (s *Server) BatchUpdateTasks(ctx context.Context, req *pb.BatchUpdateTasksRequest) (*pb.BatchUpdateTasksResponse, error) {
+	tasks := make([]*domain.Task, len(req.Tasks))
+	for i, p := range req.Tasks {
+		tasks[i] = protoToTask(p)
+		// As in UpdateTask, for clients from before time_log
+		if len(p.GetTimeLog()) == 0 {
+			if stored, err := s.clientFor(ctx).GetTask(ctx, tasks[i].ID); err == nil {
+				tasks[i].TimeLog = stored.TimeLog
+			}
+		}
+	}
+
+	results, err := s.clientFor(ctx).BatchUpdateTasks(ctx, tasks)
+	if err != nil {
+		return nil, errorStatus(codes.Internal, "failed to update tasks", err)
+	}
+	return &pb.BatchUpdateTasksResponse{Results: batchResultsToProto(results)}, nil
+}
+
+func (s *Server) AttachToTask(ctx context.Context, req *pb.AttachToTaskRequest) (*pb.AttachToTaskResponse, error) {
+	// Only links can be attached over the API; copying a path would read
+	// from the server's filesystem.
+	if !domain.IsLink(req.Attachment) {
+		return nil, status.Errorf(codes.InvalidArgument, "attachment must be a URL")
+	}
+
+	task, err := s.clientFor(ctx).AttachToTask(ctx, req.Id, req.Attachment)
+	if err != nil {
+		return nil, errorStatus(codes.Internal, "failed to attach to task", err)
+	}
+
+	return &pb.AttachToTaskResponse{Task: taskToProto(task)}, nil
+}
+
+func (s *Server) AddTaskComment(ctx context.Context, req *pb.AddTaskCommentRequest) (*pb.AddTaskCommentResponse, error) {
+	author := req.Author
+	if author == "" {
+		author = "api"
+	}
+
+	task, err := s.clientFor(ctx).AddTaskComment(ctx, req.Id, author, req.Text)
+	if err != nil {
+		return nil, errorStatus(codes.Internal, "failed to comment on task", err)
+	}
+
+	return &pb.AddTaskCommentResponse{Task: taskToProto(task)}, nil
+}
+
+func (s *Server) FindTaskByExternalRef(ctx context.Context, req *pb.FindTaskByExternalRefRequest) (*pb.FindTaskByExternalRefResponse, error) {
+	task, err := s.clientFor(ctx).FindTaskByExternalRef(ctx, req.Source, req.Id)
+	if err != nil {
+		return nil, errorStatus(codes.NotFound, "", err)
+	}
+
+	return &pb.FindTaskByExternalRefResponse{Task: taskToProto(task)}, nil
+}
+
+func (s *Server) UpsertTask(ctx context.Context, req *pb.UpsertTaskRequest) (*pb.UpsertTaskResponse, error) {
+	if req.Task == nil || req.Task.ExternalRef == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "task with an external_ref is required")
+	}
+
+	// Build a new task so a missing ID or timestamps get defaults
+	in := protoToTask(req.Task)
+	task := domain.NewTask(in.Title, in.ProjectID, in.AreaID)
+	task.Content = in.Content
+	task.Status = in.Status
+	task.Priority = in.Priority
+	task.DueDate = in.DueDate
+	task.AllDay = in.AllDay
+	task.ExternalRef = in.ExternalRef
+	for _, tag := range in.Tags {
+		task.AddTag(tag)
+	}
+	domain.SetMetadata(&task.Metadata, in.Metadata)
+
+	upserted, created, err := s.clientFor(ctx).UpsertTask(ctx, task)
+	if err != nil {
+		return nil, errorStatus(codes.Internal, "failed to upsert task", err)
+	}
+
+	return &pb.UpsertTaskResponse{Task: taskToProto(upserted), Created: created}, nil
+}
+
+func (s *Server) BulkUpdateTasks(ctx context.Context, req *pb.BulkUpdateTasksRequest) (*pb.BulkUpdateTasksResponse, error) {
+	update := protoToTaskUpdate(req.Update)
+	if update.IsEmpty() {
+		return nil, status.Errorf(codes.InvalidArgument, "no changes specified")
+	}
+
+	tasks, err := s.clientFor(ctx).BulkUpdateTasks(ctx, protoToTaskFilter(req.Filter), update)
+	if err != nil {
+		return nil, errorStatus(codes.Internal, "failed to update tasks", err)
+	}
+
+	pbTasks := make([]*pb.Task, len(tasks))
+	for i, t := range tasks {
+		pbTasks[i] = taskToProto(t)
+	}
+
+	return &pb.BulkUpdateTasksResponse{Tasks: pbTasks}, nil
+}
+
+// Note operations
+
+func (s *Server) AddNote(ctx context.Context, req *pb.AddNoteRequest) (*pb.AddNoteResponse, error) {
+	note, err := s.clientFor(ctx).AddNote(ctx, domain.NewNote(req.ParentId, req.Content))
+	if err != nil {
+		return nil, errorStatus(codes.Internal, "failed to add note", err)
+	}
+
+	return &pb.AddNoteResponse{Note: noteToProto(note)}, nil
+}
+
+func (s *Server) ListNotes(ctx context.Context, req *pb.ListNotesRequest) (*pb.ListNotesResponse, error) {
+	var notes []*domain.Note
+	var err error
+
+	switch {
+	case req.ParentId != "":
+		notes, err = s.clientFor(ctx).ListNotes(ctx, req.ParentId)
+		if err == nil && req.Search != "" {
+			var matched []*domain.Note
+			for _, n := range notes {
+				if n.Matches(req.Search) {
+					matched = append(matched, n)
+				}
+			}
+			notes = matched
+		}
+	case req.Search != "":
+		notes, err = s.clientFor(ctx).SearchNotes(ctx, req.Search)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "parent_id or search is required")
+	}
+	if err != nil {
+		return nil, errorStatus(codes.Internal, "failed to list notes", err)
+	}
+
+	pbNotes := make([]*pb.Note, len(notes))
+	for i, n := range notes {
+		pbNotes[i] = noteToProto(n)
+	}
+
+	return &pb.ListNotesResponse{Notes: pbNotes}, nil
+}
+
+func (s *Server) DeleteNote(ctx context.Context, req *pb.DeleteNoteRequest) (*pb.DeleteNoteResponse, error) {
+	if err := s.clientFor(ctx).DeleteNote(ctx, req.Id); err != nil {
+		return nil, errorStatus(codes.Internal, "failed to delete note", err)
+	}
+
+	return &pb.DeleteNoteResponse{}, nil
+}
+
+// Goal operations
+
+func (s *Server) CreateGoal(ctx context.Context, req *pb.CreateGoalRequest) (*pb.CreateGoalResponse, error) {
+	goal := domain.NewGoal(req.Title)
+	goal.Content = req.Content
+	if req.Quarter != "" {
+		quarter, err := domain.ParseQuarter(req.Quarter)
+		if err != nil {
+			return nil, errorStatus(codes.InvalidArgument, "", err)
+		}
+		goal.Quarter = quarter
+	}
+	for _, tag := range req.Tags {
+		goal.AddTag(tag)
+	}
+	domain.SetMetadata(&goal.Metadata, req.Metadata)
+	if req.DueDate != nil {
+		due := protoToDay(req.DueDate)
+		goal.DueDate = &due
+	}
+
+	created, err := s.clientFor(ctx).CreateGoal(ctx, goal)
+	if err != nil {
+		return nil, errorStatus(codes.Internal, "failed to create goal", err)
+	}
+
+	return &pb.CreateGoalResponse{Goal: goalToProto(created)}, nil
+}
+
+func (s *Server) GetGoal(ctx context.Context, req *pb.GetGoalRequest) (*pb.GetGoalResponse, error) {
+	goal, err := s.clientFor(ctx).GetGoal(ctx, req.Id)
+	if err != nil {
+		return nil, errorStatus(codes.NotFound, "", err)
+	}
+
+	return &pb.GetGoalResponse{Goal: goalToProto(goal)}, nil
+}
+
+func (s *Server) ListGoals(ctx context.Context, req *pb.ListGoalsRequest) (*pb.ListGoalsResponse, error) {
+	goals, err := s.clientFor(ctx).ListGoals(ctx)
+	if err != nil {
+		return nil, errorStatus(codes.Internal, "failed to list goals", err)
+	}
+
+	pbGoals := make([]*pb.Goal, len(goals))
+	for i, g := range goals {
+		pbGoals[i] = goalToProto(g)
+	}
+
+	return &pb.ListGoalsResponse{Goals: pbGoals}, nil
+}
+
+func (s *Server) UpdateGoal(ctx context.Context, req *pb.UpdateGoalRequest) (*pb.UpdateGoalResponse, error) {
+	if req.Goal == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "goal is required")
+	}
+	goal := protoToGoal(req.Goal)
+	if err := s.clientFor(ctx).UpdateGoal(ctx, goal); err != nil {
+		return nil, errorStatus(codes.Internal, "failed to update goal", err)
+	}
+
+	updated, err := s.clientFor(ctx).GetGoal(ctx, goal.ID)
+	if err != nil {
+		return nil, errorStatus(codes.Internal, "failed to get updated goal", err)
+	}
+
+	return &pb.UpdateGoalResponse{Goal: goalToProto(updated)}, nil
+}
+
+func (s *Server) DeleteGoal(ctx context.Context, req *pb.DeleteGoalRequest) (*pb.DeleteGoalResponse, error) {
+	if err := s.clientFor(ctx).DeleteGoal(ctx, req.Id); err != nil {
+		return nil, errorStatus(codes.Internal, "failed to delete goal", err)
+	}
+
+	return &pb.DeleteGoalResponse{}, nil
+}
+
+// Aggregate operations
+
+func (s *Server) GetOverview(ctx context.Context, req *pb.GetOverviewRequest) (*pb.GetOverviewResponse, error) {
+	overview, err := s.clientFor(ctx).GetOverview(ctx)
+	if err != nil {
+		return nil, errorStatus(codes.Internal, "failed to get overview", err)
+	}
+
+	resp := &pb.GetOverviewResponse{Areas: make([]*pb.AreaOverview, len(overview.Areas))}
+	for i, ao := range overview.Areas {
+		pbArea := &pb.AreaOverview{Area: areaToProto(ao.Area), Projects: make([]*pb.ProjectOverview, len(ao.Projects))}
+		for j, po := range ao.Projects {
+			pbProject := &pb.ProjectOverview{Project: projectToProto(po.Project), Tasks: make([]*pb.Task, len(po.Tasks))}
+			for k, t := range po.Tasks {
+				pbProject.Tasks[k] = taskToProto(t)
+			}
+			pbArea.Projects[j] = pbProject
+		}
+		resp.Areas[i] = pbArea
+	}
+	return resp, nil
+}
+
+func (s *Server) ListTasksWithRefs(ctx context.Context, req *pb.ListTasksWithRefsRequest) (*pb.ListTasksWithRefsResponse, error) {
+	if req.Query != "" {
+		if _, err := query.Parse(req.Query, query.KindTask, time.Now()); err != nil {
+			return nil, errorStatus(codes.InvalidArgument, "invalid query", err)
+		}
+	}
+
+	refs, err := s.clientFor(ctx).ListTasksWithRefs(ctx, req.Query)
+	if err != nil {
+		return nil, errorStatus(codes.Internal, "failed to list tasks", err)
+	}
+
+	resp := &pb.ListTasksWithRefsResponse{Tasks: make([]*pb.TaskWithRefs, len(refs))}
+	for i, ref := range refs {
+		pbRef := &pb.TaskWithRefs{Task: taskToProto(ref.Task)}
+		if ref.Project != nil {
+			pbRef.Project = projectToProto(ref.Project)
+		}
+		if ref.Area != nil {
+			pbRef.Area = areaToProto(ref.Area)
+		}
+		resp.Tasks[i] = pbRef
+	}
+	return resp, nil
+}
+
+// Watch streams changes to the calling user's data until the client goes
+// away
+func (s *Server) Watch(req *pb.WatchRequest, stream grpc.ServerStreamingServer[pb.WatchEvent]) error {
+	ctx := stream.Context()
+	changes, err := s.clientFor(ctx).Changes(ctx)
+	if err != nil {
+		return errorStatus(codes.Internal, "failed to watch", err)
+	}
+	for change := range changes {
+		if err := stream.Send(&pb.WatchEvent{Time: timestamppb.New(change.Time), Paths: change.Paths}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Conversion helpers
 
+func noteToProto(n *domain.Note) *pb.Note {
+	return &pb.Note{
+		Id:        n.ID,
+		ParentId:  n.ParentID,
+		Content:   n.Content,
+		CreatedAt: timestamppb.New(n.Created),
+		UpdatedAt: timestamppb.New(n.Updated),
+	}
+}
+
 func areaToProto(a *domain.Area) *pb.Area {
-	return &pb.Area{
+	area := &pb.Area{
 		Id:        a.ID,
 		Title:     a.Title,
 		Content:   a.Content,
+		Tags:      a.Tags,
+		Priority:  priorityToProto(a.Priority),
+		Review:    string(a.Review),
+		Metadata:  a.Metadata,
+		SortOrder: proto.Int32(int32(a.SortOrder)),
 		CreatedAt: timestamppb.New(a.Created),
 		UpdatedAt: timestamppb.New(a.Updated),
 	}
+	if a.LastReviewed != nil {
+		area.LastReviewed = timestamppb.New(*a.LastReviewed)
+	}
+	return area
 }
 
 func protoToArea(p *pb.Area) *domain.Area {
-	return &domain.Area{
-		ID:      p.Id,
-		Title:   p.Title,
-		Type:    "area",
-		Content: p.Content,
+	area := &domain.Area{
+		ID:        p.Id,
+		Title:     p.Title,
+		Type:      "area",
+		Content:   p.Content,
+		Tags:      p.Tags,
+		Priority:  protoPriorityToDomain(p.Priority),
+		Review:    domain.ReviewCadence(p.Review),
+		Metadata:  metadataFromProto(p.Metadata),
+		SortOrder: int(p.GetSortOrder()),
 		Timestamps: domain.Timestamps{
 			Created: p.CreatedAt.AsTime(),
 			Updated: p.UpdatedAt.AsTime(),
 		},
 	}
+	if p.LastReviewed != nil {
+		reviewed := p.LastReviewed.AsTime()
+		area.LastReviewed = &reviewed
+	}
+	return area
 }
 
 func projectToProto(p *domain.Project) *pb.Project {
 	proj := &pb.Project{
-		Id:        p.ID,
-		Title:     p.Title,
-		AreaId:    p.AreaID,
-		Content:   p.Content,
-		Status:    projectStatusToProto(p.Status),
-		Tags:      p.Tags,
-		CreatedAt: timestamppb.New(p.Created),
-		UpdatedAt: timestamppb.New(p.Updated),
+		Id:              p.ID,
+		Title:           p.Title,
+		AreaId:          p.AreaID,
+		Content:         p.Content,
+		Status:          projectStatusToProto(p.Status),
+		Priority:        priorityToProto(p.Priority),
+		Tags:            p.Tags,
+		Notify:          p.Notify,
+		Metadata:        p.Metadata,
+		ExternalRef:     externalRefToProto(p.ExternalRef),
+		GoalId:          p.GoalID,
+		ParentProjectId: p.ParentProjectID,
+		Revision:        p.Revision,
+		CreatedAt:       timestamppb.New(p.Created),
+		UpdatedAt:       timestamppb.New(p.Updated),
 	}
 	if p.DueDate != nil {
-		proj.DueDate = timestamppb.New(*p.DueDate)
+		proj.DueDate = dayToProto(*p.DueDate)
+	}
+	if p.Health != nil {
+		proj.Health = healthToProto(p.Health)
 	}
 	return proj
 }
 
+func healthToProto(h *domain.ProjectHealth) *pb.ProjectHealth {
+	health := &pb.ProjectHealth{
+		TotalTasks:        int32(h.TotalTasks),
+		CompletedTasks:    int32(h.CompletedTasks),
+		OverdueTasks:      int32(h.OverdueTasks),
+		PercentComplete:   int32(h.PercentComplete),
+		DaysSinceActivity: int32(h.DaysSinceActivity),
+		LastActivity:      timestamppb.New(h.LastActivity),
+	}
+	switch h.Status {
+	case domain.HealthOnTrack:
+		health.Status = pb.HealthStatus_HEALTH_STATUS_ON_TRACK
+	case domain.HealthAtRisk:
+		health.Status = pb.HealthStatus_HEALTH_STATUS_AT_RISK
+	case domain.HealthStalled:
+		health.Status = pb.HealthStatus_HEALTH_STATUS_STALLED
+	case domain.HealthDone:
+		health.Status = pb.HealthStatus_HEALTH_STATUS_DONE
+	case domain.HealthSomeday:
+		health.Status = pb.HealthStatus_HEALTH_STATUS_SOMEDAY
+	}
+	return health
+}
+
 func protoToProject(p *pb.Project) *domain.Project {
 	proj := &domain.Project{
-		ID:      p.Id,
-		Title:   p.Title,
-		Type:    "project",
-		AreaID:  p.AreaId,
-		Content: p.Content,
-		Status:  protoProjectStatusToDomain(p.Status),
-		Tags:    p.Tags,
+		ID:              p.Id,
+		Title:           p.Title,
+		Type:            "project",
+		AreaID:          p.AreaId,
+		Content:         p.Content,
+		Status:          protoProjectStatusToDomain(p.Status),
+		Priority:        protoPriorityToDomain(p.Priority),
+		Tags:            p.Tags,
+		Notify:          p.Notify,
+		Metadata:        metadataFromProto(p.Metadata),
+		ExternalRef:     protoToExternalRef(p.ExternalRef),
+		GoalID:          p.GoalId,
+		ParentProjectID: p.ParentProjectId,
+		Revision:        p.Revision,
 		Timestamps: domain.Timestamps{
 			Created: p.CreatedAt.AsTime(),
 			Updated: p.UpdatedAt.AsTime(),
 		},
 	}
 	if p.DueDate != nil {
-		due := p.DueDate.AsTime()
+		due := protoToDay(p.DueDate)
 		proj.DueDate = &due
 	}
 	return proj
 }
 
+func goalToProto(g *domain.Goal) *pb.Goal {
+	goal := &pb.Goal{
+		Id:        g.ID,
+		Title:     g.Title,
+		Content:   g.Content,
+		Status:    string(g.Status),
+		Quarter:   g.Quarter,
+		Tags:      g.Tags,
+		Metadata:  g.Metadata,
+		CreatedAt: timestamppb.New(g.Created),
+		UpdatedAt: timestamppb.New(g.Updated),
+	}
+	if g.DueDate != nil {
+		goal.DueDate = dayToProto(*g.DueDate)
+	}
+	if p := g.Progress; p != nil {
+		goal.Progress = &pb.GoalProgress{
+			Projects:          int32(p.Projects),
+			CompletedProjects: int32(p.CompletedProjects),
+			TotalTasks:        int32(p.TotalTasks),
+			CompletedTasks:    int32(p.CompletedTasks),
+			PercentComplete:   int32(p.PercentComplete),
+		}
+	}
+	return goal
+}
+
+func protoToGoal(p *pb.Goal) *domain.Goal {
+	goal := &domain.Goal{
+		ID:       p.Id,
+		Title:    p.Title,
+		Type:     "goal",
+		Content:  p.Content,
+		Status:   domain.GoalStatus(p.Status),
+		Quarter:  p.Quarter,
+		Tags:     p.Tags,
+		Metadata: metadataFromProto(p.Metadata),
+		Timestamps: domain.Timestamps{
+			Created: p.CreatedAt.AsTime(),
+			Updated: p.UpdatedAt.AsTime(),
+		},
+	}
+	if goal.Status == "" {
+		goal.Status = domain.GoalStatusActive
+	}
+	if p.DueDate != nil {
+		due := protoToDay(p.DueDate)
+		goal.DueDate = &due
+	}
+	return goal
+}
+
 func taskToProto(t *domain.Task) *pb.Task {
 	task := &pb.Task{
 		Id:           t.ID,
@@ -362,15 +1124,68 @@ func taskToProto(t *domain.Task) *pb.Task {
 		Priority:     priorityToProto(t.Priority),
 		Tags:         t.Tags,
 		Dependencies: t.Dependencies,
+		Attachments:  t.Attachments,
+		Metadata:     t.Metadata,
+		ExternalRef:  externalRefToProto(t.ExternalRef),
+		Context:      t.Context,
+		Effort:       string(t.Effort),
+		Revision:     t.Revision,
 		CreatedAt:    timestamppb.New(t.Created),
 		UpdatedAt:    timestamppb.New(t.Updated),
 	}
 	if t.DueDate != nil {
-		task.DueDate = timestamppb.New(*t.DueDate)
+		task.DueDate = dueToProto(*t.DueDate, t.AllDay)
+		task.AllDay = t.AllDay
+	}
+	if t.ScheduledDate != nil {
+		task.ScheduledDate = dayToProto(*t.ScheduledDate)
+	}
+	if estimate, ok := t.Estimate(); ok {
+		task.EstimatedMinutes = int32(estimate.Minutes())
+	}
+	if spent, err := domain.ParseTimeSpec(t.TimeSpent); err == nil {
+		task.ActualMinutes = int32(spent.Minutes())
+	}
+	if session := t.ActiveSession(); session != nil {
+		task.TimerStartedAt = timestamppb.New(session.Start)
+	}
+	for _, session := range t.TimeLog {
+		pbSession := &pb.TimeSession{Start: timestamppb.New(session.Start)}
+		if session.End != nil {
+			pbSession.End = timestamppb.New(*session.End)
+		}
+		task.TimeLog = append(task.TimeLog, pbSession)
 	}
 	return task
 }
 
+// dayToProto sends a calendar day as its midnight in UTC, so it reads as
+// the same day in any timezone
+func dayToProto(t time.Time) *timestamppb.Timestamp {
+	return timestamppb.New(domain.Day(t, time.UTC))
+}
+
+// protoToDay reads a calendar day sent as its midnight in UTC
+func protoToDay(ts *timestamppb.Timestamp) time.Time {
+	return domain.Day(ts.AsTime(), time.Local)
+}
+
+// dueToProto sends a due date: a calendar day if all-day, else a moment
+func dueToProto(t time.Time, allDay bool) *timestamppb.Timestamp {
+	if allDay {
+		return dayToProto(t)
+	}
+	return timestamppb.New(t)
+}
+
+// protoToDue reads a due date sent by dueToProto
+func protoToDue(ts *timestamppb.Timestamp, allDay bool) time.Time {
+	if allDay {
+		return protoToDay(ts)
+	}
+	return ts.AsTime().Local()
+}
+
 func protoToTask(p *pb.Task) *domain.Task {
 	task := &domain.Task{
 		ID:           p.Id,
@@ -383,18 +1198,144 @@ func protoToTask(p *pb.Task) *domain.Task {
 		Priority:     protoPriorityToDomain(p.Priority),
 		Tags:         p.Tags,
 		Dependencies: p.Dependencies,
+		Attachments:  p.Attachments,
+		Metadata:     metadataFromProto(p.Metadata),
+		ExternalRef:  protoToExternalRef(p.ExternalRef),
+		Context:      p.Context,
+		Effort:       domain.Effort(p.Effort),
+		Revision:     p.Revision,
 		Timestamps: domain.Timestamps{
 			Created: p.CreatedAt.AsTime(),
 			Updated: p.UpdatedAt.AsTime(),
 		},
 	}
 	if p.DueDate != nil {
-		due := p.DueDate.AsTime()
+		due := protoToDue(p.DueDate, p.AllDay)
 		task.DueDate = &due
+		task.AllDay = p.AllDay
+	}
+	if p.ScheduledDate != nil {
+		scheduled := protoToDay(p.ScheduledDate)
+		task.ScheduledDate = &scheduled
+	}
+	if p.EstimatedMinutes > 0 {
+		task.TimeEstimate = domain.FormatTimeSpec(time.Duration(p.EstimatedMinutes) * time.Minute)
+	}
+	if p.ActualMinutes > 0 {
+		task.TimeSpent = domain.FormatTimeSpec(time.Duration(p.ActualMinutes) * time.Minute)
+	}
+	for _, session := range p.TimeLog {
+		ts := domain.TimeSession{Start: session.Start.AsTime()}
+		if session.End != nil {
+			end := session.End.AsTime()
+			ts.End = &end
+		}
+		task.TimeLog = append(task.TimeLog, ts)
+	}
+	// Peers from before time_log only send the running session
+	if len(p.TimeLog) == 0 && p.TimerStartedAt != nil {
+		task.TimeLog = []domain.TimeSession{{Start: p.TimerStartedAt.AsTime()}}
 	}
 	return task
 }
 
+func batchResultsToProto(results []service.BatchResult) []*pb.BatchTaskResult {
+	out := make([]*pb.BatchTaskResult, len(results))
+	for i, r := range results {
+		out[i] = &pb.BatchTaskResult{}
+		if r.Err != nil {
+			out[i].Error = r.Err.Error()
+		} else {
+			out[i].Task = taskToProto(r.Task)
+		}
+	}
+	return out
+}
+
+func protoToTaskFilter(p *pb.TaskFilter) storage.TaskFilter {
+	var filter storage.TaskFilter
+	if p == nil {
+		return filter
+	}
+
+	filter.ProjectID = p.ProjectId
+	filter.AreaID = p.AreaId
+	filter.Tags = p.Tags
+	filter.Overdue = p.Overdue
+	if p.Status != pb.TaskStatus_TASK_STATUS_UNSPECIFIED {
+		s := protoTaskStatusToDomain(p.Status)
+		filter.Status = &s
+	}
+	if p.Priority != pb.Priority_PRIORITY_UNSPECIFIED {
+		pr := protoPriorityToDomain(p.Priority)
+		filter.Priority = &pr
+	}
+	return filter
+}
+
+func protoToTaskUpdate(p *pb.TaskUpdate) service.TaskUpdate {
+	var update service.TaskUpdate
+	if p == nil {
+		return update
+	}
+
+	if p.Status != pb.TaskStatus_TASK_STATUS_UNSPECIFIED {
+		s := protoTaskStatusToDomain(p.Status)
+		update.Status = &s
+	}
+	if p.Priority != pb.Priority_PRIORITY_UNSPECIFIED {
+		pr := protoPriorityToDomain(p.Priority)
+		update.Priority = &pr
+	}
+	if p.ProjectId != "" {
+		projectID := p.ProjectId
+		update.ProjectID = &projectID
+	}
+	if p.DueDate != nil {
+		due := protoToDue(p.DueDate, p.DueAllDay)
+		update.DueDate = &due
+		update.DueAllDay = p.DueAllDay
+	}
+	update.ClearDueDate = p.ClearDueDate
+	if p.ScheduledDate != nil {
+		scheduled := protoToDay(p.ScheduledDate)
+		update.ScheduledDate = &scheduled
+	}
+	update.ClearScheduledDate = p.ClearScheduledDate
+	update.AddTags = p.AddTags
+	update.RemoveTags = p.RemoveTags
+	update.Metadata = p.Metadata
+	update.Context = p.Context
+	if p.Effort != nil {
+		effort := domain.Effort(*p.Effort)
+		update.Effort = &effort
+	}
+	return update
+}
+
+func externalRefToProto(r *domain.ExternalRef) *pb.ExternalRef {
+	if r == nil {
+		return nil
+	}
+	return &pb.ExternalRef{Source: r.Source, Id: r.ID, Url: r.URL}
+}
+
+func protoToExternalRef(p *pb.ExternalRef) *domain.ExternalRef {
+	if p == nil || (p.Source == "" && p.Id == "") {
+		return nil
+	}
+	return &domain.ExternalRef{Source: p.Source, ID: p.Id, URL: p.Url}
+}
+
+// metadataFromProto returns an empty map for unset metadata, as the domain
+// constructors do
+func metadataFromProto(m map[string]string) map[string]string {
+	if m == nil {
+		return make(map[string]string)
+	}
+	return m
+}
+
 func projectStatusToProto(s domain.ProjectStatus) pb.ProjectStatus {
 	switch s {
 	case domain.ProjectStatusActive:
@@ -405,6 +1346,8 @@ func projectStatusToProto(s domain.ProjectStatus) pb.ProjectStatus {
 		return pb.ProjectStatus_PROJECT_STATUS_COMPLETED
 	case domain.ProjectStatusArchived:
 		return pb.ProjectStatus_PROJECT_STATUS_ARCHIVED
+	case domain.ProjectStatusSomeday:
+		return pb.ProjectStatus_PROJECT_STATUS_SOMEDAY
 	default:
 		return pb.ProjectStatus_PROJECT_STATUS_UNSPECIFIED
 	}
@@ -420,6 +1363,8 @@ func protoProjectStatusToDomain(s pb.ProjectStatus) domain.ProjectStatus {
 		return domain.ProjectStatusCompleted
 	case pb.ProjectStatus_PROJECT_STATUS_ARCHIVED:
 		return domain.ProjectStatusArchived
+	case pb.ProjectStatus_PROJECT_STATUS_SOMEDAY:
+		return domain.ProjectStatusSomeday
 	default:
 		return domain.ProjectStatusActive
 	}
@@ -437,6 +1382,8 @@ func taskStatusToProto(s domain.TaskStatus) pb.TaskStatus {
 		return pb.TaskStatus_TASK_STATUS_DONE
 	case domain.TaskStatusCancelled:
 		return pb.TaskStatus_TASK_STATUS_CANCELLED
+	case domain.TaskStatusWaiting:
+		return pb.TaskStatus_TASK_STATUS_WAITING
 	default:
 		return pb.TaskStatus_TASK_STATUS_UNSPECIFIED
 	}
@@ -454,6 +1401,8 @@ func protoTaskStatusToDomain(s pb.TaskStatus) domain.TaskStatus {
 		return domain.TaskStatusCompleted
 	case pb.TaskStatus_TASK_STATUS_CANCELLED:
 		return domain.TaskStatusCancelled
+	case pb.TaskStatus_TASK_STATUS_WAITING:
+		return domain.TaskStatusWaiting
 	default:
 		return domain.TaskStatusPending
 	}
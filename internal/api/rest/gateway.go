@@ -2,11 +2,13 @@ package rest
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
 	pb "github.com/ihavespoons/reorg/api/proto/gen"
@@ -16,6 +18,8 @@ import (
 type Gateway struct {
 	grpcAddress string
 	httpAddress string
+	handlers    map[string]http.Handler
+	grpcTLS     bool
 }
 
 // NewGateway creates a new REST gateway
@@ -23,22 +27,59 @@ func NewGateway(grpcAddress, httpAddress string) *Gateway {
 	return &Gateway{
 		grpcAddress: grpcAddress,
 		httpAddress: httpAddress,
+		handlers:    make(map[string]http.Handler),
 	}
 }
 
+// Handle serves an additional path alongside the REST API. It must be called
+// before Start.
+func (g *Gateway) Handle(pattern string, handler http.Handler) {
+	g.handlers[pattern] = handler
+}
+
+// SetGRPCTLS makes the gateway reach the gRPC server over TLS, without
+// verifying its certificate as both run on this machine. It must be called
+// before Start.
+func (g *Gateway) SetGRPCTLS() {
+	g.grpcTLS = true
+}
+
 // Start starts the REST gateway server
 func (g *Gateway) Start(ctx context.Context) error {
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithOutgoingHeaderMatcher(outgoingHeader))
 
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	creds := insecure.NewCredentials()
+	if g.grpcTLS {
+		creds = credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
 	if err := pb.RegisterReorgServiceHandlerFromEndpoint(ctx, mux, g.grpcAddress, opts); err != nil {
 		return fmt.Errorf("failed to register gateway: %w", err)
 	}
 
+	var handler http.Handler = mux
+	if len(g.handlers) > 0 {
+		root := http.NewServeMux()
+		root.Handle("/", mux)
+		for pattern, h := range g.handlers {
+			root.Handle(pattern, h)
+		}
+		handler = root
+	}
+
 	server := &http.Server{
 		Addr:    g.httpAddress,
-		Handler: mux,
+		Handler: handler,
 	}
 
 	return server.ListenAndServe()
 }
+
+// outgoingHeader passes the ETag of tasks and projects on as the standard
+// header, and other metadata with the usual Grpc-Metadata- prefix
+func outgoingHeader(key string) (string, bool) {
+	if key == "etag" {
+		return "ETag", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
@@ -0,0 +1,146 @@
+// Package approval holds imported items waiting for a person to confirm
+// how they were categorized. Automated imports queue items the AI was
+// unsure about instead of creating them; they are accepted (and then
+// created) or rejected later.
+package approval
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ihavespoons/reorg/internal/llm"
+)
+
+// DefaultThreshold is the AI confidence below which automated imports ask
+// for approval
+const DefaultThreshold = 0.6
+
+// Item is an imported note waiting for approval, with what the AI made of
+// it
+type Item struct {
+	ID             string               `json:"id"`
+	Source         string               `json:"source"`
+	SourceRef      string               `json:"source_ref,omitempty"`
+	SourceTitle    string               `json:"source_title"`
+	Content        string               `json:"content"`
+	Provider       llm.Provider         `json:"provider"`
+	Categorization llm.CategorizeResult `json:"categorization"`
+	Tasks          []llm.ExtractedTask  `json:"tasks,omitempty"`
+	Attachments    []string             `json:"attachments,omitempty"` // local files for the created tasks
+	Queued         time.Time            `json:"queued"`
+}
+
+// NewItem creates an item for a note from source
+func NewItem(source, sourceRef, title, content string) *Item {
+	return &Item{
+		ID:          fmt.Sprintf("appr-%s", uuid.New().String()[:8]),
+		Source:      source,
+		SourceRef:   sourceRef,
+		SourceTitle: title,
+		Content:     content,
+		Queued:      time.Now().UTC(),
+	}
+}
+
+// Confidence returns how sure the AI was of the item's area
+func (i *Item) Confidence() float64 {
+	return i.Categorization.AreaConfidence
+}
+
+// Queue stores pending items in a JSON file
+type Queue struct {
+	path string
+}
+
+// NewQueue creates a queue stored at path
+func NewQueue(path string) *Queue {
+	return &Queue{path: path}
+}
+
+// List returns the pending items, oldest first
+func (q *Queue) List() ([]*Item, error) {
+	var items []*Item
+	data, err := os.ReadFile(q.path)
+	if os.IsNotExist(err) {
+		return items, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read approvals: %w", err)
+	}
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("failed to parse approvals: %w", err)
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Queued.Before(items[j].Queued) })
+	return items, nil
+}
+
+// Add queues an item
+func (q *Queue) Add(item *Item) error {
+	items, err := q.List()
+	if err != nil {
+		return err
+	}
+	return q.save(append(items, item))
+}
+
+// Get returns a pending item by ID or unique ID prefix
+func (q *Queue) Get(id string) (*Item, error) {
+	items, err := q.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var found *Item
+	for _, item := range items {
+		if item.ID == id {
+			return item, nil
+		}
+		if strings.HasPrefix(item.ID, id) {
+			if found != nil {
+				return nil, fmt.Errorf("approval ID %s is ambiguous", id)
+			}
+			found = item
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("approval not found: %s", id)
+	}
+	return found, nil
+}
+
+// Remove drops an item from the queue, once accepted or rejected
+func (q *Queue) Remove(id string) error {
+	items, err := q.List()
+	if err != nil {
+		return err
+	}
+
+	kept := items[:0]
+	for _, item := range items {
+		if item.ID != id {
+			kept = append(kept, item)
+		}
+	}
+	if len(kept) == len(items) {
+		return fmt.Errorf("approval not found: %s", id)
+	}
+	return q.save(kept)
+}
+
+func (q *Queue) save(items []*Item) error {
+	if err := os.MkdirAll(filepath.Dir(q.path), 0755); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+	data, err := json.MarshalIndent(items, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(q.path, data, 0644)
+}
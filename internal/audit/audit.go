@@ -0,0 +1,213 @@
+// Package audit keeps a trail of every change to areas, projects, tasks,
+// goals and notes: what was created, updated or deleted, which fields
+// changed, when, and who changed it. Entries are JSON lines in the state
+// directory.
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Action is what happened to an item
+type Action string
+
+const (
+	Create Action = "create"
+	Update Action = "update"
+	Delete Action = "delete"
+)
+
+// Entry is one recorded change
+type Entry struct {
+	Time    time.Time         `json:"time"`
+	Actor   string            `json:"actor"`          // what made the change, e.g. cli:task update, api:UpdateTask or mcp:claude-ai
+	User    string            `json:"user,omitempty"` // the server user it was made for
+	Action  Action            `json:"action"`
+	Kind    string            `json:"kind"` // area, project, task, goal or note
+	ID      string            `json:"id"`
+	Title   string            `json:"title,omitempty"`
+	Changes map[string]Change `json:"changes,omitempty"` // fields an update changed
+}
+
+// Change is the old and new value of a field, long text shortened
+type Change struct {
+	Old any `json:"old,omitempty"`
+	New any `json:"new,omitempty"`
+}
+
+// Log appends entries to a JSON lines file. A nil Log records nothing.
+type Log struct {
+	// Actor is recorded with changes whose context doesn't name one
+	Actor string
+
+	path string
+	mu   sync.Mutex
+}
+
+// NewLog creates a log stored at path
+func NewLog(path string) *Log {
+	return &Log{path: path}
+}
+
+type actorKey struct{}
+
+// WithActor returns a context for changes made by actor, which the log
+// records instead of its own
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor of ctx, or "" if it has none
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+// Record appends a change to an item. before and after are the item
+// before and after an update, compared field by field. Failing to write
+// the entry doesn't fail the change it describes.
+func (l *Log) Record(ctx context.Context, user string, action Action, kind, id, title string, before, after any) {
+	if l == nil {
+		return
+	}
+	actor := ActorFromContext(ctx)
+	if actor == "" {
+		actor = l.Actor
+	}
+	entry := Entry{
+		Time:   time.Now().UTC(),
+		Actor:  actor,
+		User:   user,
+		Action: action,
+		Kind:   kind,
+		ID:     id,
+		Title:  title,
+	}
+	if action == Update {
+		entry.Changes = Diff(before, after)
+	}
+	_ = l.append(entry)
+}
+
+// Entries returns the recorded entries, oldest first
+func (l *Log) Entries() ([]Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := os.Open(l.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Time.Before(entries[j].Time) })
+	return entries, nil
+}
+
+// Path returns the file the log is stored in
+func (l *Log) Path() string {
+	return l.path
+}
+
+func (l *Log) append(e Entry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+// ignored are fields every update changes, and ones derived from other
+// items
+var ignored = map[string]bool{"updated": true, "health": true, "progress": true}
+
+// maxValue is how many characters of a text value are kept
+const maxValue = 80
+
+// Diff returns the fields that differ between two values of the same type,
+// by their JSON names
+func Diff(before, after any) map[string]Change {
+	was, now := fields(before), fields(after)
+	changes := make(map[string]Change)
+	for name, value := range now {
+		if !ignored[name] && !reflect.DeepEqual(was[name], value) {
+			changes[name] = Change{Old: shorten(was[name]), New: shorten(value)}
+		}
+	}
+	for name, value := range was {
+		if _, ok := now[name]; !ok && !ignored[name] {
+			changes[name] = Change{Old: shorten(value)}
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return changes
+}
+
+// fields returns the JSON fields of v
+func fields(v any) map[string]any {
+	if rv := reflect.ValueOf(v); !rv.IsValid() || rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var m map[string]any
+	_ = json.Unmarshal(data, &m)
+	return m
+}
+
+// shorten cuts long text down to maxValue characters
+func shorten(v any) any {
+	s, ok := v.(string)
+	if !ok || utf8.RuneCountInString(s) <= maxValue {
+		return v
+	}
+	s = strings.Join(strings.Fields(s), " ")
+	runes := []rune(s)
+	if len(runes) <= maxValue {
+		return s
+	}
+	return string(runes[:maxValue-1]) + "…"
+}
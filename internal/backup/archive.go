@@ -0,0 +1,446 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ArchiveExtensions are the archive names WriteArchive and ExtractArchive
+// understand. Zstandard needs the zstd command.
+var ArchiveExtensions = []string{".tar.gz", ".tgz", ".tar.zst", ".tzst", ".tar"}
+
+// archiveCompression returns "gzip", "zstd" or "" for an archive name
+func archiveCompression(path string) (string, error) {
+	name := strings.ToLower(path)
+	switch {
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		return "gzip", nil
+	case strings.HasSuffix(name, ".tar.zst"), strings.HasSuffix(name, ".tzst"):
+		return "zstd", nil
+	case strings.HasSuffix(name, ".tar"):
+		return "", nil
+	}
+	return "", fmt.Errorf("unknown archive type %s (use %s)", filepath.Base(path), strings.Join(ArchiveExtensions, ", "))
+}
+
+// WriteArchive writes a snapshot of the files in dir to a tarball at path,
+// compressed according to its extension. The .git directory is left out
+// unless includeGit is set; lock and temporary files always are. The
+// archive is written next to path and renamed into place, so a failed
+// backup never leaves half an archive behind.
+func WriteArchive(dir, path string, includeGit bool) (err error) {
+	compression, err := archiveCompression(path)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*~")
+	if err != nil {
+		return fmt.Errorf("failed to create archive: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
+
+	var out io.WriteCloser
+	var zstd *exec.Cmd
+	switch compression {
+	case "gzip":
+		out = gzip.NewWriter(f)
+	case "zstd":
+		zstd = exec.Command("zstd", "-q", "-c")
+		zstd.Stdout = f
+		if out, err = zstd.StdinPipe(); err != nil {
+			return err
+		}
+		if err = zstd.Start(); err != nil {
+			return fmt.Errorf("failed to run zstd: %w", err)
+		}
+	default:
+		out = nopCloser{f}
+	}
+
+	tw := tar.NewWriter(out)
+	err = filepath.WalkDir(dir, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, file)
+		if err != nil || rel == "." {
+			return err
+		}
+		if d.IsDir() && d.Name() == ".git" && !includeGit {
+			return filepath.SkipDir
+		}
+		if strings.HasSuffix(d.Name(), "~") {
+			return nil
+		}
+		return addToArchive(tw, file, filepath.ToSlash(rel), d)
+	})
+	if err == nil {
+		err = tw.Close()
+	}
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if zstd != nil {
+		if werr := zstd.Wait(); err == nil && werr != nil {
+			err = fmt.Errorf("zstd failed: %w", werr)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	if err = f.Sync(); err == nil {
+		err = f.Close()
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	return err
+}
+
+// addToArchive writes one directory, file or symlink to tw
+func addToArchive(tw *tar.Writer, path, name string, d fs.DirEntry) error {
+	info, err := d.Info()
+	if err != nil {
+		return err
+	}
+	var link string
+	if info.Mode()&fs.ModeSymlink != 0 {
+		if link, err = os.Readlink(path); err != nil {
+			return err
+		}
+	} else if !info.Mode().IsRegular() && !info.IsDir() {
+		return nil
+	}
+
+	hdr, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		return err
+	}
+	hdr.Name = name
+	if info.IsDir() {
+		hdr.Name += "/"
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	_, err = io.Copy(tw, f)
+	return err
+}
+
+// ExtractArchive unpacks an archive made by WriteArchive into dir, which
+// is created. Entries that would land outside dir are refused, as are
+// symlinks that point outside it and entries written through a symlink.
+func ExtractArchive(path, dir string) (err error) {
+	compression, err := archiveCompression(path)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var in io.Reader = f
+	switch compression {
+	case "gzip":
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+		in = gz
+	case "zstd":
+		zstd := exec.Command("zstd", "-q", "-d", "-c")
+		zstd.Stdin = f
+		var out io.ReadCloser
+		if out, err = zstd.StdoutPipe(); err != nil {
+			return err
+		}
+		if err = zstd.Start(); err != nil {
+			return fmt.Errorf("failed to run zstd: %w", err)
+		}
+		defer func() {
+			if err != nil {
+				_ = zstd.Process.Kill()
+				_ = zstd.Wait()
+				return
+			}
+			// A damaged or truncated archive only shows in zstd's exit status
+			_, _ = io.Copy(io.Discard, out)
+			if werr := zstd.Wait(); werr != nil {
+				err = fmt.Errorf("zstd failed: %w", werr)
+			}
+		}()
+		in = out
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	var links []string
+	tr := tar.NewReader(in)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+		if err := extractEntry(tr, hdr, dir); err != nil {
+			return fmt.Errorf("failed to extract %s: %w", hdr.Name, err)
+		}
+		if hdr.Typeflag == tar.TypeSymlink {
+			links = append(links, filepath.FromSlash(hdr.Name))
+		}
+	}
+
+	// A symlink can lead through ones extracted after it, so they are
+	// followed once all are in place
+	for _, link := range links {
+		if _, err := resolveInside(dir, link); err != nil {
+			return fmt.Errorf("failed to extract %s: %w", filepath.ToSlash(link), err)
+		}
+	}
+	return nil
+}
+
+// extractEntry writes one archive entry below dir
+func extractEntry(tr *tar.Reader, hdr *tar.Header, dir string) error {
+	name := filepath.FromSlash(hdr.Name)
+	if !filepath.IsLocal(name) {
+		return fmt.Errorf("path outside the data directory")
+	}
+	if err := checkNoSymlinks(dir, name); err != nil {
+		return err
+	}
+	target := filepath.Join(dir, name)
+
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		return os.MkdirAll(target, 0755)
+	case tar.TypeSymlink:
+		link := filepath.FromSlash(hdr.Linkname)
+		if filepath.IsAbs(link) || !filepath.IsLocal(filepath.Join(filepath.Dir(name), link)) {
+			return fmt.Errorf("symlink to %s points outside the data directory", hdr.Linkname)
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		return os.Symlink(hdr.Linkname, target)
+	case tar.TypeReg:
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		f, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, hdr.FileInfo().Mode().Perm())
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(f, tr)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err == nil {
+			err = os.Chtimes(target, hdr.ModTime, hdr.ModTime)
+		}
+		return err
+	}
+	return nil
+}
+
+// checkNoSymlinks returns an error if name below dir, or any directory on
+// the way to it, is a symlink, so nothing is written through one
+func checkNoSymlinks(dir, name string) error {
+	path := dir
+	for _, part := range strings.Split(name, string(filepath.Separator)) {
+		path = filepath.Join(path, part)
+		info, err := os.Lstat(path)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if info.Mode()&fs.ModeSymlink != 0 {
+			return fmt.Errorf("%s is a symlink", filepath.ToSlash(path[len(dir)+1:]))
+		}
+	}
+	return nil
+}
+
+// maxLinkHops is how many symlinks resolveInside follows before giving up
+// on a loop
+const maxLinkHops = 40
+
+// resolveInside follows name below dir through the symlinks on its way,
+// as the operating system would, and returns where it leads relative to
+// dir. It fails if that, or any step on the way, is outside dir.
+func resolveInside(dir, name string) (string, error) {
+	var resolved []string
+	pending := strings.Split(name, string(filepath.Separator))
+	for hops := 0; len(pending) > 0; {
+		part := pending[0]
+		pending = pending[1:]
+		switch part {
+		case "", ".":
+			continue
+		case "..":
+			if len(resolved) == 0 {
+				return "", fmt.Errorf("symlink points outside the data directory")
+			}
+			resolved = resolved[:len(resolved)-1]
+			continue
+		}
+
+		path := filepath.Join(append([]string{dir}, append(resolved, part)...)...)
+		info, err := os.Lstat(path)
+		if err != nil || info.Mode()&fs.ModeSymlink == 0 {
+			// What doesn't exist yet can't lead anywhere else
+			resolved = append(resolved, part)
+			continue
+		}
+		if hops++; hops > maxLinkHops {
+			return "", fmt.Errorf("too many levels of symlinks")
+		}
+		link, err := os.Readlink(path)
+		if err != nil {
+			return "", err
+		}
+		if filepath.IsAbs(link) {
+			return "", fmt.Errorf("symlink to %s points outside the data directory", link)
+		}
+		pending = append(strings.Split(link, string(filepath.Separator)), pending...)
+	}
+	return filepath.Join(resolved...), nil
+}
+
+type nopCloser struct{ io.Writer }
+
+func (nopCloser) Close() error { return nil }
+
+// Archive is a backup archive in a backup directory
+type Archive struct {
+	Path    string
+	Created time.Time
+	Size    int64
+}
+
+// archiveTimeFormat names archives in a backup directory, e.g.
+// reorg-20260416-093000.tar.gz
+const archiveTimeFormat = "20060102-150405"
+
+// ArchiveName is the name of an archive made at t in a backup directory
+func ArchiveName(t time.Time, ext string) string {
+	return "reorg-" + t.Format(archiveTimeFormat) + ext
+}
+
+// ListArchives returns the archives in a backup directory, newest first.
+// Other files are ignored.
+func ListArchives(dir string) ([]Archive, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+
+	var archives []Archive
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, "reorg-") {
+			continue
+		}
+		if _, err := archiveCompression(name); err != nil {
+			continue
+		}
+		stamp := strings.TrimPrefix(name, "reorg-")
+		if len(stamp) < len(archiveTimeFormat) {
+			continue
+		}
+		created, err := time.ParseInLocation(archiveTimeFormat, stamp[:len(archiveTimeFormat)], time.Local)
+		if err != nil {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		archives = append(archives, Archive{Path: filepath.Join(dir, name), Created: created, Size: info.Size()})
+	}
+	sort.Slice(archives, func(i, j int) bool { return archives[i].Created.After(archives[j].Created) })
+	return archives, nil
+}
+
+// Rotation is how many archives a backup directory keeps: the newest one
+// of each of the last Daily days and Weekly weeks that have any
+type Rotation struct {
+	Daily  int
+	Weekly int
+}
+
+// Rotate deletes the archives in dir that the rotation doesn't keep and
+// returns them. The newest archive is always kept.
+func Rotate(dir string, keep Rotation) ([]Archive, error) {
+	archives, err := ListArchives(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	kept := make(map[string]bool)
+	days := make(map[string]bool)
+	weeks := make(map[string]bool)
+	for i, a := range archives {
+		day := a.Created.Format("2006-01-02")
+		year, week := a.Created.ISOWeek()
+		weekKey := fmt.Sprintf("%d-%d", year, week)
+		if i == 0 {
+			kept[a.Path] = true
+		}
+		if !days[day] && len(days) < keep.Daily {
+			days[day] = true
+			kept[a.Path] = true
+		}
+		if !weeks[weekKey] && len(weeks) < keep.Weekly {
+			weeks[weekKey] = true
+			kept[a.Path] = true
+		}
+	}
+
+	var removed []Archive
+	for _, a := range archives {
+		if kept[a.Path] {
+			continue
+		}
+		if err := os.Remove(a.Path); err != nil {
+			return removed, fmt.Errorf("failed to remove %s: %w", filepath.Base(a.Path), err)
+		}
+		removed = append(removed, a)
+	}
+	return removed, nil
+}
@@ -0,0 +1,150 @@
+package backup
+
+import (
+	"archive/tar"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// entry is one entry of a test archive
+type entry struct {
+	name string
+	link string // symlink target; a directory ends name with /
+	body string
+}
+
+// writeTar writes entries as an uncompressed tarball at path
+func writeTar(t *testing.T, path string, entries []entry) {
+	t.Helper()
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	tw := tar.NewWriter(f)
+	for _, e := range entries {
+		hdr := &tar.Header{Name: e.name, Mode: 0644, Size: int64(len(e.body)), Typeflag: tar.TypeReg}
+		switch {
+		case e.link != "":
+			hdr.Typeflag, hdr.Linkname, hdr.Size = tar.TypeSymlink, e.link, 0
+		case strings.HasSuffix(e.name, "/"):
+			hdr.Typeflag, hdr.Mode = tar.TypeDir, 0755
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(e.body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExtractArchiveRefusesEscapes(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []entry
+	}{
+		{"path outside", []entry{{name: "../pwned", body: "x"}}},
+		{"absolute symlink", []entry{{name: "out", link: "/tmp"}}},
+		{"symlink outside", []entry{{name: "areas/out", link: "../../outside"}}},
+		{"write through symlink", []entry{
+			{name: "sub/"},
+			{name: "link", link: "sub"},
+			{name: "link/pwned", body: "x"},
+		}},
+		{"symlink outside through another", []entry{
+			{name: "out", link: "here/../outside"},
+			{name: "here", link: "."},
+		}},
+		{"symlink loop", []entry{
+			{name: "a", link: "b"},
+			{name: "b", link: "a"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			archive := filepath.Join(root, "evil.tar")
+			writeTar(t, archive, tt.entries)
+
+			dir := filepath.Join(root, "restore", "data")
+			if err := ExtractArchive(archive, dir); err == nil {
+				t.Fatal("ExtractArchive() succeeded, want an error")
+			}
+			for _, name := range []string{"pwned", "restore/pwned", "outside", "restore/outside"} {
+				if _, err := os.Lstat(filepath.Join(root, name)); err == nil {
+					t.Errorf("%s was written outside the data directory", name)
+				}
+			}
+		})
+	}
+}
+
+func TestExtractArchiveKeepsLocalSymlinks(t *testing.T) {
+	root := t.TempDir()
+	archive := filepath.Join(root, "ok.tar")
+	writeTar(t, archive, []entry{
+		{name: "areas/work/area.md", body: "work"},
+		{name: "areas/current", link: "work"},
+		{name: "notes/work", link: "../areas/work"},
+	})
+
+	dir := filepath.Join(root, "data")
+	if err := ExtractArchive(archive, dir); err != nil {
+		t.Fatalf("ExtractArchive() error = %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "notes", "work", "area.md"))
+	if err != nil || string(data) != "work" {
+		t.Errorf("reading through the symlink = %q, %v; want %q", data, err, "work")
+	}
+}
+
+func TestExtractArchiveDamagedZstd(t *testing.T) {
+	if _, err := exec.LookPath("zstd"); err != nil {
+		t.Skip("zstd is not installed")
+	}
+	tests := []struct {
+		name   string
+		damage func(data []byte) []byte
+	}{
+		// Cut short, zstd stops part way through the tarball
+		{"truncated", func(data []byte) []byte { return data[:len(data)-4] }},
+		// Past the end of the tarball, only zstd's exit status tells
+		{"trailing garbage", func(data []byte) []byte { return append(data, "not zstd"...) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			src := filepath.Join(root, "data")
+			if err := os.MkdirAll(src, 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(src, "task.md"), []byte(strings.Repeat("- [ ] item\n", 20000)), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			archive := filepath.Join(root, "data.tar.zst")
+			if err := WriteArchive(src, archive, false); err != nil {
+				t.Fatal(err)
+			}
+			data, err := os.ReadFile(archive)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(archive, tt.damage(data), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := ExtractArchive(archive, filepath.Join(root, "restore")); err == nil {
+				t.Fatal("ExtractArchive() of a damaged archive succeeded, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+// Package backup exports all goals, areas, projects and tasks as a single
+// JSON or CSV document and restores them, keeping their IDs, and snapshots
+// the whole data directory as an archive.
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/service"
+)
+
+// Version is the format version written to JSON exports
+const Version = 1
+
+// Data is a complete dump of goals, areas, projects and tasks. Goals are
+// only in JSON exports of everything, since they aren't part of an area.
+type Data struct {
+	Version  int               `json:"version"`
+	Exported time.Time         `json:"exported"`
+	Goals    []*domain.Goal    `json:"goals,omitempty"`
+	Areas    []*domain.Area    `json:"areas"`
+	Projects []*domain.Project `json:"projects"`
+	Tasks    []*domain.Task    `json:"tasks"`
+}
+
+// Export collects everything, or only one area when areaID is set
+func Export(ctx context.Context, client service.ReorgClient, areaID string) (*Data, error) {
+	data := &Data{Version: Version, Exported: time.Now().UTC()}
+
+	if areaID == "" {
+		goals, err := client.ListGoals(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list goals: %w", err)
+		}
+		for _, g := range goals {
+			// Progress is derived, so it isn't part of the export
+			g = g.Clone()
+			g.Progress = nil
+			data.Goals = append(data.Goals, g)
+		}
+	}
+
+	areas, err := client.ListAreas(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list areas: %w", err)
+	}
+	for _, a := range areas {
+		if areaID == "" || a.ID == areaID {
+			data.Areas = append(data.Areas, a)
+		}
+	}
+
+	projects, err := client.ListAllProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	for _, p := range projects {
+		if areaID == "" || p.AreaID == areaID {
+			// Revisions only mean something where they were read
+			p.Revision = ""
+			data.Projects = append(data.Projects, p)
+		}
+	}
+
+	tasks, err := client.ListAllTasks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	for _, t := range tasks {
+		if areaID == "" || t.AreaID == areaID {
+			t.Revision = ""
+			data.Tasks = append(data.Tasks, t)
+		}
+	}
+
+	return data, nil
+}
+
+// WriteJSON writes the data as indented JSON
+func (d *Data) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(d)
+}
+
+// ReadJSON reads data written by WriteJSON
+func ReadJSON(r io.Reader) (*Data, error) {
+	var d Data
+	if err := json.NewDecoder(r).Decode(&d); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON export: %w", err)
+	}
+	if d.Version > Version {
+		return nil, fmt.Errorf("export version %d is newer than supported version %d", d.Version, Version)
+	}
+	return &d, nil
+}
+
+// Result counts what Restore did
+type Result struct {
+	Created int
+	Updated int
+	Skipped int
+	Errors  []error
+}
+
+// Restore recreates the goals, areas, projects and tasks in data with their
+// original IDs. Entities whose ID already exists are skipped, or overwritten
+// when update is true.
+func Restore(ctx context.Context, client service.ReorgClient, data *Data, update bool) *Result {
+	result := &Result{}
+
+	for _, g := range data.Goals {
+		if _, err := client.GetGoal(ctx, g.ID); err == nil {
+			if !update {
+				result.Skipped++
+				continue
+			}
+			result.record(client.UpdateGoal(ctx, g), false, "goal", g.Title)
+			continue
+		}
+		_, err := client.CreateGoal(ctx, g)
+		result.record(err, true, "goal", g.Title)
+	}
+
+	for _, a := range data.Areas {
+		if _, err := client.GetArea(ctx, a.ID); err == nil {
+			if !update {
+				result.Skipped++
+				continue
+			}
+			result.record(client.UpdateArea(ctx, a), false, "area", a.Title)
+			continue
+		}
+		_, err := client.CreateArea(ctx, a)
+		result.record(err, true, "area", a.Title)
+	}
+
+	for _, p := range data.Projects {
+		if _, err := client.GetProject(ctx, p.ID); err == nil {
+			if !update {
+				result.Skipped++
+				continue
+			}
+			result.record(client.UpdateProject(ctx, p), false, "project", p.Title)
+			continue
+		}
+		_, err := client.CreateProject(ctx, p)
+		result.record(err, true, "project", p.Title)
+	}
+
+	for _, t := range data.Tasks {
+		if _, err := client.GetTask(ctx, t.ID); err == nil {
+			if !update {
+				result.Skipped++
+				continue
+			}
+			result.record(client.UpdateTask(ctx, t), false, "task", t.Title)
+			continue
+		}
+		_, err := client.CreateTask(ctx, t)
+		result.record(err, true, "task", t.Title)
+	}
+
+	return result
+}
+
+func (r *Result) record(err error, created bool, kind, title string) {
+	switch {
+	case err != nil:
+		r.Errors = append(r.Errors, fmt.Errorf("%s %q: %w", kind, title, err))
+	case created:
+		r.Created++
+	default:
+		r.Updated++
+	}
+}
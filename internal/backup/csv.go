@@ -0,0 +1,304 @@
+package backup
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// csvHeader lists the CSV columns. Areas, projects and tasks share one table
+// and leave the columns that don't apply to them empty.
+var csvHeader = []string{
+	"type", "id", "title", "area_id", "project_id", "status", "priority",
+	"due_date", "all_day", "scheduled_date", "tags", "assignee", "context", "effort", "dependencies",
+	"time_estimate", "time_spent", "recurrence", "color", "icon", "sort_order",
+	"notify", "created", "updated", "metadata", "time_log", "content",
+}
+
+// WriteCSV writes the data as a single CSV table, one row per entity.
+// Lists are separated by semicolons; metadata and time logs are JSON.
+func (d *Data) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, a := range d.Areas {
+		row := newRow()
+		row["type"], row["id"], row["title"] = "area", a.ID, a.Title
+		row["color"], row["icon"] = a.Color, a.Icon
+		row["sort_order"] = strconv.Itoa(a.SortOrder)
+		row["content"] = a.Content
+		setCommon(row, a.Timestamps, a.Metadata)
+		if err := cw.Write(row.values()); err != nil {
+			return err
+		}
+	}
+
+	for _, p := range d.Projects {
+		row := newRow()
+		row["type"], row["id"], row["title"] = "project", p.ID, p.Title
+		row["area_id"], row["status"], row["priority"] = p.AreaID, string(p.Status), string(p.Priority)
+		row["due_date"] = formatTime(p.DueDate)
+		row["tags"] = strings.Join(p.Tags, ";")
+		row["notify"] = p.Notify
+		row["content"] = p.Content
+		setCommon(row, p.Timestamps, p.Metadata)
+		if err := cw.Write(row.values()); err != nil {
+			return err
+		}
+	}
+
+	for _, t := range d.Tasks {
+		row := newRow()
+		row["type"], row["id"], row["title"] = "task", t.ID, t.Title
+		row["area_id"], row["project_id"] = t.AreaID, t.ProjectID
+		row["status"], row["priority"] = string(t.Status), string(t.Priority)
+		row["due_date"] = formatTime(t.DueDate)
+		if t.AllDay {
+			row["all_day"] = "true"
+		}
+		row["scheduled_date"] = formatTime(t.ScheduledDate)
+		row["tags"] = strings.Join(t.Tags, ";")
+		row["assignee"], row["context"] = t.Assignee, t.Context
+		row["effort"] = string(t.Effort)
+		row["dependencies"] = strings.Join(t.Dependencies, ";")
+		row["time_estimate"], row["time_spent"] = t.TimeEstimate, t.TimeSpent
+		if t.Recurrence != nil {
+			row["recurrence"] = *t.Recurrence
+		}
+		if len(t.TimeLog) > 0 {
+			log, err := json.Marshal(t.TimeLog)
+			if err != nil {
+				return err
+			}
+			row["time_log"] = string(log)
+		}
+		row["content"] = t.Content
+		setCommon(row, t.Timestamps, t.Metadata)
+		if err := cw.Write(row.values()); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadCSV reads data written by WriteCSV. Columns are matched by name, so
+// they can be reordered or dropped in a spreadsheet.
+func ReadCSV(r io.Reader) (*Data, error) {
+	cr := csv.NewReader(r)
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CSV export: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("CSV export is empty")
+	}
+
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, required := range []string{"type", "id", "title"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("CSV export has no %q column", required)
+		}
+	}
+
+	d := &Data{Version: Version}
+	for n, record := range records[1:] {
+		row := make(csvRow)
+		for name, i := range columns {
+			if i < len(record) {
+				row[name] = record[i]
+			}
+		}
+
+		line := n + 2
+		timestamps, metadata, err := parseCommon(row)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		switch row["type"] {
+		case "area":
+			a := &domain.Area{
+				ID:         row["id"],
+				Title:      row["title"],
+				Type:       "area",
+				Color:      row["color"],
+				Icon:       row["icon"],
+				Metadata:   metadata,
+				Timestamps: timestamps,
+				Content:    row["content"],
+			}
+			if row["sort_order"] != "" {
+				if a.SortOrder, err = strconv.Atoi(row["sort_order"]); err != nil {
+					return nil, fmt.Errorf("line %d: invalid sort_order: %w", line, err)
+				}
+			}
+			d.Areas = append(d.Areas, a)
+
+		case "project":
+			due, err := parseTime(row["due_date"])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid due_date: %w", line, err)
+			}
+			d.Projects = append(d.Projects, &domain.Project{
+				ID:         row["id"],
+				Title:      row["title"],
+				Type:       "project",
+				AreaID:     row["area_id"],
+				Status:     domain.ProjectStatus(row["status"]),
+				DueDate:    due,
+				Priority:   domain.Priority(row["priority"]),
+				Tags:       splitList(row["tags"]),
+				Notify:     row["notify"],
+				Metadata:   metadata,
+				Timestamps: timestamps,
+				Content:    row["content"],
+			})
+
+		case "task":
+			due, err := parseTime(row["due_date"])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid due_date: %w", line, err)
+			}
+			scheduled, err := parseTime(row["scheduled_date"])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid scheduled_date: %w", line, err)
+			}
+			t := &domain.Task{
+				ID:            row["id"],
+				Title:         row["title"],
+				Type:          "task",
+				ProjectID:     row["project_id"],
+				AreaID:        row["area_id"],
+				Status:        domain.TaskStatus(row["status"]),
+				DueDate:       due,
+				AllDay:        row["all_day"] == "true",
+				ScheduledDate: scheduled,
+				Priority:      domain.Priority(row["priority"]),
+				Assignee:      row["assignee"],
+				Context:       row["context"],
+				Effort:        domain.Effort(row["effort"]),
+				Tags:          splitList(row["tags"]),
+				Dependencies:  splitList(row["dependencies"]),
+				TimeEstimate:  row["time_estimate"],
+				TimeSpent:     row["time_spent"],
+				Metadata:      metadata,
+				Timestamps:    timestamps,
+				Content:       row["content"],
+			}
+			// Exports from before all_day only had all-day due dates
+			if _, ok := columns["all_day"]; !ok && due != nil {
+				t.AllDay = domain.IsMidnight(*due)
+			}
+			if row["recurrence"] != "" {
+				recurrence := row["recurrence"]
+				t.Recurrence = &recurrence
+			}
+			if row["time_log"] != "" {
+				if err := json.Unmarshal([]byte(row["time_log"]), &t.TimeLog); err != nil {
+					return nil, fmt.Errorf("line %d: invalid time_log: %w", line, err)
+				}
+			}
+			d.Tasks = append(d.Tasks, t)
+
+		default:
+			return nil, fmt.Errorf("line %d: unknown type %q", line, row["type"])
+		}
+	}
+
+	return d, nil
+}
+
+// csvRow holds the values of one row by column name
+type csvRow map[string]string
+
+func newRow() csvRow {
+	return make(csvRow, len(csvHeader))
+}
+
+func (r csvRow) values() []string {
+	values := make([]string, len(csvHeader))
+	for i, name := range csvHeader {
+		values[i] = r[name]
+	}
+	return values
+}
+
+func setCommon(row csvRow, ts domain.Timestamps, metadata map[string]string) {
+	row["created"] = formatTime(&ts.Created)
+	row["updated"] = formatTime(&ts.Updated)
+	if len(metadata) > 0 {
+		data, _ := json.Marshal(metadata)
+		row["metadata"] = string(data)
+	}
+}
+
+func parseCommon(row csvRow) (domain.Timestamps, map[string]string, error) {
+	var ts domain.Timestamps
+	for _, field := range []struct {
+		name string
+		dst  *time.Time
+	}{{"created", &ts.Created}, {"updated", &ts.Updated}} {
+		t, err := parseTime(row[field.name])
+		if err != nil {
+			return ts, nil, fmt.Errorf("invalid %s: %w", field.name, err)
+		}
+		if t != nil {
+			*field.dst = *t
+		}
+	}
+
+	metadata := make(map[string]string)
+	if row["metadata"] != "" {
+		if err := json.Unmarshal([]byte(row["metadata"]), &metadata); err != nil {
+			return ts, nil, fmt.Errorf("invalid metadata: %w", err)
+		}
+	}
+	return ts, metadata, nil
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// parseTime accepts RFC 3339 timestamps and plain dates, which spreadsheets
+// tend to produce when a date column is edited
+func parseTime(s string) (*time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		t, err = time.ParseInLocation("2006-01-02", s, time.Local)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &t, nil
+}
+
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,235 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/service"
+)
+
+// Conflict is an entity that differs between the two sides of a merge but
+// was last updated at the same time on both, so neither is clearly newer
+type Conflict struct {
+	Kind   string // goal, area, project or task
+	Ours   any
+	Theirs any
+}
+
+// Resolver decides a conflict, returning true to take theirs
+type Resolver func(c Conflict) (bool, error)
+
+// MergeResult lists what Merge did, or with dryRun would do
+type MergeResult struct {
+	Created   []string
+	Updated   []string
+	Kept      []string // changed on both sides, ours kept
+	Unchanged int
+	Errors    []error
+}
+
+// Merge brings the goals, areas, projects and tasks of another reorg
+// directory into client. Entities are matched by ID, or else by slug
+// within the matching parent; ones without a match are created with their
+// IDs. When both sides differ, the one updated last wins and resolve
+// decides ties. With dryRun nothing is written.
+func Merge(ctx context.Context, client service.ReorgClient, theirs *Data, resolve Resolver, dryRun bool) *MergeResult {
+	m := &merger{client: client, resolve: resolve, dryRun: dryRun, result: &MergeResult{}, ids: make(map[string]string)}
+	m.run(ctx, theirs)
+	return m.result
+}
+
+type merger struct {
+	client  service.ReorgClient
+	resolve Resolver
+	dryRun  bool
+	result  *MergeResult
+	// ids maps their IDs to ours for entities matched by slug
+	ids map[string]string
+}
+
+func (m *merger) run(ctx context.Context, theirs *Data) {
+	ours, err := Export(ctx, m.client, "")
+	if err != nil {
+		m.result.Errors = append(m.result.Errors, err)
+		return
+	}
+
+	goals := make(map[string]*domain.Goal)
+	for _, g := range ours.Goals {
+		goals[g.ID], goals["slug:"+g.Slug()] = g, g
+	}
+	for _, g := range theirs.Goals {
+		g = g.Clone()
+		local := match(m, goals, g.ID, "slug:"+g.Slug())
+		if local == nil {
+			m.apply("goal", g.Title, true, func() error { _, err := m.client.CreateGoal(ctx, g); return err })
+			continue
+		}
+		g.ID = local.ID
+		m.merge("goal", g.Title, local, g, local.Updated, g.Updated, func() error { return m.client.UpdateGoal(ctx, g) })
+	}
+
+	areas := make(map[string]*domain.Area)
+	for _, a := range ours.Areas {
+		areas[a.ID], areas["slug:"+a.Slug()] = a, a
+	}
+	for _, a := range theirs.Areas {
+		a = a.Clone()
+		local := match(m, areas, a.ID, "slug:"+a.Slug())
+		if local == nil {
+			m.apply("area", a.Title, true, func() error { _, err := m.client.CreateArea(ctx, a); return err })
+			continue
+		}
+		a.ID = local.ID
+		m.merge("area", a.Title, local, a, local.Updated, a.Updated, func() error { return m.client.UpdateArea(ctx, a) })
+	}
+
+	projects := make(map[string]*domain.Project)
+	for _, p := range ours.Projects {
+		projects[p.ID], projects["slug:"+p.AreaID+"/"+p.Slug()] = p, p
+	}
+	for _, p := range theirs.Projects {
+		p = p.Clone()
+		p.AreaID = m.ourID(p.AreaID)
+		p.GoalID = m.ourID(p.GoalID)
+		p.ParentProjectID = m.ourID(p.ParentProjectID)
+		local := match(m, projects, p.ID, "slug:"+p.AreaID+"/"+p.Slug())
+		if local == nil {
+			m.apply("project", p.Title, true, func() error { _, err := m.client.CreateProject(ctx, p); return err })
+			continue
+		}
+		p.ID = local.ID
+		m.merge("project", p.Title, local, p, local.Updated, p.Updated, func() error { return m.client.UpdateProject(ctx, p) })
+	}
+
+	// Tasks are matched first, as dependencies may point at any of them
+	tasks := make(map[string]*domain.Task)
+	for _, t := range ours.Tasks {
+		tasks[t.ID], tasks["slug:"+t.ProjectID+"/"+t.Slug()] = t, t
+	}
+	matched := make(map[*domain.Task]*domain.Task)
+	for _, t := range theirs.Tasks {
+		if local := match(m, tasks, t.ID, "slug:"+m.ourID(t.ProjectID)+"/"+t.Slug()); local != nil {
+			matched[t] = local
+		}
+	}
+	for _, t := range theirs.Tasks {
+		local := matched[t]
+		t = t.Clone()
+		t.AreaID = m.ourID(t.AreaID)
+		t.ProjectID = m.ourID(t.ProjectID)
+		for i, dep := range t.Dependencies {
+			t.Dependencies[i] = m.ourID(dep)
+		}
+		if local == nil {
+			m.apply("task", t.Title, true, func() error { _, err := m.client.CreateTask(ctx, t); return err })
+			continue
+		}
+		t.ID = local.ID
+		m.merge("task", t.Title, local, t, local.Updated, t.Updated, func() error { return m.client.UpdateTask(ctx, t) })
+	}
+}
+
+// match finds our entity with the ID, or else the slug key, and remembers
+// the ID it has here
+func match[T any](m *merger, ours map[string]*T, id, slugKey string) *T {
+	local, ok := ours[id]
+	if !ok {
+		local, ok = ours[slugKey]
+	}
+	if !ok {
+		return nil
+	}
+	ourID := entityID(local)
+	if ourID != id {
+		m.ids[id] = ourID
+	}
+	return local
+}
+
+// ourID is the ID an entity of theirs has here
+func (m *merger) ourID(id string) string {
+	if ours, ok := m.ids[id]; ok {
+		return ours
+	}
+	return id
+}
+
+// merge updates our entity with theirs when they differ and theirs wins
+func (m *merger) merge(kind, title string, ours, theirs any, ourUpdated, theirUpdated time.Time, update func() error) {
+	if contentKey(ours) == contentKey(theirs) {
+		m.result.Unchanged++
+		return
+	}
+
+	take := theirUpdated.After(ourUpdated)
+	if theirUpdated.Equal(ourUpdated) {
+		var err error
+		if take, err = m.resolve(Conflict{Kind: kind, Ours: ours, Theirs: theirs}); err != nil {
+			m.result.Errors = append(m.result.Errors, fmt.Errorf("%s %q: %w", kind, title, err))
+			return
+		}
+	}
+	if !take {
+		m.result.Kept = append(m.result.Kept, kind+" "+title)
+		return
+	}
+	m.apply(kind, title, false, update)
+}
+
+// apply creates or updates an entity, unless this is a dry run
+func (m *merger) apply(kind, title string, created bool, write func() error) {
+	if !m.dryRun {
+		if err := write(); err != nil {
+			m.result.Errors = append(m.result.Errors, fmt.Errorf("%s %q: %w", kind, title, err))
+			return
+		}
+	}
+	if created {
+		m.result.Created = append(m.result.Created, kind+" "+title)
+	} else {
+		m.result.Updated = append(m.result.Updated, kind+" "+title)
+	}
+}
+
+// contentKey is an entity as JSON without its timestamps and derived fields
+func contentKey(v any) string {
+	switch e := v.(type) {
+	case *domain.Goal:
+		e = e.Clone()
+		e.Timestamps, e.Progress = domain.Timestamps{}, nil
+		v = e
+	case *domain.Area:
+		e = e.Clone()
+		e.Timestamps = domain.Timestamps{}
+		v = e
+	case *domain.Project:
+		e = e.Clone()
+		e.Timestamps, e.Health = domain.Timestamps{}, nil
+		v = e
+	case *domain.Task:
+		e = e.Clone()
+		e.Timestamps = domain.Timestamps{}
+		v = e
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+// entityID is the ID of a goal, area, project or task
+func entityID(v any) string {
+	switch e := v.(type) {
+	case *domain.Goal:
+		return e.ID
+	case *domain.Area:
+		return e.ID
+	case *domain.Project:
+		return e.ID
+	case *domain.Task:
+		return e.ID
+	}
+	return ""
+}
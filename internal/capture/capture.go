@@ -0,0 +1,203 @@
+// Package capture turns links and snippets sent from a browser or another
+// app into inbox notes, and serves the endpoint they are sent to.
+package capture
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ihavespoons/reorg/internal/slug"
+)
+
+// Item is something captured for the inbox
+type Item struct {
+	Title     string   `json:"title"`
+	URL       string   `json:"url,omitempty"`
+	Selection string   `json:"selection,omitempty"`
+	Body      string   `json:"body,omitempty"`
+	Tags      []string `json:"tags,omitempty"`
+	// Area is where the AI thinks the item belongs, if it was categorized
+	Area string `json:"area,omitempty"`
+}
+
+// Normalize trims the item and fills in a missing title from the URL or
+// the text. It fails if there is nothing to capture.
+func (i *Item) Normalize() error {
+	i.Title = strings.TrimSpace(i.Title)
+	i.URL = strings.TrimSpace(i.URL)
+	i.Selection = strings.TrimSpace(i.Selection)
+	i.Body = strings.TrimSpace(i.Body)
+
+	if i.Title == "" {
+		switch {
+		case i.URL != "":
+			i.Title = i.URL
+			if u, err := url.Parse(i.URL); err == nil && u.Host != "" {
+				i.Title = u.Host + strings.TrimSuffix(u.Path, "/")
+			}
+		case i.Body != "":
+			i.Title, _, _ = strings.Cut(i.Body, "\n")
+		case i.Selection != "":
+			i.Title, _, _ = strings.Cut(i.Selection, "\n")
+		}
+	}
+	if r := []rune(i.Title); len(r) > 80 {
+		i.Title = string(r[:80]) + "…"
+	}
+	if i.Title == "" {
+		return fmt.Errorf("nothing to capture: give a title, url or text")
+	}
+	return nil
+}
+
+// Text is the content of the item, for categorization
+func (i *Item) Text() string {
+	return strings.TrimSpace(strings.Join([]string{i.Title, i.URL, i.Selection, i.Body}, "\n"))
+}
+
+// FileName is the name of the inbox note for the item, e.g.
+// 20260416-0930-examplecom-article.md
+func (i *Item) FileName(now time.Time) string {
+	name := now.Format("20060102-1504")
+	s := []rune(slug.Make(i.Title))
+	if s := strings.Trim(string(s[:min(len(s), 40)]), "-"); s != "" {
+		name += "-" + s
+	}
+	return name + ".md"
+}
+
+// Markdown renders the item as an inbox note with frontmatter
+func (i *Item) Markdown(now time.Time) (string, error) {
+	fm := map[string]any{
+		"title":    i.Title,
+		"source":   "capture",
+		"captured": now.Format(time.RFC3339),
+	}
+	if i.URL != "" {
+		fm["url"] = i.URL
+	}
+	if len(i.Tags) > 0 {
+		fm["tags"] = i.Tags
+	}
+	if i.Area != "" {
+		fm["area"] = i.Area
+	}
+	data, err := yaml.Marshal(fm)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	b.WriteString("---\n" + string(data) + "---\n\n# " + i.Title + "\n")
+	if i.URL != "" {
+		fmt.Fprintf(&b, "\n<%s>\n", i.URL)
+	}
+	if i.Selection != "" {
+		b.WriteString("\n> " + strings.ReplaceAll(i.Selection, "\n", "\n> ") + "\n")
+	}
+	if i.Body != "" {
+		b.WriteString("\n" + i.Body + "\n")
+	}
+	return b.String(), nil
+}
+
+// AddTags adds tags the item doesn't have yet
+func (i *Item) AddTags(tags ...string) {
+	for _, tag := range tags {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+		if tag != "" && !slices.Contains(i.Tags, tag) {
+			i.Tags = append(i.Tags, tag)
+		}
+	}
+}
+
+// SaveFunc stores a captured item and returns where it went
+type SaveFunc func(ctx context.Context, item Item) (string, error)
+
+// Handler accepts POSTed items, as JSON or a form, and saves them. The
+// token is checked against a bearer Authorization header or a token field,
+// so bookmarklets can post a plain form. Form posts get a short page back,
+// JSON posts a JSON answer.
+func Handler(token string, save SaveFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, 1<<20)
+
+		isJSON := strings.HasPrefix(r.Header.Get("Content-Type"), "application/json")
+		var item Item
+		given := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if isJSON {
+			var body struct {
+				Item
+				Token string `json:"token"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				http.Error(w, "invalid JSON: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			item = body.Item
+			if given == "" {
+				given = body.Token
+			}
+		} else {
+			if err := r.ParseForm(); err != nil {
+				http.Error(w, "invalid form: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+			item = Item{
+				Title:     r.PostFormValue("title"),
+				URL:       r.PostFormValue("url"),
+				Selection: r.PostFormValue("selection"),
+				Body:      r.PostFormValue("body"),
+			}
+			item.AddTags(strings.Split(r.PostFormValue("tags"), ",")...)
+			if given == "" {
+				given = r.PostFormValue("token")
+			}
+		}
+
+		if subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		if err := item.Normalize(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		path, err := save(r.Context(), item)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if isJSON {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]string{"title": item.Title, "path": path})
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = fmt.Fprintf(w, "<!doctype html><title>Captured</title><p>Captured <b>%s</b> to the reorg inbox.</p><script>setTimeout(function(){window.close()},1500)</script>", html.EscapeString(item.Title))
+	})
+}
+
+// Bookmarklet returns a javascript: URL that posts the current page, and
+// any selected text, to the capture endpoint at endpoint in a new window
+func Bookmarklet(endpoint, token string) string {
+	js := fmt.Sprintf(`(function(){var f=document.createElement('form');f.method='POST';f.action=%q;f.target='_blank';var v={token:%q,url:location.href,title:document.title,selection:String(window.getSelection())};for(var k in v){var i=document.createElement('input');i.type='hidden';i.name=k;i.value=v[k];f.appendChild(i)}document.body.appendChild(f);f.submit();f.remove()})()`, endpoint, token)
+	return "javascript:" + url.PathEscape(js)
+}
@@ -0,0 +1,31 @@
+package capture
+
+import (
+	"testing"
+	"time"
+)
+
+func TestItemFileName(t *testing.T) {
+	now := time.Date(2026, 4, 16, 9, 30, 0, 0, time.UTC)
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"Call the bank", "20260416-0930-call-the-bank.md"},
+		{"Ärzte anrufen: Übersicht", "20260416-0930-arzte-anrufen-ubersicht.md"},
+		{"Straße in Łódź", "20260416-0930-strasse-in-lodz.md"},
+		{"東京 旅行", "20260416-0930-東京-旅行.md"},
+		{"!!!", "20260416-0930.md"},
+		// Cut at 40 letters, not bytes, and not ending in a hyphen
+		{"Überweisung für die Miete und die Nebenk. – Mai", "20260416-0930-uberweisung-fur-die-miete-und-die-nebenk.md"},
+		{"ααααααααααααααααααααααααααααααααααααααααααααα", "20260416-0930-αααααααααααααααααααααααααααααααααααααααα.md"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			item := Item{Title: tt.title}
+			if got := item.FileName(now); got != tt.want {
+				t.Errorf("FileName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package capture
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+// DefaultClipboardPatterns pick out links and text starting with TODO:
+var DefaultClipboardPatterns = []string{`^https?://\S+$`, `(?i)^todo:`}
+
+// ReadClipboard returns the text on the clipboard, with pbpaste on macOS
+// and wl-paste, xclip or xsel on Linux
+func ReadClipboard(ctx context.Context) (string, error) {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.CommandContext(ctx, "pbpaste")
+	case "linux":
+		switch {
+		case os.Getenv("WAYLAND_DISPLAY") != "" && hasCommand("wl-paste"):
+			cmd = exec.CommandContext(ctx, "wl-paste", "--no-newline", "--type", "text")
+		case hasCommand("xclip"):
+			cmd = exec.CommandContext(ctx, "xclip", "-selection", "clipboard", "-o")
+		case hasCommand("xsel"):
+			cmd = exec.CommandContext(ctx, "xsel", "--clipboard", "--output")
+		default:
+			return "", fmt.Errorf("reading the clipboard needs wl-paste, xclip or xsel")
+		}
+	default:
+		return "", fmt.Errorf("reading the clipboard is not supported on %s", runtime.GOOS)
+	}
+
+	out, err := cmd.Output()
+	if err != nil {
+		// wl-paste and xclip fail when the clipboard is empty or holds
+		// something other than text
+		return "", nil
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func hasCommand(name string) bool {
+	_, err := exec.LookPath(name)
+	return err == nil
+}
+
+// ClipboardMatcher decides which clipboard contents are worth capturing
+type ClipboardMatcher struct {
+	patterns []*regexp.Regexp
+}
+
+// NewClipboardMatcher compiles patterns, regular expressions matched
+// against the whole clipboard text
+func NewClipboardMatcher(patterns []string) (*ClipboardMatcher, error) {
+	m := &ClipboardMatcher{}
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid clipboard pattern %q: %w", p, err)
+		}
+		m.patterns = append(m.patterns, re)
+	}
+	return m, nil
+}
+
+// Item returns the item to capture for the clipboard text, if a pattern
+// matches it. A lone link becomes the item's URL; for other text the
+// first line is the title, without a TODO: prefix, and the rest the body.
+func (m *ClipboardMatcher) Item(text string) (Item, bool) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return Item{}, false
+	}
+	matched := false
+	for _, re := range m.patterns {
+		if re.MatchString(text) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return Item{}, false
+	}
+
+	if !strings.ContainsAny(text, " \n") && (strings.HasPrefix(text, "http://") || strings.HasPrefix(text, "https://")) {
+		return Item{URL: text}, true
+	}
+	title, body, _ := strings.Cut(text, "\n")
+	if prefix := "todo:"; len(title) >= len(prefix) && strings.EqualFold(title[:len(prefix)], prefix) {
+		title = title[len(prefix):]
+	}
+	return Item{Title: title, Body: body}, true
+}
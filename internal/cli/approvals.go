@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/approval"
+	"github.com/ihavespoons/reorg/internal/audit"
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+var (
+	approvalAreaFlag    string
+	approvalProjectFlag string
+)
+
+var approvalsCmd = &cobra.Command{
+	Use:   "approvals",
+	Short: "Review imports waiting for approval",
+	Long: `Imports run with --auto don't file notes the AI was unsure about.
+Notes categorized with less than import.approval_threshold confidence
+(default 0.6) wait here until they are accepted, optionally into another
+area or project, or rejected.`,
+}
+
+var approvalsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List imports waiting for approval",
+	Args:  cobra.NoArgs,
+	RunE:  runApprovalsList,
+}
+
+var approvalsShowCmd = &cobra.Command{
+	Use:   "show [approval-id]",
+	Short: "Show a queued import and the tasks it would create",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runApprovalsShow,
+}
+
+var approvalsAcceptCmd = &cobra.Command{
+	Use:   "accept [approval-id]",
+	Short: "Create the projects and tasks of a queued import",
+	Long: `Accept a queued import, creating its project and tasks as the AI
+suggested. --area and --project file it somewhere else instead.
+
+Examples:
+  reorg approvals accept appr-1a2b
+  reorg approvals accept appr-1a2b --area work --project "Q3 planning"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runApprovalsAccept,
+}
+
+var approvalsRejectCmd = &cobra.Command{
+	Use:   "reject [approval-id]",
+	Short: "Drop a queued import",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runApprovalsReject,
+}
+
+func init() {
+	rootCmd.AddCommand(approvalsCmd)
+	approvalsCmd.AddCommand(approvalsListCmd)
+	approvalsCmd.AddCommand(approvalsShowCmd)
+	approvalsCmd.AddCommand(approvalsAcceptCmd)
+	approvalsCmd.AddCommand(approvalsRejectCmd)
+
+	approvalsAcceptCmd.Flags().StringVarP(&approvalAreaFlag, "area", "a", "", "File into this area instead")
+	approvalsAcceptCmd.Flags().StringVarP(&approvalProjectFlag, "project", "p", "", "File into this project instead (existing or new)")
+}
+
+// approvalQueue returns the queue of imports waiting for approval
+func approvalQueue() *approval.Queue {
+	return approval.NewQueue(approvalsFile())
+}
+
+// approvalsFile is where the approval queue is kept
+func approvalsFile() string {
+	return filepath.Join(stateDir(), "approvals.json")
+}
+
+// approvalThreshold returns the AI confidence below which automated
+// imports queue notes for approval
+func approvalThreshold() float64 {
+	if viper.IsSet("import.approval_threshold") {
+		return viper.GetFloat64("import.approval_threshold")
+	}
+	return approval.DefaultThreshold
+}
+
+func runApprovalsList(cmd *cobra.Command, args []string) error {
+	items, err := approvalQueue().List()
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		fmt.Println("No imports waiting for approval.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "ID\tNOTE\tAREA\tPROJECT\tCONFIDENCE\tTASKS")
+	_, _ = fmt.Fprintln(w, "--\t----\t----\t-------\t----------\t-----")
+	for _, item := range items {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%.0f%%\t%d\n",
+			item.ID, item.SourceTitle, item.Categorization.Area,
+			approvalProject(cmd.Context(), item), item.Confidence()*100, len(item.Tasks))
+	}
+	_ = w.Flush()
+	return nil
+}
+
+func runApprovalsShow(cmd *cobra.Command, args []string) error {
+	item, err := approvalQueue().Get(args[0])
+	if err != nil {
+		return err
+	}
+
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+	fmt.Println(titleStyle.Render("\n  " + item.SourceTitle + "\n"))
+	fmt.Printf("%s %s\n", labelStyle.Render("ID:"), item.ID)
+	fmt.Printf("%s %s\n", labelStyle.Render("Source:"), item.Source)
+	fmt.Printf("%s %s\n", labelStyle.Render("Queued:"), item.Queued.In(timezone).Format("2006-01-02 15:04"))
+	fmt.Printf("%s %s (%.0f%% confidence)\n", labelStyle.Render("Area:"), item.Categorization.Area, item.Confidence()*100)
+	fmt.Printf("%s %s\n", labelStyle.Render("Project:"), approvalProject(cmd.Context(), item))
+	if len(item.Categorization.Tags) > 0 {
+		fmt.Printf("%s %s\n", labelStyle.Render("Tags:"), strings.Join(item.Categorization.Tags, ", "))
+	}
+	if item.Categorization.Summary != "" {
+		fmt.Printf("%s %s\n", labelStyle.Render("Summary:"), item.Categorization.Summary)
+	}
+	if len(item.Tasks) > 0 {
+		fmt.Println(labelStyle.Render("Tasks:"))
+		for _, t := range item.Tasks {
+			if t.Context != "" {
+				fmt.Printf("  - %s %s\n", t.Title, dimStyle.Render("@"+domain.NormalizeContext(t.Context)))
+				continue
+			}
+			fmt.Printf("  - %s\n", t.Title)
+		}
+	}
+	return nil
+}
+
+func runApprovalsAccept(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	queue := approvalQueue()
+
+	item, err := queue.Get(args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := acceptApproval(ctx, item, approvalAreaFlag, approvalProjectFlag); err != nil {
+		return err
+	}
+	if dryRunFile(audit.Update, approvalsFile()) {
+		return nil
+	}
+	if err := queue.Remove(item.ID); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s Imported %s with %d task(s)\n", successStyle.Render("✓"), item.SourceTitle, len(item.Tasks))
+	return nil
+}
+
+func runApprovalsReject(cmd *cobra.Command, args []string) error {
+	queue := approvalQueue()
+
+	item, err := queue.Get(args[0])
+	if err != nil {
+		return err
+	}
+	if dryRunFile(audit.Update, approvalsFile()) {
+		return nil
+	}
+	if err := queue.Remove(item.ID); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s Rejected %s\n", successStyle.Render("✓"), item.SourceTitle)
+	return nil
+}
+
+// acceptApproval creates the area, project and tasks of a queued import as
+// one changeset. A non-empty area or project files it there instead of
+// where the AI suggested; an existing project brings its area along.
+func acceptApproval(ctx context.Context, item *approval.Item, area, project string) error {
+	cat := item.Categorization
+	if area != "" {
+		cat.Area = area
+	}
+	if project != "" {
+		cat.ProjectID = ""
+		cat.ProjectSuggestion = project
+		if p, err := findProject(ctx, project); err == nil {
+			cat.ProjectID = p.ID
+			if a, err := client.GetArea(ctx, p.AreaID); err == nil && area == "" {
+				cat.Area = a.Title
+			}
+		}
+	}
+
+	note := genericNote{
+		Name:    item.SourceTitle,
+		Content: item.Content,
+		Source:  item.Source,
+		Ref:     item.SourceRef,
+
+		Attachments: item.Attachments,
+	}
+	create := func(ctx context.Context) error {
+		return createFromCategorization(ctx, note, &cat, item.Provider, item.Tasks)
+	}
+	if store != nil {
+		return store.Batch(ctx, "accept import: "+item.SourceTitle, create)
+	}
+	return create(ctx)
+}
+
+// approvalProject names the project an item would be filed into
+func approvalProject(ctx context.Context, item *approval.Item) string {
+	if id := item.Categorization.ProjectID; id != "" {
+		if p, err := client.GetProject(ctx, id); err == nil {
+			return p.Title
+		}
+	}
+	if item.Categorization.ProjectSuggestion != "" {
+		return item.Categorization.ProjectSuggestion + " (new)"
+	}
+	return item.SourceTitle + " (new)"
+}
@@ -1,17 +1,30 @@
 package cli
 
 import (
-	"context"
 	"fmt"
 	"os"
-	"text/tabwriter"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/spf13/cobra"
 
+	"github.com/ihavespoons/reorg/internal/cli/render"
 	"github.com/ihavespoons/reorg/internal/domain"
 )
 
+var (
+	areaPriorityFlag    string
+	areaSetPriorityFlag string
+	areaTagsFlag        []string
+	areaReviewFlag      string
+	areaAddTagsFlag     []string
+	areaRemoveTagsFlag  []string
+	areaMetaFlag        []string
+	areaListRender      render.Options
+)
+
 var areaCmd = &cobra.Command{
 	Use:   "area",
 	Short: "Manage areas",
@@ -27,8 +40,39 @@ var areaListCmd = &cobra.Command{
 var areaCreateCmd = &cobra.Command{
 	Use:   "create [name]",
 	Short: "Create a new area",
+	Long: `Create a new area.
+
+An area can have a review cadence (weekly, biweekly, monthly, quarterly or
+yearly). 'reorg area list' shows when each area is next due for review, and
+'reorg area review' marks it as reviewed.
+
+Examples:
+  reorg area create "Side Projects"
+  reorg area create Health --priority high --tags fitness --review monthly`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAreaCreate,
+}
+
+var areaUpdateCmd = &cobra.Command{
+	Use:   "update [name]",
+	Short: "Change an area's priority, tags, review cadence or metadata",
+	Long: `Change an area's priority, tags, review cadence or metadata. Use
+--review none to stop reviewing an area.
+
+Examples:
+  reorg area update work --priority high
+  reorg area update personal --add-tag family --remove-tag misc
+  reorg area update life-admin --review quarterly
+  reorg area update work --meta jira_project=OPS`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAreaUpdate,
+}
+
+var areaReviewCmd = &cobra.Command{
+	Use:   "review [name]",
+	Short: "Mark an area as reviewed",
 	Args:  cobra.ExactArgs(1),
-	RunE:  runAreaCreate,
+	RunE:  runAreaReview,
 }
 
 var areaShowCmd = &cobra.Command{
@@ -50,11 +94,35 @@ func init() {
 	areaCmd.AddCommand(areaListCmd)
 	areaCmd.AddCommand(areaCreateCmd)
 	areaCmd.AddCommand(areaShowCmd)
+	areaCmd.AddCommand(areaUpdateCmd)
+	areaCmd.AddCommand(areaReviewCmd)
 	areaCmd.AddCommand(areaDeleteCmd)
+
+	// List flags
+	areaListRender.AddFlags(areaListCmd, []string{"priority", "updated", "created"}, nil)
+
+	// Show flags
+	areaShowCmd.Flags().BoolVar(&showRawFlag, "raw", false, "Print the description as written, without markdown rendering")
+
+	// Create flags
+	areaCreateCmd.Flags().StringVarP(&areaPriorityFlag, "priority", "p", "medium", "Priority (low, medium, high, urgent)")
+	areaCreateCmd.Flags().StringSliceVarP(&areaTagsFlag, "tags", "t", nil, "Tags for the area")
+	areaCreateCmd.Flags().StringVar(&areaReviewFlag, "review", "", "Review cadence (weekly, biweekly, monthly, quarterly, yearly)")
+	areaCreateCmd.Flags().StringArrayVar(&areaMetaFlag, "meta", nil, metaFlagUsage)
+
+	// Update flags
+	areaUpdateCmd.Flags().StringVarP(&areaSetPriorityFlag, "priority", "p", "", "New priority (low, medium, high, urgent)")
+	areaUpdateCmd.Flags().StringSliceVar(&areaAddTagsFlag, "add-tag", nil, "Tags to add")
+	areaUpdateCmd.Flags().StringSliceVar(&areaRemoveTagsFlag, "remove-tag", nil, "Tags to remove")
+	areaUpdateCmd.Flags().StringVar(&areaReviewFlag, "review", "", "Review cadence (weekly, biweekly, monthly, quarterly, yearly, none)")
+	areaUpdateCmd.Flags().StringArrayVar(&areaMetaFlag, "meta", nil, metaFlagUsage)
 }
 
 func runAreaList(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
+	if err := areaListRender.Validate(); err != nil {
+		return err
+	}
 
 	areas, err := client.ListAreas(ctx)
 	if err != nil {
@@ -66,30 +134,53 @@ func runAreaList(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintln(w, "NAME\tPROJECTS\tCREATED")
-	_, _ = fmt.Fprintln(w, "----\t--------\t-------")
+	groups := render.Apply(areas, areaListRender, func(a *domain.Area) render.Fields {
+		return render.Fields{Priority: a.Priority, Created: a.Created, Updated: a.Updated}
+	})
 
-	for _, area := range areas {
+	header := []string{"NAME", "PRIORITY", "TAGS", "PROJECTS", "NEXT REVIEW"}
+	return render.Table(os.Stdout, groups, header, func(area *domain.Area) []string {
 		// Count projects
 		projects, _ := client.ListProjects(ctx, area.ID)
-		projectCount := len(projects)
 
-		_, _ = fmt.Fprintf(w, "%s\t%d\t%s\n",
-			area.Title,
-			projectCount,
-			area.Created.Format("2006-01-02"),
-		)
-	}
+		priority, tags := string(area.Priority), strings.Join(area.Tags, ", ")
+		if priority == "" {
+			priority = "-"
+		}
+		if tags == "" {
+			tags = "-"
+		}
 
-	return w.Flush()
+		return []string{area.Title, priority, tags, strconv.Itoa(len(projects)), formatNextReview(area)}
+	})
 }
 
 func runAreaCreate(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	name := args[0]
 
 	area := domain.NewArea(name)
+
+	priority, err := parsePriority(areaPriorityFlag)
+	if err != nil {
+		return err
+	}
+	area.Priority = priority
+
+	meta, err := domain.ParseMetadata(areaMetaFlag)
+	if err != nil {
+		return err
+	}
+	domain.SetMetadata(&area.Metadata, meta)
+
+	if area.Review, err = domain.ParseReviewCadence(areaReviewFlag); err != nil {
+		return err
+	}
+
+	for _, tag := range areaTagsFlag {
+		area.AddTag(tag)
+	}
+
 	if _, err := client.CreateArea(ctx, area); err != nil {
 		return fmt.Errorf("failed to create area: %w", err)
 	}
@@ -99,12 +190,12 @@ func runAreaCreate(cmd *cobra.Command, args []string) error {
 }
 
 func runAreaShow(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	slug := args[0]
 
 	area, err := client.GetAreaBySlug(ctx, slug)
 	if err != nil {
-		return fmt.Errorf("area not found: %s", slug)
+		return domain.Errorf(domain.ErrNotFound, "area not found: %s", slug)
 	}
 
 	// Count projects and tasks
@@ -134,19 +225,29 @@ func runAreaShow(cmd *cobra.Command, args []string) error {
 	fmt.Println()
 
 	fmt.Printf("%s %s\n", labelStyle.Render("ID:"), area.ID)
+	if area.Priority != "" {
+		fmt.Printf("%s %s\n", labelStyle.Render("Priority:"), area.Priority)
+	}
+	if len(area.Tags) > 0 {
+		fmt.Printf("%s %s\n", labelStyle.Render("Tags:"), strings.Join(area.Tags, ", "))
+	}
+	if area.Review != "" {
+		review := string(area.Review) + ", next " + formatNextReview(area)
+		if area.LastReviewed != nil {
+			review += ", last " + area.LastReviewed.In(timezone).Format("2006-01-02")
+		}
+		fmt.Printf("%s %s\n", labelStyle.Render("Review:"), review)
+	}
 	fmt.Printf("%s %s\n", labelStyle.Render("Created:"), area.Created.Format("2006-01-02 15:04"))
 	fmt.Printf("%s %s\n", labelStyle.Render("Updated:"), area.Updated.Format("2006-01-02 15:04"))
+	printMetadata(area.Metadata, labelStyle)
 	fmt.Println()
 
 	fmt.Printf("%s %d\n", labelStyle.Render("Projects:"), len(projects))
 	fmt.Printf("%s %d/%d completed\n", labelStyle.Render("Tasks:"), completedTasks, totalTasks)
 	fmt.Println()
 
-	if area.Content != "" {
-		fmt.Println(labelStyle.Render("Description:"))
-		fmt.Println(area.Content)
-		fmt.Println()
-	}
+	printDescription(area.Content, labelStyle)
 
 	if len(projects) > 0 {
 		fmt.Println(labelStyle.Render("Projects:"))
@@ -157,6 +258,8 @@ func runAreaShow(cmd *cobra.Command, args []string) error {
 				statusIcon = "✓"
 			case domain.ProjectStatusOnHold:
 				statusIcon = "⏸"
+			case domain.ProjectStatusSomeday:
+				statusIcon = "…"
 			}
 			fmt.Printf("  %s %s\n", statusIcon, p.Title)
 		}
@@ -166,13 +269,93 @@ func runAreaShow(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runAreaUpdate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	slug := args[0]
+
+	area, err := client.GetAreaBySlug(ctx, slug)
+	if err != nil {
+		return domain.Errorf(domain.ErrNotFound, "area not found: %s", slug)
+	}
+
+	if areaSetPriorityFlag == "" && areaReviewFlag == "" && len(areaAddTagsFlag) == 0 && len(areaRemoveTagsFlag) == 0 && len(areaMetaFlag) == 0 {
+		return fmt.Errorf("nothing to change: use --priority, --add-tag, --remove-tag, --review or --meta")
+	}
+
+	meta, err := domain.ParseMetadata(areaMetaFlag)
+	if err != nil {
+		return err
+	}
+	domain.SetMetadata(&area.Metadata, meta)
+
+	if areaSetPriorityFlag != "" {
+		if area.Priority, err = parsePriority(areaSetPriorityFlag); err != nil {
+			return err
+		}
+	}
+	if areaReviewFlag != "" {
+		if area.Review, err = domain.ParseReviewCadence(areaReviewFlag); err != nil {
+			return err
+		}
+	}
+	for _, tag := range areaAddTagsFlag {
+		area.AddTag(tag)
+	}
+	for _, tag := range areaRemoveTagsFlag {
+		area.RemoveTag(tag)
+	}
+	area.UpdateTimestamp()
+
+	if err := client.UpdateArea(ctx, area); err != nil {
+		return fmt.Errorf("failed to update area: %w", err)
+	}
+
+	fmt.Printf("%s Updated area: %s\n", successStyle.Render("✓"), area.Title)
+	return nil
+}
+
+func runAreaReview(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	slug := args[0]
+
+	area, err := client.GetAreaBySlug(ctx, slug)
+	if err != nil {
+		return domain.Errorf(domain.ErrNotFound, "area not found: %s", slug)
+	}
+
+	area.MarkReviewed()
+	if err := client.UpdateArea(ctx, area); err != nil {
+		return fmt.Errorf("failed to update area: %w", err)
+	}
+
+	fmt.Printf("%s Reviewed area: %s", successStyle.Render("✓"), area.Title)
+	if next := area.NextReview(); next != nil {
+		fmt.Print(dimStyle.Render(" (next review " + next.In(timezone).Format("2006-01-02") + ")"))
+	}
+	fmt.Println()
+	return nil
+}
+
+// formatNextReview returns when an area is next due for review, or "-" if
+// it has no review cadence
+func formatNextReview(area *domain.Area) string {
+	next := area.NextReview()
+	if next == nil {
+		return "-"
+	}
+	if next.Before(time.Now().In(timezone)) {
+		return "due"
+	}
+	return next.In(timezone).Format("2006-01-02")
+}
+
 func runAreaDelete(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	slug := args[0]
 
 	area, err := client.GetAreaBySlug(ctx, slug)
 	if err != nil {
-		return fmt.Errorf("area not found: %s", slug)
+		return domain.Errorf(domain.ErrNotFound, "area not found: %s", slug)
 	}
 
 	// Check for projects
@@ -0,0 +1,238 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/audit"
+)
+
+var (
+	auditLinesFlag  int
+	auditFollowFlag bool
+	auditKindFlag   string
+	auditActorFlag  string
+	auditIDFlag     string
+	auditSinceFlag  string
+	auditJSONFlag   bool
+)
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Inspect the trail of changes",
+	Long: `Every area, project, task, goal and note that is created, updated or
+deleted is recorded in audit.jsonl in the state directory, with the time,
+the fields an update changed, and the actor that made the change: the CLI
+command (cli:task update), a script (script:<name>), an MCP client
+(mcp:<client>) or an API call (api:<method>), or the actor a remote CLI
+names.
+
+Set audit.enabled to false in config.yaml to record nothing.`,
+}
+
+var auditTailCmd = &cobra.Command{
+	Use:   "tail",
+	Short: "Show the latest changes",
+	Long: `Show the latest changes, oldest first. --follow keeps printing changes
+as they are recorded.
+
+Examples:
+  reorg audit tail
+  reorg audit tail -n 50
+  reorg audit tail -f`,
+	Args: cobra.NoArgs,
+	RunE: runAuditTail,
+}
+
+var auditSearchCmd = &cobra.Command{
+	Use:   "search [text]",
+	Short: "Find changes",
+	Long: `Find changes whose title, ID, actor or changed values contain text,
+ignoring case. Without text every change matching the flags is shown.
+
+Examples:
+  reorg audit search "quarterly report"
+  reorg audit search --kind task --since 7d
+  reorg audit search --actor mcp
+  reorg audit search --id 1a2b3c --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runAuditSearch,
+}
+
+func init() {
+	rootCmd.AddCommand(auditCmd)
+	auditCmd.AddCommand(auditTailCmd)
+	auditCmd.AddCommand(auditSearchCmd)
+
+	auditTailCmd.Flags().IntVarP(&auditLinesFlag, "lines", "n", 20, "Number of changes to show")
+	auditTailCmd.Flags().BoolVarP(&auditFollowFlag, "follow", "f", false, "Keep printing changes as they are recorded")
+	auditTailCmd.Flags().BoolVar(&auditJSONFlag, "json", false, "Print changes as JSON lines")
+
+	auditSearchCmd.Flags().StringVarP(&auditKindFlag, "kind", "k", "", "Only changes to this kind (area, project, task, goal or note)")
+	auditSearchCmd.Flags().StringVar(&auditActorFlag, "actor", "", "Only changes by actors starting with this (e.g. cli, mcp, script:triage)")
+	auditSearchCmd.Flags().StringVar(&auditIDFlag, "id", "", "Only changes to the item with this ID")
+	auditSearchCmd.Flags().StringVar(&auditSinceFlag, "since", "", "Only changes within this duration (e.g. 24h, 7d)")
+	auditSearchCmd.Flags().BoolVar(&auditJSONFlag, "json", false, "Print changes as JSON lines")
+}
+
+// newAuditLog returns the audit log in the state directory, or nil if
+// audit.enabled is false
+func newAuditLog() *audit.Log {
+	if viper.IsSet("audit.enabled") && !viper.GetBool("audit.enabled") {
+		return nil
+	}
+	log := audit.NewLog(filepath.Join(stateDir(), "audit.jsonl"))
+	log.Actor = auditActor()
+	return log
+}
+
+// auditActor names the running command in the audit log
+func auditActor() string {
+	return "cli:" + llmCaller
+}
+
+func runAuditTail(cmd *cobra.Command, args []string) error {
+	log := audit.NewLog(filepath.Join(stateDir(), "audit.jsonl"))
+	entries, err := log.Entries()
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 && !auditFollowFlag {
+		fmt.Println("No changes recorded.")
+		return nil
+	}
+	seen := len(entries)
+	if auditLinesFlag >= 0 && len(entries) > auditLinesFlag {
+		entries = entries[len(entries)-auditLinesFlag:]
+	}
+	printAuditEntries(entries)
+
+	for auditFollowFlag {
+		time.Sleep(time.Second)
+		entries, err := log.Entries()
+		if err != nil {
+			return err
+		}
+		if len(entries) > seen {
+			printAuditEntries(entries[seen:])
+		}
+		seen = len(entries)
+	}
+	return nil
+}
+
+func runAuditSearch(cmd *cobra.Command, args []string) error {
+	var since time.Time
+	if auditSinceFlag != "" {
+		d, err := parseDuration(auditSinceFlag)
+		if err != nil {
+			return fmt.Errorf("invalid --since %q: %w", auditSinceFlag, err)
+		}
+		since = time.Now().Add(-d)
+	}
+	text := ""
+	if len(args) > 0 {
+		text = strings.ToLower(args[0])
+	}
+
+	entries, err := audit.NewLog(filepath.Join(stateDir(), "audit.jsonl")).Entries()
+	if err != nil {
+		return err
+	}
+	var matches []audit.Entry
+	for _, e := range entries {
+		switch {
+		case auditKindFlag != "" && e.Kind != auditKindFlag,
+			auditActorFlag != "" && !strings.HasPrefix(e.Actor, auditActorFlag),
+			auditIDFlag != "" && e.ID != auditIDFlag,
+			!since.IsZero() && e.Time.Before(since),
+			text != "" && !auditEntryContains(e, text):
+			continue
+		}
+		matches = append(matches, e)
+	}
+	if len(matches) == 0 {
+		fmt.Println("No matching changes.")
+		return nil
+	}
+	printAuditEntries(matches)
+	return nil
+}
+
+// auditEntryContains returns true if the title, ID, actor or a changed
+// value of an entry contains text, which is lower case
+func auditEntryContains(e audit.Entry, text string) bool {
+	fields := []string{e.Title, e.ID, e.Actor}
+	for name, c := range e.Changes {
+		fields = append(fields, name, auditValue(c.Old), auditValue(c.New))
+	}
+	for _, f := range fields {
+		if strings.Contains(strings.ToLower(f), text) {
+			return true
+		}
+	}
+	return false
+}
+
+// printAuditEntries prints one line per change, and one indented line per
+// field an update changed
+func printAuditEntries(entries []audit.Entry) {
+	for _, e := range entries {
+		if auditJSONFlag {
+			data, _ := json.Marshal(e)
+			fmt.Println(string(data))
+			continue
+		}
+
+		actor := e.Actor
+		if e.User != "" {
+			actor += " (" + e.User + ")"
+		}
+		fmt.Printf("%s  %-8s %-7s %s %s\n",
+			dimStyle.Render(e.Time.In(timezone).Format("2006-01-02 15:04:05")),
+			e.Action, e.Kind, e.Title, dimStyle.Render("["+e.ID+"] by "+actor))
+
+		for _, change := range auditChanges(e.Changes) {
+			fmt.Printf("    %s\n", change)
+		}
+	}
+}
+
+// auditChanges formats the fields an update changed as "field: old → new",
+// sorted by field
+func auditChanges(changes map[string]audit.Change) []string {
+	names := make([]string, 0, len(changes))
+	for name := range changes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	lines := make([]string, len(names))
+	for i, name := range names {
+		c := changes[name]
+		lines[i] = fmt.Sprintf("%s: %s → %s", name, auditValue(c.Old), auditValue(c.New))
+	}
+	return lines
+}
+
+// auditValue formats a changed value, "-" for none
+func auditValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "-"
+	case string:
+		if v == "" {
+			return "-"
+		}
+		return v
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
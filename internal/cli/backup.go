@@ -0,0 +1,235 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/audit"
+	"github.com/ihavespoons/reorg/internal/backup"
+)
+
+var (
+	backupOutputFlag string
+	backupNoGitFlag  bool
+	backupYesFlag    bool
+)
+
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Snapshot and restore the data directory",
+	Long: `Snapshot the whole data directory as a compressed archive and restore
+it, independent of its git history.
+
+Without --output, archives go to backup.dir (default the backups folder of
+the state directory) and older ones are rotated out: the newest archive of
+each of the last backup.keep.daily days (7) and backup.keep.weekly weeks (4)
+is kept. Run 'reorg backup create' from cron or launchd to take them on a
+schedule.`,
+}
+
+var backupCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Write a snapshot of the data directory",
+	Long: `Write a snapshot of the data directory, including its git history
+unless --no-git (or backup.include_git: false) is given. The archive type
+follows the name: .tar.gz, .tar.zst (which needs the zstd command) or
+.tar; backup.format sets it for rotated archives (tar.gz by default).
+
+Examples:
+  reorg backup create
+  reorg backup create --output ~/Dropbox/reorg.tar.zst --no-git`,
+	Args: cobra.NoArgs,
+	RunE: runBackupCreate,
+}
+
+var backupListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List rotated backups",
+	Args:  cobra.NoArgs,
+	RunE:  runBackupList,
+}
+
+var backupRestoreCmd = &cobra.Command{
+	Use:   "restore [archive]",
+	Short: "Replace the data directory with a snapshot",
+	Long: `Replace the data directory with the contents of an archive. The current
+data directory is kept next to it, renamed with a .before-restore suffix,
+until you delete it. The server has to be stopped first.
+
+Examples:
+  reorg backup restore ~/.local/state/reorg/backups/reorg-20260416-093000.tar.gz`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBackupRestore,
+}
+
+func init() {
+	rootCmd.AddCommand(backupCmd)
+	backupCmd.AddCommand(backupCreateCmd)
+	backupCmd.AddCommand(backupListCmd)
+	backupCmd.AddCommand(backupRestoreCmd)
+
+	backupCreateCmd.Flags().StringVarP(&backupOutputFlag, "output", "o", "", "Write the archive here instead of backup.dir, without rotation")
+	backupCreateCmd.Flags().BoolVar(&backupNoGitFlag, "no-git", false, "Leave out the git history")
+	backupRestoreCmd.Flags().BoolVarP(&backupYesFlag, "yes", "y", false, "Restore without asking")
+}
+
+// backupDir is where rotated backups are kept
+func backupDir() string {
+	if dir := viper.GetString("backup.dir"); dir != "" {
+		return expandHome(dir)
+	}
+	return filepath.Join(stateDir(), "backups")
+}
+
+func runBackupCreate(cmd *cobra.Command, args []string) error {
+	if _, err := os.Stat(filepath.Join(dataDir, "areas")); os.IsNotExist(err) {
+		return fmt.Errorf("no reorg data in %s", dataDir)
+	}
+	includeGit := !backupNoGitFlag
+	if viper.IsSet("backup.include_git") && !cmd.Flags().Changed("no-git") {
+		includeGit = viper.GetBool("backup.include_git")
+	}
+
+	path := expandHome(backupOutputFlag)
+	if path == "" {
+		format := viper.GetString("backup.format")
+		if format == "" {
+			format = "tar.gz"
+		}
+		path = filepath.Join(backupDir(), backup.ArchiveName(time.Now(), "."+strings.TrimPrefix(format, ".")))
+	}
+	if dryRunFile(audit.Create, path) {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create backup directory: %w", err)
+	}
+	if err := backup.WriteArchive(dataDir, path, includeGit); err != nil {
+		return err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s Backed up %s to %s %s\n", successStyle.Render("✓"), dataDir, path, dimStyle.Render(formatSize(info.Size())))
+	if backupOutputFlag != "" {
+		return nil
+	}
+
+	keep := backup.Rotation{Daily: 7, Weekly: 4}
+	if viper.IsSet("backup.keep.daily") {
+		keep.Daily = viper.GetInt("backup.keep.daily")
+	}
+	if viper.IsSet("backup.keep.weekly") {
+		keep.Weekly = viper.GetInt("backup.keep.weekly")
+	}
+	removed, err := backup.Rotate(backupDir(), keep)
+	for _, a := range removed {
+		fmt.Println(dimStyle.Render("  Removed " + filepath.Base(a.Path)))
+	}
+	return err
+}
+
+func runBackupList(cmd *cobra.Command, args []string) error {
+	archives, err := backup.ListArchives(backupDir())
+	if err != nil {
+		return err
+	}
+	if len(archives) == 0 {
+		fmt.Printf("No backups in %s.\n", backupDir())
+		return nil
+	}
+	for _, a := range archives {
+		fmt.Printf("  %s  %8s  %s\n", a.Created.Format("2006-01-02 15:04"), formatSize(a.Size), a.Path)
+	}
+	return nil
+}
+
+func runBackupRestore(cmd *cobra.Command, args []string) error {
+	archive := expandHome(args[0])
+	if _, err := os.Stat(archive); err != nil {
+		return fmt.Errorf("failed to open archive: %w", err)
+	}
+	pid, err := runningServer(servePIDFile())
+	if err != nil {
+		return err
+	}
+	if pid != 0 {
+		return fmt.Errorf("reorg serve is running (pid %d); stop it with 'reorg serve stop' first", pid)
+	}
+
+	// Unpack next to the data directory, so putting it in place is a rename
+	tmp, err := os.MkdirTemp(filepath.Dir(dataDir), ".reorg-restore-")
+	if err != nil {
+		return fmt.Errorf("failed to create restore directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(tmp) }()
+	if err := backup.ExtractArchive(archive, tmp); err != nil {
+		return err
+	}
+	if _, err := os.Stat(filepath.Join(tmp, "areas")); err != nil {
+		return fmt.Errorf("%s is not a reorg backup (it has no areas)", filepath.Base(archive))
+	}
+
+	// The archive is checked before a dry run stops
+	if dryRunFile(audit.Update, dataDir) {
+		return nil
+	}
+
+	var previous string
+	if _, err := os.Stat(dataDir); err == nil {
+		if !backupYesFlag {
+			reader := bufio.NewReader(os.Stdin)
+			fmt.Print(promptStyle.Render(fmt.Sprintf("Replace %s with the backup? [y/N]: ", dataDir)))
+			input, _ := reader.ReadString('\n')
+			input = strings.ToLower(strings.TrimSpace(input))
+			if input != "y" && input != "yes" {
+				fmt.Println("Aborted.")
+				return nil
+			}
+		}
+		previous = dataDir + ".before-restore-" + time.Now().Format("20060102-150405")
+		if err := os.Rename(dataDir, previous); err != nil {
+			return fmt.Errorf("failed to move the data directory aside: %w", err)
+		}
+	}
+	if err := os.Rename(tmp, dataDir); err != nil {
+		if previous != "" {
+			_ = os.Rename(previous, dataDir)
+		}
+		return fmt.Errorf("failed to restore: %w", err)
+	}
+	// MkdirTemp creates the directory private to the user
+	_ = os.Chmod(dataDir, 0755)
+
+	fmt.Printf("%s Restored %s from %s\n", successStyle.Render("✓"), dataDir, filepath.Base(archive))
+	if previous != "" {
+		fmt.Println(dimStyle.Render("  The previous data is in " + previous))
+	}
+	if _, err := os.Stat(filepath.Join(dataDir, ".git")); os.IsNotExist(err) {
+		fmt.Println(dimStyle.Render("  The backup has no git history; run 'git init' in the data directory to track changes again"))
+	}
+	return nil
+}
+
+// formatSize formats a byte count like 1.2 MB
+func formatSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
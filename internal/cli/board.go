@@ -0,0 +1,417 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/term"
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/service"
+)
+
+var (
+	boardAreaFlag        string
+	boardInteractiveFlag bool
+)
+
+// boardDoneLimit is how many of the most recently completed tasks the done
+// column shows
+const boardDoneLimit = 10
+
+var boardCmd = &cobra.Command{
+	Use:   "board [project]",
+	Short: "Show tasks as a kanban board",
+	Long: `Show tasks in columns by status: pending, in progress, blocked and done.
+The done column holds the most recently completed tasks. Give a project, or
+--area, to show only its tasks.
+
+With --interactive, move around the board and drag tasks between columns.
+The board redraws when tasks change elsewhere, in remote mode too:
+
+  ←/→ h/l   move between columns     ↑/↓ j/k   move within a column
+  </> H/L   drag the task left/right (changes its status)
+  q         quit
+
+Examples:
+  reorg board
+  reorg board website
+  reorg board --area work -i`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runBoard,
+}
+
+func init() {
+	rootCmd.AddCommand(boardCmd)
+
+	boardCmd.Flags().StringVarP(&boardAreaFlag, "area", "a", "", "Only show tasks in this area")
+	boardCmd.Flags().BoolVarP(&boardInteractiveFlag, "interactive", "i", false, "Move tasks between columns with the keyboard")
+	boardCmd.ValidArgsFunction = completeFirstArg(completeProjects)
+}
+
+// boardColumn is a board column and the status of the tasks in it
+type boardColumn struct {
+	title  string
+	status domain.TaskStatus
+	tasks  []*service.TaskRef
+	more   int // done tasks left out
+}
+
+// board holds the tasks shown on a board, and the cursor when interactive
+type board struct {
+	project   *domain.Project
+	area      *domain.Area
+	columns   []*boardColumn
+	col, row  int
+	status    string
+	raw       *term.State
+	showOwner bool
+}
+
+func runBoard(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	b := &board{}
+	if len(args) > 0 {
+		project, err := findProject(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		b.project = project
+	}
+	if boardAreaFlag != "" {
+		area, err := client.GetAreaBySlug(ctx, boardAreaFlag)
+		if err != nil {
+			return domain.Errorf(domain.ErrNotFound, "area not found: %s", boardAreaFlag)
+		}
+		b.area = area
+	}
+	b.showOwner = b.project == nil
+	if err := b.load(ctx); err != nil {
+		return err
+	}
+
+	if !boardInteractiveFlag {
+		fmt.Println(b.view(terminalWidth(), false))
+		return nil
+	}
+	if !term.IsTerminal(os.Stdin.Fd()) {
+		return fmt.Errorf("reorg board --interactive needs an interactive terminal")
+	}
+	return b.run(ctx)
+}
+
+// terminalWidth returns the width of the terminal, or 120 when it isn't one
+func terminalWidth() int {
+	if width, _, err := term.GetSize(os.Stdout.Fd()); err == nil && width > 0 {
+		return width
+	}
+	return 120
+}
+
+// load reads the tasks on the board into their columns
+func (b *board) load(ctx context.Context) error {
+	refs, err := client.ListTasksWithRefs(ctx, "")
+	if err != nil {
+		return fmt.Errorf("failed to list tasks: %w", err)
+	}
+	refs = slices.DeleteFunc(refs, func(r *service.TaskRef) bool {
+		if b.project != nil && r.Task.ProjectID != b.project.ID {
+			return true
+		}
+		// A someday project's own board still shows its tasks
+		if b.project == nil && inSomedayProject(r) {
+			return true
+		}
+		return b.area != nil && (r.Area == nil || r.Area.ID != b.area.ID)
+	})
+
+	b.columns = []*boardColumn{
+		{title: "Pending", status: domain.TaskStatusPending},
+		{title: "In progress", status: domain.TaskStatusInProgress},
+		{title: "Blocked", status: domain.TaskStatusBlocked},
+		{title: "Done", status: domain.TaskStatusCompleted},
+	}
+	for _, ref := range refs {
+		for _, c := range b.columns {
+			if ref.Task.Status == c.status {
+				c.tasks = append(c.tasks, ref)
+			}
+		}
+	}
+
+	// Most urgent first, and the latest completed first
+	for _, c := range b.columns[:3] {
+		slices.SortStableFunc(c.tasks, func(x, y *service.TaskRef) int {
+			return y.Task.Priority.Rank() - x.Task.Priority.Rank()
+		})
+	}
+	done := b.columns[3]
+	slices.SortStableFunc(done.tasks, func(x, y *service.TaskRef) int {
+		return y.Task.Updated.Compare(x.Task.Updated)
+	})
+	if len(done.tasks) > boardDoneLimit {
+		done.more = len(done.tasks) - boardDoneLimit
+		done.tasks = done.tasks[:boardDoneLimit]
+	}
+
+	b.clampCursor()
+	return nil
+}
+
+func (b *board) clampCursor() {
+	if n := len(b.columns[b.col].tasks); b.row >= n {
+		b.row = max(n-1, 0)
+	}
+}
+
+// view renders the columns side by side to fit width, highlighting the
+// cursor when interactive
+func (b *board) view(width int, interactive bool) string {
+	colWidth := max((width-len(b.columns))/len(b.columns)-2, 16)
+	columnStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("8")).
+		Width(colWidth).
+		Padding(0, 1)
+	headerStyle := lipgloss.NewStyle().Bold(true)
+	cursorStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12"))
+
+	title := "Board"
+	switch {
+	case b.project != nil:
+		title = b.project.Title
+	case b.area != nil:
+		title = b.area.Title
+	}
+
+	rendered := make([]string, len(b.columns))
+	for i, c := range b.columns {
+		var lines []string
+		lines = append(lines, headerStyle.Render(fmt.Sprintf("%s (%d)", c.title, len(c.tasks)+c.more)), "")
+		for j, ref := range c.tasks {
+			card := boardCard(ref, colWidth-4, b.showOwner)
+			if interactive && i == b.col && j == b.row {
+				card = cursorStyle.Render("> " + card)
+			} else {
+				card = "  " + card
+			}
+			lines = append(lines, card)
+		}
+		if c.more > 0 {
+			lines = append(lines, dimStyle.Render(fmt.Sprintf("  … %d more", c.more)))
+		}
+		style := columnStyle
+		if interactive && i == b.col {
+			style = style.BorderForeground(lipgloss.Color("12"))
+		}
+		rendered[i] = style.Render(strings.Join(lines, "\n"))
+	}
+
+	return titleStyle.Render("  "+title) + "\n" + lipgloss.JoinHorizontal(lipgloss.Top, rendered...)
+}
+
+// boardCard is a task's line on the board: its title, marked with its
+// priority and due date, and its project when the board spans several
+func boardCard(ref *service.TaskRef, width int, showOwner bool) string {
+	t := ref.Task
+	text := t.Title
+	if t.Priority == domain.PriorityHigh || t.Priority == domain.PriorityUrgent {
+		text = "!" + text
+	}
+	if r := []rune(text); len(r) > width {
+		text = string(r[:width-1]) + "…"
+	}
+
+	var details []string
+	if showOwner && ref.Project != nil {
+		details = append(details, ref.Project.Title)
+	}
+	if t.DueDate != nil && t.Status != domain.TaskStatusCompleted {
+		due := "due " + t.DueDate.Format("Jan 2")
+		if t.IsOverdue() {
+			due = lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Render(due)
+		}
+		details = append(details, due)
+	}
+	if len(details) > 0 {
+		text += "\n    " + dimStyle.Render(strings.Join(details, " · "))
+	}
+	return text
+}
+
+// run is the interactive board
+func (b *board) run(ctx context.Context) error {
+	state, err := term.MakeRaw(os.Stdin.Fd())
+	if err != nil {
+		return fmt.Errorf("failed to set up terminal: %w", err)
+	}
+	b.raw = state
+	defer b.exitRaw()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Redraw when other clients, or edits outside reorg, change tasks. The
+	// board still works without.
+	changes, err := client.Changes(ctx)
+	if err != nil {
+		changes = nil
+	}
+
+	keys := make(chan string)
+	keyErr := make(chan error, 1)
+	go func() {
+		buf := make([]byte, 3)
+		for {
+			n, err := os.Stdin.Read(buf)
+			if err != nil {
+				keyErr <- err
+				return
+			}
+			select {
+			case keys <- string(buf[:n]):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		b.render()
+
+		var key string
+		select {
+		case err := <-keyErr:
+			return err
+		case _, ok := <-changes:
+			if !ok {
+				changes = nil
+			} else {
+				b.refresh(ctx)
+			}
+			continue
+		case key = <-keys:
+		}
+
+		switch key {
+		case "q", "\x03", "\x1b":
+			b.exitRaw()
+			fmt.Println()
+			return nil
+		case "h", "\x1b[D":
+			if b.col > 0 {
+				b.col--
+				b.clampCursor()
+			}
+		case "l", "\x1b[C":
+			if b.col < len(b.columns)-1 {
+				b.col++
+				b.clampCursor()
+			}
+		case "j", "\x1b[B":
+			if b.row < len(b.columns[b.col].tasks)-1 {
+				b.row++
+			}
+		case "k", "\x1b[A":
+			if b.row > 0 {
+				b.row--
+			}
+		case "<", "H":
+			b.drag(ctx, -1)
+		case ">", "L":
+			b.drag(ctx, 1)
+		}
+	}
+}
+
+// refresh reloads the board after a change elsewhere, keeping the cursor
+// on the same task while it stays in the column
+func (b *board) refresh(ctx context.Context) {
+	var selected string
+	if tasks := b.columns[b.col].tasks; b.row < len(tasks) {
+		selected = tasks[b.row].Task.ID
+	}
+	if err := b.load(ctx); err != nil {
+		b.status = lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Render("Error: " + err.Error())
+		return
+	}
+	for i, r := range b.columns[b.col].tasks {
+		if r.Task.ID == selected {
+			b.row = i
+		}
+	}
+	b.clampCursor()
+}
+
+// drag moves the task under the cursor to the next column in direction,
+// changing its status, and keeps the cursor on it
+func (b *board) drag(ctx context.Context, direction int) {
+	from := b.columns[b.col]
+	to := b.col + direction
+	if to < 0 || to >= len(b.columns) || len(from.tasks) == 0 {
+		return
+	}
+	ref := from.tasks[b.row]
+	status := b.columns[to].status
+
+	if err := setTaskStatus(ctx, ref.Task, status); err != nil {
+		b.status = lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Render("Error: " + err.Error())
+		return
+	}
+	b.status = successStyle.Render(fmt.Sprintf("✓ %s → %s", ref.Task.Title, b.columns[to].title))
+
+	if err := b.load(ctx); err != nil {
+		b.status = lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Render("Error: " + err.Error())
+		return
+	}
+	b.col = to
+	b.row = 0
+	for i, r := range b.columns[to].tasks {
+		if r.Task.ID == ref.Task.ID {
+			b.row = i
+		}
+	}
+}
+
+// setTaskStatus changes a task's status, starting and completing it
+// through the client so the usual side effects happen
+func setTaskStatus(ctx context.Context, task *domain.Task, status domain.TaskStatus) error {
+	switch status {
+	case domain.TaskStatusInProgress:
+		return client.StartTask(ctx, task.ID)
+	case domain.TaskStatusCompleted:
+		return client.CompleteTask(ctx, task.ID)
+	}
+	task, err := client.GetTask(ctx, task.ID)
+	if err != nil {
+		return err
+	}
+	service.TaskUpdate{Status: &status}.Apply(task)
+	return client.UpdateTask(ctx, task)
+}
+
+func (b *board) render() {
+	var s strings.Builder
+	s.WriteString("\x1b[H\x1b[2J")
+	s.WriteString(b.view(terminalWidth(), true) + "\n\n")
+	s.WriteString(dimStyle.Render("←/→ columns · ↑/↓ tasks · </> drag · q quit") + "\n")
+	if b.status != "" {
+		s.WriteString(b.status + "\n")
+	}
+
+	// Raw mode needs explicit carriage returns
+	fmt.Print(strings.ReplaceAll(s.String(), "\n", "\r\n"))
+}
+
+func (b *board) exitRaw() {
+	if b.raw != nil {
+		_ = term.Restore(os.Stdin.Fd(), b.raw)
+		b.raw = nil
+	}
+}
@@ -0,0 +1,281 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+var (
+	calendarWeekFlag bool
+	calendarAreaFlag string
+)
+
+var calendarCmd = &cobra.Command{
+	Use:   "calendar [date]",
+	Short: "Show due tasks and project deadlines on a calendar",
+	Long: `Show a month grid with the open tasks and active projects due on each day,
+and the tasks scheduled to start on it, or with --week a detailed view of
+one week. The date picks the month or week to show (default today) and can
+be YYYY-MM, YYYY-MM-DD, a weekday or an offset such as +1m.
+
+Projects are marked ◆, tasks due •, tasks scheduled ○, and anything
+overdue is shown in red.
+
+Examples:
+  reorg calendar
+  reorg calendar 2025-03
+  reorg calendar --week +1w
+  reorg calendar --area work`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runCalendar,
+}
+
+func init() {
+	rootCmd.AddCommand(calendarCmd)
+
+	calendarCmd.Flags().BoolVar(&calendarWeekFlag, "week", false, "Show one week in detail")
+	calendarCmd.Flags().StringVarP(&calendarAreaFlag, "area", "a", "", "Only show this area")
+}
+
+// calendarEntry is a task or project due on a day, or a task scheduled on
+// it
+type calendarEntry struct {
+	title     string
+	detail    string
+	project   bool
+	scheduled bool
+	overdue   bool
+}
+
+func (e calendarEntry) String() string {
+	mark := "•"
+	switch {
+	case e.project:
+		mark = "◆"
+	case e.scheduled:
+		mark = "○"
+	}
+	s := mark + " " + e.title
+	if e.overdue {
+		s = lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Render(s)
+	}
+	return s
+}
+
+func runCalendar(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	now := time.Now().In(timezone)
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	if len(args) > 0 {
+		var err error
+		if day, err = parseCalendarDate(args[0]); err != nil {
+			return err
+		}
+	}
+
+	if calendarAreaFlag != "" {
+		if _, err := client.GetAreaBySlug(ctx, calendarAreaFlag); err != nil {
+			return domain.Errorf(domain.ErrNotFound, "area not found: %s", calendarAreaFlag)
+		}
+	}
+	entries, err := calendarEntries(ctx, calendarAreaFlag)
+	if err != nil {
+		return err
+	}
+
+	if calendarWeekFlag {
+		printCalendarWeek(day, entries, now)
+	} else {
+		printCalendarMonth(day, entries, now)
+	}
+	return nil
+}
+
+// parseCalendarDate parses a due date, or a month as YYYY-MM
+func parseCalendarDate(s string) (time.Time, error) {
+	if month, err := time.ParseInLocation("2006-01", s, timezone); err == nil {
+		return month, nil
+	}
+	return parseDueDate(s)
+}
+
+// calendarEntries collects the open tasks and active projects with due
+// dates, and the open tasks with scheduled dates, from the overview, keyed
+// by day
+func calendarEntries(ctx context.Context, areaSlug string) (map[string][]calendarEntry, error) {
+	overview, err := client.GetOverview(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tasks: %w", err)
+	}
+
+	now := time.Now().In(timezone)
+	entries := make(map[string][]calendarEntry)
+	for _, area := range overview.Areas {
+		if areaSlug != "" && area.Area.Slug() != areaSlug {
+			continue
+		}
+		for _, po := range area.Projects {
+			p := po.Project
+			if p.IsSomeday() {
+				continue
+			}
+			if p.IsActive() && p.DueDate != nil {
+				key := p.DueDate.Format(time.DateOnly)
+				entries[key] = append(entries[key], calendarEntry{
+					title:   p.Title,
+					detail:  area.Area.Title,
+					project: true,
+					overdue: p.IsOverdueAt(now),
+				})
+			}
+			for _, t := range po.Tasks {
+				if !openTask(t) {
+					continue
+				}
+				detail := p.Title
+				if t.Context != "" {
+					detail += " @" + t.Context
+				}
+				if t.DueDate != nil {
+					key := t.DueDate.Format(time.DateOnly)
+					entries[key] = append(entries[key], calendarEntry{
+						title:   t.Title,
+						detail:  detail,
+						overdue: t.IsOverdueAt(now),
+					})
+				}
+				if t.ScheduledDate != nil {
+					key := t.ScheduledDate.Format(time.DateOnly)
+					entries[key] = append(entries[key], calendarEntry{
+						title:     t.Title,
+						detail:    detail,
+						scheduled: true,
+					})
+				}
+			}
+		}
+	}
+
+	// Projects first, then by title
+	for _, day := range entries {
+		slices.SortFunc(day, func(a, b calendarEntry) int {
+			if a.project != b.project {
+				if a.project {
+					return -1
+				}
+				return 1
+			}
+			return strings.Compare(a.title, b.title)
+		})
+	}
+	return entries, nil
+}
+
+// startOfWeek returns the Monday on or before day
+func startOfWeek(day time.Time) time.Time {
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
+// printCalendarMonth prints a Monday-first grid of the month containing
+// day, listing as many entries as fit in each cell
+func printCalendarMonth(day time.Time, entries map[string][]calendarEntry, now time.Time) {
+	const cellLines = 4
+	first := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	cellWidth := max(terminalWidth()/7-2, 10)
+
+	cellStyle := lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("8")).
+		Width(cellWidth).
+		Height(cellLines)
+	todayStyle := cellStyle.BorderForeground(lipgloss.Color("12"))
+	dayStyle := lipgloss.NewStyle().Bold(true)
+	otherMonthStyle := dimStyle
+
+	fmt.Println(titleStyle.Render("  " + first.Format("January 2006")))
+	var header []string
+	for _, name := range []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"} {
+		header = append(header, lipgloss.NewStyle().Width(cellWidth+2).Align(lipgloss.Center).Render(name))
+	}
+	fmt.Println(lipgloss.JoinHorizontal(lipgloss.Top, header...))
+
+	var weeks []string
+	for week := startOfWeek(first); week.Month() == first.Month() || week.Before(first); week = week.AddDate(0, 0, 7) {
+		cells := make([]string, 7)
+		for i := range cells {
+			d := week.AddDate(0, 0, i)
+			dayEntries := entries[d.Format(time.DateOnly)]
+
+			lines := []string{dayStyle.Render(fmt.Sprint(d.Day()))}
+			if d.Month() != first.Month() {
+				lines[0] = otherMonthStyle.Render(fmt.Sprint(d.Day()))
+			}
+			for j, e := range dayEntries {
+				if j == cellLines-2 && len(dayEntries) > cellLines-1 {
+					lines = append(lines, dimStyle.Render(fmt.Sprintf("+%d more", len(dayEntries)-j)))
+					break
+				}
+				lines = append(lines, calendarEntry{
+					title:     truncate(e.title, cellWidth-2),
+					project:   e.project,
+					scheduled: e.scheduled,
+					overdue:   e.overdue,
+				}.String())
+			}
+
+			style := cellStyle
+			if d.Equal(today) {
+				style = todayStyle
+			}
+			cells[i] = style.Render(strings.Join(lines, "\n"))
+		}
+		weeks = append(weeks, lipgloss.JoinHorizontal(lipgloss.Top, cells...))
+	}
+	fmt.Println(lipgloss.JoinVertical(lipgloss.Left, weeks...))
+}
+
+// printCalendarWeek prints each day of the week containing day with
+// everything due or scheduled on it, and what it belongs to
+func printCalendarWeek(day time.Time, entries map[string][]calendarEntry, now time.Time) {
+	monday := startOfWeek(day)
+	sunday := monday.AddDate(0, 0, 6)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	fmt.Println(titleStyle.Render(fmt.Sprintf("  Week of %s – %s", monday.Format("Jan 2"), sunday.Format("Jan 2, 2006"))))
+	fmt.Println()
+	for i := range 7 {
+		d := monday.AddDate(0, 0, i)
+		heading := d.Format("Monday, Jan 2")
+		if d.Equal(today) {
+			heading += " (today)"
+		}
+		fmt.Println("  " + lipgloss.NewStyle().Bold(true).Render(heading))
+
+		dayEntries := entries[d.Format(time.DateOnly)]
+		if len(dayEntries) == 0 {
+			fmt.Println(dimStyle.Render("    nothing due or scheduled"))
+		}
+		for _, e := range dayEntries {
+			fmt.Printf("    %s  %s\n", e, dimStyle.Render(e.detail))
+		}
+		fmt.Println()
+	}
+}
+
+// truncate shortens s to width runes, ending with … when cut
+func truncate(s string, width int) string {
+	if r := []rune(s); len(r) > width {
+		return string(r[:max(width-1, 0)]) + "…"
+	}
+	return s
+}
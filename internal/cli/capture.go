@@ -0,0 +1,309 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/audit"
+	"github.com/ihavespoons/reorg/internal/capture"
+	"github.com/ihavespoons/reorg/internal/dateparse"
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/slug"
+	"github.com/ihavespoons/reorg/internal/storage/markdown"
+)
+
+var (
+	captureURLFlag     string
+	captureStdinFlag   bool
+	captureJSONFlag    bool
+	captureProjectFlag string
+	captureTagsFlag    []string
+
+	captureWatchClipboardFlag bool
+	captureYesFlag            bool
+)
+
+var captureCmd = &cobra.Command{
+	Use:   "capture [title]",
+	Short: "Capture links and notes into the inbox",
+	Long: `Capture a note into the inbox, or a task into a project, without any
+prompts, for Shortcuts, Alfred, Raycast and scripts.
+
+With --stdin the text is read from stdin: the first line is the title and
+the rest the body. With --json stdin holds an object instead,
+{"title", "body", "url", "tags", "project", "due"}, and the result is printed
+as JSON: {"ok": true, "type": "inbox" or "task", "title", "path" or "id"},
+or {"ok": false, "error"} on failure. --project (or "project") creates a
+task there instead of an inbox item.
+
+Exit codes: 0 on success, 2 when the input is invalid or the project
+doesn't exist, 1 for any other failure.
+
+Examples:
+  reorg capture "Call the bank"
+  pbpaste | reorg capture --stdin
+  echo '{"title": "Buy stamps", "project": "errands", "due": "fri"}' | reorg capture --json
+
+With capture.enabled and a capture.token, 'reorg serve' accepts POST
+requests on capture.path (default /capture) of the REST port, as JSON or a
+form with title, url, selection, body and tags fields. The token goes in
+an "Authorization: Bearer" header or a token field. Captured items are
+written to ~/.reorg/inbox/ for 'reorg inbox' or 'reorg import inbox', and
+with capture.categorize the AI suggests an area and tags first.
+
+--watch-clipboard keeps running and watches the clipboard for links and
+text starting with TODO: (or capture.clipboard.patterns, regular
+expressions). A desktop notification asks whether to file each one into
+the inbox; --yes files them without asking.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runCapture,
+}
+
+var captureBookmarkletCmd = &cobra.Command{
+	Use:   "bookmarklet",
+	Short: "Print a bookmarklet that captures the current page",
+	Long: `Print a bookmarklet that sends the current page, and any selected text,
+to the capture endpoint of 'reorg serve'. Add it as a bookmark's URL.
+
+Examples:
+  reorg capture bookmarklet
+  reorg capture bookmarklet --url http://laptop.local:8080`,
+	Args: cobra.NoArgs,
+	RunE: runCaptureBookmarklet,
+}
+
+func init() {
+	rootCmd.AddCommand(captureCmd)
+	captureCmd.AddCommand(captureBookmarkletCmd)
+
+	captureCmd.Flags().BoolVar(&captureStdinFlag, "stdin", false, "Read the title and body from stdin")
+	captureCmd.Flags().BoolVar(&captureJSONFlag, "json", false, "Read a JSON object from stdin and print the result as JSON")
+	captureCmd.Flags().StringVarP(&captureProjectFlag, "project", "p", "", "Create a task in this project instead of an inbox item")
+	captureCmd.Flags().StringSliceVarP(&captureTagsFlag, "tags", "t", nil, "Tags for the item")
+	captureCmd.Flags().BoolVar(&captureWatchClipboardFlag, "watch-clipboard", false, "Watch the clipboard and offer to capture links and TODOs")
+	captureCmd.Flags().BoolVarP(&captureYesFlag, "yes", "y", false, "With --watch-clipboard, capture without asking")
+
+	captureBookmarkletCmd.Flags().StringVar(&captureURLFlag, "url", "", "Address of the REST server (default http://localhost:8080)")
+}
+
+// captureInput is what capture reads with --json
+type captureInput struct {
+	capture.Item
+	Project string `json:"project,omitempty"`
+	Due     string `json:"due,omitempty"`
+}
+
+// captureResult is what capture prints with --json
+type captureResult struct {
+	OK    bool   `json:"ok"`
+	Type  string `json:"type,omitempty"`
+	Title string `json:"title,omitempty"`
+	Path  string `json:"path,omitempty"`
+	ID    string `json:"id,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+func runCapture(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	if captureWatchClipboardFlag {
+		return runCaptureClipboard(args)
+	}
+
+	result, err := captureFrom(ctx, args)
+	if captureJSONFlag {
+		// The JSON answer is the output, errors included
+		cmd.SilenceErrors = true
+		cmd.SilenceUsage = true
+		if err != nil {
+			result = captureResult{Error: err.Error()}
+		}
+		data, _ := json.Marshal(result)
+		fmt.Println(string(data))
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if result.Type == "task" {
+		fmt.Printf("%s Created task: %s\n", successStyle.Render("✓"), result.Title)
+		fmt.Printf("  ID: %s\n", dimStyle.Render(result.ID))
+	} else {
+		fmt.Printf("%s Captured to inbox: %s\n", successStyle.Render("✓"), result.Title)
+		fmt.Printf("  %s\n", dimStyle.Render(result.Path))
+	}
+	return nil
+}
+
+// captureFrom reads the item from args and stdin and saves it as an inbox
+// item or a task
+func captureFrom(ctx context.Context, args []string) (captureResult, error) {
+	var in captureInput
+	switch {
+	case captureJSONFlag:
+		if err := json.NewDecoder(os.Stdin).Decode(&in); err != nil {
+			return captureResult{}, exitCode(2, fmt.Errorf("invalid JSON input: %w", err))
+		}
+	case captureStdinFlag:
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return captureResult{}, fmt.Errorf("failed to read stdin: %w", err)
+		}
+		title, body, _ := strings.Cut(strings.TrimSpace(string(data)), "\n")
+		in.Title, in.Body = title, body
+	}
+	if len(args) == 1 {
+		in.Title = args[0]
+	}
+	if captureProjectFlag != "" {
+		in.Project = captureProjectFlag
+	}
+	in.AddTags(captureTagsFlag...)
+
+	if err := in.Normalize(); err != nil {
+		return captureResult{}, exitCode(2, err)
+	}
+
+	if in.Project == "" {
+		if in.Due != "" {
+			return captureResult{}, exitCode(2, fmt.Errorf("a due date needs a project"))
+		}
+		path, err := captureSaver(store)(ctx, in.Item)
+		if err != nil {
+			return captureResult{}, err
+		}
+		return captureResult{OK: true, Type: "inbox", Title: in.Title, Path: path}, nil
+	}
+
+	project, err := findProject(ctx, in.Project)
+	if err != nil {
+		return captureResult{}, exitCode(2, err)
+	}
+	task := domain.NewTask(in.Title, project.ID, project.AreaID)
+	for _, tag := range in.Tags {
+		task.AddTag(tag)
+	}
+	task.Content = strings.TrimSpace(strings.Join([]string{in.Selection, in.Body}, "\n\n"))
+	if in.URL != "" {
+		task.Attachments = append(task.Attachments, in.URL)
+	}
+	if in.Due != "" {
+		due, allDay, err := dateparse.ParseMoment(in.Due, time.Now().In(timezone))
+		if err != nil {
+			return captureResult{}, exitCode(2, err)
+		}
+		task.DueDate = &due
+		task.AllDay = allDay
+	}
+
+	created, err := client.CreateTask(ctx, task)
+	if err != nil {
+		return captureResult{}, fmt.Errorf("failed to create task: %w", err)
+	}
+	return captureResult{OK: true, Type: "task", Title: created.Title, ID: created.ID}, nil
+}
+
+func runCaptureBookmarklet(cmd *cobra.Command, args []string) error {
+	token := viper.GetString("capture.token")
+	if token == "" {
+		return fmt.Errorf("set capture.token in config.yaml first (e.g. the output of 'openssl rand -hex 16')")
+	}
+
+	server := captureURLFlag
+	if server == "" {
+		server = "http://localhost:8080"
+	}
+	endpoint := strings.TrimSuffix(server, "/") + capturePath()
+
+	fmt.Println(capture.Bookmarklet(endpoint, token))
+	fmt.Fprintln(os.Stderr, dimStyle.Render("Add this as the URL of a bookmark; 'reorg serve' needs capture.enabled."))
+	return nil
+}
+
+// capturePath is the path the capture endpoint is served on
+func capturePath() string {
+	if path := viper.GetString("capture.path"); path != "" {
+		return path
+	}
+	return "/capture"
+}
+
+// captureSaver returns a function that writes captured items to the inbox,
+// committed through s when it isn't nil. With capture.categorize the AI
+// suggests an area and tags first; if it can't, the item is saved as is.
+func captureSaver(s *markdown.Store) capture.SaveFunc {
+	return func(ctx context.Context, item capture.Item) (string, error) {
+		if viper.GetBool("capture.categorize") {
+			llmClient, err := getLLMClient()
+			if err == nil {
+				result, cerr := llmClient.Categorize(ctx, item.Text())
+				if err = cerr; err == nil {
+					item.Area = result.Area
+					item.AddTags(result.Tags...)
+				}
+			}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "capture: could not categorize %q: %v\n", item.Title, err)
+			}
+		}
+
+		now := time.Now().In(timezone)
+		content, err := item.Markdown(now)
+		if err != nil {
+			return "", err
+		}
+
+		dir := filepath.Join(dataDir, "inbox")
+		if path := filepath.Join(dir, item.FileName(now)); dryRunFile(audit.Create, path) {
+			return path, nil
+		}
+		var path string
+		write := func(context.Context) error {
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return err
+			}
+			// Don't overwrite an item captured with the same title in the
+			// same minute
+			name := strings.TrimSuffix(item.FileName(now), ".md")
+			taken := func(n string) bool {
+				_, err := os.Lstat(filepath.Join(dir, n+".md"))
+				return err == nil
+			}
+			for {
+				path = filepath.Join(dir, slug.Unique(name, taken)+".md")
+				f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+				if os.IsExist(err) {
+					// Captured by someone else since
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				_, err = f.WriteString(content)
+				if cerr := f.Close(); err == nil {
+					err = cerr
+				}
+				return err
+			}
+		}
+
+		if s != nil {
+			err = s.Batch(ctx, "capture "+item.Title, write)
+		} else {
+			err = write(ctx)
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to write inbox item: %w", err)
+		}
+		return path, nil
+	}
+}
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/capture"
+	"github.com/ihavespoons/reorg/internal/notify"
+)
+
+// clipboardInterval is how often the clipboard is checked
+const clipboardInterval = time.Second
+
+// runCaptureClipboard watches the clipboard until interrupted, offering to
+// capture what matches the clipboard patterns into the inbox
+func runCaptureClipboard(args []string) error {
+	if len(args) > 0 || captureStdinFlag || captureJSONFlag || captureProjectFlag != "" {
+		return fmt.Errorf("--watch-clipboard can't be combined with a title, --stdin, --json or --project")
+	}
+
+	patterns := viper.GetStringSlice("capture.clipboard.patterns")
+	if len(patterns) == 0 {
+		patterns = capture.DefaultClipboardPatterns
+	}
+	matcher, err := capture.NewClipboardMatcher(patterns)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// What is on the clipboard already isn't new
+	last, err := capture.ReadClipboard(ctx)
+	if err != nil {
+		return err
+	}
+	desktop := notify.NewDesktop()
+	save := captureSaver(store)
+
+	fmt.Println(dimStyle.Render("Watching the clipboard, press Ctrl+C to stop"))
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(clipboardInterval):
+		}
+
+		text, err := capture.ReadClipboard(ctx)
+		if err != nil || text == last {
+			continue
+		}
+		last = text
+
+		item, ok := matcher.Item(text)
+		if !ok {
+			continue
+		}
+		item.AddTags(captureTagsFlag...)
+		if err := item.Normalize(); err != nil {
+			continue
+		}
+
+		if !captureYesFlag {
+			ok, err := desktop.Ask(ctx, notify.Message{Title: "Capture to reorg inbox?", Body: item.Title}, "Capture")
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s\n", dimStyle.Render("Error: "+err.Error()))
+				continue
+			}
+			if !ok {
+				fmt.Printf("  %s %s\n", item.Title, dimStyle.Render("(ignored)"))
+				continue
+			}
+		}
+
+		path, err := save(ctx, item)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s\n", dimStyle.Render("Error: "+err.Error()))
+			continue
+		}
+		fmt.Printf("%s Captured to inbox: %s\n", successStyle.Render("✓"), item.Title)
+		fmt.Printf("  %s\n", dimStyle.Render(path))
+	}
+}
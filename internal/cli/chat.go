@@ -0,0 +1,311 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/llm"
+)
+
+var chatCmd = &cobra.Command{
+	Use:   "chat [message]",
+	Short: "Talk to an assistant that can read and change your tasks",
+	Long: `Start an interactive chat with the configured AI provider. The assistant
+can list and search your projects and tasks, create tasks and complete them
+while answering. Type exit or press Ctrl-D to leave.
+
+With a message, answer it and exit instead.
+
+Examples:
+  reorg chat
+  reorg chat "what's overdue in work?"
+  reorg chat "add 'renew passport' to life admin, due next friday"`,
+	RunE: runChat,
+}
+
+func init() {
+	rootCmd.AddCommand(chatCmd)
+}
+
+func runChat(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	llmClient, err := getLLMClient()
+	if err != nil {
+		return fmt.Errorf("failed to create LLM client: %w", err)
+	}
+
+	agent := llm.NewAgent(llmClient, chatTools())
+	agent.OnToolCall = func(call llm.ToolCall, result string, err error) {
+		line := fmt.Sprintf("  → %s %s", call.Name, string(call.Arguments))
+		if err != nil {
+			line += ": " + err.Error()
+		}
+		fmt.Println(dimStyle.Render(line))
+	}
+
+	if len(args) > 0 {
+		if _, err := agent.SendStream(ctx, strings.Join(args, " "), printChunk); err != nil {
+			return err
+		}
+		fmt.Println()
+		return nil
+	}
+
+	youStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12"))
+	fmt.Println(titleStyle.Render("\n  reorg chat\n"))
+	fmt.Println(dimStyle.Render("Ask about or change your tasks. Type exit to leave.") + "\n")
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for {
+		fmt.Print(youStyle.Render("you> "))
+		if !scanner.Scan() {
+			fmt.Println()
+			return scanner.Err()
+		}
+		message := strings.TrimSpace(scanner.Text())
+		switch message {
+		case "":
+			continue
+		case "exit", "quit":
+			return nil
+		}
+
+		if _, err := agent.SendStream(ctx, message, printChunk); err != nil {
+			fmt.Printf("  Error: %v\n\n", err)
+			continue
+		}
+		fmt.Print("\n\n")
+	}
+}
+
+// printChunk shows part of a streamed response as it arrives
+func printChunk(text string) {
+	fmt.Print(text)
+}
+
+// chatTools are the tools the chat assistant can call
+func chatTools() []llm.Tool {
+	return []llm.Tool{
+		{
+			Name:        "list_projects",
+			Description: "List projects, optionally filtered by a query expression.",
+			Arguments:   `{"query": "optional, e.g. area:work status:active"}`,
+			Run:         chatListProjects,
+		},
+		{
+			Name:        "list_tasks",
+			Description: "List tasks matching a query expression. Fields: status, priority, tag, project, area, due, overdue; bare words match the title.",
+			Arguments:   `{"query": "optional, e.g. status:pending,in_progress project:website due<=+1w"}`,
+			Run:         chatListTasks,
+		},
+		{
+			Name:        "search",
+			Description: "Find tasks and notes containing some text.",
+			Arguments:   `{"text": "text to look for"}`,
+			Run:         chatSearch,
+		},
+		{
+			Name:        "create_task",
+			Description: "Create a task in a project.",
+			Arguments:   `{"title": "task title", "project": "project slug", "priority": "optional: low, medium, high or urgent", "due": "optional, e.g. 2025-03-01, tomorrow, +1w, or with a time: fri 17:00", "tags": ["optional"]}`,
+			Run:         chatCreateTask,
+		},
+		{
+			Name:        "complete_task",
+			Description: "Mark a task as completed.",
+			Arguments:   `{"task": "task ID or slug"}`,
+			Run:         chatCompleteTask,
+		},
+	}
+}
+
+func chatListProjects(ctx context.Context, raw json.RawMessage) (string, error) {
+	var args struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return "", err
+	}
+
+	var projects []*domain.Project
+	var err error
+	if args.Query != "" {
+		projects, err = client.QueryProjects(ctx, args.Query)
+	} else {
+		projects, err = client.ListAllProjects(ctx)
+	}
+	if err != nil {
+		return "", err
+	}
+	if len(projects) == 0 {
+		return "no projects", nil
+	}
+
+	var b strings.Builder
+	for _, p := range projects {
+		areaTitle := ""
+		if a, err := client.GetArea(ctx, p.AreaID); err == nil {
+			areaTitle = a.Title
+		}
+		fmt.Fprintf(&b, "%s | %s | area: %s | %s\n", p.Slug(), p.Title, areaTitle, p.Status)
+	}
+	return b.String(), nil
+}
+
+func chatListTasks(ctx context.Context, raw json.RawMessage) (string, error) {
+	var args struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return "", err
+	}
+
+	var tasks []*domain.Task
+	var err error
+	if args.Query != "" {
+		tasks, err = client.QueryTasks(ctx, args.Query)
+	} else {
+		tasks, err = client.ListAllTasks(ctx)
+	}
+	if err != nil {
+		return "", err
+	}
+	return describeChatTasks(ctx, tasks), nil
+}
+
+func chatSearch(ctx context.Context, raw json.RawMessage) (string, error) {
+	var args struct {
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return "", err
+	}
+	if args.Text == "" {
+		return "", fmt.Errorf("text is required")
+	}
+
+	all, err := client.ListAllTasks(ctx)
+	if err != nil {
+		return "", err
+	}
+	text := strings.ToLower(args.Text)
+	var tasks []*domain.Task
+	for _, t := range all {
+		if strings.Contains(strings.ToLower(t.Title+" "+t.Content), text) {
+			tasks = append(tasks, t)
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("Tasks:\n" + describeChatTasks(ctx, tasks))
+	notes, err := client.SearchNotes(ctx, args.Text)
+	if err != nil {
+		return "", err
+	}
+	b.WriteString("\nNotes:\n")
+	if len(notes) == 0 {
+		b.WriteString("no notes\n")
+	}
+	for _, n := range notes {
+		fmt.Fprintf(&b, "%s (on %s): %s\n", n.ID, n.ParentID, n.Content)
+	}
+	return b.String(), nil
+}
+
+func chatCreateTask(ctx context.Context, raw json.RawMessage) (string, error) {
+	var args struct {
+		Title    string   `json:"title"`
+		Project  string   `json:"project"`
+		Priority string   `json:"priority"`
+		Due      string   `json:"due"`
+		Tags     []string `json:"tags"`
+	}
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return "", err
+	}
+	if args.Title == "" || args.Project == "" {
+		return "", fmt.Errorf("title and project are required")
+	}
+
+	project, err := findProject(ctx, args.Project)
+	if err != nil {
+		return "", err
+	}
+
+	task := domain.NewTask(args.Title, project.ID, project.AreaID)
+	if args.Priority != "" {
+		if task.Priority, err = parsePriority(args.Priority); err != nil {
+			return "", err
+		}
+	}
+	if args.Due != "" {
+		due, allDay, err := parseDueMoment(args.Due)
+		if err != nil {
+			return "", err
+		}
+		task.DueDate = &due
+		task.AllDay = allDay
+	}
+	for _, tag := range args.Tags {
+		task.AddTag(tag)
+	}
+
+	created, err := client.CreateTask(ctx, task)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("created %s (%s) in %s", created.Title, created.Slug(), project.Title), nil
+}
+
+func chatCompleteTask(ctx context.Context, raw json.RawMessage) (string, error) {
+	var args struct {
+		Task string `json:"task"`
+	}
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return "", err
+	}
+
+	task, err := findTask(ctx, args.Task)
+	if err != nil {
+		return "", err
+	}
+	if err := client.CompleteTask(ctx, task.ID); err != nil {
+		return "", err
+	}
+	return "completed " + task.Title, nil
+}
+
+// describeChatTasks lists tasks one per line for the model
+func describeChatTasks(ctx context.Context, tasks []*domain.Task) string {
+	if len(tasks) == 0 {
+		return "no tasks\n"
+	}
+
+	projects := make(map[string]string)
+	var b strings.Builder
+	for _, t := range tasks {
+		title, ok := projects[t.ProjectID]
+		if !ok {
+			if p, err := client.GetProject(ctx, t.ProjectID); err == nil {
+				title = p.Title
+			}
+			projects[t.ProjectID] = title
+		}
+		due := "-"
+		if t.DueDate != nil {
+			due = t.DueDate.Format("2006-01-02")
+		}
+		fmt.Fprintf(&b, "%s | %s | project: %s | %s | priority: %s | due: %s\n",
+			t.Slug(), t.Title, title, t.Status, t.Priority, due)
+	}
+	return b.String()
+}
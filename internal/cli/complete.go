@@ -0,0 +1,255 @@
+package cli
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/service"
+)
+
+// completionTimeout bounds the lookups behind shell completion, so a slow
+// or unreachable server doesn't hang the shell
+const completionTimeout = 2 * time.Second
+
+func init() {
+	for _, cmd := range []*cobra.Command{
+		taskShowCmd, taskMoveCmd, taskUpdateCmd, taskAttachCmd, taskDeleteCmd,
+	} {
+		cmd.ValidArgsFunction = completeFirstArg(completeTasks(nil))
+	}
+	for _, cmd := range []*cobra.Command{
+		taskCompleteCmd, taskStartCmd, taskTimerStartCmd, taskTimerStopCmd, taskDueCmd, taskSnoozeCmd,
+	} {
+		cmd.ValidArgsFunction = completeFirstArg(completeTasks(openTask))
+	}
+	for _, cmd := range []*cobra.Command{
+		projectShowCmd, projectCompleteCmd, projectDeferCmd, projectReviveCmd, projectMoveCmd, projectNotifyCmd, projectUpdateCmd, projectDeleteCmd,
+	} {
+		cmd.ValidArgsFunction = completeFirstArg(completeProjects)
+	}
+	for _, cmd := range []*cobra.Command{
+		areaUpdateCmd, areaReviewCmd, areaShowCmd, areaDeleteCmd,
+	} {
+		cmd.ValidArgsFunction = completeFirstArg(completeAreas)
+	}
+	for _, cmd := range []*cobra.Command{
+		goalShowCmd, goalUpdateCmd, goalAchieveCmd, goalDeleteCmd,
+	} {
+		cmd.ValidArgsFunction = completeFirstArg(completeGoals)
+	}
+	for _, cmd := range []*cobra.Command{noteAddCmd, noteListCmd} {
+		cmd.ValidArgsFunction = completeFirstArg(completeAll(completeTasks(nil), completeProjects))
+	}
+	workspaceUseCmd.ValidArgsFunction = completeFirstArg(completeWorkspaces)
+}
+
+// registerFlagCompletions completes --project, --area, --goal and --workspace on
+// every command that has them. It runs once all flags are defined.
+func registerFlagCompletions(cmd *cobra.Command) {
+	if cmd == rootCmd {
+		_ = cmd.RegisterFlagCompletionFunc("workspace", completeWorkspaces)
+	}
+	if cmd.LocalNonPersistentFlags().Lookup("project") != nil {
+		_ = cmd.RegisterFlagCompletionFunc("project", completeProjects)
+	}
+	if cmd.LocalNonPersistentFlags().Lookup("area") != nil {
+		_ = cmd.RegisterFlagCompletionFunc("area", completeAreas)
+	}
+	if cmd.LocalNonPersistentFlags().Lookup("goal") != nil {
+		_ = cmd.RegisterFlagCompletionFunc("goal", completeGoals)
+	}
+	if cmd.LocalNonPersistentFlags().Lookup("context") != nil {
+		_ = cmd.RegisterFlagCompletionFunc("context", completeContexts)
+	}
+	if cmd.LocalNonPersistentFlags().Lookup("effort") != nil {
+		_ = cmd.RegisterFlagCompletionFunc("effort", cobra.FixedCompletions(
+			[]cobra.Completion{"small", "medium", "large"}, cobra.ShellCompDirectiveNoFileComp))
+	}
+	for _, child := range cmd.Commands() {
+		registerFlagCompletions(child)
+	}
+}
+
+// completionClient returns the client for completion lookups. Completion
+// runs through cobra's hidden __complete command, which skips the usual
+// setup and only parses the flags of the command line being completed
+// after the config is read, so the config is read again here to honour
+// --workspace, --data-dir, --mode and --server.
+func completionClient(cmd *cobra.Command) (service.ReorgClient, error) {
+	if client != nil {
+		return client, nil
+	}
+	flags := cmd.Flags()
+	if !flags.Changed("data-dir") {
+		dataDir = ""
+	}
+	if !flags.Changed("mode") {
+		mode = ""
+	}
+	if !flags.Changed("server") {
+		serverAddress = ""
+	}
+	initConfig()
+	if err := initClient(); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// completeFirstArg completes the first argument with fn, and leaves the
+// rest to the shell's default completion
+func completeFirstArg(fn cobra.CompletionFunc) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveDefault
+		}
+		return fn(cmd, args, toComplete)
+	}
+}
+
+// completeAll offers the completions of each fn
+func completeAll(fns ...cobra.CompletionFunc) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		var completions []cobra.Completion
+		for _, fn := range fns {
+			c, _ := fn(cmd, args, toComplete)
+			completions = append(completions, c...)
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// openTask reports whether a task can still be started or completed
+func openTask(t *domain.Task) bool {
+	return t.Status != domain.TaskStatusCompleted && t.Status != domain.TaskStatusCancelled
+}
+
+// completeTasks offers the slugs of the tasks matching keep, or their IDs
+// where more than one task has the same slug, described by their titles
+func completeTasks(keep func(*domain.Task) bool) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		c, err := completionClient(cmd)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+		defer cancel()
+		tasks, err := c.ListAllTasks(ctx)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		slugs := make(map[string]int, len(tasks))
+		for _, t := range tasks {
+			slugs[t.Slug()]++
+		}
+		var completions []cobra.Completion
+		for _, t := range tasks {
+			if keep != nil && !keep(t) {
+				continue
+			}
+			name := t.Slug()
+			if slugs[name] > 1 {
+				name = t.ID
+			}
+			completions = append(completions, cobra.CompletionWithDesc(name, t.Title))
+		}
+		slices.Sort(completions)
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeProjects offers project slugs, described by their titles
+func completeProjects(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	c, err := completionClient(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+	projects, err := c.ListAllProjects(ctx)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var completions []cobra.Completion
+	for _, p := range projects {
+		completions = append(completions, cobra.CompletionWithDesc(p.Slug(), p.Title))
+	}
+	slices.Sort(completions)
+	return slices.Compact(completions), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeAreas offers area slugs, described by their titles
+func completeAreas(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	c, err := completionClient(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+	areas, err := c.ListAreas(ctx)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var completions []cobra.Completion
+	for _, a := range areas {
+		completions = append(completions, cobra.CompletionWithDesc(a.Slug(), a.Title))
+	}
+	slices.Sort(completions)
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeGoals offers goal slugs, described by their titles
+func completeGoals(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	c, err := completionClient(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+	goals, err := c.ListGoals(ctx)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var completions []cobra.Completion
+	for _, g := range goals {
+		completions = append(completions, cobra.CompletionWithDesc(g.Slug(), g.Title))
+	}
+	slices.Sort(completions)
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeContexts offers the contexts already used by tasks
+func completeContexts(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	c, err := completionClient(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+	tasks, err := c.ListAllTasks(ctx)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var completions []cobra.Completion
+	for _, t := range tasks {
+		if t.Context != "" && !slices.Contains(completions, t.Context) {
+			completions = append(completions, t.Context)
+		}
+	}
+	slices.Sort(completions)
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeWorkspaces offers the configured workspace names
+func completeWorkspaces(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return workspaceNames(), cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,196 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/digest"
+	"github.com/ihavespoons/reorg/internal/notify"
+	"github.com/ihavespoons/reorg/internal/service"
+)
+
+var (
+	digestWeeklyFlag bool
+	digestAIFlag     bool
+	digestSendFlag   bool
+)
+
+var digestCmd = &cobra.Command{
+	Use:   "digest",
+	Short: "Summarize what's due, overdue, completed and stalled",
+	Long: `Print a digest of open tasks due today and overdue, tasks completed
+yesterday and stalled projects. --weekly covers the coming and past week
+instead, and --ai adds a short summary written by the AI.
+
+With --send the digest is delivered instead of printed: to digest.channel
+(any notification channel, e.g. a Slack webhook) and, when digest.email.to
+is set, by email. 'reorg serve' sends it on a schedule when digest.enabled
+is set.
+
+Examples:
+  reorg digest
+  reorg digest --weekly --ai
+  reorg digest --send`,
+	RunE: runDigest,
+}
+
+func init() {
+	rootCmd.AddCommand(digestCmd)
+	digestCmd.Flags().BoolVar(&digestWeeklyFlag, "weekly", false, "Cover the week instead of the day")
+	digestCmd.Flags().BoolVar(&digestAIFlag, "ai", false, "Add a summary written by the AI")
+	digestCmd.Flags().BoolVar(&digestSendFlag, "send", false, "Deliver the digest instead of printing it")
+}
+
+func runDigest(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	period := digest.PeriodDaily
+	if digestWeeklyFlag {
+		period = digest.PeriodWeekly
+	}
+	d, err := buildDigest(ctx, client, period, digestAIFlag || viper.GetBool("digest.ai"))
+	if err != nil {
+		return err
+	}
+
+	if digestSendFlag {
+		if err := sendDigest(ctx, d); err != nil {
+			return err
+		}
+		fmt.Printf("%s Sent the %s digest\n", successStyle.Render("✓"), d.Period)
+		return nil
+	}
+
+	fmt.Println()
+	fmt.Println(titleStyle.Render(d.Title()))
+	fmt.Println()
+	fmt.Println(d.Text())
+	return nil
+}
+
+// buildDigest assembles the digest for now, with an AI summary if ai is
+// set. A failed summary leaves the digest without one.
+func buildDigest(ctx context.Context, c service.ReorgClient, period digest.Period, ai bool) (*digest.Digest, error) {
+	tasks, err := c.ListAllTasks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	projects, err := c.ListAllProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	d := digest.Build(tasks, projects, period, time.Now().In(timezone))
+	if ai {
+		llmClient, err := getLLMClient()
+		if err == nil {
+			err = d.Summarize(ctx, llmClient)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "digest: could not summarize with AI: %v\n", err)
+		}
+	}
+	return d, nil
+}
+
+// sendDigest delivers the digest to digest.channel, or the default
+// notification channel, and by email when digest.email.to is set
+func sendDigest(ctx context.Context, d *digest.Digest) error {
+	msg := d.Message()
+	channel := viper.GetString("digest.channel")
+	notifies := channel != "" || viper.GetString("notifications.default") != ""
+	to := viper.GetStringSlice("digest.email.to")
+	if !notifies && len(to) == 0 {
+		return fmt.Errorf("no digest channel configured (set digest.channel, notifications.default or digest.email.to)")
+	}
+
+	if notifies {
+		if err := newNotifier().Send(ctx, channel, msg); err != nil {
+			return fmt.Errorf("failed to send digest: %w", err)
+		}
+	}
+
+	if len(to) > 0 {
+		email, err := notify.NewEmail(notify.EmailConfig{
+			Host:     viper.GetString("digest.email.smtp_host"),
+			Port:     viper.GetInt("digest.email.smtp_port"),
+			Username: viper.GetString("digest.email.username"),
+			Password: viper.GetString("digest.email.password"),
+			From:     viper.GetString("digest.email.from"),
+			To:       to,
+		})
+		if err != nil {
+			return err
+		}
+		if err := email.Notify(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// digestSchedule reads when serve sends digests: the period, the HH:MM
+// time, and for weekly digests the weekday
+func digestSchedule() (digest.Period, string, time.Weekday, error) {
+	period, err := digest.ParsePeriod(viper.GetString("digest.schedule"))
+	if err != nil {
+		return "", "", 0, err
+	}
+	at := viper.GetString("digest.time")
+	if at == "" {
+		at = "08:00"
+	}
+	if _, err := nextDailyRun(time.Now().In(timezone), at); err != nil {
+		return "", "", 0, fmt.Errorf("invalid digest.time %q (use HH:MM)", at)
+	}
+
+	day := time.Monday
+	if name := viper.GetString("digest.day"); name != "" {
+		found := false
+		for d := time.Sunday; d <= time.Saturday; d++ {
+			if strings.EqualFold(name, d.String()) || strings.EqualFold(name, d.String()[:3]) {
+				day, found = d, true
+				break
+			}
+		}
+		if !found {
+			return "", "", 0, fmt.Errorf("invalid digest.day %q (use a weekday, e.g. monday)", name)
+		}
+	}
+	return period, at, day, nil
+}
+
+// runDigestSchedule sends a digest every day, or every week on day, at the
+// HH:MM time at until ctx is cancelled
+func runDigestSchedule(ctx context.Context, c service.ReorgClient, period digest.Period, at string, day time.Weekday) {
+	for {
+		next, err := nextDailyRun(time.Now().In(timezone), at)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "digest: %v\n", err)
+			return
+		}
+		for period == digest.PeriodWeekly && next.Weekday() != day {
+			next = next.AddDate(0, 0, 1)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Until(next)):
+		}
+
+		d, err := buildDigest(ctx, c, period, viper.GetBool("digest.ai"))
+		if err == nil {
+			err = sendDigest(ctx, d)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "digest: %v\n", err)
+		}
+	}
+}
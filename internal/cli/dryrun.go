@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/ihavespoons/reorg/internal/audit"
+	"github.com/ihavespoons/reorg/internal/service"
+)
+
+// dryRunChanges counts the changes a --dry-run command asked for
+var dryRunChanges int
+
+// startDryRun swaps the client for one that prints the changes commands
+// make instead of making them. Commands use the client rather than the
+// store from then on, as in remote mode.
+func startDryRun() {
+	client = service.NewDryRunClient(client, printDryRunChange)
+	store = nil
+}
+
+// dryRunFile reports whether this is a dry run, in which case a command
+// that writes a file itself, rather than through the client, skips it and
+// the change is printed instead. An update of a file that doesn't exist
+// yet is shown as writing it.
+func dryRunFile(action audit.Action, path string) bool {
+	if !dryRun {
+		return false
+	}
+	dryRunChanges++
+	if _, err := os.Stat(path); action == audit.Update && os.IsNotExist(err) {
+		action = audit.Create
+	}
+	switch action {
+	case audit.Create:
+		fmt.Println(diffAddStyle.Render("+ write ") + path)
+	case audit.Delete:
+		fmt.Println(diffRemoveStyle.Render("- delete ") + path)
+	default:
+		fmt.Println("~ replace " + path)
+	}
+	return true
+}
+
+// finishDryRun says that nothing was changed
+func finishDryRun() {
+	if dryRunChanges == 0 {
+		fmt.Println(dimStyle.Render("Dry run: nothing would change"))
+		return
+	}
+	fmt.Println(dimStyle.Render(fmt.Sprintf("Dry run: %d change(s) not made", dryRunChanges)))
+}
+
+// printDryRunChange prints a change like a diff: + for items created, -
+// for items deleted, and ~ with the old and new value of each field for
+// updates
+func printDryRunChange(c service.DryRunChange) {
+	dryRunChanges++
+
+	label := c.Kind
+	if c.Title != "" {
+		label += fmt.Sprintf(" %q", c.Title)
+	}
+	label += " " + dimStyle.Render("["+c.ID+"]")
+
+	switch c.Action {
+	case audit.Create:
+		fmt.Println(diffAddStyle.Render("+ create ") + label)
+	case audit.Delete:
+		fmt.Println(diffRemoveStyle.Render("- delete ") + label)
+	default:
+		if len(c.Changes) == 0 {
+			fmt.Println("~ update " + label + dimStyle.Render(" (no changes)"))
+			return
+		}
+		fmt.Println("~ update " + label)
+		names := make([]string, 0, len(c.Changes))
+		for name := range c.Changes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			change := c.Changes[name]
+			if change.Old != nil {
+				fmt.Println(diffRemoveStyle.Render(fmt.Sprintf("    - %s: %s", name, auditValue(change.Old))))
+			}
+			if change.New != nil {
+				fmt.Println(diffAddStyle.Render(fmt.Sprintf("    + %s: %s", name, auditValue(change.New))))
+			}
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package cli
+
+import (
+	"context"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/approval"
+	"github.com/ihavespoons/reorg/internal/backup"
+	"github.com/ihavespoons/reorg/internal/storage/markdown"
+)
+
+// dryRunHome sets up a home directory with an initialized data directory
+// in data/ and the state directory in state/, and returns it
+func dryRunHome(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_STATE_HOME", filepath.Join(home, "state"))
+	t.Setenv("REORG_WORKSPACE", "")
+	if err := markdown.NewStore(filepath.Join(home, "data")).Initialize(); err != nil {
+		t.Fatal(err)
+	}
+	return home
+}
+
+// runDryRun runs reorg with --dry-run and args against the data directory
+// in home
+func runDryRun(t *testing.T, home string, args ...string) {
+	t.Helper()
+	viper.Reset()
+	resetFlags(rootCmd)
+	cfgFile, dataDir, mode, workspace = "", "", "", ""
+	store, client = nil, nil
+	t.Cleanup(func() { dryRun = false })
+
+	rootCmd.SetArgs(append([]string{"--dry-run", "--data-dir", filepath.Join(home, "data")}, args...))
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("reorg %v: %v", args, err)
+	}
+}
+
+// resetFlags puts the flags of cmd and its subcommands back to their
+// defaults, as they are for each run of the binary
+func resetFlags(cmd *cobra.Command) {
+	reset := func(f *pflag.Flag) {
+		if s, ok := f.Value.(pflag.SliceValue); ok {
+			_ = s.Replace(nil)
+		} else {
+			_ = f.Value.Set(f.DefValue)
+		}
+		f.Changed = false
+	}
+	cmd.Flags().VisitAll(reset)
+	cmd.PersistentFlags().VisitAll(reset)
+	for _, c := range cmd.Commands() {
+		resetFlags(c)
+	}
+}
+
+// snapshot returns the contents of every file and directory under dir
+func snapshot(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	files := make(map[string]string)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			files[path] = "dir"
+			return err
+		}
+		data, err := os.ReadFile(path)
+		files[path] = string(data)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+// assertUnchanged fails the test if anything under dir differs from before
+func assertUnchanged(t *testing.T, dir string, before map[string]string) {
+	t.Helper()
+	after := snapshot(t, dir)
+	if maps.Equal(before, after) {
+		return
+	}
+	for path, content := range after {
+		if old, ok := before[path]; !ok {
+			t.Errorf("%s was created", path)
+		} else if old != content {
+			t.Errorf("%s was changed", path)
+		}
+	}
+	for path := range before {
+		if _, ok := after[path]; !ok {
+			t.Errorf("%s was removed", path)
+		}
+	}
+}
+
+func TestDryRunBackupCreate(t *testing.T) {
+	home := dryRunHome(t)
+	before := snapshot(t, home)
+	runDryRun(t, home, "backup", "create")
+	assertUnchanged(t, home, before)
+}
+
+func TestDryRunBackupRestore(t *testing.T) {
+	home := dryRunHome(t)
+	archive := filepath.Join(home, "old.tar.gz")
+	if err := backup.WriteArchive(filepath.Join(home, "data"), archive, false); err != nil {
+		t.Fatal(err)
+	}
+	before := snapshot(t, home)
+	runDryRun(t, home, "backup", "restore", archive, "--yes")
+	assertUnchanged(t, home, before)
+}
+
+func TestDryRunCapture(t *testing.T) {
+	home := dryRunHome(t)
+	before := snapshot(t, home)
+	runDryRun(t, home, "capture", "Call the bank")
+	assertUnchanged(t, home, before)
+}
+
+func TestDryRunInbox(t *testing.T) {
+	home := dryRunHome(t)
+	dir := filepath.Join(home, "data", "inbox")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"one.md", "two.md"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("# "+name+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	before := snapshot(t, home)
+
+	// Triage needs a terminal, so the actions are run directly
+	dryRun = true
+	t.Cleanup(func() { dryRun = false })
+	triage := &inboxTriage{dir: dir}
+	if err := triage.load(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(triage.items) != 2 {
+		t.Fatalf("loaded %d inbox item(s), want 2", len(triage.items))
+	}
+	triage.snooze()
+	if err := triage.delete(context.Background(), triage.targets(), "y"); err != nil {
+		t.Fatal(err)
+	}
+	if len(triage.items) != 0 {
+		t.Errorf("%d inbox item(s) left, want both handled", len(triage.items))
+	}
+	assertUnchanged(t, home, before)
+}
+
+func TestDryRunPlan(t *testing.T) {
+	home := dryRunHome(t)
+	before := snapshot(t, home)
+	runDryRun(t, home, "plan", "tomorrow")
+	assertUnchanged(t, home, before)
+}
+
+func TestDryRunApprovals(t *testing.T) {
+	for _, action := range []string{"accept", "reject"} {
+		t.Run(action, func(t *testing.T) {
+			home := dryRunHome(t)
+			item := approval.NewItem("test", "note.md", "Renew passport", "Renew the passport")
+			item.Categorization.Area = "Personal"
+			if err := approval.NewQueue(filepath.Join(home, "state", "reorg", "approvals.json")).Add(item); err != nil {
+				t.Fatal(err)
+			}
+			before := snapshot(t, home)
+			runDryRun(t, home, "approvals", action, item.ID)
+			assertUnchanged(t, home, before)
+		})
+	}
+}
+
+func TestDryRunWorkspaceUse(t *testing.T) {
+	home := dryRunHome(t)
+	config := filepath.Join(home, ".reorg", "config.yaml")
+	if err := os.MkdirAll(filepath.Dir(config), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(config, []byte("workspaces:\n  work:\n    data_dir: "+filepath.Join(home, "data")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	before := snapshot(t, home)
+	runDryRun(t, home, "workspace", "use", "work")
+	assertUnchanged(t, home, before)
+
+	saved := filepath.Join(home, "state", "reorg", "workspace")
+	if err := os.MkdirAll(filepath.Dir(saved), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(saved, []byte("work\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	before = snapshot(t, home)
+	runDryRun(t, home, "workspace", "use", "--unset")
+	assertUnchanged(t, home, before)
+}
+
+func TestDryRunInit(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_STATE_HOME", filepath.Join(home, "state"))
+	before := snapshot(t, home)
+	runDryRun(t, home, "init", "--skip-wizard")
+	assertUnchanged(t, home, before)
+}
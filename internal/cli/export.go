@@ -0,0 +1,218 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/backup"
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/ical"
+	"github.com/ihavespoons/reorg/internal/integrations/taskwarrior"
+	"github.com/ihavespoons/reorg/internal/service"
+)
+
+var (
+	exportFormatFlag    string
+	exportAreaFlag      string
+	exportFileFlag      string
+	exportOutputFlag    string
+	exportCompletedFlag bool
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export data to other formats",
+	Long: `Export areas, projects and tasks for backup, migration or analysis.
+
+JSON is a complete dump that 'reorg import file' restores with the original
+IDs. CSV holds the same data as one table with a row per area, project and
+task, for spreadsheets. The taskwarrior format holds tasks only and can be
+loaded with 'task import'.
+
+Examples:
+  reorg export --format json > backup.json
+  reorg export --format csv --area work -o work.csv
+  reorg export --format taskwarrior | task import
+  reorg export ical`,
+	Args: cobra.NoArgs,
+	RunE: runExport,
+}
+
+var exportICalCmd = &cobra.Command{
+	Use:   "ical",
+	Short: "Export due dates as an iCalendar file",
+	Long: `Write tasks and projects with due dates to an .ics file that can be
+imported into Calendar, Google Calendar or any other calendar app.
+
+'reorg serve' can also host the calendar for subscriptions (see ical in
+config.yaml).
+
+Examples:
+  reorg export ical
+  reorg export ical -o ~/Desktop/reorg.ics
+  reorg export ical -o - --completed`,
+	RunE: runExportICal,
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.AddCommand(exportICalCmd)
+
+	exportCmd.Flags().StringVarP(&exportFormatFlag, "format", "f", "json", "Output format (json, csv, taskwarrior)")
+	exportCmd.Flags().StringVarP(&exportAreaFlag, "area", "a", "", "Only export this area")
+	exportCmd.Flags().StringVarP(&exportFileFlag, "output", "o", "-", "Output file (- for stdout)")
+
+	exportICalCmd.Flags().StringVarP(&exportOutputFlag, "output", "o", "reorg.ics", "Output file (- for stdout)")
+	exportICalCmd.Flags().BoolVar(&exportCompletedFlag, "completed", false, "Include completed tasks and projects")
+}
+
+func runExport(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	switch exportFormatFlag {
+	case "json", "csv", "taskwarrior":
+	default:
+		return fmt.Errorf("unknown format %q (use json, csv or taskwarrior)", exportFormatFlag)
+	}
+
+	var areaID string
+	if exportAreaFlag != "" {
+		area, err := findAreaByIDOrSlug(ctx, exportAreaFlag)
+		if err != nil {
+			return domain.Errorf(domain.ErrNotFound, "area not found: %s", exportAreaFlag)
+		}
+		areaID = area.ID
+	}
+
+	data, err := backup.Export(ctx, client, areaID)
+	if err != nil {
+		return err
+	}
+
+	out := os.Stdout
+	if exportFileFlag != "-" {
+		f, err := os.Create(exportFileFlag)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", exportFileFlag, err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	switch exportFormatFlag {
+	case "csv":
+		err = data.WriteCSV(out)
+	case "taskwarrior":
+		err = taskwarrior.Write(out, taskwarriorTasks(data))
+	default:
+		err = data.WriteJSON(out)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write export: %w", err)
+	}
+
+	if exportFileFlag != "-" {
+		fmt.Printf("%s Exported %d area(s), %d project(s) and %d task(s) to %s\n", successStyle.Render("✓"),
+			len(data.Areas), len(data.Projects), len(data.Tasks), exportFileFlag)
+	}
+	return nil
+}
+
+// taskwarriorTasks converts exported tasks, naming projects "Area.Project"
+// so they import back into the same area
+func taskwarriorTasks(data *backup.Data) []taskwarrior.Task {
+	areaTitles := make(map[string]string, len(data.Areas))
+	for _, a := range data.Areas {
+		areaTitles[a.ID] = a.Title
+	}
+	projectNames := make(map[string]string, len(data.Projects))
+	for _, p := range data.Projects {
+		projectNames[p.ID] = areaTitles[p.AreaID] + "." + p.Title
+	}
+	tasksByID := make(map[string]*domain.Task, len(data.Tasks))
+	for _, t := range data.Tasks {
+		tasksByID[t.ID] = t
+	}
+
+	uuidOf := func(id string) string {
+		if t, ok := tasksByID[id]; ok {
+			return taskwarrior.UUID(t)
+		}
+		return taskwarrior.UUID(&domain.Task{ID: id})
+	}
+
+	tasks := make([]taskwarrior.Task, len(data.Tasks))
+	for i, t := range data.Tasks {
+		tasks[i] = taskwarrior.FromDomain(t, projectNames[t.ProjectID], uuidOf)
+	}
+	return tasks
+}
+
+func runExportICal(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	data, err := buildCalendar(ctx, client, exportCompletedFlag)
+	if err != nil {
+		return err
+	}
+
+	if exportOutputFlag == "-" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+
+	if err := os.WriteFile(exportOutputFlag, data, 0644); err != nil {
+		return fmt.Errorf("failed to write calendar: %w", err)
+	}
+	fmt.Printf("%s Exported calendar to %s\n", successStyle.Render("✓"), exportOutputFlag)
+	return nil
+}
+
+// buildCalendar renders the due dates of all tasks and projects
+func buildCalendar(ctx context.Context, c service.ReorgClient, completed bool) ([]byte, error) {
+	tasks, err := c.ListAllTasks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	projects, err := c.ListAllProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	return ical.Calendar(tasks, projects, ical.Options{
+		Name:      viper.GetString("ical.name"),
+		Completed: completed,
+	}), nil
+}
+
+// calendarHandler serves the iCalendar feed, rebuilt on every request so
+// subscribers always see current due dates. The feed is that of the client
+// the request's token gets from clientFor, sent as a bearer token or, as
+// calendar apps can't send headers, a token query parameter.
+func calendarHandler(clientFor func(token string) (service.ReorgClient, bool)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			token = r.URL.Query().Get("token")
+		}
+		c, ok := clientFor(token)
+		if !ok {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "a valid token is required", http.StatusUnauthorized)
+			return
+		}
+		data, err := buildCalendar(r.Context(), c, viper.GetBool("ical.completed"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		_, _ = w.Write(data)
+	})
+}
@@ -0,0 +1,215 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/term"
+)
+
+// finderHeight is how many matches the finder shows at once
+const finderHeight = 10
+
+// errSelectionCancelled is returned when a selection prompt is left
+// without choosing anything
+var errSelectionCancelled = fmt.Errorf("selection cancelled")
+
+// selectItem asks the user to pick one of labels and returns its index.
+// On a terminal it's a fuzzy finder that filters as you type; otherwise
+// the labels are numbered and a number is read from stdin.
+func selectItem(prompt string, labels []string) (int, error) {
+	if len(labels) == 0 {
+		return 0, fmt.Errorf("nothing to select")
+	}
+	if !term.IsTerminal(os.Stdin.Fd()) || !term.IsTerminal(os.Stdout.Fd()) {
+		return selectNumbered(prompt, labels)
+	}
+	f := &finder{prompt: prompt, labels: labels}
+	return f.run()
+}
+
+// selectNumbered is the plain prompt for when stdin isn't a terminal
+func selectNumbered(prompt string, labels []string) (int, error) {
+	fmt.Println(prompt)
+	for i, label := range labels {
+		fmt.Printf("  %d. %s\n", i+1, label)
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Print("Enter number: ")
+	input, _ := reader.ReadString('\n')
+
+	num, err := strconv.Atoi(strings.TrimSpace(input))
+	if err != nil || num < 1 || num > len(labels) {
+		return 0, fmt.Errorf("invalid selection")
+	}
+	return num - 1, nil
+}
+
+// finder is an inline fuzzy finder over a list of labels
+type finder struct {
+	prompt  string
+	labels  []string
+	query   []rune
+	matches []int
+	cursor  int
+	drawn   int
+	raw     *term.State
+}
+
+func (f *finder) run() (int, error) {
+	state, err := term.MakeRaw(os.Stdin.Fd())
+	if err != nil {
+		return 0, fmt.Errorf("failed to set up terminal: %w", err)
+	}
+	f.raw = state
+	defer f.exitRaw()
+
+	f.filter()
+	buf := make([]byte, 64)
+	for {
+		f.render()
+
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			return 0, err
+		}
+		switch key := string(buf[:n]); key {
+		case "\x03", "\x1b":
+			f.clear()
+			return 0, errSelectionCancelled
+		case "\r", "\n":
+			if len(f.matches) == 0 {
+				continue
+			}
+			f.clear()
+			choice := f.matches[f.cursor]
+			fmt.Printf("%s %s\r\n", f.prompt, f.labels[choice])
+			return choice, nil
+		case "\x1b[A", "\x10":
+			if f.cursor > 0 {
+				f.cursor--
+			}
+		case "\x1b[B", "\x0e":
+			if f.cursor < len(f.matches)-1 {
+				f.cursor++
+			}
+		case "\x7f", "\x08":
+			if len(f.query) > 0 {
+				f.query = f.query[:len(f.query)-1]
+				f.filter()
+			}
+		case "\x15":
+			f.query = nil
+			f.filter()
+		default:
+			if strings.HasPrefix(key, "\x1b") {
+				continue
+			}
+			for _, r := range key {
+				if unicode.IsPrint(r) {
+					f.query = append(f.query, r)
+				}
+			}
+			f.filter()
+		}
+	}
+}
+
+// filter recomputes the matches for the query, best first
+func (f *finder) filter() {
+	type scored struct{ index, score int }
+	var found []scored
+	for i, label := range f.labels {
+		if score, ok := fuzzyScore(string(f.query), label); ok {
+			found = append(found, scored{i, score})
+		}
+	}
+	slices.SortStableFunc(found, func(a, b scored) int { return b.score - a.score })
+
+	f.matches = f.matches[:0]
+	for _, s := range found {
+		f.matches = append(f.matches, s.index)
+	}
+	f.cursor = 0
+}
+
+// render redraws the finder below the cursor, leaving the cursor after
+// the query
+func (f *finder) render() {
+	f.clear()
+
+	input := fmt.Sprintf("%s %s", promptStyle.Render(f.prompt), string(f.query))
+	lines := []string{input}
+
+	start := max(0, f.cursor-finderHeight+1)
+	end := min(len(f.matches), start+finderHeight)
+	for i := start; i < end; i++ {
+		label := f.labels[f.matches[i]]
+		if i == f.cursor {
+			lines = append(lines, lipgloss.NewStyle().Bold(true).Render("> "+label))
+		} else {
+			lines = append(lines, "  "+label)
+		}
+	}
+	lines = append(lines, dimStyle.Render(fmt.Sprintf("  %d/%d · ↑/↓ move · enter select · esc cancel", len(f.matches), len(f.labels))))
+
+	fmt.Print(strings.Join(lines, "\r\n"))
+	f.drawn = len(lines)
+	fmt.Printf("\x1b[%dA\r", f.drawn-1)
+	if width := lipgloss.Width(input); width > 0 {
+		fmt.Printf("\x1b[%dC", width)
+	}
+}
+
+// clear erases what the last render drew
+func (f *finder) clear() {
+	if f.drawn > 0 {
+		fmt.Print("\r\x1b[J")
+		f.drawn = 0
+	}
+}
+
+func (f *finder) exitRaw() {
+	if f.raw != nil {
+		_ = term.Restore(os.Stdin.Fd(), f.raw)
+		f.raw = nil
+	}
+}
+
+// fuzzyScore reports whether the runes of query appear in order in label,
+// ignoring case, and how well they match. Consecutive runes and runes at
+// the start of words score higher, gaps lower.
+func fuzzyScore(query, label string) (int, bool) {
+	if query == "" {
+		return 0, true
+	}
+	q := []rune(strings.ToLower(query))
+	score, qi, last := 0, 0, -1
+	prev := ' '
+	for i, r := range strings.ToLower(label) {
+		if qi < len(q) && r == q[qi] {
+			score++
+			if last >= 0 && last+utf8.RuneLen(prev) == i {
+				score += 4
+			}
+			if !unicode.IsLetter(prev) && !unicode.IsDigit(prev) {
+				score += 3
+			}
+			if last >= 0 {
+				score -= min(i-last, 10) / 3
+			}
+			last = i
+			qi++
+		}
+		prev = r
+	}
+	return score, qi == len(q)
+}
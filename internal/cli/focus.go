@@ -0,0 +1,212 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/x/term"
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/notify"
+)
+
+var focusDurationFlag time.Duration
+
+var focusCmd = &cobra.Command{
+	Use:   "focus [task-id]",
+	Short: "Work on a task for a timed focus session",
+	Long: `Start a task and its timer, and count down a focus session of 25 minutes,
+or of --duration. When the session ends the timer is stopped, so the
+session is added to the task's time spent, and a notification is sent
+through the project's channel. Stopping early with q or Ctrl+C records the
+time worked so far without a notification.
+
+Press i during the session to mark an interruption. A session that was
+interrupted is noted in the task's comment thread with how many times.
+
+Examples:
+  reorg focus fix-header
+  reorg focus fix-header --duration 50m`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFocus,
+}
+
+func init() {
+	rootCmd.AddCommand(focusCmd)
+	focusCmd.Flags().DurationVarP(&focusDurationFlag, "duration", "d", 25*time.Minute, "Length of the session")
+}
+
+func runFocus(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	if focusDurationFlag <= 0 {
+		return fmt.Errorf("--duration must be positive")
+	}
+	task, err := findTask(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	if task.IsComplete() || task.Status == domain.TaskStatusCancelled {
+		return fmt.Errorf("task is %s: %s", task.Status, task.Title)
+	}
+	if task.ActiveSession() != nil {
+		return fmt.Errorf("timer already running for task: %s (stop it with 'reorg task timer stop')", task.Title)
+	}
+
+	if task.Status != domain.TaskStatusInProgress {
+		if err := client.StartTask(ctx, task.ID); err != nil {
+			return fmt.Errorf("failed to start task: %w", err)
+		}
+	}
+	if err := client.StartTaskTimer(ctx, task.ID); err != nil {
+		return fmt.Errorf("failed to start timer: %w", err)
+	}
+	fmt.Printf("%s Focusing on %s for %s\n", successStyle.Render("▶"), task.Title, domain.FormatTimeSpec(focusDurationFlag))
+
+	session := &focusSession{length: focusDurationFlag, start: time.Now()}
+	finished, runErr := session.run(ctx)
+
+	// Whatever ended the session, the time worked is kept
+	elapsed, err := client.StopTaskTimer(ctx, task.ID)
+	if err != nil {
+		return fmt.Errorf("failed to stop timer: %w", err)
+	}
+	if runErr != nil {
+		return runErr
+	}
+	if task, err = client.GetTask(ctx, task.ID); err != nil {
+		return err
+	}
+
+	ended := "Stopped"
+	if finished {
+		ended = "Finished"
+	}
+	fmt.Printf("%s %s focus session: %s %s\n", successStyle.Render("■"), ended, task.Title,
+		dimStyle.Render(fmt.Sprintf("(%s, %s total)", domain.FormatTimeSpec(elapsed), task.TimeSpent)))
+	if task.IsOverEstimate(time.Now()) {
+		printOverEstimate(task)
+	}
+
+	if session.interruptions > 0 {
+		text := fmt.Sprintf("Focus session of %s, interrupted %d time(s)", domain.FormatTimeSpec(elapsed), session.interruptions)
+		if _, err := client.AddTaskComment(ctx, task.ID, "cli", text); err != nil {
+			return fmt.Errorf("failed to note interruptions: %w", err)
+		}
+		fmt.Println(dimStyle.Render(fmt.Sprintf("  Interrupted %d time(s), noted on the task", session.interruptions)))
+	}
+
+	if finished {
+		var channel, projectTitle string
+		if project, err := client.GetProject(ctx, task.ProjectID); err == nil {
+			channel, projectTitle = project.Notify, project.Title
+		}
+		msg := notify.Message{
+			Title: "Focus session done: " + task.Title,
+			Body:  fmt.Sprintf("%s focused, %s in total", domain.FormatTimeSpec(elapsed), task.TimeSpent),
+		}
+		if projectTitle != "" {
+			msg.Body += " · Project: " + projectTitle
+		}
+		if err := newNotifier().Send(ctx, channel, msg); err != nil {
+			return fmt.Errorf("failed to send notification: %w", err)
+		}
+	}
+	return nil
+}
+
+// focusSession counts down a focus session
+type focusSession struct {
+	length        time.Duration
+	start         time.Time
+	interruptions int
+}
+
+// run waits for the session to end, showing its progress and reading keys
+// when stdin is a terminal. It returns true if the session ran its full
+// length, false if it was stopped early.
+func (s *focusSession) run(ctx context.Context) (bool, error) {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	var keys <-chan string
+	if term.IsTerminal(os.Stdin.Fd()) {
+		state, err := term.MakeRaw(os.Stdin.Fd())
+		if err != nil {
+			return false, fmt.Errorf("failed to set up terminal: %w", err)
+		}
+		defer func() { _ = term.Restore(os.Stdin.Fd(), state) }()
+		defer fmt.Print("\r\n")
+		keys = readFocusKeys(ctx)
+	}
+
+	end := time.NewTimer(s.length)
+	defer end.Stop()
+	tick := time.NewTicker(time.Second)
+	defer tick.Stop()
+
+	for {
+		if keys != nil {
+			s.render(time.Now())
+		}
+		select {
+		case <-ctx.Done():
+			return false, nil
+		case <-end.C:
+			if keys != nil {
+				s.render(s.start.Add(s.length))
+			}
+			return true, nil
+		case <-tick.C:
+		case key := <-keys:
+			switch key {
+			case "i", "I":
+				s.interruptions++
+			case "q", "\x03", "\x1b":
+				return false, nil
+			}
+		}
+	}
+}
+
+// readFocusKeys sends the keys typed until ctx is done
+func readFocusKeys(ctx context.Context) <-chan string {
+	keys := make(chan string)
+	go func() {
+		buf := make([]byte, 3)
+		for {
+			n, err := os.Stdin.Read(buf)
+			if err != nil {
+				return
+			}
+			select {
+			case keys <- string(buf[:n]):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return keys
+}
+
+// render redraws the progress line of the session at now
+func (s *focusSession) render(now time.Time) {
+	const width = 30
+	elapsed := min(now.Sub(s.start), s.length)
+	filled := int(width * elapsed / s.length)
+	left := (s.length - elapsed).Round(time.Second)
+
+	line := fmt.Sprintf("  %s%s %02d:%02d left",
+		successStyle.Render(strings.Repeat("█", filled)), dimStyle.Render(strings.Repeat("░", width-filled)),
+		int(left.Minutes()), int(left.Seconds())%60)
+	if s.interruptions > 0 {
+		line += fmt.Sprintf(" · %d interrupted", s.interruptions)
+	}
+	line += dimStyle.Render("   i interruption · q stop")
+	fmt.Print("\r" + line + "\x1b[K")
+}
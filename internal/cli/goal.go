@@ -0,0 +1,420 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/cli/render"
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+var (
+	goalQuarterFlag    string
+	goalDueFlag        string
+	goalTagsFlag       []string
+	goalMetaFlag       []string
+	goalTitleFlag      string
+	goalStatusFlag     string
+	goalAddTagsFlag    []string
+	goalRemoveTagsFlag []string
+	goalAllFlag        bool
+	goalListRender     render.Options
+)
+
+var goalCmd = &cobra.Command{
+	Use:     "goal",
+	Aliases: []string{"goals"},
+	Short:   "Manage goals",
+	Long: `Goals are objectives, usually for a quarter, that projects work towards.
+A project links to a goal with 'reorg project update <project> --goal <goal>',
+and a goal's progress is rolled up from its linked projects.`,
+}
+
+var goalListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List goals",
+	Long: `List active goals with their progress. Use --all to include achieved and
+dropped goals.`,
+	Args: cobra.NoArgs,
+	RunE: runGoalList,
+}
+
+var goalCreateCmd = &cobra.Command{
+	Use:   "create [title]",
+	Short: "Create a new goal",
+	Long: `Create a new goal. The quarter defaults to the current one.
+
+Examples:
+  reorg goal create "Launch the new website"
+  reorg goal create "Run a half marathon" --quarter 2026-Q2 --tags health`,
+	Args: cobra.ExactArgs(1),
+	RunE: runGoalCreate,
+}
+
+var goalShowCmd = &cobra.Command{
+	Use:   "show [goal]",
+	Short: "Show a goal and its projects",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGoalShow,
+}
+
+var goalUpdateCmd = &cobra.Command{
+	Use:   "update [goal]",
+	Short: "Change a goal's title, status, quarter, due date, tags or metadata",
+	Long: `Change a goal's title, status, quarter, due date, tags or metadata. Use
+--due none to clear the due date.
+
+Examples:
+  reorg goal update launch-the-new-website --quarter 2026-Q4
+  reorg goal update run-a-half-marathon --status dropped`,
+	Args: cobra.ExactArgs(1),
+	RunE: runGoalUpdate,
+}
+
+var goalAchieveCmd = &cobra.Command{
+	Use:   "achieve [goal]",
+	Short: "Mark a goal as achieved",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGoalAchieve,
+}
+
+var goalDeleteCmd = &cobra.Command{
+	Use:   "delete [goal]",
+	Short: "Delete a goal and unlink its projects",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGoalDelete,
+}
+
+func init() {
+	rootCmd.AddCommand(goalCmd)
+	goalCmd.AddCommand(goalListCmd)
+	goalCmd.AddCommand(goalCreateCmd)
+	goalCmd.AddCommand(goalShowCmd)
+	goalCmd.AddCommand(goalUpdateCmd)
+	goalCmd.AddCommand(goalAchieveCmd)
+	goalCmd.AddCommand(goalDeleteCmd)
+
+	// List flags
+	goalListCmd.Flags().BoolVar(&goalAllFlag, "all", false, "Include achieved and dropped goals")
+	goalListRender.AddFlags(goalListCmd, []string{"due", "updated", "created"}, []string{"status"})
+
+	// Show flags
+	goalShowCmd.Flags().BoolVar(&showRawFlag, "raw", false, "Print the description as written, without markdown rendering")
+
+	// Create flags
+	goalCreateCmd.Flags().StringVar(&goalQuarterFlag, "quarter", "", "Quarter (e.g. 2026-Q1, defaults to the current one)")
+	goalCreateCmd.Flags().StringVarP(&goalDueFlag, "due", "d", "", "Due date (e.g. 2026-03-31)")
+	goalCreateCmd.Flags().StringSliceVarP(&goalTagsFlag, "tags", "t", nil, "Tags for the goal")
+	goalCreateCmd.Flags().StringArrayVar(&goalMetaFlag, "meta", nil, metaFlagUsage)
+
+	// Update flags
+	goalUpdateCmd.Flags().StringVar(&goalTitleFlag, "title", "", "New title")
+	goalUpdateCmd.Flags().StringVarP(&goalStatusFlag, "status", "s", "", "New status (active, achieved, dropped)")
+	goalUpdateCmd.Flags().StringVar(&goalQuarterFlag, "quarter", "", "New quarter (e.g. 2026-Q1)")
+	goalUpdateCmd.Flags().StringVarP(&goalDueFlag, "due", "d", "", "New due date, or none to clear it")
+	goalUpdateCmd.Flags().StringSliceVar(&goalAddTagsFlag, "add-tag", nil, "Tags to add")
+	goalUpdateCmd.Flags().StringSliceVar(&goalRemoveTagsFlag, "remove-tag", nil, "Tags to remove")
+	goalUpdateCmd.Flags().StringArrayVar(&goalMetaFlag, "meta", nil, metaFlagUsage)
+	_ = goalUpdateCmd.RegisterFlagCompletionFunc("status", cobra.FixedCompletions(
+		[]cobra.Completion{"active", "achieved", "dropped"}, cobra.ShellCompDirectiveNoFileComp))
+}
+
+func runGoalList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	if err := goalListRender.Validate(); err != nil {
+		return err
+	}
+
+	goals, err := client.ListGoals(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list goals: %w", err)
+	}
+	if !goalAllFlag {
+		var active []*domain.Goal
+		for _, g := range goals {
+			if g.IsActive() {
+				active = append(active, g)
+			}
+		}
+		goals = active
+	}
+
+	if len(goals) == 0 {
+		fmt.Println("No goals found. Create one with 'reorg goal create <title>'")
+		return nil
+	}
+
+	groups := render.Apply(goals, goalListRender, func(g *domain.Goal) render.Fields {
+		return render.Fields{Due: g.DueDate, Created: g.Created, Updated: g.Updated, Status: string(g.Status)}
+	})
+
+	header := []string{"GOAL", "QUARTER", "STATUS", "PROJECTS", "TASKS", "DONE"}
+	return render.Table(os.Stdout, groups, header, func(g *domain.Goal) []string {
+		quarter := g.Quarter
+		if quarter == "" {
+			quarter = "-"
+		}
+		p := goalProgress(g)
+		return []string{
+			g.Title,
+			quarter,
+			string(g.Status),
+			fmt.Sprintf("%d/%d", p.CompletedProjects, p.Projects),
+			fmt.Sprintf("%d/%d", p.CompletedTasks, p.TotalTasks),
+			fmt.Sprintf("%d%%", p.PercentComplete),
+		}
+	})
+}
+
+func runGoalCreate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	goal := domain.NewGoal(args[0])
+
+	goal.Quarter = domain.QuarterOf(time.Now().In(timezone))
+	if goalQuarterFlag != "" {
+		quarter, err := domain.ParseQuarter(goalQuarterFlag)
+		if err != nil {
+			return err
+		}
+		goal.Quarter = quarter
+	}
+
+	if goalDueFlag != "" {
+		due, err := parseDueDate(goalDueFlag)
+		if err != nil {
+			return err
+		}
+		goal.DueDate = &due
+	}
+
+	meta, err := domain.ParseMetadata(goalMetaFlag)
+	if err != nil {
+		return err
+	}
+	domain.SetMetadata(&goal.Metadata, meta)
+
+	for _, tag := range goalTagsFlag {
+		goal.AddTag(tag)
+	}
+
+	if _, err := client.CreateGoal(ctx, goal); err != nil {
+		return fmt.Errorf("failed to create goal: %w", err)
+	}
+
+	fmt.Printf("%s Created goal: %s %s\n", successStyle.Render("✓"), goal.Title, dimStyle.Render("("+goal.Slug()+")"))
+	return nil
+}
+
+func runGoalShow(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	goal, err := findGoal(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	projects, err := goalProjects(ctx, goal)
+	if err != nil {
+		return err
+	}
+	progress := goalProgress(goal)
+
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12"))
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+
+	fmt.Println()
+	fmt.Println(headerStyle.Render(goal.Title))
+	fmt.Println()
+
+	fmt.Printf("%s %s\n", labelStyle.Render("ID:"), goal.ID)
+	fmt.Printf("%s %s\n", labelStyle.Render("Status:"), goal.Status)
+	if goal.Quarter != "" {
+		fmt.Printf("%s %s\n", labelStyle.Render("Quarter:"), goal.Quarter)
+	}
+	if goal.DueDate != nil {
+		fmt.Printf("%s %s\n", labelStyle.Render("Due:"), goal.DueDate.Format("2006-01-02"))
+	}
+	if len(goal.Tags) > 0 {
+		fmt.Printf("%s %s\n", labelStyle.Render("Tags:"), strings.Join(goal.Tags, ", "))
+	}
+	fmt.Printf("%s %s\n", labelStyle.Render("Created:"), goal.Created.Format("2006-01-02 15:04"))
+	fmt.Printf("%s %s\n", labelStyle.Render("Updated:"), goal.Updated.Format("2006-01-02 15:04"))
+	printMetadata(goal.Metadata, labelStyle)
+	fmt.Println()
+
+	fmt.Printf("%s %s %d%%\n", labelStyle.Render("Progress:"), progressBar(progress.PercentComplete, 20), progress.PercentComplete)
+	fmt.Printf("%s %d/%d completed\n", labelStyle.Render("Projects:"), progress.CompletedProjects, progress.Projects)
+	fmt.Printf("%s %d/%d completed\n", labelStyle.Render("Tasks:"), progress.CompletedTasks, progress.TotalTasks)
+	fmt.Println()
+
+	printDescription(goal.Content, labelStyle)
+
+	if len(projects) > 0 {
+		fmt.Println(labelStyle.Render("Projects:"))
+		for _, p := range projects {
+			h := projectHealth(ctx, p)
+			fmt.Printf("  %s %s %s\n", projectStatusIcon(p), p.Title,
+				dimStyle.Render(fmt.Sprintf("(%d/%d, %s)", h.CompletedTasks, h.TotalTasks, h.Status)))
+		}
+		fmt.Println()
+	} else {
+		fmt.Println(dimStyle.Render("No linked projects. Link one with 'reorg project update <project> --goal " + goal.Slug() + "'"))
+		fmt.Println()
+	}
+
+	return nil
+}
+
+func runGoalUpdate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	goal, err := findGoal(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	if goalTitleFlag == "" && goalStatusFlag == "" && goalQuarterFlag == "" && goalDueFlag == "" &&
+		len(goalAddTagsFlag) == 0 && len(goalRemoveTagsFlag) == 0 && len(goalMetaFlag) == 0 {
+		return fmt.Errorf("nothing to change: use --title, --status, --quarter, --due, --add-tag, --remove-tag or --meta")
+	}
+
+	meta, err := domain.ParseMetadata(goalMetaFlag)
+	if err != nil {
+		return err
+	}
+	domain.SetMetadata(&goal.Metadata, meta)
+
+	if goalTitleFlag != "" {
+		goal.Title = goalTitleFlag
+	}
+	if goalStatusFlag != "" {
+		if goal.Status, err = domain.ParseGoalStatus(goalStatusFlag); err != nil {
+			return err
+		}
+	}
+	if goalQuarterFlag != "" {
+		if goal.Quarter, err = domain.ParseQuarter(goalQuarterFlag); err != nil {
+			return err
+		}
+	}
+	switch strings.ToLower(goalDueFlag) {
+	case "":
+	case "none":
+		goal.DueDate = nil
+	default:
+		due, err := parseDueDate(goalDueFlag)
+		if err != nil {
+			return err
+		}
+		goal.DueDate = &due
+	}
+	for _, tag := range goalAddTagsFlag {
+		goal.AddTag(tag)
+	}
+	for _, tag := range goalRemoveTagsFlag {
+		goal.RemoveTag(tag)
+	}
+
+	if err := client.UpdateGoal(ctx, goal); err != nil {
+		return fmt.Errorf("failed to update goal: %w", err)
+	}
+
+	fmt.Printf("%s Updated goal: %s\n", successStyle.Render("✓"), goal.Title)
+	return nil
+}
+
+func runGoalAchieve(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	goal, err := findGoal(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	goal.Achieve()
+	if err := client.UpdateGoal(ctx, goal); err != nil {
+		return fmt.Errorf("failed to update goal: %w", err)
+	}
+
+	fmt.Printf("%s Achieved goal: %s\n", successStyle.Render("✓"), goal.Title)
+	return nil
+}
+
+func runGoalDelete(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	goal, err := findGoal(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteGoal(ctx, goal.ID); err != nil {
+		return fmt.Errorf("failed to delete goal: %w", err)
+	}
+
+	fmt.Printf("%s Deleted goal: %s\n", successStyle.Render("✓"), goal.Title)
+	return nil
+}
+
+// findGoal looks up a goal by slug or ID
+func findGoal(ctx context.Context, identifier string) (*domain.Goal, error) {
+	if goal, err := client.GetGoalBySlug(ctx, identifier); err == nil {
+		return goal, nil
+	}
+	if goal, err := client.GetGoal(ctx, identifier); err == nil {
+		return goal, nil
+	}
+	return nil, domain.Errorf(domain.ErrNotFound, "goal not found: %s", identifier)
+}
+
+// goalProjects returns the projects linked to a goal
+func goalProjects(ctx context.Context, goal *domain.Goal) ([]*domain.Project, error) {
+	projects, err := client.ListAllProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	var linked []*domain.Project
+	for _, p := range projects {
+		if p.GoalID == goal.ID {
+			linked = append(linked, p)
+		}
+	}
+	return linked, nil
+}
+
+// goalProgress returns the progress the service computed for a goal, or
+// none if it wasn't filled in
+func goalProgress(g *domain.Goal) *domain.GoalProgress {
+	if g.Progress == nil {
+		return &domain.GoalProgress{}
+	}
+	return g.Progress
+}
+
+// progressBar renders percent as a bar width characters wide
+func progressBar(percent, width int) string {
+	filled := min(max(percent, 0), 100) * width / 100
+	return successStyle.Render(strings.Repeat("█", filled)) + dimStyle.Render(strings.Repeat("░", width-filled))
+}
+
+// projectStatusIcon returns the icon used for a project's status in lists
+func projectStatusIcon(p *domain.Project) string {
+	switch p.Status {
+	case domain.ProjectStatusCompleted:
+		return successStyle.Render("✓")
+	case domain.ProjectStatusOnHold:
+		return dimStyle.Render("⏸")
+	case domain.ProjectStatusArchived:
+		return dimStyle.Render("▫")
+	case domain.ProjectStatusSomeday:
+		return dimStyle.Render("…")
+	}
+	return "○"
+}
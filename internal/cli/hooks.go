@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/hooks"
+)
+
+// startedAt is when this run of reorg began; an import's tasks and projects
+// are the ones imported since
+var startedAt = time.Now().Truncate(time.Second)
+
+// newHooks creates a runner for the shell hooks in the config, or nil if
+// there are none
+func newHooks() *hooks.Runner {
+	commands := make(map[hooks.Event]string)
+	for _, event := range hooks.Events {
+		if command := viper.GetString("hooks." + string(event)); command != "" {
+			commands[event] = expandHome(command)
+		}
+	}
+	if len(commands) == 0 {
+		return nil
+	}
+	return &hooks.Runner{Commands: commands, Timeout: viper.GetDuration("hooks.timeout")}
+}
+
+// runImportFinishHook runs the on_import_finish hook with the tasks and
+// projects the import created
+func runImportFinishHook(cmd *cobra.Command, args []string) error {
+	runner := newHooks()
+	if !runner.Has(hooks.ImportFinish) || importDryRunFlag || client == nil {
+		return nil
+	}
+	ctx := cmd.Context()
+
+	result := hooks.ImportResult{
+		Command:  cmd.CommandPath(),
+		Started:  startedAt,
+		Finished: time.Now(),
+		Tasks:    []*domain.Task{},
+		Projects: []*domain.Project{},
+	}
+	if tasks, err := client.ListAllTasks(ctx); err == nil {
+		var imported []*domain.Task
+		for _, task := range tasks {
+			if importedSince(task.Metadata, startedAt) {
+				imported = append(imported, task)
+			}
+		}
+		if imported != nil {
+			result.Tasks = imported
+		}
+	}
+	if projects, err := client.ListAllProjects(ctx); err == nil {
+		var imported []*domain.Project
+		for _, project := range projects {
+			if importedSince(project.Metadata, startedAt) {
+				imported = append(imported, project)
+			}
+		}
+		if imported != nil {
+			result.Projects = imported
+		}
+	}
+
+	runner.Run(ctx, hooks.ImportFinish, result)
+	return nil
+}
+
+// importedSince returns true if an entity's metadata records an import at
+// or after t
+func importedSince(meta map[string]string, t time.Time) bool {
+	at := domain.ProvenanceFromMetadata(meta).ImportedAt
+	return at != nil && !at.Before(t)
+}
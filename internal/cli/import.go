@@ -1,11 +1,12 @@
 package cli
 
 import (
-	"bufio"
 	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,11 +15,15 @@ import (
 	"github.com/spf13/viper"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
 
+	"github.com/ihavespoons/reorg/internal/approval"
 	"github.com/ihavespoons/reorg/internal/domain"
 	"github.com/ihavespoons/reorg/internal/integrations/apple_notes"
 	"github.com/ihavespoons/reorg/internal/integrations/obsidian"
 	"github.com/ihavespoons/reorg/internal/llm"
+	"github.com/ihavespoons/reorg/internal/pipeline"
+	"github.com/ihavespoons/reorg/internal/slug"
 )
 
 var (
@@ -27,12 +32,16 @@ var (
 	importDryRunFlag   bool
 	importAutoFlag     bool
 	importVaultFlag    string
+	importWorkersFlag  int
+	importAllFlag      bool
 )
 
 var importCmd = &cobra.Command{
 	Use:   "import",
 	Short: "Import notes from external sources",
 	Long:  `Import notes from Apple Notes, Obsidian vaults, or markdown folders.`,
+
+	PersistentPostRunE: runImportFinishHook,
 }
 
 var importNotesCmd = &cobra.Command{
@@ -41,10 +50,14 @@ var importNotesCmd = &cobra.Command{
 	Long: `Import notes from Apple Notes and categorize them using AI.
 
 The import process:
-1. Reads notes from Apple Notes via AppleScript
+1. Reads notes from Apple Notes via JavaScript for Automation
 2. Uses AI to categorize each note (work/personal/life-admin)
 3. Extracts actionable tasks from notes
-4. Creates projects and tasks in reorg`,
+4. Creates projects and tasks in reorg
+
+Each run picks up the notes created or changed since the last one; the
+first run, and runs with --since, look back that far instead. Notes
+imported before are only read again when they change, or with --all.`,
 	RunE: runImportNotes,
 }
 
@@ -85,6 +98,7 @@ func init() {
 	importNotesCmd.Flags().StringVar(&importFolderFlag, "folder", "", "Only import from this folder")
 	importNotesCmd.Flags().BoolVar(&importDryRunFlag, "dry-run", false, "Show what would be imported without making changes")
 	importNotesCmd.Flags().BoolVar(&importAutoFlag, "auto", false, "Automatically accept AI categorizations")
+	importNotesCmd.Flags().BoolVar(&importAllFlag, "all", false, "Read notes imported before again")
 
 	// Obsidian flags
 	importObsidianCmd.Flags().StringVar(&importSinceFlag, "since", "", "Import notes modified within this duration")
@@ -92,6 +106,11 @@ func init() {
 	importObsidianCmd.Flags().BoolVar(&importDryRunFlag, "dry-run", false, "Show what would be imported")
 	importObsidianCmd.Flags().BoolVar(&importAutoFlag, "auto", false, "Auto-accept categorizations")
 	importObsidianCmd.Flags().StringVar(&importVaultFlag, "vault", "", "Obsidian vault path (can also be set in config)")
+
+	for _, cmd := range []*cobra.Command{importNotesCmd, importObsidianCmd, importInboxCmd} {
+		addDedupeFlags(cmd)
+		cmd.Flags().IntVar(&importWorkersFlag, "workers", 0, "Notes to analyze at once (default import.workers or 4)")
+	}
 }
 
 func getLLMClient() (llm.Client, error) {
@@ -100,22 +119,97 @@ func getLLMClient() (llm.Client, error) {
 	baseURL := viper.GetString("llm.base_url")
 	apiKey := viper.GetString("llm.api_key")
 
+	prompts, err := llm.LoadPrompts(promptTemplatesDir())
+	if err != nil {
+		return nil, err
+	}
+
 	cfg := llm.Config{
 		Provider: llm.Provider(provider),
 		APIKey:   apiKey,
 		Model:    model,
 		BaseURL:  baseURL,
+		Areas:    categorizationAreas(context.Background()),
+		Prompts:  prompts,
+		Usage:    &budgetNotice{Ledger: usageLedger()},
 	}
 
 	if cfg.Provider == "" {
 		cfg.Provider = llm.ProviderClaude
 	}
 
-	return llm.NewClientWithFallback(cfg)
+	var llmClient llm.Client
+	if viper.IsSet("llm.providers") {
+		llmClient, err = providerChain(cfg)
+	} else {
+		llmClient, err = llm.NewClientWithFallback(cfg)
+	}
+
+	// Unless disabled, fall back to heuristic extraction when the model
+	// is unavailable so imports keep working offline
+	if viper.GetString("llm.fallback") == "none" || cfg.Provider == llm.ProviderHeuristic {
+		return llmClient, err
+	}
+	if err != nil {
+		fmt.Println(dimStyle.Render(fmt.Sprintf("AI unavailable (%v), using heuristic extraction", err)))
+		return llm.NewHeuristicClient().WithAreas(cfg.Areas), nil
+	}
+	return llm.NewFallbackClient(llmClient, llm.NewHeuristicClient().WithAreas(cfg.Areas)), nil
+}
+
+// categorizationAreas returns the areas notes can be filed into: the
+// existing areas, described by llm.areas.<slug>.description in the config,
+// their "description" metadata or the built-in description, plus any areas
+// only named in the config. Nil means the default areas.
+func categorizationAreas(ctx context.Context) []llm.AreaContext {
+	configured := viper.GetStringMap("llm.areas")
+	describe := func(slug string, area *llm.AreaContext) {
+		key := "llm.areas." + slug
+		if d := viper.GetString(key + ".description"); d != "" {
+			area.Description = d
+		}
+		area.Keywords = viper.GetStringSlice(key + ".keywords")
+	}
+
+	var areas []llm.AreaContext
+	seen := make(map[string]bool)
+	if client != nil {
+		existing, _ := client.ListAreas(ctx)
+		for _, a := range existing {
+			area := llm.AreaContext{Slug: a.Slug(), Title: a.Title, Description: a.Metadata["description"]}
+			if area.Description == "" {
+				area.Description = llm.DefaultAreaDescription(area.Slug)
+			}
+			describe(area.Slug, &area)
+			areas = append(areas, area)
+			seen[area.Slug] = true
+		}
+	}
+
+	slugs := make([]string, 0, len(configured))
+	for slug := range configured {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+	for _, slug := range slugs {
+		if seen[slug] {
+			continue
+		}
+		area := llm.AreaContext{Slug: slug, Title: cases.Title(language.English).String(strings.ReplaceAll(slug, "-", " "))}
+		describe(slug, &area)
+		areas = append(areas, area)
+	}
+	return areas
+}
+
+// promptTemplatesDir returns the directory of prompt template overrides,
+// or an empty string
+func promptTemplatesDir() string {
+	return expandHome(viper.GetString("llm.templates_dir"))
 }
 
 func runImportNotes(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 
 	// Get LLM client
 	llmClient, err := getLLMClient()
@@ -137,35 +231,70 @@ func runImportNotes(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	fmt.Println(titleStyle.Render("\n  Import from Apple Notes\n"))
-	fmt.Printf("Looking for notes modified in the last %s...\n\n", importSinceFlag)
-
-	// Read Apple Notes
-	reader := apple_notes.NewReader()
-	var notes []apple_notes.Note
+	// Notes imported before are remembered with their modification date,
+	// so only new and changed notes are read again
+	imported, err := loadImportedItems("apple_notes")
+	if err != nil {
+		return err
+	}
 
-	if importFolderFlag != "" {
-		notes, err = reader.ListNotesByFolder(ctx, importFolderFlag)
-	} else {
-		notes, err = reader.ListRecentNotes(ctx, since)
+	fmt.Println(titleStyle.Render("\n  Import from Apple Notes\n"))
+	latest := imported.latest()
+	switch {
+	case importFolderFlag != "":
+		since = 0
+		fmt.Printf("Looking for notes in %s...\n\n", importFolderFlag)
+	case !cmd.Flags().Changed("since") && !importAllFlag && !latest.IsZero():
+		// A minute of slack for notes saved while the last import ran
+		since = time.Since(latest) + time.Minute
+		fmt.Printf("Looking for notes changed since the last import (%s)...\n\n", latest.In(timezone).Format("Jan 2 15:04"))
+	default:
+		fmt.Printf("Looking for notes modified in the last %s...\n\n", importSinceFlag)
 	}
 
+	// Read Apple Notes: list IDs and dates first, then fetch only the notes
+	// that changed
+	reader := apple_notes.NewReader()
+	infos, err := reader.ListNoteInfo(ctx, importFolderFlag, since)
 	if err != nil {
 		return fmt.Errorf("failed to read Apple Notes: %w", err)
 	}
-
-	if len(notes) == 0 {
-		fmt.Println("No notes found matching criteria.")
+	var ids []string
+	for _, info := range infos {
+		if importAllFlag || imported.isNew(info.ID, info.ModDate) {
+			ids = append(ids, info.ID)
+		}
+	}
+	if len(ids) == 0 {
+		fmt.Println("No new or changed notes found.")
 		return nil
 	}
+	notes, err := reader.GetNotes(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to read Apple Notes: %w", err)
+	}
 
 	fmt.Printf("Found %d note(s)\n\n", len(notes))
 
-	return processNotes(ctx, llmClient, notesToGeneric(notes))
+	generic := notesToGeneric(notes)
+	if !importDryRunFlag {
+		saveNoteAttachments(ctx, reader, notes, generic)
+	}
+	if err := processNotes(ctx, llmClient, generic); err != nil {
+		return err
+	}
+
+	if importDryRunFlag {
+		return nil
+	}
+	for _, n := range notes {
+		imported.add(n.ID, n.ModDate)
+	}
+	return imported.save()
 }
 
 func runImportObsidian(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 
 	// Get vault path
 	vaultPath := importVaultFlag
@@ -224,11 +353,11 @@ func runImportObsidian(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("Found %d note(s)\n\n", len(notes))
 
-	return processNotes(ctx, llmClient, obsidianNotesToGeneric(notes))
+	return processNotes(ctx, llmClient, obsidianNotesToGeneric(notes, "obsidian"))
 }
 
 func runImportInbox(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 
 	inboxDir := filepath.Join(dataDir, "inbox")
 	if _, err := os.Stat(inboxDir); os.IsNotExist(err) {
@@ -262,7 +391,7 @@ func runImportInbox(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("Found %d item(s) in inbox\n\n", len(notes))
 
-	return processNotes(ctx, llmClient, obsidianNotesToGeneric(notes))
+	return processNotes(ctx, llmClient, obsidianNotesToGeneric(notes, "inbox"))
 }
 
 // genericNote is a common format for notes from different sources
@@ -270,41 +399,125 @@ type genericNote struct {
 	Name    string
 	Content string
 	Source  string
+	Ref     string // ID or path of the note in its source
+	// Attachments are local files, such as the photo a note was read from,
+	// attached to the tasks created from it
+	Attachments []string
+	// Tasks are the tasks a note lists itself, such as checklist items,
+	// created as they are alongside the ones the AI extracts
+	Tasks []llm.ExtractedTask
+	// Area is the area the note is filed into without asking the AI, as
+	// mapped from its folder; the AI then only picks the project, unless
+	// Project names it too
+	Area    string
+	Project string
 }
 
 func notesToGeneric(notes []apple_notes.Note) []genericNote {
+	// Keys are lowercased by viper, so folders match whatever their case
+	folderMap := viper.GetStringMapString("integrations.apple_notes.folder_map")
 	result := make([]genericNote, len(notes))
 	for i, n := range notes {
 		result[i] = genericNote{
 			Name:    n.Name,
 			Content: n.PlainText,
 			Source:  "apple_notes",
+			Ref:     n.ID,
+		}
+		if area, ok := folderMap[strings.ToLower(n.FolderPath)]; ok {
+			result[i].Area = area
+		} else {
+			result[i].Area = folderMap[strings.ToLower(n.Folder)]
+		}
+		for _, item := range n.Checklist {
+			if !item.Done {
+				result[i].Tasks = append(result[i].Tasks, llm.ExtractedTask{Title: item.Text})
+			}
 		}
 	}
 	return result
 }
 
-func obsidianNotesToGeneric(notes []obsidian.Note) []genericNote {
+// saveNoteAttachments saves the images and files of the notes about to be
+// imported. They are kept in the state directory rather than a temporary
+// one, so imports waiting for approval can still attach them.
+func saveNoteAttachments(ctx context.Context, reader *apple_notes.Reader, notes []apple_notes.Note, generic []genericNote) {
+	for i, n := range notes {
+		dir := filepath.Join(stateDir(), "attachments", "apple_notes", slug.Make(n.ID))
+		paths, err := reader.SaveAttachments(ctx, n, dir)
+		if err != nil {
+			fmt.Printf("Warning: failed to save attachments of %q: %v\n", n.Name, err)
+		}
+		generic[i].Attachments = paths
+	}
+}
+
+// mergeTasks adds the extracted tasks that the note doesn't list itself
+func mergeTasks(listed, extracted []llm.ExtractedTask) []llm.ExtractedTask {
+	merged := slices.Clone(listed)
+	for _, t := range extracted {
+		if !slices.ContainsFunc(listed, func(l llm.ExtractedTask) bool { return strings.EqualFold(l.Title, t.Title) }) {
+			merged = append(merged, t)
+		}
+	}
+	return merged
+}
+
+func obsidianNotesToGeneric(notes []obsidian.Note, source string) []genericNote {
 	result := make([]genericNote, len(notes))
 	for i, n := range notes {
 		result[i] = genericNote{
 			Name:    n.Name,
 			Content: n.Content,
-			Source:  "obsidian",
+			Source:  source,
+			Ref:     n.Path,
 		}
 	}
 	return result
 }
 
 func processNotes(ctx context.Context, llmClient llm.Client, notes []genericNote) error {
-	reader := bufio.NewReader(os.Stdin)
+	// Record the whole run as one changeset so it can be undone together
+	if store != nil && !importDryRunFlag && len(notes) > 0 {
+		source := notes[0].Source
+		if name, ok := sourceNames[source]; ok {
+			source = name
+		}
+		action := fmt.Sprintf("import %s: %d note(s)", source, len(notes))
+		return store.Batch(ctx, action, func(ctx context.Context) error {
+			return importNotes(ctx, llmClient, notes)
+		})
+	}
+	return importNotes(ctx, llmClient, notes)
+}
+
+func importNotes(ctx context.Context, llmClient llm.Client, notes []genericNote) error {
+	// With --auto nothing prompts, so duplicates are merged
+	if err := startDedupe(importAutoFlag, llmClient); err != nil {
+		return err
+	}
+	reader := importDuplicates.reader
+	approvals := approvalQueue()
+	threshold := approvalThreshold()
 	headerStyle := lipgloss.NewStyle().Bold(true)
 	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
 
 	// Build context of existing projects for AI matching
 	existingProjects := buildProjectContext(ctx)
 
-	for i, note := range notes {
+	// Notes are analyzed in parallel, ahead of the prompts and writes,
+	// which stay one note at a time
+	opts := importPipelineOptions()
+	if len(notes) > 1 && opts.Workers > 1 {
+		fmt.Println(dimStyle.Render(fmt.Sprintf("Analyzing with %d workers...", min(opts.Workers, len(notes)))))
+		fmt.Println()
+	}
+	analyses := pipeline.Run(ctx, notes, opts, func(ctx context.Context, note genericNote) noteAnalysis {
+		return analyzeNote(ctx, llmClient, note, existingProjects)
+	})
+
+	for i, analysis := range analyses {
+		note := notes[i]
 		fmt.Printf("%s (%d/%d)\n", headerStyle.Render(note.Name), i+1, len(notes))
 
 		// Preview content
@@ -315,9 +528,7 @@ func processNotes(ctx context.Context, llmClient llm.Client, notes []genericNote
 		fmt.Println(labelStyle.Render(preview))
 		fmt.Println()
 
-		// Categorize with LLM (with existing project context)
-		fmt.Println("Analyzing...")
-		result, err := llmClient.CategorizeWithContext(ctx, note.Content, existingProjects)
+		result, err := analysis.result, analysis.err
 		if err != nil {
 			fmt.Printf("  Error: %v\n", err)
 			continue
@@ -343,6 +554,9 @@ func processNotes(ctx context.Context, llmClient llm.Client, notes []genericNote
 		}
 		fmt.Printf("  %s %s\n", labelStyle.Render("Summary:"), result.Summary)
 		fmt.Printf("  %s %v\n", labelStyle.Render("Actionable:"), result.IsActionable)
+		if len(note.Tasks) > 0 {
+			fmt.Printf("  %s %d item(s)\n", labelStyle.Render("Checklist:"), len(note.Tasks))
+		}
 		fmt.Println()
 
 		if importDryRunFlag {
@@ -369,8 +583,39 @@ func processNotes(ctx context.Context, llmClient llm.Client, notes []genericNote
 			}
 		}
 
+		tasks, err := analysis.tasks, analysis.tasksErr
+		if result.IsActionable && !analysis.extracted {
+			tasks, err = llmClient.ExtractTasks(ctx, note.Content)
+		}
+		if err != nil {
+			fmt.Printf("  Error: failed to extract tasks: %v\n", err)
+			fmt.Println()
+			continue
+		}
+		if len(note.Tasks) > 0 {
+			tasks = mergeTasks(note.Tasks, tasks)
+			result.IsActionable = true
+		}
+
+		// Without anyone confirming, leave what the AI was unsure of for
+		// approval
+		if importAutoFlag && result.AreaConfidence < threshold {
+			item := approval.NewItem(note.Source, note.Ref, note.Name, note.Content)
+			item.Provider = llmClient.Provider()
+			item.Categorization = *result
+			item.Tasks = tasks
+			item.Attachments = note.Attachments
+			if err := approvals.Add(item); err != nil {
+				fmt.Printf("  Error: %v\n", err)
+			} else {
+				fmt.Println(promptStyle.Render(fmt.Sprintf("  ? Low confidence, queued for approval (%s)", item.ID)))
+			}
+			fmt.Println()
+			continue
+		}
+
 		// Create project/tasks
-		if err := createFromCategorization(ctx, note, result, llmClient); err != nil {
+		if err := createFromCategorization(ctx, note, result, llmClient.Provider(), tasks); err != nil {
 			fmt.Printf("  Error: %v\n", err)
 		} else {
 			fmt.Println(successStyle.Render("  ✓ Imported"))
@@ -378,10 +623,87 @@ func processNotes(ctx context.Context, llmClient llm.Client, notes []genericNote
 		fmt.Println()
 	}
 
+	printDedupeSummary()
 	return nil
 }
 
-func createFromCategorization(ctx context.Context, note genericNote, cat *llm.CategorizeResult, llmClient llm.Client) error {
+// noteAnalysis is what the AI made of a note
+type noteAnalysis struct {
+	result    *llm.CategorizeResult
+	err       error
+	tasks     []llm.ExtractedTask
+	tasksErr  error
+	extracted bool // whether tasks were extracted
+}
+
+// analyzeNote categorizes a note and, when nobody will be asked to accept
+// the categorization first, extracts its tasks
+func analyzeNote(ctx context.Context, llmClient llm.Client, note genericNote, existingProjects []llm.ProjectContext) noteAnalysis {
+	var a noteAnalysis
+	if note.Area != "" {
+		a.result, a.err = categorizeInArea(ctx, llmClient, note, existingProjects)
+	} else {
+		a.result, a.err = llmClient.CategorizeWithContext(ctx, note.Content, existingProjects)
+	}
+	if a.err != nil || !importAutoFlag || importDryRunFlag || !a.result.IsActionable {
+		return a
+	}
+	a.tasks, a.tasksErr = llmClient.ExtractTasks(ctx, note.Content)
+	a.extracted = true
+	return a
+}
+
+// categorizeInArea categorizes a note whose area is already known,
+// offering the AI only that area's projects to match
+func categorizeInArea(ctx context.Context, llmClient llm.Client, note genericNote, existingProjects []llm.ProjectContext) (*llm.CategorizeResult, error) {
+	var inArea []llm.ProjectContext
+	for _, p := range existingProjects {
+		if strings.EqualFold(p.Area, note.Area) || slug.Make(p.Area) == slug.Make(note.Area) {
+			inArea = append(inArea, p)
+		}
+	}
+
+	result, err := llmClient.CategorizeWithContext(ctx, note.Content, inArea)
+	if err != nil {
+		return nil, err
+	}
+	result.Area = note.Area
+	result.AreaConfidence = 1
+	if note.Project != "" {
+		result.ProjectID = ""
+		result.ProjectSuggestion = note.Project
+		for _, p := range inArea {
+			if strings.EqualFold(p.Title, note.Project) || slug.Make(p.Title) == slug.Make(note.Project) {
+				result.ProjectID = p.ID
+			}
+		}
+	}
+	if !slices.ContainsFunc(inArea, func(p llm.ProjectContext) bool { return p.ID == result.ProjectID }) {
+		result.ProjectID = ""
+	}
+	return result, nil
+}
+
+// importPipelineOptions returns the worker count and rate limit for
+// analyzing notes: --workers or import.workers, and import.rate_limit
+// requests per second
+func importPipelineOptions() pipeline.Options {
+	opts := pipeline.Options{
+		Workers: pipeline.DefaultWorkers,
+		Rate:    viper.GetFloat64("import.rate_limit"),
+	}
+	if viper.IsSet("import.workers") {
+		opts.Workers = viper.GetInt("import.workers")
+	}
+	if importWorkersFlag > 0 {
+		opts.Workers = importWorkersFlag
+	}
+	return opts
+}
+
+// createFromCategorization files a note as the AI categorized it, creating
+// the area and project if needed and a task for each extracted task
+func createFromCategorization(ctx context.Context, note genericNote, cat *llm.CategorizeResult, provider llm.Provider, tasks []llm.ExtractedTask) error {
 	// Find or create area
 	areas, err := client.ListAreas(ctx)
 	if err != nil {
@@ -406,6 +728,19 @@ func createFromCategorization(ctx context.Context, note genericNote, cat *llm.Ca
 		}
 	}
 
+	// Record where everything created from this note came from
+	importedAt := time.Now()
+	confidence := cat.AreaConfidence
+	provenance := domain.Provenance{
+		Source:       note.Source,
+		SourceRef:    note.Ref,
+		SourceTitle:  note.Name,
+		ImportedAt:   &importedAt,
+		AIProvider:   string(provider),
+		AIConfidence: &confidence,
+		AISummary:    cat.Summary,
+	}
+
 	var targetProject *domain.Project
 
 	// Check if AI matched an existing project by ID
@@ -427,18 +762,23 @@ func createFromCategorization(ctx context.Context, note genericNote, cat *llm.Ca
 		// Check if project exists by slug
 		projects, _ := client.ListProjects(ctx, targetArea.ID)
 		for _, p := range projects {
-			if strings.EqualFold(p.Slug(), slugify(projectTitle)) {
+			if strings.EqualFold(p.Slug(), slug.Make(projectTitle)) {
 				targetProject = p
 				break
 			}
 		}
 
+		if targetProject == nil {
+			targetProject = importDuplicates.project(ctx, projectTitle, projects)
+		}
+
 		if targetProject == nil {
 			newProject := domain.NewProject(projectTitle, targetArea.ID)
 			newProject.Content = cat.Summary
 			for _, tag := range cat.Tags {
 				newProject.AddTag(tag)
 			}
+			provenance.WriteTo(newProject.Metadata)
 			targetProject, err = client.CreateProject(ctx, newProject)
 			if err != nil {
 				return fmt.Errorf("failed to create project: %w", err)
@@ -446,19 +786,25 @@ func createFromCategorization(ctx context.Context, note genericNote, cat *llm.Ca
 		}
 	}
 
-	// Extract and create tasks if actionable
+	// Create the tasks extracted from actionable notes
+	attached := false
 	if cat.IsActionable {
-		tasks, err := llmClient.ExtractTasks(ctx, note.Content)
-		if err != nil {
-			return fmt.Errorf("failed to extract tasks: %w", err)
-		}
-
+		var pending []*domain.Task
 		for _, t := range tasks {
 			task := domain.NewTask(t.Title, targetProject.ID, targetArea.ID)
 			task.Content = t.Description
+			task.Context = domain.NormalizeContext(t.Context)
 			for _, tag := range t.Tags {
 				task.AddTag(tag)
 			}
+			provenance.WriteTo(task.Metadata)
+			if due, err := time.ParseInLocation("2006-01-02", t.DueDate, timezone); err == nil {
+				task.DueDate = &due
+				task.AllDay = true
+			}
+			if scheduled, err := time.ParseInLocation("2006-01-02", t.ScheduledDate, timezone); err == nil {
+				task.ScheduledDate = &scheduled
+			}
 
 			switch strings.ToLower(t.Priority) {
 			case "low":
@@ -471,16 +817,58 @@ func createFromCategorization(ctx context.Context, note genericNote, cat *llm.Ca
 				task.Priority = domain.PriorityMedium
 			}
 
-			if _, err := client.CreateTask(ctx, task); err != nil {
-				// Skip duplicate tasks
+			if handled, err := importDuplicates.task(ctx, task); err != nil || handled {
 				continue
 			}
+			importDuplicates.added(task)
+			pending = append(pending, task)
+		}
+
+		if len(pending) > 0 {
+			results, err := client.BatchCreateTasks(ctx, pending)
+			if err != nil {
+				return fmt.Errorf("failed to create tasks: %w", err)
+			}
+			for _, r := range results {
+				// Skip tasks that couldn't be created
+				if r.Err != nil {
+					continue
+				}
+				attachNoteFiles(ctx, note, r.Task)
+				attached = true
+			}
+		}
+	}
+
+	// Keep the original of a note with nothing to do, such as a photo of
+	// reference notes, as a task of its own
+	if len(note.Attachments) > 0 && !attached {
+		task := domain.NewTask(note.Name, targetProject.ID, targetArea.ID)
+		task.Content = note.Content
+		for _, tag := range cat.Tags {
+			task.AddTag(tag)
+		}
+		provenance.WriteTo(task.Metadata)
+		created, err := client.CreateTask(ctx, task)
+		if err != nil {
+			return fmt.Errorf("failed to create task: %w", err)
 		}
+		attachNoteFiles(ctx, note, created)
 	}
 
 	return nil
 }
 
+// attachNoteFiles attaches a note's files to a task created from it. A file
+// that can't be attached is reported and skipped.
+func attachNoteFiles(ctx context.Context, note genericNote, task *domain.Task) {
+	for _, path := range note.Attachments {
+		if _, err := client.AttachToTask(ctx, task.ID, path); err != nil {
+			fmt.Printf("  Error: failed to attach %s: %v\n", filepath.Base(path), err)
+		}
+	}
+}
+
 func parseDuration(s string) (time.Duration, error) {
 	if strings.HasSuffix(s, "d") {
 		days := strings.TrimSuffix(s, "d")
@@ -491,18 +879,6 @@ func parseDuration(s string) (time.Duration, error) {
 	return time.ParseDuration(s)
 }
 
-func slugify(s string) string {
-	slug := strings.ToLower(s)
-	slug = strings.ReplaceAll(slug, " ", "-")
-	var result strings.Builder
-	for _, r := range slug {
-		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' {
-			result.WriteRune(r)
-		}
-	}
-	return result.String()
-}
-
 // buildProjectContext creates a list of existing projects for AI matching
 func buildProjectContext(ctx context.Context) []llm.ProjectContext {
 	var projects []llm.ProjectContext
@@ -528,3 +904,127 @@ func buildProjectContext(ctx context.Context) []llm.ProjectContext {
 
 	return projects
 }
+
+// projectIndex finds projects by title within an area, creating them on
+// first use
+type projectIndex map[string]*domain.Project
+
+// importedTasks maps the refs of items imported from a source before to
+// their tasks. Tasks are matched by external reference, or by provenance
+// if they were imported before external references were recorded.
+func importedTasks(ctx context.Context, source string) (map[string]*domain.Task, error) {
+	tasks, err := client.ListAllTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	imported := make(map[string]*domain.Task)
+	for _, t := range tasks {
+		switch {
+		case t.ExternalRef != nil:
+			if t.ExternalRef.Source == source {
+				imported[t.ExternalRef.ID] = t
+			}
+		case t.Metadata[domain.MetaSource] == source && t.Metadata[domain.MetaSourceRef] != "":
+			imported[t.Metadata[domain.MetaSourceRef]] = t
+		}
+	}
+	return imported, nil
+}
+
+// loadProjectIndex indexes all existing projects
+func loadProjectIndex(ctx context.Context) (projectIndex, error) {
+	projects, err := client.ListAllProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	index := make(projectIndex, len(projects))
+	for _, p := range projects {
+		index.add(p)
+	}
+	return index, nil
+}
+
+// find returns the project titled title in an area
+func (idx projectIndex) find(areaID, title string) (*domain.Project, bool) {
+	p, ok := idx[areaID+"/"+strings.ToLower(title)]
+	return p, ok
+}
+
+// add indexes a newly created project
+func (idx projectIndex) add(p *domain.Project) {
+	idx[p.AreaID+"/"+strings.ToLower(p.Title)] = p
+}
+
+// get returns the project titled title in area, creating it if needed
+func (idx projectIndex) get(ctx context.Context, area *domain.Area, title string) (*domain.Project, error) {
+	if p, ok := idx.match(ctx, area.ID, title); ok {
+		return p, nil
+	}
+
+	p, err := client.CreateProject(ctx, domain.NewProject(title, area.ID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project %q: %w", title, err)
+	}
+	idx.add(p)
+	return p, nil
+}
+
+// importedItems remembers which items an import has processed, files by
+// path and notes by ID, with their modification time, so later runs only
+// pick up new or changed ones
+type importedItems struct {
+	path string
+	seen map[string]time.Time
+}
+
+// loadImportedItems reads the record for the import called name
+func loadImportedItems(name string) (*importedItems, error) {
+	f := &importedItems{
+		path: filepath.Join(stateDir(), "imported_"+name+".yaml"),
+		seen: make(map[string]time.Time),
+	}
+	data, err := os.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return f, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, &f.seen); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", f.path, err)
+	}
+	return f, nil
+}
+
+func (f *importedItems) isNew(path string, modTime time.Time) bool {
+	seen, ok := f.seen[path]
+	return !ok || modTime.After(seen)
+}
+
+func (f *importedItems) add(path string, modTime time.Time) {
+	f.seen[path] = modTime
+}
+
+// latest returns the newest modification time seen, or zero if nothing
+// was imported yet
+func (f *importedItems) latest() time.Time {
+	var latest time.Time
+	for _, t := range f.seen {
+		if t.After(latest) {
+			latest = t
+		}
+	}
+	return latest
+}
+
+func (f *importedItems) save() error {
+	data, err := yaml.Marshal(f.seen)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(f.path, data, 0644)
+}
@@ -0,0 +1,181 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/integrations/audio"
+	"github.com/ihavespoons/reorg/internal/llm"
+)
+
+var importWatchFlag time.Duration
+
+var importAudioCmd = &cobra.Command{
+	Use:   "audio [dir]",
+	Short: "Transcribe voice memos and import them",
+	Long: `Transcribe the recordings in a folder and import the transcripts like
+notes: the AI categorizes each one and extracts its tasks.
+
+Recordings are transcribed with whisper.cpp (import.audio.provider:
+whisper-cpp, the default, with a model file in import.audio.model; ffmpeg
+converts formats whisper.cpp can't read) or the OpenAI API
+(import.audio.provider: openai). Each file is only imported once unless
+--all is given. The folder defaults to import.audio.dir.
+
+--watch keeps running and checks the folder again at that interval, plus
+up to import.watch.jitter, and not during import.watch.quiet_hours; it
+needs --auto, as nobody is there to answer prompts.
+
+Examples:
+  reorg import audio ~/VoiceMemos
+  reorg import audio --auto --watch 5m`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runImportAudio,
+}
+
+func init() {
+	importCmd.AddCommand(importAudioCmd)
+	importAudioCmd.Flags().BoolVar(&importDryRunFlag, "dry-run", false, "Show what would be imported")
+	importAudioCmd.Flags().BoolVar(&importAutoFlag, "auto", false, "Auto-accept categorizations")
+	importAudioCmd.Flags().BoolVar(&importAllFlag, "all", false, "Import files that were imported before again")
+	importAudioCmd.Flags().DurationVar(&importWatchFlag, "watch", 0, "Keep checking the folder at this interval")
+	addDedupeFlags(importAudioCmd)
+	importAudioCmd.Flags().IntVar(&importWorkersFlag, "workers", 0, "Notes to analyze at once (default import.workers or 4)")
+}
+
+func runImportAudio(cmd *cobra.Command, args []string) error {
+	dir, err := importFolderDir(args, "import.audio.dir")
+	if err != nil {
+		return err
+	}
+
+	transcriber, err := audio.NewTranscriber(audio.Config{
+		Provider: viper.GetString("import.audio.provider"),
+		Binary:   viper.GetString("import.audio.binary"),
+		Model:    expandHome(viper.GetString("import.audio.model")),
+		APIKey:   viper.GetString("import.audio.api_key"),
+		BaseURL:  viper.GetString("import.audio.base_url"),
+		Language: viper.GetString("import.audio.language"),
+	})
+	if err != nil {
+		return err
+	}
+	llmClient, err := getLLMClient()
+	if err != nil {
+		return fmt.Errorf("failed to create LLM client: %w", err)
+	}
+
+	fmt.Println(titleStyle.Render("\n  Import voice memos\n"))
+
+	return watchFolder(func(ctx context.Context) error {
+		return importAudioOnce(ctx, dir, transcriber, llmClient)
+	})
+}
+
+// watchFolder runs a folder import once, or with --watch again at about
+// that interval until interrupted
+func watchFolder(once func(ctx context.Context) error) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	schedule, err := loadWatchSchedule(importWatchFlag)
+	if err != nil {
+		return err
+	}
+	for {
+		if err := once(ctx); err != nil {
+			return err
+		}
+		if importWatchFlag <= 0 {
+			return nil
+		}
+		now := time.Now().In(timezone)
+		next := schedule.next(now)
+		if next.Sub(now) > importWatchFlag+schedule.jitter {
+			fmt.Println(dimStyle.Render("Quiet hours, next check at " + next.Format("15:04")))
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(next.Sub(now)):
+		}
+	}
+}
+
+// importFolderDir returns the folder a folder import reads, from its
+// argument or the config key, as an absolute path
+func importFolderDir(args []string, key string) (string, error) {
+	dir := viper.GetString(key)
+	if len(args) == 1 {
+		dir = args[0]
+	}
+	if dir == "" {
+		return "", fmt.Errorf("no folder given (pass one or set %s)", key)
+	}
+	if importWatchFlag > 0 && !importAutoFlag {
+		return "", fmt.Errorf("--watch needs --auto")
+	}
+	// Files are remembered by absolute path, whichever way the folder is given
+	return filepath.Abs(expandHome(dir))
+}
+
+// importAudioOnce transcribes and imports the new recordings in dir
+func importAudioOnce(ctx context.Context, dir string, transcriber audio.Transcriber, llmClient llm.Client) error {
+	files, err := audio.List(dir)
+	if err != nil {
+		return err
+	}
+	imported, err := loadImportedItems("audio")
+	if err != nil {
+		return err
+	}
+
+	var notes []genericNote
+	var done []audio.File
+	for _, f := range files {
+		if !importAllFlag && !imported.isNew(f.Path, f.ModTime) {
+			continue
+		}
+		fmt.Printf("Transcribing %s...\n", f.Name)
+		text, err := transcriber.Transcribe(ctx, f.Path)
+		if err != nil {
+			fmt.Printf("  Error: %v\n", err)
+			continue
+		}
+		done = append(done, f)
+		if text == "" {
+			fmt.Println(dimStyle.Render("  Nothing was said, skipping"))
+			continue
+		}
+		notes = append(notes, genericNote{Name: f.Name, Content: text, Source: "audio", Ref: f.Path})
+	}
+	if len(done) == 0 {
+		if importWatchFlag <= 0 {
+			fmt.Println("No new recordings found.")
+		}
+		return nil
+	}
+	fmt.Println()
+
+	if len(notes) > 0 {
+		if err := processNotes(ctx, llmClient, notes); err != nil {
+			return err
+		}
+	}
+
+	if importDryRunFlag {
+		return nil
+	}
+	for _, f := range done {
+		imported.add(f.Path, f.ModTime)
+	}
+	return imported.save()
+}
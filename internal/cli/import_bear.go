@@ -0,0 +1,236 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/integrations/bear"
+	"github.com/ihavespoons/reorg/internal/integrations/journal"
+	"github.com/ihavespoons/reorg/internal/slug"
+)
+
+var importTagFlag string
+
+var importBearCmd = &cobra.Command{
+	Use:   "bear",
+	Short: "Import notes from Bear (macOS)",
+	Long: `Import notes from the Bear database on macOS and categorize them using AI.
+
+Bear's nested tags map to areas and projects: a note tagged
+#work/website-redesign goes to the website-redesign project of the work
+area without asking the AI, when an area called work exists. A tag that
+is just an area's name sets the area and leaves the project to the AI.
+Other notes are categorized as usual.
+
+Each run picks up the notes created or changed since the last one; --all
+reads every note again, and --since looks back that far. Trashed,
+archived and encrypted notes are skipped. The database is read with the
+sqlite3 tool and is never modified.
+
+Examples:
+  reorg import bear
+  reorg import bear --tag work --dry-run
+  reorg import bear --db ~/backup/database.sqlite`,
+	Args: cobra.NoArgs,
+	RunE: runImportBear,
+}
+
+var importJournalCmd = &cobra.Command{
+	Use:   "journal [export-dir]",
+	Short: "Import entries exported from Apple Journal",
+	Long: `Import Apple Journal entries and categorize them using AI.
+
+Journal keeps its entries encrypted and can't be scripted, so entries are
+read from an export: a folder of HTML or text files, searched with its
+subfolders. Each entry is imported once unless --all is given. The folder
+defaults to import.journal.dir.
+
+Examples:
+  reorg import journal ~/Documents/Journal\ Export
+  reorg import journal --dry-run`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runImportJournal,
+}
+
+func init() {
+	importCmd.AddCommand(importBearCmd)
+	importCmd.AddCommand(importJournalCmd)
+
+	importBearCmd.Flags().StringVar(&importAppDBFlag, "db", "", "Path to the Bear database (default: found automatically)")
+	importBearCmd.Flags().StringVar(&importTagFlag, "tag", "", "Only import notes with this tag, or a tag nested under it")
+	importBearCmd.Flags().StringVar(&importSinceFlag, "since", "", "Import notes modified within this duration (e.g., 24h, 7d)")
+	for _, cmd := range []*cobra.Command{importBearCmd, importJournalCmd} {
+		cmd.Flags().BoolVar(&importDryRunFlag, "dry-run", false, "Show what would be imported")
+		cmd.Flags().BoolVar(&importAutoFlag, "auto", false, "Auto-accept categorizations")
+		cmd.Flags().BoolVar(&importAllFlag, "all", false, "Import notes that were imported before again")
+		addDedupeFlags(cmd)
+		cmd.Flags().IntVar(&importWorkersFlag, "workers", 0, "Notes to analyze at once (default import.workers or 4)")
+	}
+}
+
+func runImportBear(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	path := importAppDBFlag
+	if path == "" {
+		var err error
+		if path, err = bear.DefaultPath(); err != nil {
+			return err
+		}
+	}
+	var since time.Duration
+	if importSinceFlag != "" {
+		var err error
+		if since, err = parseDuration(importSinceFlag); err != nil {
+			return err
+		}
+	}
+
+	llmClient, err := getLLMClient()
+	if err != nil {
+		return fmt.Errorf("failed to create LLM client: %w", err)
+	}
+	imported, err := loadImportedItems("bear")
+	if err != nil {
+		return err
+	}
+	areas, err := client.ListAreas(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list areas: %w", err)
+	}
+
+	fmt.Println(titleStyle.Render("\n  Import from Bear\n"))
+
+	all, err := bear.Read(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	var notes []genericNote
+	var read []bear.Note
+	for _, n := range all {
+		if importTagFlag != "" && !hasBearTag(n.Tags, importTagFlag) {
+			continue
+		}
+		if since > 0 && time.Since(n.Modified) > since {
+			continue
+		}
+		if !importAllFlag && since == 0 && !imported.isNew(n.ID, n.Modified) {
+			continue
+		}
+		read = append(read, n)
+		note := genericNote{Name: n.Title, Content: n.Text, Source: "bear", Ref: n.ID}
+		note.Area, note.Project = bearTagArea(n.Tags, areas)
+		notes = append(notes, note)
+	}
+	if len(notes) == 0 {
+		fmt.Println("No new or changed notes found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d note(s)\n\n", len(notes))
+	if err := processNotes(ctx, llmClient, notes); err != nil {
+		return err
+	}
+
+	if importDryRunFlag {
+		return nil
+	}
+	for _, n := range read {
+		imported.add(n.ID, n.Modified)
+	}
+	return imported.save()
+}
+
+// hasBearTag reports whether tags include tag or a tag nested under it
+func hasBearTag(tags []string, tag string) bool {
+	tag = strings.ToLower(strings.Trim(tag, "#/"))
+	for _, t := range tags {
+		t = strings.ToLower(t)
+		if t == tag || strings.HasPrefix(t, tag+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// bearTagArea maps a note's tags to an area and project: the first tag
+// whose top level names an existing area gives the area, and its next
+// level the project
+func bearTagArea(tags []string, areas []*domain.Area) (area, project string) {
+	for _, tag := range tags {
+		top, rest, _ := strings.Cut(tag, "/")
+		for _, a := range areas {
+			if !strings.EqualFold(a.Title, top) && a.Slug() != slug.Make(top) {
+				continue
+			}
+			project, _, _ = strings.Cut(rest, "/")
+			return a.Title, project
+		}
+	}
+	return "", ""
+}
+
+func runImportJournal(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	dir := viper.GetString("import.journal.dir")
+	if len(args) == 1 {
+		dir = args[0]
+	}
+	if dir == "" {
+		return fmt.Errorf("no folder given (pass one or set import.journal.dir)")
+	}
+
+	llmClient, err := getLLMClient()
+	if err != nil {
+		return fmt.Errorf("failed to create LLM client: %w", err)
+	}
+	imported, err := loadImportedItems("journal")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(titleStyle.Render("\n  Import from Journal\n"))
+
+	entries, err := journal.Read(expandHome(dir))
+	if err != nil {
+		return err
+	}
+
+	var notes []genericNote
+	var read []journal.Entry
+	for _, e := range entries {
+		if !importAllFlag && !imported.isNew(e.Path, e.ModTime) {
+			continue
+		}
+		read = append(read, e)
+		name := e.Title
+		if name == "" {
+			name = e.Date.Format("Journal, Jan 2 2006")
+		}
+		notes = append(notes, genericNote{Name: name, Content: e.Text, Source: "journal", Ref: e.Path})
+	}
+	if len(notes) == 0 {
+		fmt.Println("No new entries found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d entries\n\n", len(notes))
+	if err := processNotes(ctx, llmClient, notes); err != nil {
+		return err
+	}
+
+	if importDryRunFlag {
+		return nil
+	}
+	for _, e := range read {
+		imported.add(e.Path, e.ModTime)
+	}
+	return imported.save()
+}
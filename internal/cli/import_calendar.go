@@ -0,0 +1,220 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/integrations/ics"
+)
+
+var (
+	importCalendarAreaFlag string
+	importCalendarDaysFlag int
+)
+
+var importCalendarCmd = &cobra.Command{
+	Use:   "calendar [file-or-url...]",
+	Short: "Create tasks for calendar events",
+	Long: `Read .ics calendar files or feeds, such as a work calendar export, and
+create tasks for the upcoming events that match import.calendar.rules.
+
+Each rule has a regular expression matched against event titles,
+case-insensitively, and optionally the task title ({title} is the
+event's), how long before the event the task is due, and its project,
+area and tags. The first matching rule wins; events no rule matches are
+ignored. Recurring events are expanded, with the date added to the titles
+of their tasks.
+
+Events are recognised by their UID, so running again only adds new ones,
+or with --update also moves tasks for rescheduled events and cancels
+those for cancelled ones. Files and URLs default to
+import.calendar.sources.
+
+Examples:
+  reorg import calendar ~/Downloads/work.ics --dry-run
+  reorg import calendar --days 7 --update`,
+	RunE: runImportCalendar,
+}
+
+func init() {
+	importCmd.AddCommand(importCalendarCmd)
+
+	importCalendarCmd.Flags().StringVarP(&importCalendarAreaFlag, "area", "a", "work", "Area for rules that don't name one")
+	importCalendarCmd.Flags().IntVar(&importCalendarDaysFlag, "days", 14, "How many days ahead to look for events")
+	importCalendarCmd.Flags().BoolVar(&importAppUpdateFlag, "update", false, "Update tasks imported before instead of skipping them")
+	importCalendarCmd.Flags().BoolVar(&importDryRunFlag, "dry-run", false, "Show what would be imported")
+}
+
+// calendarRule is an entry of import.calendar.rules
+type calendarRule struct {
+	Match   string   `mapstructure:"match"`
+	Task    string   `mapstructure:"task"`
+	Before  string   `mapstructure:"before"`
+	Project string   `mapstructure:"project"`
+	Area    string   `mapstructure:"area"`
+	Tags    []string `mapstructure:"tags"`
+
+	re     *regexp.Regexp
+	before time.Duration
+}
+
+func runImportCalendar(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	rules, err := loadCalendarRules()
+	if err != nil {
+		return err
+	}
+	sources := args
+	if len(sources) == 0 {
+		sources = viper.GetStringSlice("import.calendar.sources")
+	}
+	if len(sources) == 0 {
+		return fmt.Errorf("no calendars given (pass files or URLs or set import.calendar.sources)")
+	}
+
+	var events []ics.Event
+	for _, source := range sources {
+		evs, err := readCalendar(ctx, source)
+		if err != nil {
+			return err
+		}
+		events = append(events, evs...)
+	}
+
+	// Cancelled events only matter to tasks made for them before
+	imported, err := importedTasks(ctx, "calendar")
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().In(timezone)
+	var tasks []appTask
+	for _, e := range ics.Expand(events, now, now.AddDate(0, 0, importCalendarDaysFlag)) {
+		if e.Cancelled() && imported[e.Ref()] == nil {
+			continue
+		}
+		for _, rule := range rules {
+			if rule.re.MatchString(e.Summary) {
+				tasks = append(tasks, calendarTask(e, rule))
+				break
+			}
+		}
+	}
+
+	// The flag is separate from the other app importers' for its default
+	importAppAreaFlag = importCalendarAreaFlag
+	// Occurrences of a recurring event share a title, so events are only
+	// told apart by UID
+	importOnDuplicateFlag = duplicateCreate
+	return importApp(ctx, "Calendar", "calendar", "", nil, tasks)
+}
+
+// loadCalendarRules reads and checks import.calendar.rules
+func loadCalendarRules() ([]calendarRule, error) {
+	var rules []calendarRule
+	if err := viper.UnmarshalKey("import.calendar.rules", &rules); err != nil {
+		return nil, fmt.Errorf("invalid import.calendar.rules: %w", err)
+	}
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("no import.calendar.rules to pick events with")
+	}
+	for i := range rules {
+		r := &rules[i]
+		re, err := regexp.Compile("(?i)" + r.Match)
+		if err != nil {
+			return nil, fmt.Errorf("invalid match %q in import.calendar.rules: %w", r.Match, err)
+		}
+		r.re = re
+		if r.Before != "" {
+			if r.before, err = time.ParseDuration(r.Before); err != nil {
+				return nil, fmt.Errorf("invalid before %q in import.calendar.rules: %w", r.Before, err)
+			}
+		}
+	}
+	return rules, nil
+}
+
+// readCalendar parses a calendar file or feed. webcal:// feeds are
+// fetched over HTTPS.
+func readCalendar(ctx context.Context, source string) ([]ics.Event, error) {
+	if u, ok := strings.CutPrefix(source, "webcal://"); ok {
+		source = "https://" + u
+	}
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		f, err := os.Open(expandHome(source))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open calendar: %w", err)
+		}
+		defer func() { _ = f.Close() }()
+		return ics.Parse(f)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := (&http.Client{Timeout: 30 * time.Second}).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch calendar: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("failed to fetch calendar: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return ics.Parse(resp.Body)
+}
+
+// calendarTask maps an event to the task a rule makes of it
+func calendarTask(e ics.Event, rule calendarRule) appTask {
+	title := e.Summary
+	if rule.Task != "" {
+		title = strings.ReplaceAll(rule.Task, "{title}", e.Summary)
+	}
+	// Tasks in a project need distinct titles
+	if e.Recurring {
+		title += " (" + e.Start.In(timezone).Format("Jan 2") + ")"
+	}
+	due := e.Start.Add(-rule.before)
+
+	when := e.Start.In(timezone).Format("Mon Jan 2 15:04")
+	if e.AllDay {
+		when = e.Start.Format("Mon Jan 2")
+	}
+	notes := []string{"Event: " + e.Summary + ", " + when}
+	if e.Location != "" {
+		notes = append(notes, "Where: "+e.Location)
+	}
+	if e.Description != "" {
+		notes = append(notes, "", e.Description)
+	}
+
+	status := domain.TaskStatusPending
+	if e.Cancelled() {
+		status = domain.TaskStatusCancelled
+	}
+	return appTask{
+		Ref:      e.Ref(),
+		URL:      e.URL,
+		Title:    title,
+		Notes:    strings.Join(notes, "\n"),
+		Project:  rule.Project,
+		Area:     rule.Area,
+		Tags:     rule.Tags,
+		Due:      &due,
+		AllDay:   e.AllDay && rule.before%(24*time.Hour) == 0,
+		Status:   status,
+		Priority: domain.PriorityMedium,
+	}
+}
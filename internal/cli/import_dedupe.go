@@ -0,0 +1,242 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/dedupe"
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/llm"
+)
+
+// What to do with an imported item that looks like an existing one
+const (
+	duplicateAsk    = "ask"
+	duplicateMerge  = "merge"
+	duplicateSkip   = "skip"
+	duplicateCreate = "create"
+)
+
+var (
+	importOnDuplicateFlag string
+	importAIDedupeFlag    bool
+
+	// importDuplicates handles duplicates for the running import, if the
+	// command checks for them
+	importDuplicates *importDedupe
+)
+
+// addDedupeFlags registers the duplicate handling flags on an import command
+func addDedupeFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&importOnDuplicateFlag, "on-duplicate", duplicateAsk, "What to do with items like existing ones (ask, merge, skip, create)")
+	cmd.Flags().BoolVar(&importAIDedupeFlag, "ai-dedupe", false, "Ask the AI provider about similar titles")
+}
+
+// importDedupe decides what happens to imported projects and tasks whose
+// titles are like existing ones. Similar projects are reused rather than
+// created again; similar tasks are merged into the existing task, skipped
+// or created anyway.
+type importDedupe struct {
+	detector dedupe.Detector
+	mode     string
+	reader   *bufio.Reader
+	tasks    map[string][]*domain.Task // by project ID, loaded on first use
+	reused   int                       // projects
+	merged   int                       // tasks
+	skipped  int                       // tasks
+}
+
+// startDedupe sets up duplicate handling from the flags. With auto, which
+// commands that don't prompt pass, ask becomes merge. llmClient is used for
+// --ai-dedupe if set; otherwise the configured provider is.
+func startDedupe(auto bool, llmClient llm.Client) error {
+	mode := strings.ToLower(importOnDuplicateFlag)
+	switch mode {
+	case duplicateAsk, duplicateMerge, duplicateSkip, duplicateCreate:
+	default:
+		return fmt.Errorf("invalid --on-duplicate: %s (use ask, merge, skip or create)", importOnDuplicateFlag)
+	}
+	if mode == duplicateAsk && auto {
+		mode = duplicateMerge
+	}
+
+	d := &importDedupe{
+		mode:   mode,
+		reader: bufio.NewReader(os.Stdin),
+		tasks:  make(map[string][]*domain.Task),
+	}
+	if importAIDedupeFlag {
+		if llmClient == nil {
+			var err error
+			if llmClient, err = getLLMClient(); err != nil {
+				return err
+			}
+		}
+		d.detector.LLM = llmClient
+	}
+
+	importDuplicates = d
+	return nil
+}
+
+// project returns an existing project to use instead of creating one
+// titled title, or nil
+func (d *importDedupe) project(ctx context.Context, title string, projects []*domain.Project) *domain.Project {
+	if d == nil || d.mode == duplicateCreate {
+		return nil
+	}
+
+	match := d.detector.Find(ctx, "project", title, dedupe.Projects(projects))
+	if match == nil {
+		return nil
+	}
+
+	fmt.Printf("  %s %q looks like existing project %s\n", promptStyle.Render("?"), title, describeMatch(match))
+	if d.mode == duplicateAsk {
+		fmt.Print(promptStyle.Render("  Use the existing project? [Y/n]: "))
+		input, _ := d.reader.ReadString('\n')
+		input = strings.ToLower(strings.TrimSpace(input))
+		if input != "" && input != "y" && input != "yes" {
+			return nil
+		}
+	}
+
+	for _, p := range projects {
+		if p.ID == match.ID {
+			d.reused++
+			return p
+		}
+	}
+	return nil
+}
+
+// task checks a task about to be created against the tasks in its
+// project. It returns true if the task was merged into a similar one or
+// skipped, and so should not be created.
+func (d *importDedupe) task(ctx context.Context, task *domain.Task) (bool, error) {
+	if d == nil || d.mode == duplicateCreate {
+		return false, nil
+	}
+
+	existing, err := d.projectTasks(ctx, task.ProjectID)
+	if err != nil {
+		return false, err
+	}
+	match := d.detector.Find(ctx, "task", task.Title, dedupe.Tasks(existing))
+	if match == nil {
+		return false, nil
+	}
+
+	fmt.Printf("    %s looks like existing task %s\n", promptStyle.Render("?"), describeMatch(match))
+	action := d.mode
+	if action == duplicateAsk {
+		fmt.Print(promptStyle.Render("    [m]erge, [s]kip or [c]reate? [M/s/c]: "))
+		input, _ := d.reader.ReadString('\n')
+		switch strings.ToLower(strings.TrimSpace(input)) {
+		case "s", "skip":
+			action = duplicateSkip
+		case "c", "create":
+			action = duplicateCreate
+		default:
+			action = duplicateMerge
+		}
+	}
+
+	switch action {
+	case duplicateSkip:
+		d.skipped++
+		return true, nil
+	case duplicateMerge:
+		for _, t := range existing {
+			if t.ID == match.ID {
+				t.MergeFrom(task)
+				if err := client.UpdateTask(ctx, t); err != nil {
+					return false, fmt.Errorf("failed to merge into %q: %w", t.Title, err)
+				}
+				d.merged++
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// added records a created task so later imports are checked against it
+func (d *importDedupe) added(task *domain.Task) {
+	if d == nil {
+		return
+	}
+	if tasks, ok := d.tasks[task.ProjectID]; ok {
+		d.tasks[task.ProjectID] = append(tasks, task)
+	}
+}
+
+func (d *importDedupe) projectTasks(ctx context.Context, projectID string) ([]*domain.Task, error) {
+	if tasks, ok := d.tasks[projectID]; ok {
+		return tasks, nil
+	}
+	tasks, err := client.ListTasks(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	d.tasks[projectID] = tasks
+	return tasks, nil
+}
+
+// summary describes what duplicate handling did, for the end of an import
+func (d *importDedupe) summary() string {
+	if d == nil || d.reused+d.merged+d.skipped == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Duplicates: reused %d project(s), merged %d task(s), skipped %d", d.reused, d.merged, d.skipped)
+}
+
+func describeMatch(m *dedupe.Match) string {
+	if m.ByLLM {
+		return fmt.Sprintf("%q (AI match)", m.Title)
+	}
+	return fmt.Sprintf("%q (%.0f%% similar)", m.Title, m.Score*100)
+}
+
+// areaProjects returns the distinct projects of an area in the index,
+// sorted by title
+func (idx projectIndex) areaProjects(areaID string) []*domain.Project {
+	seen := make(map[string]bool)
+	var projects []*domain.Project
+	for _, p := range idx {
+		if p.AreaID == areaID && !seen[p.ID] {
+			seen[p.ID] = true
+			projects = append(projects, p)
+		}
+	}
+	sort.Slice(projects, func(i, j int) bool { return projects[i].Title < projects[j].Title })
+	return projects
+}
+
+// match returns the project titled title in an area, or one like it that
+// duplicate handling picks. Picked projects are indexed under title too, so
+// later items reuse them without asking again.
+func (idx projectIndex) match(ctx context.Context, areaID, title string) (*domain.Project, bool) {
+	if p, ok := idx.find(areaID, title); ok {
+		return p, true
+	}
+	p := importDuplicates.project(ctx, title, idx.areaProjects(areaID))
+	if p == nil {
+		return nil, false
+	}
+	idx[areaID+"/"+strings.ToLower(title)] = p
+	return p, true
+}
+
+// printDedupeSummary ends an import with what duplicate handling did
+func printDedupeSummary() {
+	if s := importDuplicates.summary(); s != "" {
+		fmt.Printf("%s %s\n", dimStyle.Render("·"), dimStyle.Render(s))
+	}
+}
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/backup"
+)
+
+var importFileUpdateFlag bool
+
+var importFileCmd = &cobra.Command{
+	Use:   "file [path]",
+	Short: "Restore areas, projects and tasks from an export",
+	Long: `Recreate areas, projects and tasks from a file written by 'reorg export',
+keeping their original IDs. The format is taken from the file extension
+(.json or .csv).
+
+Entities that already exist are skipped unless --update is given, in which
+case they are overwritten with the exported version. The whole import is
+recorded as a single commit.
+
+Examples:
+  reorg import file backup.json
+  reorg import file work.csv --update`,
+	Args: cobra.ExactArgs(1),
+	RunE: runImportFile,
+}
+
+func init() {
+	importCmd.AddCommand(importFileCmd)
+	importFileCmd.Flags().BoolVar(&importFileUpdateFlag, "update", false, "Overwrite entities that already exist")
+	importFileCmd.Flags().BoolVar(&importDryRunFlag, "dry-run", false, "Show what would be imported")
+}
+
+func runImportFile(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	path := args[0]
+
+	// The server API assigns new IDs, so restoring needs the local store
+	if store == nil {
+		return fmt.Errorf("import file is only available in embedded mode")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var data *backup.Data
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		data, err = backup.ReadJSON(f)
+	case ".csv":
+		data, err = backup.ReadCSV(f)
+	default:
+		return fmt.Errorf("unknown file type %q (use .json or .csv)", filepath.Ext(path))
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Found %d area(s), %d project(s) and %d task(s) in %s\n",
+		len(data.Areas), len(data.Projects), len(data.Tasks), filepath.Base(path))
+
+	if importDryRunFlag {
+		return nil
+	}
+
+	var result *backup.Result
+	err = store.Batch(ctx, "import file: "+filepath.Base(path), func(ctx context.Context) error {
+		result = backup.Restore(ctx, client, data, importFileUpdateFlag)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, e := range result.Errors {
+		fmt.Printf("  %s %v\n", dimStyle.Render("✗"), e)
+	}
+	fmt.Printf("%s Created %d, updated %d, skipped %d existing\n", successStyle.Render("✓"),
+		result.Created, result.Updated, result.Skipped)
+	if len(result.Errors) > 0 {
+		return fmt.Errorf("%d item(s) could not be imported", len(result.Errors))
+	}
+	return nil
+}
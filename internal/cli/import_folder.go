@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/integrations/folder"
+	"github.com/ihavespoons/reorg/internal/llm"
+)
+
+var importMoveToFlag string
+
+var importFolderCmd = &cobra.Command{
+	Use:   "folder [dir...]",
+	Short: "Import text and markdown files dropped into folders",
+	Long: `Import the text and markdown files in one or more folders, such as a
+Syncthing share, a scanner's output folder or Downloads, like notes: the
+AI categorizes each one and extracts its tasks. A markdown file's
+frontmatter title is used as its name.
+
+Each file is only imported once unless --all is given, and files changed
+in the last few seconds wait for the next run, in case they are still
+being written. With --move-to, imported files are moved into that folder;
+a relative path is inside each watched folder. The folders default to
+import.folder.dirs and the destination to import.folder.move_to.
+
+--watch keeps running and checks the folders again at that interval, plus
+up to import.watch.jitter, and not during import.watch.quiet_hours; it
+needs --auto, as nobody is there to answer prompts.
+
+Examples:
+  reorg import folder ~/Sync/notes
+  reorg import folder ~/Sync/notes ~/Scans --auto --watch 5m --move-to imported`,
+	RunE: runImportFolder,
+}
+
+func init() {
+	importCmd.AddCommand(importFolderCmd)
+	importFolderCmd.Flags().BoolVar(&importDryRunFlag, "dry-run", false, "Show what would be imported")
+	importFolderCmd.Flags().BoolVar(&importAutoFlag, "auto", false, "Auto-accept categorizations")
+	importFolderCmd.Flags().BoolVar(&importAllFlag, "all", false, "Import files that were imported before again")
+	importFolderCmd.Flags().DurationVar(&importWatchFlag, "watch", 0, "Keep checking the folders at this interval")
+	importFolderCmd.Flags().StringVar(&importMoveToFlag, "move-to", "", "Move imported files into this folder")
+	addDedupeFlags(importFolderCmd)
+	importFolderCmd.Flags().IntVar(&importWorkersFlag, "workers", 0, "Notes to analyze at once (default import.workers or 4)")
+}
+
+func runImportFolder(cmd *cobra.Command, args []string) error {
+	dirs := args
+	if len(dirs) == 0 {
+		dirs = viper.GetStringSlice("import.folder.dirs")
+	}
+	if len(dirs) == 0 {
+		return fmt.Errorf("no folder given (pass one or set import.folder.dirs)")
+	}
+	if importWatchFlag > 0 && !importAutoFlag {
+		return fmt.Errorf("--watch needs --auto")
+	}
+	for i, dir := range dirs {
+		abs, err := filepath.Abs(expandHome(dir))
+		if err != nil {
+			return err
+		}
+		dirs[i] = abs
+	}
+
+	moveTo := importMoveToFlag
+	if moveTo == "" {
+		moveTo = viper.GetString("import.folder.move_to")
+	}
+
+	llmClient, err := getLLMClient()
+	if err != nil {
+		return fmt.Errorf("failed to create LLM client: %w", err)
+	}
+
+	fmt.Println(titleStyle.Render("\n  Import from folders\n"))
+
+	return watchFolder(func(ctx context.Context) error {
+		return importFolderOnce(ctx, dirs, expandHome(moveTo), llmClient)
+	})
+}
+
+// importFolderOnce imports the new files in dirs and moves them into
+// moveTo, if set
+func importFolderOnce(ctx context.Context, dirs []string, moveTo string, llmClient llm.Client) error {
+	imported, err := loadImportedItems("folder")
+	if err != nil {
+		return err
+	}
+
+	var notes []genericNote
+	var done []folder.File
+	for _, dir := range dirs {
+		files, err := folder.List(dir)
+		if err != nil {
+			return err
+		}
+		for _, f := range files {
+			if !importAllFlag && !imported.isNew(f.Path, f.ModTime) {
+				continue
+			}
+			title, text, err := folder.Read(f)
+			if err != nil {
+				fmt.Printf("Error: failed to read %s: %v\n", f.Name, err)
+				continue
+			}
+			done = append(done, f)
+			if text == "" {
+				continue
+			}
+			notes = append(notes, genericNote{Name: title, Content: text, Source: "folder", Ref: f.Path})
+		}
+	}
+	if len(done) == 0 {
+		if importWatchFlag <= 0 {
+			fmt.Println("No new files found.")
+		}
+		return nil
+	}
+
+	if len(notes) > 0 {
+		fmt.Printf("Found %d file(s)\n\n", len(notes))
+		if err := processNotes(ctx, llmClient, notes); err != nil {
+			return err
+		}
+	}
+
+	if importDryRunFlag {
+		return nil
+	}
+	for _, f := range done {
+		imported.add(f.Path, f.ModTime)
+		if moveTo == "" {
+			continue
+		}
+		dest := moveTo
+		if !filepath.IsAbs(dest) {
+			dest = filepath.Join(filepath.Dir(f.Path), dest)
+		}
+		if _, err := folder.Move(f, dest); err != nil {
+			fmt.Printf("Error: %v\n", err)
+		}
+	}
+	return imported.save()
+}
@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/integrations/gitlab"
+)
+
+var (
+	importGitLabAreaFlag        string
+	importGitLabSkipReviewsFlag bool
+)
+
+var importGitLabCmd = &cobra.Command{
+	Use:   "gitlab",
+	Short: "Import your GitLab issues and review requests",
+	Long: `Import the GitLab issues assigned to you, and the merge requests you
+were asked to review as "Review: ..." tasks tagged review.
+
+Each item goes to a project named after its GitLab project, in the area
+import.gitlab.group_map gives for its group (the longest matching group
+path wins, so a subgroup can go elsewhere than its parent), or --area.
+Labels become tags, and issues are due on their own due date or their
+milestone's. Tasks link back to the issue or merge request.
+
+With --update, items imported before are brought up to date, so closing,
+reopening or merging in GitLab does the same to the task.
+
+The token is import.gitlab.token or GITLAB_TOKEN, a personal access token
+with the read_api scope. For a self-hosted instance, set import.gitlab.url.
+
+Examples:
+  reorg import gitlab --dry-run
+  reorg import gitlab --update --skip-completed`,
+	Args: cobra.NoArgs,
+	RunE: runImportGitLab,
+}
+
+func init() {
+	importCmd.AddCommand(importGitLabCmd)
+
+	importGitLabCmd.Flags().StringVarP(&importGitLabAreaFlag, "area", "a", "work", "Area for groups without a group_map entry")
+	importGitLabCmd.Flags().BoolVar(&importGitLabSkipReviewsFlag, "skip-reviews", false, "Don't import merge requests to review")
+	importGitLabCmd.Flags().BoolVar(&importAppSkipCompletedFlag, "skip-completed", false, "Don't import closed issues and merge requests")
+	importGitLabCmd.Flags().BoolVar(&importAppUpdateFlag, "update", false, "Update tasks imported before instead of skipping them")
+	importGitLabCmd.Flags().BoolVar(&importDryRunFlag, "dry-run", false, "Show what would be imported")
+	addDedupeFlags(importGitLabCmd)
+}
+
+func runImportGitLab(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	token := viper.GetString("import.gitlab.token")
+	if token == "" {
+		token = os.Getenv("GITLAB_TOKEN")
+	}
+	if token == "" {
+		return fmt.Errorf("no GitLab token (set import.gitlab.token or GITLAB_TOKEN)")
+	}
+	gc := gitlab.NewClient(token, viper.GetString("import.gitlab.url"))
+
+	issues, err := gc.AssignedIssues(ctx)
+	if err != nil {
+		return err
+	}
+	var reviews []gitlab.Issue
+	if !importGitLabSkipReviewsFlag {
+		if reviews, err = gc.ReviewRequests(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Keys are lowercased by viper
+	groupMap := viper.GetStringMapString("import.gitlab.group_map")
+
+	var tasks []appTask
+	for _, issue := range issues {
+		tasks = append(tasks, gitlabTask(issue, fmt.Sprintf("issue-%d", issue.ID), issue.Title, groupMap))
+	}
+	for _, mr := range reviews {
+		task := gitlabTask(mr, fmt.Sprintf("mr-%d", mr.ID), "Review: "+mr.Title, groupMap)
+		task.Tags = append(task.Tags, "review")
+		tasks = append(tasks, task)
+	}
+
+	// The flag is separate from the other app importers' for its default
+	importAppAreaFlag = importGitLabAreaFlag
+	return importApp(ctx, "GitLab", "gitlab", "", nil, tasks)
+}
+
+// gitlabTask maps an issue or merge request to a task
+func gitlabTask(issue gitlab.Issue, ref, title string, groupMap map[string]string) appTask {
+	project := issue.Project()
+	status := domain.TaskStatusCompleted
+	if issue.Open() {
+		status = domain.TaskStatusPending
+	}
+	return appTask{
+		Ref:      ref,
+		URL:      issue.WebURL,
+		Title:    title,
+		Notes:    issue.Description,
+		Project:  path.Base(project),
+		Area:     gitlabGroupArea(path.Dir(project), groupMap),
+		Tags:     issue.Labels,
+		Due:      issue.Due(),
+		AllDay:   true,
+		Status:   status,
+		Priority: domain.PriorityMedium,
+		Created:  issue.CreatedAt,
+		Updated:  issue.UpdatedAt,
+	}
+}
+
+// gitlabGroupArea returns the area group_map gives for a group path or the
+// closest parent group that has one, or "" for the default area
+func gitlabGroupArea(group string, groupMap map[string]string) string {
+	for group = strings.ToLower(group); group != "." && group != "/" && group != ""; group = path.Dir(group) {
+		if area, ok := groupMap[group]; ok {
+			return area
+		}
+	}
+	return ""
+}
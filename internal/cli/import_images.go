@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/integrations/images"
+	"github.com/ihavespoons/reorg/internal/llm"
+)
+
+var importImagesCmd = &cobra.Command{
+	Use:   "images [dir]",
+	Short: "Read photos of whiteboards and paper notes and import them",
+	Long: `Read the text in the photos and scans in a folder with OCR and import it
+like a note: the AI categorizes each one and extracts its tasks. The image
+is attached to the tasks created from it, or, when there is nothing to do,
+kept in a task of its own.
+
+Text is read with the macOS Vision framework (import.images.provider:
+vision, the default on macOS) or Tesseract (tesseract, the default
+elsewhere). Each file is only imported once unless --all is given. The
+folder defaults to import.images.dir.
+
+--watch keeps running and checks the folder again at that interval, plus
+up to import.watch.jitter, and not during import.watch.quiet_hours; it
+needs --auto, as nobody is there to answer prompts.
+
+Examples:
+  reorg import images ~/Pictures/Whiteboards
+  reorg import images ~/Scans --auto --watch 10m`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runImportImages,
+}
+
+func init() {
+	importCmd.AddCommand(importImagesCmd)
+	importImagesCmd.Flags().BoolVar(&importDryRunFlag, "dry-run", false, "Show what would be imported")
+	importImagesCmd.Flags().BoolVar(&importAutoFlag, "auto", false, "Auto-accept categorizations")
+	importImagesCmd.Flags().BoolVar(&importAllFlag, "all", false, "Import files that were imported before again")
+	importImagesCmd.Flags().DurationVar(&importWatchFlag, "watch", 0, "Keep checking the folder at this interval")
+	addDedupeFlags(importImagesCmd)
+	importImagesCmd.Flags().IntVar(&importWorkersFlag, "workers", 0, "Notes to analyze at once (default import.workers or 4)")
+}
+
+func runImportImages(cmd *cobra.Command, args []string) error {
+	dir, err := importFolderDir(args, "import.images.dir")
+	if err != nil {
+		return err
+	}
+
+	recognizer, err := images.NewRecognizer(images.Config{
+		Provider: viper.GetString("import.images.provider"),
+		Binary:   expandHome(viper.GetString("import.images.binary")),
+		Language: viper.GetString("import.images.language"),
+	})
+	if err != nil {
+		return err
+	}
+	llmClient, err := getLLMClient()
+	if err != nil {
+		return fmt.Errorf("failed to create LLM client: %w", err)
+	}
+
+	fmt.Println(titleStyle.Render("\n  Import images\n"))
+
+	return watchFolder(func(ctx context.Context) error {
+		return importImagesOnce(ctx, dir, recognizer, llmClient)
+	})
+}
+
+// importImagesOnce reads and imports the new images in dir
+func importImagesOnce(ctx context.Context, dir string, recognizer images.Recognizer, llmClient llm.Client) error {
+	files, err := images.List(dir)
+	if err != nil {
+		return err
+	}
+	imported, err := loadImportedItems("images")
+	if err != nil {
+		return err
+	}
+
+	var notes []genericNote
+	var done []images.File
+	for _, f := range files {
+		if !importAllFlag && !imported.isNew(f.Path, f.ModTime) {
+			continue
+		}
+		fmt.Printf("Reading %s...\n", f.Name)
+		text, err := recognizer.Recognize(ctx, f.Path)
+		if err != nil {
+			fmt.Printf("  Error: %v\n", err)
+			continue
+		}
+		done = append(done, f)
+		if text == "" {
+			fmt.Println(dimStyle.Render("  No text found, skipping"))
+			continue
+		}
+		notes = append(notes, genericNote{
+			Name:        f.Name,
+			Content:     text,
+			Source:      "images",
+			Ref:         f.Path,
+			Attachments: []string{f.Path},
+		})
+	}
+	if len(done) == 0 {
+		if importWatchFlag <= 0 {
+			fmt.Println("No new images found.")
+		}
+		return nil
+	}
+	fmt.Println()
+
+	if len(notes) > 0 {
+		if err := processNotes(ctx, llmClient, notes); err != nil {
+			return err
+		}
+	}
+
+	if importDryRunFlag {
+		return nil
+	}
+	for _, f := range done {
+		imported.add(f.Path, f.ModTime)
+	}
+	return imported.save()
+}
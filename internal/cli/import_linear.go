@@ -0,0 +1,216 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/integrations/linear"
+)
+
+var (
+	importLinearAreaFlag string
+	importLinearPushFlag bool
+)
+
+var importLinearCmd = &cobra.Command{
+	Use:   "linear",
+	Short: "Import and sync the Linear issues assigned to you",
+	Long: `Import the Linear issues assigned to you as tasks.
+
+Each issue goes to a project named after its Linear project, or its team
+if it has none, in the area import.linear.team_map gives for the team's
+key (e.g. ENG: work), or --area. Priorities and states carry over, and the
+due date is the issue's own or the end of its cycle. Tasks link back to
+their issue.
+
+With --update, issues imported before are brought up to date, so closing
+or reopening an issue in Linear does the same to its task. With --push,
+tasks completed, cancelled, started or reopened in reorg since their issue
+last changed move the issue to a matching state in Linear first.
+
+The API key is import.linear.api_key or LINEAR_API_KEY (create one under
+Settings > Security & access in Linear).
+
+Examples:
+  reorg import linear --dry-run
+  reorg import linear --update --push`,
+	Args: cobra.NoArgs,
+	RunE: runImportLinear,
+}
+
+func init() {
+	importCmd.AddCommand(importLinearCmd)
+
+	importLinearCmd.Flags().StringVarP(&importLinearAreaFlag, "area", "a", "work", "Area for teams without a team_map entry")
+	importLinearCmd.Flags().BoolVar(&importAppSkipCompletedFlag, "skip-completed", false, "Don't import completed and canceled issues")
+	importLinearCmd.Flags().BoolVar(&importAppUpdateFlag, "update", false, "Update tasks imported before instead of skipping them")
+	importLinearCmd.Flags().BoolVar(&importLinearPushFlag, "push", false, "Send state changes made in reorg back to Linear")
+	importLinearCmd.Flags().BoolVar(&importDryRunFlag, "dry-run", false, "Show what would be imported")
+	addDedupeFlags(importLinearCmd)
+}
+
+func runImportLinear(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	apiKey := viper.GetString("import.linear.api_key")
+	if apiKey == "" {
+		apiKey = os.Getenv("LINEAR_API_KEY")
+	}
+	if apiKey == "" {
+		return fmt.Errorf("no Linear API key (set import.linear.api_key or LINEAR_API_KEY)")
+	}
+	lc := linear.NewClient(apiKey, viper.GetString("import.linear.url"))
+
+	issues, err := lc.AssignedIssues(ctx)
+	if err != nil {
+		return err
+	}
+
+	if importLinearPushFlag {
+		if err := pushLinearStates(ctx, lc, issues); err != nil {
+			return err
+		}
+	}
+
+	// Keys are lowercased by viper
+	teamMap := viper.GetStringMapString("import.linear.team_map")
+
+	var tasks []appTask
+	for _, issue := range issues {
+		project := issue.Team.Name
+		if issue.Project != nil {
+			project = issue.Project.Name
+		}
+		task := appTask{
+			Ref:      issue.ID,
+			URL:      issue.URL,
+			Title:    issue.Title,
+			Notes:    issue.Description,
+			Project:  project,
+			Area:     teamMap[strings.ToLower(issue.Team.Key)],
+			Due:      issue.Due(),
+			AllDay:   true,
+			Status:   linearTaskStatus(issue.State.Type),
+			Priority: linearPriority(issue.Priority),
+			Created:  issue.CreatedAt,
+			Updated:  issue.UpdatedAt,
+		}
+		for _, label := range issue.Labels.Nodes {
+			task.Tags = append(task.Tags, label.Name)
+		}
+		tasks = append(tasks, task)
+	}
+
+	// The flag is separate from the other app importers' for its default
+	importAppAreaFlag = importLinearAreaFlag
+	return importApp(ctx, "Linear", "linear", "https://linear.app/issue/%s", nil, tasks)
+}
+
+// pushLinearStates moves issues whose task changed state in reorg after
+// the issue last changed to a matching state, and updates the issues to
+// match so the import doesn't undo the change
+func pushLinearStates(ctx context.Context, lc *linear.Client, issues []linear.Issue) error {
+	imported, err := importedTasks(ctx, "linear")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(titleStyle.Render("\n  Push to Linear\n"))
+	states := make(map[string][]linear.WorkflowState)
+	for i := range issues {
+		issue := &issues[i]
+		task := imported[issue.ID]
+		if task == nil || !task.Updated.After(issue.UpdatedAt) {
+			continue
+		}
+		want := linearStateType(task.Status)
+		if want == linearStateType(linearTaskStatus(issue.State.Type)) {
+			continue
+		}
+
+		if states[issue.Team.ID] == nil {
+			if states[issue.Team.ID], err = lc.TeamStates(ctx, issue.Team.ID); err != nil {
+				return fmt.Errorf("failed to read %s's workflow states: %w", issue.Team.Name, err)
+			}
+		}
+		state, ok := findLinearState(states[issue.Team.ID], want)
+		if !ok {
+			fmt.Printf("  %s %s\n", issue.Identifier, dimStyle.Render("(no "+want+" state to move to)"))
+			continue
+		}
+
+		fmt.Printf("  %s %s\n", issue.Identifier, dimStyle.Render("→ "+state.Name+" in Linear"))
+		if importDryRunFlag {
+			continue
+		}
+		if err := lc.SetState(ctx, issue.ID, state.ID); err != nil {
+			fmt.Printf("    %s\n", dimStyle.Render("Error: "+err.Error()))
+			continue
+		}
+		issue.State.Type, issue.State.Name = state.Type, state.Name
+		issue.UpdatedAt = task.Updated
+	}
+	return nil
+}
+
+// findLinearState picks the first state of a type in board order. Tasks
+// reopened in reorg go to the first unstarted state, or the backlog.
+func findLinearState(states []linear.WorkflowState, stateType string) (linear.WorkflowState, bool) {
+	types := []string{stateType}
+	if stateType == linear.StateUnstarted {
+		types = append(types, linear.StateBacklog)
+	}
+	for _, t := range types {
+		for _, s := range states {
+			if s.Type == t {
+				return s, true
+			}
+		}
+	}
+	return linear.WorkflowState{}, false
+}
+
+// linearTaskStatus maps a Linear state type to a task status
+func linearTaskStatus(stateType string) domain.TaskStatus {
+	switch stateType {
+	case linear.StateStarted:
+		return domain.TaskStatusInProgress
+	case linear.StateCompleted:
+		return domain.TaskStatusCompleted
+	case linear.StateCanceled:
+		return domain.TaskStatusCancelled
+	}
+	return domain.TaskStatusPending
+}
+
+// linearStateType maps a task status to the Linear state type for it
+func linearStateType(status domain.TaskStatus) string {
+	switch status {
+	case domain.TaskStatusInProgress:
+		return linear.StateStarted
+	case domain.TaskStatusCompleted:
+		return linear.StateCompleted
+	case domain.TaskStatusCancelled:
+		return linear.StateCanceled
+	}
+	return linear.StateUnstarted
+}
+
+// linearPriority maps Linear's priorities, where 0 is none and 1 urgent
+func linearPriority(p int) domain.Priority {
+	switch p {
+	case 1:
+		return domain.PriorityUrgent
+	case 2:
+		return domain.PriorityHigh
+	case 4:
+		return domain.PriorityLow
+	}
+	return domain.PriorityMedium
+}
@@ -0,0 +1,210 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/integrations/org"
+)
+
+var (
+	importOrgAreaFlag     string
+	importOrgSkipDoneFlag bool
+)
+
+var importOrgCmd = &cobra.Command{
+	Use:   "org [path]",
+	Short: "Import TODO headings from org-mode files",
+	Long: `Import TODO headings from an org-mode file or a directory of .org files.
+
+Each file becomes a project (named by #+TITLE or the file name) in --area,
+and each heading with a TODO keyword becomes a task. Priorities ([#A] high,
+[#B] medium, [#C] low), tags (including inherited and #+FILETAGS), DEADLINE
+as the due date, SCHEDULED as the scheduled date, and done states are
+carried over. Custom #+TODO keywords
+are respected. Headings that were imported before are skipped.
+
+Examples:
+  reorg import org ~/org
+  reorg import org ~/org/work.org --area work
+  reorg import org ~/org --skip-done --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runImportOrg,
+}
+
+func init() {
+	importCmd.AddCommand(importOrgCmd)
+	importOrgCmd.Flags().StringVarP(&importOrgAreaFlag, "area", "a", "personal", "Area to create projects in")
+	importOrgCmd.Flags().BoolVar(&importOrgSkipDoneFlag, "skip-done", false, "Don't import finished headings")
+	importOrgCmd.Flags().BoolVar(&importDryRunFlag, "dry-run", false, "Show what would be imported")
+	addDedupeFlags(importOrgCmd)
+}
+
+func runImportOrg(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	reader, err := org.NewReader(args[0])
+	if err != nil {
+		return err
+	}
+	files, err := reader.ListFiles(ctx)
+	if err != nil {
+		return err
+	}
+
+	area, err := findAreaByIDOrSlug(ctx, importOrgAreaFlag)
+	if err != nil {
+		return domain.Errorf(domain.ErrNotFound, "area not found: %s", importOrgAreaFlag)
+	}
+
+	if err := startDedupe(false, nil); err != nil {
+		return err
+	}
+
+	fmt.Println(titleStyle.Render("\n  Import from org-mode\n"))
+	fmt.Printf("Found %d file(s)\n\n", len(files))
+
+	var created, skipped int
+	run := func(ctx context.Context) error {
+		var err error
+		created, skipped, err = importOrgFiles(ctx, files, area)
+		return err
+	}
+	if store != nil && !importDryRunFlag {
+		err = store.Batch(ctx, fmt.Sprintf("import org: %d file(s)", len(files)), run)
+	} else {
+		err = run(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	verb := "Imported"
+	if importDryRunFlag {
+		verb = "Would import"
+	}
+	fmt.Printf("\n%s %s %d task(s), skipped %d\n", successStyle.Render("✓"), verb, created, skipped)
+	printDedupeSummary()
+	return nil
+}
+
+// importOrgFiles creates a project per file and a task per TODO heading
+func importOrgFiles(ctx context.Context, files []org.File, area *domain.Area) (created, skipped int, err error) {
+	projects, err := loadProjectIndex(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Headings are identified by org link, e.g. "work.org::*Call Sam"
+	imported, err := importedTasks(ctx, "org")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	now := time.Now()
+	for _, file := range files {
+		headings := file.Tasks()
+		if len(headings) == 0 {
+			continue
+		}
+		fmt.Printf("%s\n", file.Title)
+
+		var pending []*domain.Task
+		for _, h := range headings {
+			ref := file.RelativePath + "::*" + h.Title
+			if imported[ref] != nil || (importOrgSkipDoneFlag && h.Done) {
+				skipped++
+				continue
+			}
+
+			fmt.Printf("  %s %s\n", h.Title, dimStyle.Render(h.Keyword))
+			if importDryRunFlag {
+				created++
+				continue
+			}
+
+			project, err := projects.get(ctx, area, file.Title)
+			if err != nil {
+				return created, skipped, err
+			}
+
+			task := orgTask(h, project)
+			domain.Provenance{
+				Source:      "org",
+				SourceRef:   ref,
+				SourceTitle: h.Title,
+				ImportedAt:  &now,
+			}.WriteTo(task.Metadata)
+			task.ExternalRef = &domain.ExternalRef{Source: "org", ID: ref}
+
+			handled, err := importDuplicates.task(ctx, task)
+			if err != nil {
+				return created, skipped, err
+			}
+			if handled {
+				continue
+			}
+
+			importDuplicates.added(task)
+			imported[ref] = task
+			pending = append(pending, task)
+		}
+		if len(pending) == 0 {
+			continue
+		}
+
+		// One call per file, which counts in remote mode
+		results, err := client.BatchCreateTasks(ctx, pending)
+		if err != nil {
+			return created, skipped, fmt.Errorf("failed to create tasks: %w", err)
+		}
+		for _, r := range results {
+			if r.Err != nil {
+				fmt.Printf("    %s\n", dimStyle.Render("Error: "+r.Err.Error()))
+				skipped++
+				continue
+			}
+			created++
+		}
+	}
+
+	return created, skipped, nil
+}
+
+// orgTask converts a TODO heading to a task
+func orgTask(h org.Heading, project *domain.Project) *domain.Task {
+	task := domain.NewTask(h.Title, project.ID, project.AreaID)
+
+	switch {
+	case h.Keyword == "CANCELLED" || h.Keyword == "CANCELED":
+		task.Status = domain.TaskStatusCancelled
+	case h.Done:
+		task.Status = domain.TaskStatusCompleted
+	case h.Keyword == "NEXT" || h.Keyword == "STARTED":
+		task.Status = domain.TaskStatusInProgress
+	case h.Keyword == "WAITING" || h.Keyword == "HOLD":
+		task.Status = domain.TaskStatusBlocked
+	}
+
+	switch h.Priority {
+	case "A":
+		task.Priority = domain.PriorityHigh
+	case "C":
+		task.Priority = domain.PriorityLow
+	}
+
+	for _, tag := range h.Tags {
+		task.AddTag(tag)
+	}
+	task.DueDate = h.Deadline
+	task.AllDay = h.Deadline != nil && domain.IsMidnight(*h.Deadline)
+	task.ScheduledDate = h.Scheduled
+	if h.Body != "" {
+		task.Content = fmt.Sprintf("# %s\n\n%s\n", h.Title, h.Body)
+	}
+	return task
+}
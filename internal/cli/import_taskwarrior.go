@@ -0,0 +1,244 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/integrations/taskwarrior"
+	"github.com/ihavespoons/reorg/internal/service"
+)
+
+var (
+	importTWAreaFlag          string
+	importTWProjectFlag       string
+	importTWSkipCompletedFlag bool
+)
+
+var importTaskwarriorCmd = &cobra.Command{
+	Use:   "taskwarrior [file]",
+	Short: "Import tasks from Taskwarrior",
+	Long: `Import the output of Taskwarrior's 'task export' from a file or stdin.
+
+Projects named "Area.Project" go to the matching area; other projects are
+created in --area. Priorities, tags, due and scheduled dates, annotations
+and dependencies are mapped to reorg, and UDAs and other Taskwarrior fields are kept in
+metadata so 'reorg export --format taskwarrior' can round-trip them.
+Tasks that were imported before are skipped.
+
+Examples:
+  task export | reorg import taskwarrior
+  reorg import taskwarrior tasks.json --area work
+  reorg import taskwarrior tasks.json --skip-completed`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runImportTaskwarrior,
+}
+
+func init() {
+	importCmd.AddCommand(importTaskwarriorCmd)
+	importTaskwarriorCmd.Flags().StringVarP(&importTWAreaFlag, "area", "a", "personal", "Area for projects that don't name one")
+	importTaskwarriorCmd.Flags().StringVarP(&importTWProjectFlag, "project", "p", "Taskwarrior", "Project for tasks without a project")
+	importTaskwarriorCmd.Flags().BoolVar(&importTWSkipCompletedFlag, "skip-completed", false, "Don't import completed and deleted tasks")
+	importTaskwarriorCmd.Flags().BoolVar(&importDryRunFlag, "dry-run", false, "Show what would be imported")
+	addDedupeFlags(importTaskwarriorCmd)
+}
+
+func runImportTaskwarrior(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	var in io.Reader = os.Stdin
+	if len(args) > 0 && args[0] != "-" {
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	tasks, err := taskwarrior.Read(in)
+	if err != nil {
+		return err
+	}
+
+	defaultArea, err := findAreaByIDOrSlug(ctx, importTWAreaFlag)
+	if err != nil {
+		return domain.Errorf(domain.ErrNotFound, "area not found: %s", importTWAreaFlag)
+	}
+
+	if err := startDedupe(false, nil); err != nil {
+		return err
+	}
+
+	fmt.Println(titleStyle.Render("\n  Import from Taskwarrior\n"))
+
+	importer := &twImporter{defaultArea: defaultArea}
+	if err := importer.load(ctx); err != nil {
+		return err
+	}
+
+	run := func(ctx context.Context) error { return importer.run(ctx, tasks) }
+	if store != nil && !importDryRunFlag {
+		action := fmt.Sprintf("import Taskwarrior: %d task(s)", len(tasks))
+		err = store.Batch(ctx, action, run)
+	} else {
+		err = run(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	verb := "Imported"
+	if importDryRunFlag {
+		verb = "Would import"
+	}
+	fmt.Printf("\n%s %s %d task(s), skipped %d\n", successStyle.Render("✓"), verb, importer.created, importer.skipped)
+	printDedupeSummary()
+	return nil
+}
+
+// twImporter creates reorg tasks from Taskwarrior tasks
+type twImporter struct {
+	defaultArea *domain.Area
+	areas       []*domain.Area
+	projects    projectIndex
+	byUUID      map[string]*domain.Task // tasks imported now or earlier
+	created     int
+	skipped     int
+}
+
+// load reads existing areas, projects and previously imported tasks
+func (im *twImporter) load(ctx context.Context) error {
+	var err error
+	if im.areas, err = client.ListAreas(ctx); err != nil {
+		return err
+	}
+
+	if im.projects, err = loadProjectIndex(ctx); err != nil {
+		return err
+	}
+
+	im.byUUID, err = importedTasks(ctx, "taskwarrior")
+	return err
+}
+
+func (im *twImporter) run(ctx context.Context, tasks []taskwarrior.Task) error {
+	now := time.Now()
+	var pending []*domain.Task
+	var pendingTW []taskwarrior.Task
+
+	for _, tw := range tasks {
+		if _, ok := tw.TaskStatus(); !ok || im.byUUID[tw.UUID] != nil {
+			im.skipped++
+			continue
+		}
+		if importTWSkipCompletedFlag && (tw.Status == "completed" || tw.Status == "deleted") {
+			im.skipped++
+			continue
+		}
+
+		area, projectTitle := im.resolveProject(tw.Project)
+		fmt.Printf("  %s %s\n", tw.Description, dimStyle.Render("→ "+area.Title+" / "+projectTitle))
+		if importDryRunFlag {
+			im.created++
+			continue
+		}
+
+		project, err := im.projects.get(ctx, area, projectTitle)
+		if err != nil {
+			return err
+		}
+
+		task := tw.ToDomain(project.ID, area.ID)
+		domain.Provenance{
+			Source:      "taskwarrior",
+			SourceRef:   tw.UUID,
+			SourceTitle: tw.Description,
+			ImportedAt:  &now,
+		}.WriteTo(task.Metadata)
+		task.ExternalRef = &domain.ExternalRef{Source: "taskwarrior", ID: tw.UUID}
+
+		handled, err := importDuplicates.task(ctx, task)
+		if err != nil {
+			return err
+		}
+		if handled {
+			continue
+		}
+
+		// Counted now, so later tasks of the export are checked against it
+		importDuplicates.added(task)
+		pending = append(pending, task)
+		pendingTW = append(pendingTW, tw)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	// One call, and one commit, for the whole export
+	results, err := client.BatchCreateTasks(ctx, pending)
+	if err != nil {
+		return fmt.Errorf("failed to create tasks: %w", err)
+	}
+	var imported []taskwarrior.Task
+	for i, r := range results {
+		if r.Err != nil {
+			fmt.Printf("    %s\n", dimStyle.Render("Error: "+r.Err.Error()))
+			im.skipped++
+			continue
+		}
+		im.byUUID[pendingTW[i].UUID] = r.Task
+		imported = append(imported, pendingTW[i])
+		im.created++
+	}
+
+	// Dependencies can point at tasks later in the export, so link them
+	// once everything exists
+	var linked []*domain.Task
+	for _, tw := range imported {
+		task := im.byUUID[tw.UUID]
+		for _, dep := range tw.Depends {
+			if target := im.byUUID[strings.TrimSpace(dep)]; target != nil {
+				task.Dependencies = append(task.Dependencies, target.ID)
+			}
+		}
+		if len(task.Dependencies) > 0 {
+			linked = append(linked, task)
+		}
+	}
+	if len(linked) == 0 {
+		return nil
+	}
+	results, err = client.BatchUpdateTasks(ctx, linked)
+	if err != nil {
+		return fmt.Errorf("failed to link dependencies: %w", err)
+	}
+	if errs := service.BatchErrors(results); len(errs) > 0 {
+		return fmt.Errorf("failed to link dependencies: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// resolveProject splits a Taskwarrior project like "Work.Website" into an
+// area and a project title
+func (im *twImporter) resolveProject(name string) (*domain.Area, string) {
+	if name == "" {
+		return im.defaultArea, importTWProjectFlag
+	}
+
+	if first, rest, ok := strings.Cut(name, "."); ok {
+		for _, a := range im.areas {
+			if strings.EqualFold(a.Title, first) || strings.EqualFold(a.Slug(), first) {
+				return a, rest
+			}
+		}
+	}
+	return im.defaultArea, name
+}
@@ -0,0 +1,498 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/integrations/omnifocus"
+	"github.com/ihavespoons/reorg/internal/integrations/things"
+)
+
+var (
+	importAppDBFlag            string
+	importAppAreaFlag          string
+	importAppSkipCompletedFlag bool
+	importAppUpdateFlag        bool
+)
+
+var importThingsCmd = &cobra.Command{
+	Use:   "things",
+	Short: "Import areas, projects and to-dos from Things 3 (macOS)",
+	Long: `Import from the Things 3 database on macOS.
+
+Things areas become reorg areas (matched by name, created if missing), and
+projects and to-dos are created under them. To-dos without a project go to
+a "Things" project in their area, or in --area. Deadlines, tags, notes and
+completed or cancelled states are carried over. The database is read with
+the sqlite3 tool and is never modified. To-dos that were imported before
+are skipped, or brought up to date with --update.
+
+Examples:
+  reorg import things
+  reorg import things --skip-completed --dry-run
+  reorg import things --update
+  reorg import things --db ~/backup/main.sqlite`,
+	Args: cobra.NoArgs,
+	RunE: runImportThings,
+}
+
+var importOmniFocusCmd = &cobra.Command{
+	Use:   "omnifocus",
+	Short: "Import projects and actions from OmniFocus (macOS)",
+	Long: `Import from OmniFocus on macOS using JavaScript for Automation.
+
+Top-level folders become reorg areas (matched by name, created if missing),
+and projects and actions are created under them. Inbox actions go to an
+"OmniFocus" project in --area. Due dates, tags, notes, flags (as high
+priority) and completed or dropped states are carried over. OmniFocus must
+be installed, and macOS will ask to allow automation the first time.
+Actions that were imported before are skipped, or brought up to date with
+--update.
+
+Examples:
+  reorg import omnifocus
+  reorg import omnifocus --area work --skip-completed`,
+	Args: cobra.NoArgs,
+	RunE: runImportOmniFocus,
+}
+
+func init() {
+	importCmd.AddCommand(importThingsCmd)
+	importCmd.AddCommand(importOmniFocusCmd)
+
+	importThingsCmd.Flags().StringVar(&importAppDBFlag, "db", "", "Path to the Things database (default: found automatically)")
+	for _, cmd := range []*cobra.Command{importThingsCmd, importOmniFocusCmd} {
+		cmd.Flags().StringVarP(&importAppAreaFlag, "area", "a", "personal", "Area for items outside any area or folder")
+		cmd.Flags().BoolVar(&importAppSkipCompletedFlag, "skip-completed", false, "Don't import completed and cancelled items")
+		cmd.Flags().BoolVar(&importAppUpdateFlag, "update", false, "Update tasks imported before instead of skipping them")
+		cmd.Flags().BoolVar(&importDryRunFlag, "dry-run", false, "Show what would be imported")
+		addDedupeFlags(cmd)
+	}
+}
+
+// appProject and appTask are projects and tasks read from a task manager
+// app, before they are mapped to areas
+type appProject struct {
+	Ref     string
+	URL     string // link to the item, if the app gives one
+	Title   string
+	Notes   string
+	Area    string
+	Tags    []string
+	Due     *time.Time
+	Status  domain.ProjectStatus
+	Created time.Time
+}
+
+type appTask struct {
+	Ref      string
+	URL      string
+	Title    string
+	Notes    string
+	Project  string
+	Area     string
+	Tags     []string
+	Due      *time.Time
+	AllDay   bool // Due is a whole day, not a moment
+	Status   domain.TaskStatus
+	Priority domain.Priority
+	Created  time.Time
+	Updated  time.Time
+}
+
+func runImportThings(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	path := importAppDBFlag
+	if path == "" {
+		var err error
+		if path, err = things.DefaultPath(); err != nil {
+			return err
+		}
+	}
+
+	db, err := things.Read(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	taskStatus := func(s things.Status) domain.TaskStatus {
+		switch s {
+		case things.StatusCompleted:
+			return domain.TaskStatusCompleted
+		case things.StatusCancelled:
+			return domain.TaskStatusCancelled
+		}
+		return domain.TaskStatusPending
+	}
+
+	var projects []appProject
+	for _, p := range db.Projects {
+		status := domain.ProjectStatusActive
+		switch p.Status {
+		case things.StatusCompleted:
+			status = domain.ProjectStatusCompleted
+		case things.StatusCancelled:
+			status = domain.ProjectStatusArchived
+		}
+		projects = append(projects, appProject{
+			Ref:     p.UUID,
+			Title:   p.Title,
+			Notes:   p.Notes,
+			Area:    p.Area,
+			Tags:    p.Tags,
+			Due:     p.Deadline,
+			Status:  status,
+			Created: p.Created,
+		})
+	}
+
+	var tasks []appTask
+	for _, t := range db.Tasks {
+		tasks = append(tasks, appTask{
+			Ref:      t.UUID,
+			Title:    t.Title,
+			Notes:    t.Notes,
+			Project:  t.Project,
+			Area:     t.Area,
+			Tags:     t.Tags,
+			Due:      t.Deadline,
+			AllDay:   true,
+			Status:   taskStatus(t.Status),
+			Priority: domain.PriorityMedium,
+			Created:  t.Created,
+			Updated:  t.Modified,
+		})
+	}
+
+	return importApp(ctx, "Things", "things", "things:///show?id=%s", projects, tasks)
+}
+
+func runImportOmniFocus(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	db, err := omnifocus.NewReader().Read(ctx)
+	if err != nil {
+		return err
+	}
+
+	var projects []appProject
+	for _, p := range db.Projects {
+		status := domain.ProjectStatusActive
+		switch {
+		case p.Completed:
+			status = domain.ProjectStatusCompleted
+		case p.Dropped:
+			status = domain.ProjectStatusArchived
+		}
+		projects = append(projects, appProject{
+			Ref:    p.ID,
+			Title:  p.Name,
+			Notes:  p.Note,
+			Area:   p.Folder,
+			Due:    p.Due,
+			Status: status,
+		})
+	}
+
+	var tasks []appTask
+	for _, t := range db.Tasks {
+		task := appTask{
+			Ref:      t.ID,
+			Title:    t.Name,
+			Notes:    t.Note,
+			Project:  t.Project,
+			Area:     t.Folder,
+			Tags:     t.Tags,
+			Due:      t.Due,
+			Status:   domain.TaskStatusPending,
+			Priority: domain.PriorityMedium,
+		}
+		switch {
+		case t.Completed:
+			task.Status = domain.TaskStatusCompleted
+		case t.Dropped:
+			task.Status = domain.TaskStatusCancelled
+		}
+		if t.Flagged {
+			task.Priority = domain.PriorityHigh
+		}
+		if t.Created != nil {
+			task.Created = *t.Created
+		}
+		if t.Modified != nil {
+			task.Updated = *t.Modified
+		}
+		tasks = append(tasks, task)
+	}
+
+	return importApp(ctx, "OmniFocus", "omnifocus", "omnifocus:///task/%s", projects, tasks)
+}
+
+// importApp creates the areas, projects and tasks read from an app inside
+// one git commit
+func importApp(ctx context.Context, name, source, link string, projects []appProject, tasks []appTask) error {
+	defaultArea, err := findAreaByIDOrSlug(ctx, importAppAreaFlag)
+	if err != nil {
+		return domain.Errorf(domain.ErrNotFound, "area not found: %s", importAppAreaFlag)
+	}
+
+	if err := startDedupe(false, nil); err != nil {
+		return err
+	}
+
+	fmt.Println(titleStyle.Render(fmt.Sprintf("\n  Import from %s\n", name)))
+	fmt.Printf("Found %d project(s) and %d task(s)\n\n", len(projects), len(tasks))
+
+	importer := &appImporter{name: name, source: source, link: link, defaultArea: defaultArea}
+	if err := importer.load(ctx); err != nil {
+		return err
+	}
+
+	run := func(ctx context.Context) error { return importer.run(ctx, projects, tasks) }
+	if store != nil && !importDryRunFlag {
+		err = store.Batch(ctx, fmt.Sprintf("import %s: %d task(s)", name, len(tasks)), run)
+	} else {
+		err = run(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	verb := "Imported"
+	if importDryRunFlag {
+		verb = "Would import"
+	}
+	fmt.Printf("\n%s %s %d task(s)", successStyle.Render("✓"), verb, importer.created)
+	if importAppUpdateFlag {
+		fmt.Printf(", updated %d", importer.updated)
+	}
+	fmt.Printf(", skipped %d\n", importer.skipped)
+	printDedupeSummary()
+	return nil
+}
+
+// appImporter maps app areas and projects onto reorg ones
+type appImporter struct {
+	name        string
+	source      string
+	link        string // format for a link to an item, given its ref
+	defaultArea *domain.Area
+	areas       []*domain.Area
+	projects    projectIndex
+	imported    map[string]*domain.Task // by source ref
+	created     int
+	updated     int
+	skipped     int
+}
+
+// load reads existing areas, projects and previously imported items
+func (im *appImporter) load(ctx context.Context) error {
+	var err error
+	if im.areas, err = client.ListAreas(ctx); err != nil {
+		return err
+	}
+	if im.projects, err = loadProjectIndex(ctx); err != nil {
+		return err
+	}
+
+	im.imported, err = importedTasks(ctx, im.source)
+	return err
+}
+
+// ref returns the external reference for an app item, linking to url or,
+// if that is empty, the app's link for the item if it has one
+func (im *appImporter) ref(id, url string) *domain.ExternalRef {
+	if url == "" && im.link != "" {
+		url = fmt.Sprintf(im.link, id)
+	}
+	return &domain.ExternalRef{Source: im.source, ID: id, URL: url}
+}
+
+func (im *appImporter) run(ctx context.Context, projects []appProject, tasks []appTask) error {
+	now := time.Now()
+	provenance := func(ref, title string) domain.Provenance {
+		return domain.Provenance{Source: im.source, SourceRef: ref, SourceTitle: title, ImportedAt: &now}
+	}
+
+	// Projects are created up front so their details survive; ones that
+	// only hold skipped tasks are left out
+	wanted := make(map[string]bool)
+	for _, t := range tasks {
+		if !im.skip(t) && im.imported[t.Ref] == nil {
+			wanted[t.Area+"/"+t.Project] = true
+		}
+	}
+	for _, p := range projects {
+		if !wanted[p.Area+"/"+p.Title] || importDryRunFlag {
+			continue
+		}
+		area, err := im.area(ctx, p.Area)
+		if err != nil {
+			return err
+		}
+		if _, ok := im.projects.match(ctx, area.ID, p.Title); ok {
+			continue
+		}
+
+		project := domain.NewProject(p.Title, area.ID)
+		project.Status = p.Status
+		project.DueDate = p.Due
+		for _, tag := range p.Tags {
+			project.AddTag(tag)
+		}
+		if p.Notes != "" {
+			project.Content = fmt.Sprintf("# %s\n\n%s\n", p.Title, p.Notes)
+		}
+		if !p.Created.IsZero() {
+			project.Created = p.Created.UTC()
+		}
+		provenance(p.Ref, p.Title).WriteTo(project.Metadata)
+		project.ExternalRef = im.ref(p.Ref, p.URL)
+
+		if project, err = client.CreateProject(ctx, project); err != nil {
+			return fmt.Errorf("failed to create project %q: %w", p.Title, err)
+		}
+		im.projects.add(project)
+	}
+
+	for _, t := range tasks {
+		if im.skip(t) {
+			im.skipped++
+			continue
+		}
+
+		existing := im.imported[t.Ref]
+		projectTitle := t.Project
+		if projectTitle == "" {
+			projectTitle = im.name
+		}
+		areaTitle := t.Area
+		if areaTitle == "" {
+			areaTitle = im.defaultArea.Title
+		}
+		if existing != nil {
+			fmt.Printf("  %s %s\n", t.Title, dimStyle.Render("(update)"))
+		} else {
+			fmt.Printf("  %s %s\n", t.Title, dimStyle.Render("→ "+areaTitle+" / "+projectTitle))
+		}
+		if importDryRunFlag {
+			if existing != nil {
+				im.updated++
+			} else {
+				im.created++
+			}
+			continue
+		}
+
+		// Updated tasks stay where they are, even if moved since
+		var projectID, areaID string
+		if existing != nil {
+			projectID, areaID = existing.ProjectID, existing.AreaID
+		} else {
+			area, err := im.area(ctx, t.Area)
+			if err != nil {
+				return err
+			}
+			project, err := im.projects.get(ctx, area, projectTitle)
+			if err != nil {
+				return err
+			}
+			projectID, areaID = project.ID, area.ID
+		}
+
+		task := domain.NewTask(t.Title, projectID, areaID)
+		task.Status = t.Status
+		task.Priority = t.Priority
+		task.DueDate = t.Due
+		task.AllDay = t.AllDay
+		for _, tag := range t.Tags {
+			task.AddTag(tag)
+		}
+		if t.Notes != "" {
+			task.Content = fmt.Sprintf("# %s\n\n%s\n", t.Title, t.Notes)
+		}
+		if !t.Created.IsZero() {
+			task.Created = t.Created.UTC()
+		}
+		if !t.Updated.IsZero() {
+			task.Updated = t.Updated.UTC()
+		}
+		provenance(t.Ref, t.Title).WriteTo(task.Metadata)
+		task.ExternalRef = im.ref(t.Ref, t.URL)
+
+		if existing == nil {
+			handled, err := importDuplicates.task(ctx, task)
+			if err != nil {
+				return err
+			}
+			if handled {
+				continue
+			}
+		}
+
+		saved, created, err := im.upsert(ctx, existing, task)
+		if err != nil {
+			fmt.Printf("    %s\n", dimStyle.Render("Error: "+err.Error()))
+			im.skipped++
+			continue
+		}
+		im.imported[t.Ref] = saved
+		if created {
+			importDuplicates.added(saved)
+			im.created++
+		} else {
+			im.updated++
+		}
+	}
+
+	return nil
+}
+
+// upsert creates a task or updates the one imported before. Tasks imported
+// before external references were recorded get one first, so the update
+// finds them.
+func (im *appImporter) upsert(ctx context.Context, existing, task *domain.Task) (*domain.Task, bool, error) {
+	if existing != nil && existing.ExternalRef == nil {
+		existing.ExternalRef = task.ExternalRef
+		if err := client.UpdateTask(ctx, existing); err != nil {
+			return nil, false, err
+		}
+	}
+	return client.UpsertTask(ctx, task)
+}
+
+// skip reports whether a task was imported before or is filtered out
+func (im *appImporter) skip(t appTask) bool {
+	if im.imported[t.Ref] != nil && !importAppUpdateFlag {
+		return true
+	}
+	done := t.Status == domain.TaskStatusCompleted || t.Status == domain.TaskStatusCancelled
+	return importAppSkipCompletedFlag && done
+}
+
+// area returns the reorg area matching an app area or folder by name,
+// creating it if needed. An empty title is the default area.
+func (im *appImporter) area(ctx context.Context, title string) (*domain.Area, error) {
+	if title == "" {
+		return im.defaultArea, nil
+	}
+	for _, a := range im.areas {
+		if strings.EqualFold(a.Title, title) || strings.EqualFold(a.Slug(), title) {
+			return a, nil
+		}
+	}
+
+	area, err := client.CreateArea(ctx, domain.NewArea(title))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create area %q: %w", title, err)
+	}
+	fmt.Printf("  %s\n", dimStyle.Render("Created area "+title))
+	im.areas = append(im.areas, area)
+	return area, nil
+}
@@ -0,0 +1,416 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/term"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ihavespoons/reorg/internal/audit"
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/integrations/obsidian"
+)
+
+var inboxCmd = &cobra.Command{
+	Use:   "inbox",
+	Short: "Triage inbox items interactively",
+	Long: `Sweep through the inbox in one pass. Select items and act on all of them
+with a single keystroke:
+
+  ↑/↓ j/k   move            space   select
+  a         select all      t       convert to tasks in a project
+  p         turn into projects in an area
+  z         snooze until tomorrow
+  d         delete          q       quit
+
+Items are read from ~/.reorg/inbox/. To categorize them with AI instead, use
+'reorg import inbox'.`,
+	RunE: runInbox,
+}
+
+func init() {
+	rootCmd.AddCommand(inboxCmd)
+}
+
+// snoozeFile records snoozed inbox items, keyed by path relative to the inbox
+const snoozeFile = ".snoozed.yaml"
+
+// inboxItem is a note in the inbox and whether it is selected
+type inboxItem struct {
+	note     obsidian.Note
+	selected bool
+}
+
+// inboxTriage holds the state of an interactive triage session
+type inboxTriage struct {
+	dir     string
+	items   []*inboxItem
+	cursor  int
+	status  string
+	snoozed map[string]time.Time
+	raw     *term.State
+}
+
+func runInbox(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	if !term.IsTerminal(os.Stdin.Fd()) {
+		return fmt.Errorf("reorg inbox needs an interactive terminal (use 'reorg import inbox' instead)")
+	}
+
+	t := &inboxTriage{dir: filepath.Join(dataDir, "inbox")}
+	if err := t.load(ctx); err != nil {
+		return err
+	}
+	if len(t.items) == 0 {
+		fmt.Println("Inbox is empty.")
+		return nil
+	}
+
+	if err := t.enterRaw(); err != nil {
+		return err
+	}
+	defer t.exitRaw()
+
+	buf := make([]byte, 3)
+	for len(t.items) > 0 {
+		t.render()
+
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			return err
+		}
+		key := string(buf[:n])
+
+		switch key {
+		case "q", "\x03", "\x1b":
+			t.exitRaw()
+			fmt.Println()
+			return nil
+		case "j", "\x1b[B":
+			if t.cursor < len(t.items)-1 {
+				t.cursor++
+			}
+		case "k", "\x1b[A":
+			if t.cursor > 0 {
+				t.cursor--
+			}
+		case " ":
+			t.items[t.cursor].selected = !t.items[t.cursor].selected
+			if t.cursor < len(t.items)-1 {
+				t.cursor++
+			}
+		case "a":
+			all := len(t.selection()) < len(t.items)
+			for _, item := range t.items {
+				item.selected = all
+			}
+		case "t":
+			t.act("tasks", "Project for tasks", t.convertToTasks)
+		case "p":
+			t.act("projects", "Area for projects", t.convertToProjects)
+		case "z":
+			t.snooze()
+		case "d":
+			t.act("delete", "Delete selected items? [y/N]", t.delete)
+		}
+	}
+
+	t.exitRaw()
+	fmt.Println(t.status)
+	fmt.Println(successStyle.Render("✓ Inbox zero"))
+	return nil
+}
+
+// load reads inbox notes, skipping any that are still snoozed
+func (t *inboxTriage) load(ctx context.Context) error {
+	t.snoozed = make(map[string]time.Time)
+	if data, err := os.ReadFile(filepath.Join(t.dir, snoozeFile)); err == nil {
+		if err := yaml.Unmarshal(data, &t.snoozed); err != nil {
+			return fmt.Errorf("failed to read snoozed items: %w", err)
+		}
+	}
+
+	if _, err := os.Stat(t.dir); os.IsNotExist(err) {
+		return nil
+	}
+
+	reader, err := obsidian.NewReader(t.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read inbox: %w", err)
+	}
+	notes, err := reader.ListNotes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read inbox notes: %w", err)
+	}
+
+	now := time.Now()
+	for _, note := range notes {
+		if until, ok := t.snoozed[note.RelativePath]; ok && now.Before(until) {
+			continue
+		}
+		t.items = append(t.items, &inboxItem{note: note})
+	}
+	return nil
+}
+
+// selection returns the selected items, which is empty if nothing is
+// selected. targets falls back to the item under the cursor.
+func (t *inboxTriage) selection() []*inboxItem {
+	var selected []*inboxItem
+	for _, item := range t.items {
+		if item.selected {
+			selected = append(selected, item)
+		}
+	}
+	return selected
+}
+
+func (t *inboxTriage) targets() []*inboxItem {
+	if selected := t.selection(); len(selected) > 0 {
+		return selected
+	}
+	return []*inboxItem{t.items[t.cursor]}
+}
+
+// act prompts for a line of input and applies fn to the targeted items,
+// committing the result as one changeset named after action
+func (t *inboxTriage) act(action, prompt string, fn func(ctx context.Context, items []*inboxItem, input string) error) {
+	items := t.targets()
+
+	t.exitRaw()
+	fmt.Printf("\n%s ", promptStyle.Render(prompt+":"))
+	input, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	input = strings.TrimSpace(input)
+
+	ctx := context.Background()
+	apply := func() error { return fn(ctx, items, input) }
+	if store != nil {
+		apply = func() error {
+			return store.Batch(ctx, fmt.Sprintf("inbox %s: %d item(s)", action, len(items)), func(ctx context.Context) error {
+				return fn(ctx, items, input)
+			})
+		}
+	}
+
+	if input == "" {
+		t.status = "Cancelled"
+	} else if err := apply(); err != nil {
+		t.status = "Error: " + err.Error()
+	}
+
+	if err := t.enterRaw(); err != nil {
+		t.status = "Error: " + err.Error()
+	}
+}
+
+// convertToTasks creates a task in the given project for each item
+func (t *inboxTriage) convertToTasks(ctx context.Context, items []*inboxItem, projectSlug string) error {
+	project, err := findProject(ctx, projectSlug)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		task := domain.NewTask(item.note.Name, project.ID, project.AreaID)
+		task.Content = item.note.Content
+		inboxProvenance(item).WriteTo(task.Metadata)
+		if _, err := client.CreateTask(ctx, task); err != nil {
+			return fmt.Errorf("failed to create task %q: %w", item.note.Name, err)
+		}
+		if err := t.remove(item); err != nil {
+			return err
+		}
+	}
+
+	t.status = fmt.Sprintf("Created %d task(s) in %s", len(items), project.Title)
+	return nil
+}
+
+// convertToProjects creates a project in the given area for each item
+func (t *inboxTriage) convertToProjects(ctx context.Context, items []*inboxItem, areaSlug string) error {
+	area, err := client.GetAreaBySlug(ctx, areaSlug)
+	if err != nil {
+		return domain.Errorf(domain.ErrNotFound, "area not found: %s", areaSlug)
+	}
+
+	for _, item := range items {
+		project := domain.NewProject(item.note.Name, area.ID)
+		project.Content = item.note.Content
+		inboxProvenance(item).WriteTo(project.Metadata)
+		if _, err := client.CreateProject(ctx, project); err != nil {
+			return fmt.Errorf("failed to create project %q: %w", item.note.Name, err)
+		}
+		if err := t.remove(item); err != nil {
+			return err
+		}
+	}
+
+	t.status = fmt.Sprintf("Created %d project(s) in %s", len(items), area.Title)
+	return nil
+}
+
+// delete removes the items from the inbox after confirmation
+func (t *inboxTriage) delete(_ context.Context, items []*inboxItem, confirm string) error {
+	if confirm = strings.ToLower(confirm); confirm != "y" && confirm != "yes" {
+		t.status = "Cancelled"
+		return nil
+	}
+
+	for _, item := range items {
+		if err := t.remove(item); err != nil {
+			return err
+		}
+	}
+
+	t.status = fmt.Sprintf("Deleted %d item(s)", len(items))
+	return nil
+}
+
+// snooze hides the targeted items until tomorrow morning
+func (t *inboxTriage) snooze() {
+	items := t.targets()
+	now := time.Now().In(timezone)
+	until := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, timezone)
+
+	for _, item := range items {
+		t.snoozed[item.note.RelativePath] = until
+		t.drop(item)
+	}
+
+	if err := t.saveSnoozed(); err != nil {
+		t.status = "Error: " + err.Error()
+		return
+	}
+	t.status = fmt.Sprintf("Snoozed %d item(s) until tomorrow", len(items))
+}
+
+// remove deletes an item's file and drops it from the list
+func (t *inboxTriage) remove(item *inboxItem) error {
+	if dryRunFile(audit.Delete, item.note.Path) {
+		t.drop(item)
+		return nil
+	}
+	if err := os.Remove(item.note.Path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove %s: %w", item.note.RelativePath, err)
+	}
+	t.drop(item)
+
+	if _, ok := t.snoozed[item.note.RelativePath]; ok {
+		delete(t.snoozed, item.note.RelativePath)
+		return t.saveSnoozed()
+	}
+	return nil
+}
+
+func (t *inboxTriage) drop(item *inboxItem) {
+	for i, it := range t.items {
+		if it == item {
+			t.items = append(t.items[:i], t.items[i+1:]...)
+			break
+		}
+	}
+	if t.cursor >= len(t.items) && t.cursor > 0 {
+		t.cursor = len(t.items) - 1
+	}
+}
+
+func (t *inboxTriage) saveSnoozed() error {
+	path := filepath.Join(t.dir, snoozeFile)
+	if dryRunFile(audit.Update, path) {
+		return nil
+	}
+
+	// Forget snoozes that have expired
+	now := time.Now()
+	for path, until := range t.snoozed {
+		if !now.Before(until) {
+			delete(t.snoozed, path)
+		}
+	}
+
+	data, err := yaml.Marshal(t.snoozed)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// inboxProvenance records that an entity was created from an inbox item
+func inboxProvenance(item *inboxItem) domain.Provenance {
+	now := time.Now()
+	return domain.Provenance{
+		Source:      "inbox",
+		SourceRef:   item.note.Path,
+		SourceTitle: item.note.Name,
+		ImportedAt:  &now,
+	}
+}
+
+func (t *inboxTriage) render() {
+	cursorStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12"))
+
+	var b strings.Builder
+	b.WriteString("\x1b[H\x1b[2J")
+	b.WriteString(titleStyle.Render("  Inbox") + "\n\n")
+
+	for i, item := range t.items {
+		check := "[ ]"
+		if item.selected {
+			check = "[x]"
+		}
+		line := fmt.Sprintf("%s %s  %s", check, item.note.Name, dimStyle.Render(inboxPreview(item.note.Content)))
+		if i == t.cursor {
+			b.WriteString(cursorStyle.Render("> ") + line + "\n")
+		} else {
+			b.WriteString("  " + line + "\n")
+		}
+	}
+
+	b.WriteString("\n" + dimStyle.Render("space select · a all · t tasks · p projects · z snooze · d delete · q quit") + "\n")
+	if t.status != "" {
+		b.WriteString(t.status + "\n")
+	}
+
+	// Raw mode needs explicit carriage returns
+	fmt.Print(strings.ReplaceAll(b.String(), "\n", "\r\n"))
+}
+
+// inboxPreview returns the first line of body text, shortened for the list
+func inboxPreview(content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if r := []rune(line); len(r) > 50 {
+			line = string(r[:50]) + "…"
+		}
+		return line
+	}
+	return ""
+}
+
+func (t *inboxTriage) enterRaw() error {
+	state, err := term.MakeRaw(os.Stdin.Fd())
+	if err != nil {
+		return fmt.Errorf("failed to set up terminal: %w", err)
+	}
+	t.raw = state
+	return nil
+}
+
+func (t *inboxTriage) exitRaw() {
+	if t.raw != nil {
+		_ = term.Restore(os.Stdin.Fd(), t.raw)
+		t.raw = nil
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/spf13/cobra"
 
+	"github.com/ihavespoons/reorg/internal/audit"
 	"github.com/ihavespoons/reorg/internal/domain"
 	"github.com/ihavespoons/reorg/internal/storage/markdown"
 )
@@ -52,7 +53,7 @@ func init() {
 }
 
 func runInit(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 
 	fmt.Println(titleStyle.Render("\n  Reorg - Personal Organization Tool\n"))
 
@@ -60,6 +61,9 @@ func runInit(cmd *cobra.Command, args []string) error {
 	if _, err := os.Stat(filepath.Join(dataDir, "areas")); err == nil {
 		return fmt.Errorf("reorg is already initialized at %s", dataDir)
 	}
+	if dryRunFile(audit.Create, dataDir) {
+		return nil
+	}
 
 	fmt.Printf("Initializing reorg in %s\n\n", dimStyle.Render(dataDir))
 
@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/llm"
+	"github.com/ihavespoons/reorg/internal/usage"
+)
+
+var (
+	llmUsageDaysFlag  int
+	llmUsageWeeksFlag int
+)
+
+// llmCaller names the command making model requests in the usage ledger
+var llmCaller string
+
+var llmCmd = &cobra.Command{
+	Use:   "llm",
+	Short: "Inspect AI provider usage",
+}
+
+var llmUsageCmd = &cobra.Command{
+	Use:   "usage",
+	Short: "Show tokens used and estimated cost",
+	Long: `Show the tokens used by AI features and their estimated cost per day,
+per week and per command, and how much of llm.budget.monthly is spent.
+
+Costs use Claude list prices (override with llm.pricing); local Ollama
+models are free. Claude Code doesn't report tokens, so its counts are
+estimated from the text length and marked with ~.`,
+	Args: cobra.NoArgs,
+	RunE: runLLMUsage,
+}
+
+func init() {
+	rootCmd.AddCommand(llmCmd)
+	llmCmd.AddCommand(llmUsageCmd)
+
+	llmUsageCmd.Flags().IntVar(&llmUsageDaysFlag, "days", 7, "Number of days to show")
+	llmUsageCmd.Flags().IntVar(&llmUsageWeeksFlag, "weeks", 4, "Number of weeks to show")
+}
+
+// usageLedger returns the ledger model requests are recorded in, with the
+// configured prices and budget
+func usageLedger() *usage.Ledger {
+	ledger := usage.NewLedger(filepath.Join(stateDir(), "llm-usage.jsonl"))
+	ledger.Caller = llmCaller
+	ledger.MonthlyBudget = viper.GetFloat64("llm.budget.monthly")
+	_ = viper.UnmarshalKey("llm.pricing", &ledger.Prices)
+	return ledger
+}
+
+// budgetNotice tells the user once when the budget stops AI requests,
+// which otherwise quietly fall back to heuristic extraction
+type budgetNotice struct {
+	*usage.Ledger
+	once sync.Once
+}
+
+func (b *budgetNotice) Allow(provider llm.Provider, model string) error {
+	err := b.Ledger.Allow(provider, model)
+	if err != nil {
+		b.once.Do(func() {
+			fmt.Println(dimStyle.Render(fmt.Sprintf("AI paused (%v)", err)))
+		})
+	}
+	return err
+}
+
+func runLLMUsage(cmd *cobra.Command, args []string) error {
+	ledger := usageLedger()
+	now := time.Now().In(timezone)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	// Weeks start on Monday, as ISO weeks do
+	dayStart := today.AddDate(0, 0, -(llmUsageDaysFlag - 1))
+	weekStart := today.AddDate(0, 0, -((int(today.Weekday())+6)%7 + 7*(llmUsageWeeksFlag-1)))
+	since := dayStart
+	if weekStart.Before(since) {
+		since = weekStart
+	}
+	entries, err := ledger.Entries(since)
+	if err != nil {
+		return err
+	}
+
+	var daily, weekly []usage.Entry
+	for _, e := range entries {
+		if !e.Time.Before(dayStart) {
+			daily = append(daily, e)
+		}
+		if !e.Time.Before(weekStart) {
+			weekly = append(weekly, e)
+		}
+	}
+
+	fmt.Println(titleStyle.Render("Daily"))
+	printUsage("DAY", usage.Summarize(daily, func(e usage.Entry) string {
+		return e.Time.In(timezone).Format("2006-01-02")
+	}))
+
+	fmt.Println(titleStyle.Render("Weekly"))
+	printUsage("WEEK", usage.Summarize(weekly, func(e usage.Entry) string {
+		year, week := e.Time.In(timezone).ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	}))
+
+	fmt.Println(titleStyle.Render(fmt.Sprintf("By command (last %d days)", llmUsageDaysFlag)))
+	printUsage("COMMAND", usage.Summarize(daily, func(e usage.Entry) string {
+		if e.Caller == "" {
+			return "-"
+		}
+		return e.Caller
+	}))
+
+	spent, err := ledger.MonthSpend(now)
+	if err != nil {
+		return err
+	}
+	if ledger.MonthlyBudget > 0 {
+		line := fmt.Sprintf("This month: $%.2f of $%.2f budget", spent, ledger.MonthlyBudget)
+		if spent >= ledger.MonthlyBudget {
+			line += " (paid AI requests paused)"
+		}
+		fmt.Println(line)
+	} else {
+		fmt.Printf("This month: $%.2f\n", spent)
+	}
+	return nil
+}
+
+func printUsage(label string, totals []usage.Total) {
+	if len(totals) == 0 {
+		fmt.Println(dimStyle.Render("No AI requests recorded.") + "\n")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "%s\tCALLS\tINPUT\tOUTPUT\tCOST\n", label)
+	_, _ = fmt.Fprintln(w, "--\t-----\t-----\t------\t----")
+	for _, t := range totals {
+		approx := ""
+		if t.Estimated {
+			approx = "~"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%s%d\t%s%d\t$%.4f\n",
+			t.Key, t.Calls, approx, t.InputTokens, approx, t.OutputTokens, t.Cost)
+	}
+	_ = w.Flush()
+	fmt.Println()
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/llm"
+)
+
+// providerChain builds a client trying each provider listed under
+// llm.providers in order. Entries are a provider name or a map with
+// provider, model, base_url and api_key; settings an entry leaves out come
+// from the top-level llm settings when it is the configured llm.provider.
+// Providers that can't be set up (e.g. claude without an API key) are
+// skipped.
+func providerChain(base llm.Config) (llm.Client, error) {
+	entries, ok := viper.Get("llm.providers").([]any)
+	if !ok {
+		return nil, fmt.Errorf("llm.providers must be a list")
+	}
+
+	var clients []llm.Client
+	for _, entry := range entries {
+		cfg := llm.Config{APIKey: base.APIKey, Areas: base.Areas, Prompts: base.Prompts, Usage: base.Usage}
+		switch e := entry.(type) {
+		case string:
+			cfg.Provider = llm.Provider(e)
+		case map[string]any:
+			get := func(key string) string {
+				s, _ := e[key].(string)
+				return s
+			}
+			cfg.Provider = llm.Provider(get("provider"))
+			cfg.Model = get("model")
+			cfg.BaseURL = get("base_url")
+			if key := get("api_key"); key != "" {
+				cfg.APIKey = key
+			}
+		}
+		if cfg.Provider == "" {
+			return nil, fmt.Errorf("llm.providers entry without a provider: %v", entry)
+		}
+		if cfg.Provider == base.Provider {
+			if cfg.Model == "" {
+				cfg.Model = base.Model
+			}
+			if cfg.BaseURL == "" {
+				cfg.BaseURL = base.BaseURL
+			}
+		}
+
+		c, err := llm.NewClient(cfg)
+		if err != nil {
+			reason, _, _ := strings.Cut(err.Error(), "\n")
+			fmt.Println(dimStyle.Render(fmt.Sprintf("Skipping %s (%s)", cfg.Provider, reason)))
+			continue
+		}
+		clients = append(clients, c)
+	}
+	if len(clients) == 0 {
+		return nil, fmt.Errorf("none of the providers in llm.providers could be set up")
+	}
+
+	chain := llm.NewChainClient(clients...)
+	if viper.IsSet("llm.circuit_breaker.failures") {
+		chain.Failures = viper.GetInt("llm.circuit_breaker.failures")
+	}
+	if viper.IsSet("llm.circuit_breaker.cooldown") {
+		chain.Cooldown = viper.GetDuration("llm.circuit_breaker.cooldown")
+	}
+	return chain, nil
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/term"
+)
+
+// showRawFlag turns off markdown rendering in the show commands
+var showRawFlag bool
+
+// printDescription prints the markdown body of an item under a heading.
+// It's rendered with glamour on a terminal, and printed as written with
+// --raw or when the output is piped.
+func printDescription(content string, labelStyle lipgloss.Style) {
+	if content == "" {
+		return
+	}
+	fmt.Println(labelStyle.Render("Description:"))
+	fmt.Println(renderMarkdown(content))
+	fmt.Println()
+}
+
+// renderMarkdown styles markdown for the terminal, falling back to the
+// plain text when it can't
+func renderMarkdown(content string) string {
+	if showRawFlag || !term.IsTerminal(os.Stdout.Fd()) {
+		return content
+	}
+	r, err := glamour.NewTermRenderer(
+		glamour.WithAutoStyle(),
+		glamour.WithWordWrap(min(terminalWidth(), 100)-4),
+	)
+	if err != nil {
+		return content
+	}
+	out, err := r.Render(content)
+	if err != nil {
+		return content
+	}
+	return strings.Trim(out, "\n")
+}
@@ -5,14 +5,19 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
+	"github.com/ihavespoons/reorg/internal/dateparse"
 	mcpserver "github.com/ihavespoons/reorg/internal/mcp"
-	"github.com/ihavespoons/reorg/internal/service"
+	"github.com/ihavespoons/reorg/internal/plan"
 	"github.com/ihavespoons/reorg/internal/storage/markdown"
 )
 
+var mcpReadOnlyFlag bool
+
 var mcpCmd = &cobra.Command{
 	Use:   "mcp",
 	Short: "Start MCP server for Claude Desktop integration",
@@ -23,6 +28,11 @@ This runs an MCP server over stdio that exposes reorg functionality as tools:
   - list_projects, create_project, complete_project
   - list_tasks, create_task, complete_task, start_task
   - get_status
+  - list_approvals, accept_approval, reject_approval
+  - plan_tasks
+
+--read-only (or mcp.read_only) leaves out the tools that change data, so
+a shared assistant can only look things up.
 
 To use with Claude Desktop, add this to your claude_desktop_config.json:
 
@@ -43,6 +53,7 @@ Config file location:
 
 func init() {
 	rootCmd.AddCommand(mcpCmd)
+	mcpCmd.Flags().BoolVar(&mcpReadOnlyFlag, "read-only", false, "Only offer tools that read data")
 }
 
 func runMCP(cmd *cobra.Command, args []string) error {
@@ -59,9 +70,37 @@ func runMCP(cmd *cobra.Command, args []string) error {
 
 	// Initialize local store and client
 	store := markdown.NewStore(dataDir)
-	client := service.NewLocalClient(store)
+	client, err := newLocalClient(store)
+	if err != nil {
+		return err
+	}
 
 	// Create and run MCP server
 	server := mcpserver.NewServer(client)
-	return server.Run(context.Background())
+	if mcpReadOnlyFlag || viper.GetBool("mcp.read_only") {
+		server.SetReadOnly()
+	}
+	server.SetApprovals(approvalQueue(), acceptApproval)
+	server.SetPlanner(func(ctx context.Context, day string, week, ai bool) (*plan.Plan, error) {
+		date, err := dateparse.Parse(day, time.Now().In(timezone))
+		if err != nil {
+			return nil, err
+		}
+		p, err := buildPlan(ctx, client, date, week)
+		if err == nil && ai {
+			orderPlan(ctx, p)
+		}
+		return p, err
+	})
+
+	// Tools reuse CLI code that prints progress, which must not end up in
+	// the protocol stream
+	out := os.Stdout
+	os.Stdout = os.Stderr
+
+	ctx := cmd.Context()
+	if err := client.Watch(ctx); err != nil {
+		return err
+	}
+	return server.RunIO(ctx, os.Stdin, out)
 }
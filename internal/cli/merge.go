@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/backup"
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/service"
+	"github.com/ihavespoons/reorg/internal/storage/markdown"
+)
+
+var (
+	mergeDryRunFlag bool
+	mergePreferFlag string
+)
+
+var mergeCmd = &cobra.Command{
+	Use:   "merge [other-data-dir]",
+	Short: "Merge another reorg directory into this one",
+	Long: `Bring the goals, areas, projects and tasks of another reorg data
+directory, such as a copy from a second machine, into this one.
+
+Entities are matched by ID, or else by slug within the same area or
+project, and those without a match are created with their IDs. Where both
+sides differ, the one updated last wins. When both were updated at the
+same time you are asked which to keep, unless --prefer ours or --prefer
+theirs decides.
+
+Examples:
+  reorg merge ~/laptop-reorg --dry-run
+  reorg merge /mnt/backup/.reorg --prefer ours`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMerge,
+}
+
+func init() {
+	rootCmd.AddCommand(mergeCmd)
+	mergeCmd.Flags().BoolVar(&mergeDryRunFlag, "dry-run", false, "Show what would change")
+	mergeCmd.Flags().StringVar(&mergePreferFlag, "prefer", "", "Side to keep when both changed at the same time (ours, theirs)")
+}
+
+func runMerge(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	resolve := askMergeConflict
+	switch mergePreferFlag {
+	case "":
+	case "ours", "theirs":
+		take := mergePreferFlag == "theirs"
+		resolve = func(backup.Conflict) (bool, error) { return take, nil }
+	default:
+		return fmt.Errorf("invalid --prefer %q (use ours or theirs)", mergePreferFlag)
+	}
+
+	other := expandHome(args[0])
+	if _, err := os.Stat(filepath.Join(other, "areas")); err != nil {
+		return fmt.Errorf("%s is not a reorg data directory", other)
+	}
+	if abs, _ := filepath.Abs(other); abs == dataDir {
+		return fmt.Errorf("can't merge the data directory into itself")
+	}
+	theirs, err := backup.Export(ctx, service.NewLocalClient(markdown.NewStore(other)), "")
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", other, err)
+	}
+
+	var result *backup.MergeResult
+	run := func(ctx context.Context) error {
+		result = backup.Merge(ctx, client, theirs, resolve, mergeDryRunFlag)
+		return nil
+	}
+	if store != nil && !mergeDryRunFlag {
+		err = store.Batch(ctx, "merge: "+filepath.Base(other), run)
+	} else {
+		err = run(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	created, updated := "Created", "Updated"
+	if mergeDryRunFlag {
+		created, updated = "Would create", "Would update"
+	}
+	for _, what := range result.Created {
+		fmt.Printf("  %s %s\n", successStyle.Render(created), what)
+	}
+	for _, what := range result.Updated {
+		fmt.Printf("  %s %s\n", successStyle.Render(updated), what)
+	}
+	for _, what := range result.Kept {
+		fmt.Printf("  %s %s\n", dimStyle.Render("Kept ours"), what)
+	}
+	for _, err := range result.Errors {
+		fmt.Printf("  Error: %v\n", err)
+	}
+	fmt.Printf("\n%d created, %d updated, %d kept, %d unchanged\n", len(result.Created), len(result.Updated), len(result.Kept), result.Unchanged)
+	return nil
+}
+
+// askMergeConflict shows both sides of a conflict and asks which to keep
+func askMergeConflict(c backup.Conflict) (bool, error) {
+	fmt.Printf("\n%s changed on both sides at the same time:\n", titleStyle.Render(c.Kind))
+	fmt.Printf("  ours:   %s\n", mergeSummary(c.Ours))
+	fmt.Printf("  theirs: %s\n", mergeSummary(c.Theirs))
+
+	reader := bufio.NewReader(os.Stdin)
+	for {
+		fmt.Print(promptStyle.Render("Keep [o]urs or [t]heirs? "))
+		input, err := reader.ReadString('\n')
+		switch strings.ToLower(strings.TrimSpace(input)) {
+		case "o", "ours":
+			return false, nil
+		case "t", "theirs":
+			return true, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("no answer; use --prefer")
+		}
+	}
+}
+
+// mergeSummary describes one side of a conflict in a line
+func mergeSummary(v any) string {
+	switch e := v.(type) {
+	case *domain.Goal:
+		return fmt.Sprintf("%s (%s)", e.Title, e.Status)
+	case *domain.Area:
+		return e.Title
+	case *domain.Project:
+		return fmt.Sprintf("%s (%s)", e.Title, e.Status)
+	case *domain.Task:
+		s := fmt.Sprintf("%s (%s, %s", e.Title, e.Status, e.Priority)
+		if e.DueDate != nil {
+			s += ", due " + e.DueDate.Format("2006-01-02")
+		}
+		return s + ")"
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// metaFlagUsage is the help text shared by --meta flags
+const metaFlagUsage = "Metadata as key=value (repeatable, an empty value removes the key)"
+
+// formatExternalRef shows an external reference with its link
+func formatExternalRef(ref *domain.ExternalRef) string {
+	if ref.URL == "" {
+		return ref.String()
+	}
+	return ref.String() + " " + dimStyle.Render("("+ref.URL+")")
+}
+
+// printMetadata lists an entity's metadata sorted by key
+func printMetadata(meta map[string]string, labelStyle lipgloss.Style) {
+	if len(meta) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(meta))
+	for key := range meta {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fmt.Println(labelStyle.Render("Metadata:"))
+	for _, key := range keys {
+		fmt.Printf("  %s = %s\n", key, meta[key])
+	}
+}
@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/storage/markdown"
+)
+
+var migrateDryRunFlag bool
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Upgrade data files to the current format",
+	Long: `Upgrade the files in the data directory to the frontmatter format this
+version of reorg writes.
+
+Every file records the format it was written in as schema_version; files
+without one predate it. Migrate rewrites the older files, fixing up fields
+whose layout changed, and commits them as one changeset that 'reorg undo'
+can reverse. Files from a newer reorg are refused rather than rewritten.
+
+Migrate also renames the files of projects and tasks to the naming set by
+storage.filenames: "slug" names them after their titles, "id" after their
+IDs, so that renaming an item doesn't move its file. Files named by a slug
+an older reorg made differently are renamed too. Until then files are
+renamed as their items are updated.
+
+--dry-run shows the changes as a diff without writing anything.
+
+Examples:
+  reorg migrate --dry-run
+  reorg migrate`,
+	Args: cobra.NoArgs,
+	RunE: runMigrate,
+}
+
+func init() {
+	rootCmd.AddCommand(migrateCmd)
+	migrateCmd.Flags().BoolVar(&migrateDryRunFlag, "dry-run", false, "Show the changes without writing them")
+}
+
+func runMigrate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	if store == nil {
+		return fmt.Errorf("migrate is only available in embedded mode")
+	}
+	cmd.SilenceUsage = true
+
+	planned, err := store.PlanMigrations(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to plan migration: %w", err)
+	}
+	renames, err := store.PlanRenames(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to plan renames: %w", err)
+	}
+	if len(planned) == 0 && len(renames) == 0 {
+		fmt.Printf("All files are at schema version %d.\n", markdown.SchemaVersion)
+		return nil
+	}
+
+	if migrateDryRunFlag {
+		for _, m := range planned {
+			fmt.Println(titleStyle.Render(fmt.Sprintf("%s (version %d)", m.Path, m.From)))
+			for _, desc := range m.Applied {
+				fmt.Println(dimStyle.Render("  " + desc))
+			}
+			printDiff(string(m.Before), string(m.After))
+			fmt.Println()
+		}
+		for _, r := range renames {
+			fmt.Printf("%s %s %s\n", diffRemoveStyle.Render(r.From), dimStyle.Render("→"), diffAddStyle.Render(r.To))
+		}
+		if len(planned) > 0 {
+			fmt.Printf("Would migrate %d file(s) to schema version %d.\n", len(planned), markdown.SchemaVersion)
+		}
+		if len(renames) > 0 {
+			fmt.Printf("Would rename %d file(s) by %s.\n", len(renames), store.FileNaming())
+		}
+		return nil
+	}
+
+	if len(planned) > 0 {
+		if err := store.Migrate(ctx, planned); err != nil {
+			return err
+		}
+		fmt.Printf("%s Migrated %d file(s) to schema version %d\n", successStyle.Render("✓"), len(planned), markdown.SchemaVersion)
+	}
+	if len(renames) > 0 {
+		if err := store.RenameFiles(ctx, renames); err != nil {
+			return err
+		}
+		fmt.Printf("%s Renamed %d file(s) by %s\n", successStyle.Render("✓"), len(renames), store.FileNaming())
+	}
+	return nil
+}
+
+var (
+	diffAddStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+	diffRemoveStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+)
+
+// printDiff prints the lines that differ between before and after, with
+// "-" and "+" like a unified diff without hunks
+func printDiff(before, after string) {
+	a := strings.Split(strings.TrimSuffix(before, "\n"), "\n")
+	b := strings.Split(strings.TrimSuffix(after, "\n"), "\n")
+
+	// lcs[i][j] is the length of the longest common run of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i, j = i+1, j+1
+		case j < len(b) && (i == len(a) || lcs[i][j+1] >= lcs[i+1][j]):
+			fmt.Println(diffAddStyle.Render("+ " + b[j]))
+			j++
+		default:
+			fmt.Println(diffRemoveStyle.Render("- " + a[i]))
+			i++
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+var noteCmd = &cobra.Command{
+	Use:   "note",
+	Short: "Manage notes on projects and tasks",
+	Long: `Notes are timestamped markdown snippets attached to a project or task,
+such as meeting minutes, decisions or progress updates. They are stored
+next to the item and shown by 'task show' and 'project show'.`,
+}
+
+var noteAddCmd = &cobra.Command{
+	Use:   "add [task-or-project] [text]",
+	Short: "Add a note to a task or project",
+	Long: `Add a note to a task (by ID, ID prefix or slug) or a project (by ID or
+slug). Without text, the note is read from stdin.
+
+Examples:
+  reorg note add task-1a2b "Called the vendor, quote arrives Friday"
+  reorg note add website "Launch moved to next sprint"
+  pbpaste | reorg note add website`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runNoteAdd,
+}
+
+var noteListCmd = &cobra.Command{
+	Use:   "list [task-or-project]",
+	Short: "List the notes of a task or project",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runNoteList,
+}
+
+var noteSearchCmd = &cobra.Command{
+	Use:   "search [text]",
+	Short: "Find notes containing text",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runNoteSearch,
+}
+
+var noteDeleteCmd = &cobra.Command{
+	Use:   "delete [note-id]",
+	Short: "Delete a note",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runNoteDelete,
+}
+
+func init() {
+	rootCmd.AddCommand(noteCmd)
+	noteCmd.AddCommand(noteAddCmd)
+	noteCmd.AddCommand(noteListCmd)
+	noteCmd.AddCommand(noteSearchCmd)
+	noteCmd.AddCommand(noteDeleteCmd)
+}
+
+func runNoteAdd(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	parentID, title, err := findNoteParent(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	var text string
+	if len(args) > 1 {
+		text = args[1]
+	} else {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("failed to read note: %w", err)
+		}
+		text = string(data)
+	}
+	if strings.TrimSpace(text) == "" {
+		return fmt.Errorf("note is empty")
+	}
+
+	note, err := client.AddNote(ctx, domain.NewNote(parentID, text))
+	if err != nil {
+		return fmt.Errorf("failed to add note: %w", err)
+	}
+
+	fmt.Printf("%s Added note to %s %s\n", successStyle.Render("✓"), title, dimStyle.Render("("+note.ID+")"))
+	return nil
+}
+
+func runNoteList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	parentID, title, err := findNoteParent(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	notes, err := client.ListNotes(ctx, parentID)
+	if err != nil {
+		return fmt.Errorf("failed to list notes: %w", err)
+	}
+	if len(notes) == 0 {
+		fmt.Printf("No notes on %s. Add one with 'reorg note add %s <text>'\n", title, args[0])
+		return nil
+	}
+
+	fmt.Println(titleStyle.Render("\n  Notes on " + title + "\n"))
+	for _, n := range notes {
+		printNote(n, "")
+	}
+	return nil
+}
+
+func runNoteSearch(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	text := strings.Join(args, " ")
+
+	notes, err := client.SearchNotes(ctx, text)
+	if err != nil {
+		return fmt.Errorf("failed to search notes: %w", err)
+	}
+	if len(notes) == 0 {
+		fmt.Printf("No notes contain %q\n", text)
+		return nil
+	}
+
+	// Name the project or task each note belongs to
+	titles := make(map[string]string)
+	tasks, _ := client.ListAllTasks(ctx)
+	for _, t := range tasks {
+		titles[t.ID] = t.Title
+	}
+	projects, _ := client.ListAllProjects(ctx)
+	for _, p := range projects {
+		titles[p.ID] = p.Title
+	}
+
+	for _, n := range notes {
+		printNote(n, titles[n.ParentID])
+	}
+	fmt.Printf("%d note(s)\n", len(notes))
+	return nil
+}
+
+func runNoteDelete(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	if err := client.DeleteNote(ctx, args[0]); err != nil {
+		return fmt.Errorf("failed to delete note: %w", err)
+	}
+
+	fmt.Printf("%s Deleted note: %s\n", successStyle.Render("✓"), args[0])
+	return nil
+}
+
+// findNoteParent resolves a task or project identifier to its ID and title
+func findNoteParent(ctx context.Context, identifier string) (string, string, error) {
+	if task, err := findTask(ctx, identifier); err == nil {
+		return task.ID, task.Title, nil
+	}
+	if project, err := findProjectByIDOrSlug(ctx, identifier); err == nil {
+		return project.ID, project.Title, nil
+	}
+	return "", "", fmt.Errorf("no task or project found: %s", identifier)
+}
+
+// printNote prints a note's time and ID, followed by its indented text.
+// parent, if given, names the item it belongs to.
+func printNote(n *domain.Note, parent string) {
+	header := n.Created.In(timezone).Format("2006-01-02 15:04")
+	if parent != "" {
+		header += "  " + parent
+	}
+	fmt.Printf("  %s %s\n", lipgloss.NewStyle().Bold(true).Render(header), dimStyle.Render(n.ID))
+	for _, line := range strings.Split(n.Content, "\n") {
+		fmt.Printf("    %s\n", line)
+	}
+	fmt.Println()
+}
+
+// printNotes prints the notes of a project or task under a heading, for
+// the show commands
+func printNotes(ctx context.Context, parentID string, labelStyle lipgloss.Style) {
+	notes, err := client.ListNotes(ctx, parentID)
+	if err != nil || len(notes) == 0 {
+		return
+	}
+
+	fmt.Println(labelStyle.Render(fmt.Sprintf("Notes (%d):", len(notes))))
+	for _, n := range notes {
+		printNote(n, "")
+	}
+}
@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/pick"
+	"github.com/ihavespoons/reorg/internal/service"
+)
+
+var (
+	pickTimeFlag    string
+	pickEnergyFlag  string
+	pickContextFlag string
+	pickLimitFlag   int
+	pickAIFlag      bool
+)
+
+var pickCmd = &cobra.Command{
+	Use:   "pick",
+	Short: "Suggest what to work on now",
+	Long: `Suggest open tasks that fit the time and energy you have, ranked by due
+date, scheduled date, priority and context. Blocked tasks and tasks
+scheduled for a later day are left out.
+
+--time leaves out tasks whose remaining estimate, or the typical length of
+their effort (small 30m, medium 2h, large 4h), is longer. --energy leaves
+out bigger tasks: low keeps small ones, medium keeps small and medium ones.
+--context leaves out tasks that need a different context. Tasks without an
+estimate, effort or context are kept.
+
+With --ai the AI re-ranks the suggestions for the situation.
+
+Examples:
+  reorg pick
+  reorg pick --time 30m --energy low
+  reorg pick --context errands --ai`,
+	Args: cobra.NoArgs,
+	RunE: runPick,
+}
+
+func init() {
+	rootCmd.AddCommand(pickCmd)
+
+	pickCmd.Flags().StringVarP(&pickTimeFlag, "time", "t", "", "Time available (e.g. 30m, 2h)")
+	pickCmd.Flags().StringVarP(&pickEnergyFlag, "energy", "e", "", "Energy level (low, medium, high)")
+	pickCmd.Flags().StringVarP(&pickContextFlag, "context", "c", "", "Where you are (e.g. home, errands)")
+	pickCmd.Flags().IntVarP(&pickLimitFlag, "limit", "n", 5, "Number of suggestions")
+	pickCmd.Flags().BoolVar(&pickAIFlag, "ai", false, "Ask the AI to re-rank the suggestions")
+	_ = pickCmd.RegisterFlagCompletionFunc("energy", cobra.FixedCompletions(
+		[]cobra.Completion{"low", "medium", "high"}, cobra.ShellCompDirectiveNoFileComp))
+}
+
+func runPick(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	opts := pick.Options{Context: pickContextFlag, Now: time.Now().In(timezone)}
+	if pickTimeFlag != "" {
+		available, err := domain.ParseTimeSpec(pickTimeFlag)
+		if err != nil {
+			return err
+		}
+		opts.Time = available
+	}
+	energy, err := pick.ParseEnergy(pickEnergyFlag)
+	if err != nil {
+		return err
+	}
+	opts.Energy = energy
+
+	tasks, err := client.ListAllTasks(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list tasks: %w", err)
+	}
+	projects, err := client.ListAllProjects(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list projects: %w", err)
+	}
+	projectTitles := make(map[string]string, len(projects))
+	for _, p := range projects {
+		projectTitles[p.ID] = p.Title
+	}
+
+	byID := make(map[string]*domain.Task, len(tasks))
+	for _, t := range tasks {
+		byID[t.ID] = t
+	}
+	lookup := func(id string) *domain.Task { return byID[id] }
+
+	suggestions := pick.Suggest(service.WithoutSomeday(tasks, projects), projectTitles, lookup, opts)
+	if len(suggestions) == 0 {
+		fmt.Println("Nothing fits right now.")
+		return nil
+	}
+
+	// The AI sees a few more than are shown, so it can bring one forward
+	if pickAIFlag {
+		candidates := suggestions
+		if pickLimitFlag > 0 && len(candidates) > pickLimitFlag*2 {
+			candidates = candidates[:pickLimitFlag*2]
+		}
+		llmClient, err := getLLMClient()
+		if err == nil {
+			err = pick.Rank(ctx, llmClient, candidates, opts)
+		}
+		if err != nil {
+			fmt.Println(dimStyle.Render(fmt.Sprintf("  Could not rank with AI (%v), using default order", err)))
+		}
+		suggestions = candidates
+	}
+	if pickLimitFlag > 0 && len(suggestions) > pickLimitFlag {
+		suggestions = suggestions[:pickLimitFlag]
+	}
+
+	fmt.Println(titleStyle.Render("\n  Suggested now\n"))
+	for i, s := range suggestions {
+		t := s.Task
+		title := t.Title
+		if t.Context != "" {
+			title += " @" + t.Context
+		}
+
+		var details []string
+		if s.Project != "" {
+			details = append(details, s.Project)
+		}
+		if needed, ok := pick.Needed(t, opts.Now); ok {
+			details = append(details, "~"+domain.FormatTimeSpec(needed))
+		}
+		details = append(details, s.Reasons...)
+		if s.Note != "" {
+			details = append(details, s.Note)
+		}
+		fmt.Printf("  %d. %s %s\n", i+1, title, dimStyle.Render("("+strings.Join(details, ", ")+")"))
+	}
+	fmt.Println()
+	return nil
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/ihavespoons/reorg/internal/filelock"
+)
+
+// servePIDFile is where a running server holds a lock and records its
+// process ID, so a second one won't start on the same data directory and
+// 'reorg serve stop' can find it. It is kept in the data directory, so
+// servers on different data directories don't block each other whatever
+// their state directories.
+func servePIDFile() string {
+	return filepath.Join(dataDir, ".serve.pid~")
+}
+
+// acquirePIDFile locks the PID file at path and writes the current process
+// ID to it, failing if another process holds the lock. The lock goes away
+// with the process, so a server that crashed leaves nothing to clean up.
+// The returned function empties the file and releases the lock.
+func acquirePIDFile(path string) (func(), error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create data directory: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	if err := filelock.TryLock(f); err != nil {
+		_ = f.Close()
+		if !errors.Is(err, filelock.ErrLocked) {
+			return nil, fmt.Errorf("failed to lock %s: %w", path, err)
+		}
+		if pid, err := readPIDFile(path); err == nil {
+			return nil, fmt.Errorf("reorg serve is already running (pid %d); stop it with 'reorg serve stop'", pid)
+		}
+		return nil, fmt.Errorf("reorg serve is already running on %s", filepath.Dir(path))
+	}
+
+	// The file is only written while locked, so whoever reads a PID from
+	// it checks the lock first
+	err = f.Truncate(0)
+	if err == nil {
+		_, err = f.WriteAt([]byte(fmt.Sprintf("%d\n", os.Getpid())), 0)
+	}
+	if err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return func() {
+		_ = f.Truncate(0)
+		_ = filelock.Unlock(f)
+		_ = f.Close()
+	}, nil
+}
+
+// runningServer returns the process ID of the server holding the PID file
+// at path, or 0 if none does
+func runningServer(path string) (int, error) {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = f.Close() }()
+
+	switch err := filelock.TryLock(f); {
+	case err == nil:
+		_ = filelock.Unlock(f)
+		return 0, nil
+	case !errors.Is(err, filelock.ErrLocked):
+		return 0, fmt.Errorf("failed to lock %s: %w", path, err)
+	}
+	pid, err := readPIDFile(path)
+	if err != nil {
+		return 0, fmt.Errorf("reorg serve is starting or stopping; try again: %w", err)
+	}
+	return pid, nil
+}
+
+// readPIDFile returns the process ID in a PID file
+func readPIDFile(path string) (int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil || pid <= 0 {
+		return 0, fmt.Errorf("invalid PID file %s", path)
+	}
+	return pid, nil
+}
+
+// processAlive reports whether a process with the ID exists. Processes
+// owned by other users count as alive.
+func processAlive(pid int) bool {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	err = p.Signal(syscall.Signal(0))
+	return err == nil || !(errors.Is(err, os.ErrProcessDone) || errors.Is(err, syscall.ESRCH))
+}
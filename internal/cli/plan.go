@@ -0,0 +1,304 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/x/term"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/audit"
+	"github.com/ihavespoons/reorg/internal/dateparse"
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/notify"
+	"github.com/ihavespoons/reorg/internal/plan"
+	"github.com/ihavespoons/reorg/internal/service"
+	"github.com/ihavespoons/reorg/internal/storage/markdown"
+)
+
+var (
+	planAIFlag     bool
+	planNotifyFlag bool
+	planWeekFlag   bool
+	planAcceptFlag bool
+)
+
+var planCmd = &cobra.Command{
+	Use:   "plan [day]",
+	Short: "Assemble a daily or weekly plan",
+	Long: `Collect the tasks worth doing on a day: overdue and due tasks, tasks
+scheduled for it or earlier, work in progress, and high priority items.
+Tasks scheduled for a later day are left out until then. The plan is
+printed and written to plans/<date>.md in the data directory. With --week
+it covers the seven days from the given day and is written to
+plans/<date>-week.md.
+
+With --ai the AI proposes an order, weighing due dates, priorities and
+estimates. Accepting the proposal keeps that order and starts the first
+task; declining keeps the default order. --accept accepts without asking.
+
+The day defaults to tomorrow and accepts today, tomorrow, +2d or a date.
+'reorg serve' can run this every evening (see planning in config.yaml).
+
+Examples:
+  reorg plan tomorrow
+  reorg plan today --ai
+  reorg plan today --week --ai --accept
+  reorg plan 2025-03-01`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runPlan,
+}
+
+func init() {
+	rootCmd.AddCommand(planCmd)
+	planCmd.Flags().BoolVar(&planAIFlag, "ai", false, "Ask the AI to order the plan")
+	planCmd.Flags().BoolVar(&planNotifyFlag, "notify", false, "Send a notification with the plan summary")
+	planCmd.Flags().BoolVar(&planWeekFlag, "week", false, "Plan the seven days from the given day")
+	planCmd.Flags().BoolVar(&planAcceptFlag, "accept", false, "Accept the AI order and start the first task without asking")
+}
+
+func runPlan(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	day := "tomorrow"
+	if len(args) > 0 {
+		day = args[0]
+	}
+	date, err := dateparse.Parse(day, time.Now().In(timezone))
+	if err != nil {
+		return err
+	}
+
+	p, err := buildPlan(ctx, client, date, planWeekFlag)
+	if err != nil {
+		return err
+	}
+	ordered := false
+	if planAIFlag || viper.GetBool("planning.ai") {
+		ordered = orderPlan(ctx, p)
+	}
+
+	fmt.Println(titleStyle.Render(fmt.Sprintf("\n  Plan for %s\n", p.Period())))
+	for _, g := range p.Goals {
+		fmt.Println(dimStyle.Render("  Goal: " + g))
+	}
+	if len(p.Goals) > 0 {
+		fmt.Println()
+	}
+	if len(p.Items) == 0 {
+		fmt.Println("  Nothing scheduled.")
+	}
+	for i, item := range p.Items {
+		details := string(item.Reason)
+		if item.Project != "" {
+			details = item.Project + ", " + details
+		}
+		if item.Note != "" {
+			details += ": " + item.Note
+		}
+		title := item.Task.Title
+		if item.Task.Context != "" {
+			title += " @" + item.Task.Context
+		}
+		fmt.Printf("  %d. %s %s\n", i+1, title, dimStyle.Render("("+details+")"))
+	}
+	fmt.Println()
+
+	// An AI proposal is only asked about interactively; scheduled and piped
+	// runs keep it as before
+	accepted := ordered && planAcceptFlag
+	if ordered && !planAcceptFlag && term.IsTerminal(os.Stdin.Fd()) {
+		reader := bufio.NewReader(os.Stdin)
+		fmt.Print(promptStyle.Render("Accept this order and start the first task? [y/N]: "))
+		input, _ := reader.ReadString('\n')
+		input = strings.ToLower(strings.TrimSpace(input))
+		accepted = input == "y" || input == "yes"
+		if !accepted {
+			p, err = buildPlan(ctx, client, date, planWeekFlag)
+			if err != nil {
+				return err
+			}
+			fmt.Println(dimStyle.Render("  Keeping the default order"))
+		}
+	}
+
+	path, err := savePlan(ctx, p, store)
+	if err != nil {
+		return err
+	}
+	if accepted && len(p.Items) > 0 {
+		first := p.Items[0].Task
+		if first.Status == domain.TaskStatusPending {
+			if err := client.StartTask(ctx, first.ID); err != nil {
+				return fmt.Errorf("failed to start task: %w", err)
+			}
+			fmt.Printf("%s Started: %s\n", successStyle.Render("✓"), first.Title)
+		}
+	}
+	fmt.Println(dimStyle.Render("  Written to " + path))
+
+	if planNotifyFlag {
+		if err := newNotifier().Send(ctx, "", planMessage(p)); err != nil {
+			return fmt.Errorf("failed to send notification: %w", err)
+		}
+	}
+	return nil
+}
+
+// buildPlan builds the plan for day, or the week from day
+func buildPlan(ctx context.Context, c service.ReorgClient, day time.Time, week bool) (*plan.Plan, error) {
+	tasks, err := c.ListAllTasks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	projects, err := c.ListAllProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	projectTitles := make(map[string]string, len(projects))
+	for _, p := range projects {
+		projectTitles[p.ID] = p.Title
+	}
+	tasks = service.WithoutSomeday(tasks, projects)
+
+	if week {
+		p := plan.BuildWeek(tasks, projectTitles, day)
+		p.Goals = goalSummaries(ctx, c)
+		return p, nil
+	}
+	return plan.Build(tasks, projectTitles, day), nil
+}
+
+// goalSummaries describes the active goals for a weekly plan. Goals are
+// left out if they can't be read.
+func goalSummaries(ctx context.Context, c service.ReorgClient) []string {
+	goals, err := c.ListGoals(ctx)
+	if err != nil {
+		return nil
+	}
+	var summaries []string
+	for _, g := range goals {
+		if !g.IsActive() {
+			continue
+		}
+		details := []string{fmt.Sprintf("%d%% done", goalProgress(g).PercentComplete)}
+		if g.Quarter != "" {
+			details = append([]string{g.Quarter}, details...)
+		}
+		summaries = append(summaries, fmt.Sprintf("%s (%s)", g.Title, strings.Join(details, ", ")))
+	}
+	return summaries
+}
+
+// orderPlan has the AI order p, reporting whether it did
+func orderPlan(ctx context.Context, p *plan.Plan) bool {
+	if len(p.Items) < 2 {
+		return false
+	}
+	llmClient, err := getLLMClient()
+	if err == nil {
+		err = p.Order(ctx, llmClient)
+	}
+	if err != nil {
+		fmt.Println(dimStyle.Render(fmt.Sprintf("  Could not order with AI (%v), using default order", err)))
+		return false
+	}
+	return true
+}
+
+// savePlan writes the plan as a note under plans/. s may be nil in remote
+// mode, in which case the note is not committed.
+func savePlan(ctx context.Context, p *plan.Plan, s *markdown.Store) (string, error) {
+	path := filepath.Join(dataDir, "plans", p.Name()+".md")
+	if dryRunFile(audit.Update, path) {
+		return path, nil
+	}
+	write := func(context.Context) error {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		return os.WriteFile(path, []byte(p.Markdown()), 0644)
+	}
+
+	var err error
+	if s != nil {
+		err = s.Batch(ctx, "plan "+p.Name(), write)
+	} else {
+		err = write(ctx)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to write plan: %w", err)
+	}
+	return path, nil
+}
+
+// planMessage is the notification sent when a plan is ready
+func planMessage(p *plan.Plan) notify.Message {
+	var titles []string
+	for i, item := range p.Items {
+		if i == 3 {
+			titles = append(titles, "…")
+			break
+		}
+		titles = append(titles, item.Task.Title)
+	}
+
+	return notify.Message{
+		Title: fmt.Sprintf("Plan for %s: %s", p.Day.Format("Mon Jan 2"), p.Summary()),
+		Body:  strings.Join(titles, ", "),
+	}
+}
+
+// runPlanSchedule writes tomorrow's plan every day at planning.time until ctx
+// is cancelled
+func runPlanSchedule(ctx context.Context, c service.ReorgClient, s *markdown.Store, at string) {
+	for {
+		next, err := nextDailyRun(time.Now().In(timezone), at)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "planning: %v\n", err)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Until(next)):
+		}
+
+		tomorrow := time.Now().In(timezone).AddDate(0, 0, 1)
+		p, err := buildPlan(ctx, c, tomorrow, false)
+		if err == nil {
+			if viper.GetBool("planning.ai") {
+				orderPlan(ctx, p)
+			}
+			_, err = savePlan(ctx, p, s)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "planning: %v\n", err)
+			continue
+		}
+		if err := newNotifier().Send(ctx, "", planMessage(p)); err != nil {
+			fmt.Fprintf(os.Stderr, "planning: %v\n", err)
+		}
+	}
+}
+
+// nextDailyRun returns the next time after now at the HH:MM clock time at
+func nextDailyRun(now time.Time, at string) (time.Time, error) {
+	clock, err := time.Parse("15:04", at)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q (use HH:MM)", at)
+	}
+
+	next := time.Date(now.Year(), now.Month(), now.Day(), clock.Hour(), clock.Minute(), 0, 0, now.Location())
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next, nil
+}
@@ -1,43 +1,98 @@
 package cli
 
 import (
-	"bufio"
 	"context"
 	"fmt"
 	"os"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
+	"github.com/ihavespoons/reorg/internal/cli/render"
 	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/notify"
+	"github.com/ihavespoons/reorg/internal/service"
 )
 
 var (
 	projectAreaFlag     string
 	projectPriorityFlag string
 	projectTagsFlag     []string
+	projectNotifyFlag   string
+	projectTestFlag     bool
+	projectQueryFlag    string
+	projectListRender   render.Options
+	projectStalledDays  int
+	projectMetaFlag     []string
+	projectAddTagsFlag  []string
+	projectRmTagsFlag   []string
+	projectGoalFlag     string
+	projectAllFlag      bool
+	projectParentFlag   string
+	projectTreeFlag     bool
 )
 
 var projectCmd = &cobra.Command{
-	Use:   "project",
-	Short: "Manage projects",
-	Long:  `Projects are collections of related tasks within an area.`,
+	Use:     "project",
+	Aliases: []string{"projects"},
+	Short:   "Manage projects",
+	Long:    `Projects are collections of related tasks within an area.`,
 }
 
 var projectListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List projects",
-	RunE:  runProjectList,
+	Long: `List projects, optionally narrowed down with a query expression.
+Someday projects are left out unless --all or a query is given, so
+-q status:someday lists the backlog.
+
+Query fields: status, priority, tag, area, title, due, created, updated,
+overdue, has and meta.<key>. See 'reorg task list --help' for the syntax.
+
+--tree lists each project's sub-projects indented under it. A parent's
+task counts and health include the tasks of its sub-projects.
+
+Examples:
+  reorg project list -q "status:active priority>=high"
+  reorg project list -q "area:work has:due -status:completed"
+  reorg project list -q "meta.github:any"
+  reorg project list --tree`,
+	RunE: runProjectList,
+}
+
+var projectStalledCmd = &cobra.Command{
+	Use:   "stalled",
+	Short: "List active projects with no recent activity",
+	Long: `List active projects that have had no activity for --days days, oldest
+first. Activity is any change to the project or one of its tasks, including
+time tracked on a task.
+
+Examples:
+  reorg projects stalled
+  reorg projects stalled --days 30`,
+	Args: cobra.NoArgs,
+	RunE: runProjectStalled,
 }
 
 var projectCreateCmd = &cobra.Command{
 	Use:   "create [name]",
 	Short: "Create a new project",
-	Args:  cobra.ExactArgs(1),
-	RunE:  runProjectCreate,
+	Long: `Create a new project. With --parent it is a sub-project of another
+project, in the same area and stored in that project's directory. Projects
+nest one level: a sub-project can't have sub-projects of its own.
+
+Examples:
+  reorg project create "Website" --area work
+  reorg project create "Blog redesign" --parent website`,
+	Args: cobra.ExactArgs(1),
+	RunE: runProjectCreate,
 }
 
 var projectShowCmd = &cobra.Command{
@@ -54,6 +109,68 @@ var projectCompleteCmd = &cobra.Command{
 	RunE:  runProjectComplete,
 }
 
+var projectDeferCmd = &cobra.Command{
+	Use:   "defer [project]",
+	Short: "Move a project to the someday/maybe backlog",
+	Long: `Move a project to the someday/maybe backlog. Someday projects and their
+tasks are left out of project and task lists, status, plans, pick, the
+board and the calendar until they are revived. 'reorg review someday' goes
+through them.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runProjectDefer,
+}
+
+var projectReviveCmd = &cobra.Command{
+	Use:   "revive [project]",
+	Short: "Make a someday project active again",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runProjectRevive,
+}
+
+var projectMoveCmd = &cobra.Command{
+	Use:   "move [project]",
+	Short: "Move a project to another area",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runProjectMove,
+}
+
+var projectNotifyCmd = &cobra.Command{
+	Use:   "notify [project] [channel]",
+	Short: "Set where notifications for a project are sent",
+	Long: `Set the notification channel for a project. Reminders and status changes
+for the project are sent there instead of the default channel.
+
+A channel is one of:
+  desktop      Show a desktop notification
+  none         Don't send notifications for this project
+  <url>        Post to a webhook (Slack incoming webhooks are detected)
+
+Omit the channel to go back to the default from notifications.default.
+
+Examples:
+  reorg project notify website https://hooks.slack.com/services/T000/B000/XXX
+  reorg project notify garden desktop --test`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runProjectNotify,
+}
+
+var projectUpdateCmd = &cobra.Command{
+	Use:   "update [project]",
+	Short: "Change a project's tags, goal, parent or metadata",
+	Long: `Change a project's tags, goal, parent or metadata. Metadata is free-form
+key=value data, such as the ID of a linked issue, that can be queried with
+meta.<key>. Use --goal none to unlink the project from its goal, and
+--parent none to make a sub-project a top-level project again.
+
+Examples:
+  reorg project update website --add-tag client
+  reorg project update website --goal launch-the-new-website
+  reorg project update blog-redesign --parent website
+  reorg project update website --meta jira=WEB-1 --meta github=https://github.com/acme/web`,
+	Args: cobra.ExactArgs(1),
+	RunE: runProjectUpdate,
+}
+
 var projectDeleteCmd = &cobra.Command{
 	Use:   "delete [project]",
 	Short: "Delete a project",
@@ -64,31 +181,77 @@ var projectDeleteCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(projectCmd)
 	projectCmd.AddCommand(projectListCmd)
+	projectCmd.AddCommand(projectStalledCmd)
 	projectCmd.AddCommand(projectCreateCmd)
 	projectCmd.AddCommand(projectShowCmd)
 	projectCmd.AddCommand(projectCompleteCmd)
+	projectCmd.AddCommand(projectDeferCmd)
+	projectCmd.AddCommand(projectReviveCmd)
+	projectCmd.AddCommand(projectMoveCmd)
+	projectCmd.AddCommand(projectNotifyCmd)
+	projectCmd.AddCommand(projectUpdateCmd)
 	projectCmd.AddCommand(projectDeleteCmd)
 
 	// List flags
 	projectListCmd.Flags().StringVarP(&projectAreaFlag, "area", "a", "", "Filter by area")
+	projectListCmd.Flags().StringVarP(&projectQueryFlag, "query", "q", "", "Filter with a query expression")
+	projectListCmd.Flags().BoolVar(&projectAllFlag, "all", false, "Include someday projects")
+	projectListCmd.Flags().BoolVar(&projectTreeFlag, "tree", false, "Show sub-projects under their parent")
+	projectListRender.AddFlags(projectListCmd, []string{"due", "priority", "updated", "created"}, []string{"area", "status"})
+
+	// Show flags
+	projectShowCmd.Flags().BoolVar(&showRawFlag, "raw", false, "Print the description as written, without markdown rendering")
+
+	// Stalled flags
+	projectStalledCmd.Flags().IntVarP(&projectStalledDays, "days", "d", domain.StalledAfterDays, "Days without activity")
 
 	// Create flags
 	projectCreateCmd.Flags().StringVarP(&projectAreaFlag, "area", "a", "", "Area for the project")
 	projectCreateCmd.Flags().StringVarP(&projectPriorityFlag, "priority", "p", "medium", "Priority (low, medium, high, urgent)")
 	projectCreateCmd.Flags().StringSliceVarP(&projectTagsFlag, "tags", "t", nil, "Tags for the project")
+	projectCreateCmd.Flags().StringVar(&projectNotifyFlag, "notify", "", "Notification channel (desktop, none, or a webhook URL)")
+	projectCreateCmd.Flags().StringArrayVar(&projectMetaFlag, "meta", nil, metaFlagUsage)
+	projectCreateCmd.Flags().StringVarP(&projectGoalFlag, "goal", "g", "", "Goal the project works towards")
+	projectCreateCmd.Flags().StringVar(&projectParentFlag, "parent", "", "Project to create this one as a sub-project of")
+
+	// Update flags
+	projectUpdateCmd.Flags().StringSliceVar(&projectAddTagsFlag, "add-tag", nil, "Tags to add")
+	projectUpdateCmd.Flags().StringSliceVar(&projectRmTagsFlag, "remove-tag", nil, "Tags to remove")
+	projectUpdateCmd.Flags().StringArrayVar(&projectMetaFlag, "meta", nil, metaFlagUsage)
+	projectUpdateCmd.Flags().StringVarP(&projectGoalFlag, "goal", "g", "", "Goal the project works towards, or none")
+	projectUpdateCmd.Flags().StringVar(&projectParentFlag, "parent", "", "Project this one is a sub-project of, or none")
+
+	// Notify flags
+	projectNotifyCmd.Flags().BoolVar(&projectTestFlag, "test", false, "Send a test notification")
+
+	// Move flags
+	projectMoveCmd.Flags().StringVarP(&projectAreaFlag, "area", "a", "", "Destination area")
+	_ = projectMoveCmd.MarkFlagRequired("area")
 }
 
 func runProjectList(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
+	if err := projectListRender.Validate(); err != nil {
+		return err
+	}
 
 	var projects []*domain.Project
 	var err error
 
-	if projectAreaFlag != "" {
+	if projectQueryFlag != "" {
+		expr := projectQueryFlag
+		if projectAreaFlag != "" {
+			expr = "area:" + projectAreaFlag + " " + expr
+		}
+		projects, err = client.QueryProjects(ctx, expr)
+		if err != nil {
+			return fmt.Errorf("failed to list projects: %w", err)
+		}
+	} else if projectAreaFlag != "" {
 		// Get area by slug
 		area, err := client.GetAreaBySlug(ctx, projectAreaFlag)
 		if err != nil {
-			return fmt.Errorf("area not found: %s", projectAreaFlag)
+			return domain.Errorf(domain.ErrNotFound, "area not found: %s", projectAreaFlag)
 		}
 		projects, err = client.ListProjects(ctx, area.ID)
 		if err != nil {
@@ -101,55 +264,201 @@ func runProjectList(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if projectQueryFlag == "" && !projectAllFlag {
+		projects = slices.DeleteFunc(projects, (*domain.Project).IsSomeday)
+	}
+
 	if len(projects) == 0 {
 		fmt.Println("No projects found. Create one with 'reorg project create <name>'")
 		return nil
 	}
 
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintln(w, "PROJECT\tAREA\tSTATUS\tPRIORITY\tTASKS")
-	_, _ = fmt.Fprintln(w, "-------\t----\t------\t--------\t-----")
+	areaNames := make(map[string]string)
+	areas, _ := client.ListAreas(ctx)
+	for _, a := range areas {
+		areaNames[a.ID] = a.Title
+	}
+
+	titles := make(map[string]string)
+	groups := render.Apply(projects, projectListRender, func(p *domain.Project) render.Fields {
+		return render.Fields{
+			Due:      p.DueDate,
+			Priority: p.Priority,
+			Created:  p.Created,
+			Updated:  p.Updated,
+			Area:     areaNames[p.AreaID],
+			Status:   string(p.Status),
+		}
+	})
+	if projectTreeFlag {
+		for i := range groups {
+			groups[i].Items = projectTree(groups[i].Items, titles)
+		}
+	}
 
+	// Health goes last so its color codes don't throw off the alignment
+	header := []string{"PROJECT", "AREA", "STATUS", "PRIORITY", "TASKS", "DONE", "OVERDUE", "ACTIVITY", "HEALTH"}
+	return render.Table(os.Stdout, groups, header, func(p *domain.Project) []string {
+		h := projectHealth(ctx, p)
+		title := p.Title
+		if t, ok := titles[p.ID]; ok {
+			title = t
+		}
+		return []string{
+			title,
+			areaNames[p.AreaID],
+			string(p.Status),
+			string(p.Priority),
+			fmt.Sprintf("%d/%d", h.CompletedTasks, h.TotalTasks),
+			fmt.Sprintf("%d%%", h.PercentComplete),
+			strconv.Itoa(h.OverdueTasks),
+			formatActivity(h),
+			renderHealth(h.Status),
+		}
+	})
+}
+
+// projectTree reorders projects so each parent is followed by its
+// sub-projects, keeping their order otherwise, and records the indented
+// titles of sub-projects in titles. Sub-projects whose parent isn't in the
+// list stay where they are.
+func projectTree(projects []*domain.Project, titles map[string]string) []*domain.Project {
+	listed := make(map[string]bool, len(projects))
 	for _, p := range projects {
-		// Get area name
-		area, _ := client.GetArea(ctx, p.AreaID)
-		areaName := ""
-		if area != nil {
-			areaName = area.Title
+		listed[p.ID] = true
+	}
+	children := make(map[string][]*domain.Project)
+	for _, p := range projects {
+		if p.IsSubProject() && listed[p.ParentProjectID] {
+			children[p.ParentProjectID] = append(children[p.ParentProjectID], p)
 		}
+	}
 
-		// Count tasks
-		tasks, _ := client.ListTasks(ctx, p.ID)
-		completedTasks := 0
-		for _, t := range tasks {
-			if t.IsComplete() {
-				completedTasks++
-			}
+	tree := make([]*domain.Project, 0, len(projects))
+	for _, p := range projects {
+		if p.IsSubProject() && listed[p.ParentProjectID] {
+			continue
+		}
+		tree = append(tree, p)
+		for _, child := range children[p.ID] {
+			titles[child.ID] = "  └ " + child.Title
+			tree = append(tree, child)
+		}
+	}
+	return tree
+}
+
+func runProjectStalled(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	projects, err := client.ListAllProjects(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	var stalled []*domain.Project
+	for _, p := range projects {
+		if p.IsActive() && projectHealth(ctx, p).DaysSinceActivity >= projectStalledDays {
+			stalled = append(stalled, p)
+		}
+	}
+	sort.Slice(stalled, func(i, j int) bool {
+		return stalled[i].Health.DaysSinceActivity > stalled[j].Health.DaysSinceActivity
+	})
+
+	if len(stalled) == 0 {
+		fmt.Printf("%s No active project has been idle for %d days or more\n", successStyle.Render("✓"), projectStalledDays)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "PROJECT\tAREA\tTASKS\tOVERDUE\tLAST ACTIVITY")
+	_, _ = fmt.Fprintln(w, "-------\t----\t-----\t-------\t-------------")
+
+	for _, p := range stalled {
+		areaName := ""
+		if area, _ := client.GetArea(ctx, p.AreaID); area != nil {
+			areaName = area.Title
 		}
-		taskStr := fmt.Sprintf("%d/%d", completedTasks, len(tasks))
 
-		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+		h := p.Health
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d/%d\t%d\t%s (%s)\n",
 			p.Title,
 			areaName,
-			p.Status,
-			p.Priority,
-			taskStr,
+			h.CompletedTasks,
+			h.TotalTasks,
+			h.OverdueTasks,
+			h.LastActivity.In(timezone).Format("2006-01-02"),
+			formatActivity(h),
 		)
 	}
 
 	return w.Flush()
 }
 
+// projectHealth returns the health the service computed for a project,
+// working it out from the project's tasks if the server didn't send one
+func projectHealth(ctx context.Context, p *domain.Project) *domain.ProjectHealth {
+	if p.Health == nil {
+		tasks, _ := client.ListTasks(ctx, p.ID)
+		p.Health = service.ProjectHealth(p, tasks, time.Now().In(timezone))
+	}
+	return p.Health
+}
+
+// healthColors maps each health status to the color it is shown in
+var healthColors = map[domain.HealthStatus]lipgloss.Color{
+	domain.HealthOnTrack: lipgloss.Color("10"),
+	domain.HealthAtRisk:  lipgloss.Color("11"),
+	domain.HealthStalled: lipgloss.Color("9"),
+	domain.HealthDone:    lipgloss.Color("8"),
+	domain.HealthSomeday: lipgloss.Color("8"),
+}
+
+// renderHealth renders a health status in its color, e.g. "at risk" in yellow
+func renderHealth(status domain.HealthStatus) string {
+	label := strings.ReplaceAll(string(status), "_", " ")
+	return lipgloss.NewStyle().Foreground(healthColors[status]).Render(label)
+}
+
+// formatActivity describes how long ago a project was last touched
+func formatActivity(h *domain.ProjectHealth) string {
+	switch {
+	case h.LastActivity.IsZero():
+		return "never"
+	case h.DaysSinceActivity == 0:
+		return "today"
+	case h.DaysSinceActivity == 1:
+		return "yesterday"
+	default:
+		return fmt.Sprintf("%dd ago", h.DaysSinceActivity)
+	}
+}
+
 func runProjectCreate(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	name := args[0]
 
+	meta, err := domain.ParseMetadata(projectMetaFlag)
+	if err != nil {
+		return err
+	}
+
+	var parent *domain.Project
+	if projectParentFlag != "" {
+		if parent, err = findProject(ctx, projectParentFlag); err != nil {
+			return err
+		}
+	}
+
 	// Get area
 	var areaID string
-	if projectAreaFlag != "" {
+	if parent != nil && projectAreaFlag == "" {
+		areaID = parent.AreaID
+	} else if projectAreaFlag != "" {
 		area, err := client.GetAreaBySlug(ctx, projectAreaFlag)
 		if err != nil {
-			return fmt.Errorf("area not found: %s", projectAreaFlag)
+			return domain.Errorf(domain.ErrNotFound, "area not found: %s", projectAreaFlag)
 		}
 		areaID = area.ID
 	} else {
@@ -163,26 +472,22 @@ func runProjectCreate(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("no areas found. Create one first with 'reorg area create <name>'")
 		}
 
-		fmt.Println("Select an area:")
+		labels := make([]string, len(areas))
 		for i, a := range areas {
-			fmt.Printf("  %d. %s\n", i+1, a.Title)
+			labels[i] = a.Title
 		}
-
-		reader := bufio.NewReader(os.Stdin)
-		fmt.Print("Enter number: ")
-		input, _ := reader.ReadString('\n')
-		input = strings.TrimSpace(input)
-
-		num, err := strconv.Atoi(input)
-		if err != nil || num < 1 || num > len(areas) {
-			return fmt.Errorf("invalid selection")
+		i, err := selectItem("Select an area:", labels)
+		if err != nil {
+			return err
 		}
-
-		areaID = areas[num-1].ID
+		areaID = areas[i].ID
 	}
 
 	// Create project
 	project := domain.NewProject(name, areaID)
+	if parent != nil {
+		project.ParentProjectID = parent.ID
+	}
 
 	// Set priority
 	switch strings.ToLower(projectPriorityFlag) {
@@ -200,6 +505,22 @@ func runProjectCreate(cmd *cobra.Command, args []string) error {
 	for _, tag := range projectTagsFlag {
 		project.AddTag(tag)
 	}
+	domain.SetMetadata(&project.Metadata, meta)
+
+	if projectNotifyFlag != "" {
+		if _, err := notify.ForChannel(projectNotifyFlag); err != nil {
+			return err
+		}
+		project.Notify = projectNotifyFlag
+	}
+
+	if projectGoalFlag != "" {
+		goal, err := findGoal(ctx, projectGoalFlag)
+		if err != nil {
+			return err
+		}
+		project.GoalID = goal.ID
+	}
 
 	if _, err := client.CreateProject(ctx, project); err != nil {
 		return fmt.Errorf("failed to create project: %w", err)
@@ -210,7 +531,7 @@ func runProjectCreate(cmd *cobra.Command, args []string) error {
 }
 
 func runProjectShow(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	slug := args[0]
 
 	// Try to find project by slug (checking all areas)
@@ -225,7 +546,7 @@ func runProjectShow(cmd *cobra.Command, args []string) error {
 	}
 
 	if project == nil {
-		return fmt.Errorf("project not found: %s", slug)
+		return domain.Errorf(domain.ErrNotFound, "project not found: %s", slug)
 	}
 
 	// Get area
@@ -237,12 +558,7 @@ func runProjectShow(cmd *cobra.Command, args []string) error {
 
 	// Get tasks
 	tasks, _ := client.ListTasks(ctx, project.ID)
-	completedTasks := 0
-	for _, t := range tasks {
-		if t.IsComplete() {
-			completedTasks++
-		}
-	}
+	health := projectHealth(ctx, project)
 
 	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12"))
 	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
@@ -253,6 +569,13 @@ func runProjectShow(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("%s %s\n", labelStyle.Render("ID:"), project.ID)
 	fmt.Printf("%s %s\n", labelStyle.Render("Area:"), areaName)
+	if project.IsSubProject() {
+		parentName := project.ParentProjectID
+		if parent, err := client.GetProject(ctx, project.ParentProjectID); err == nil {
+			parentName = parent.Title
+		}
+		fmt.Printf("%s %s\n", labelStyle.Render("Parent:"), parentName)
+	}
 	fmt.Printf("%s %s\n", labelStyle.Render("Status:"), project.Status)
 	fmt.Printf("%s %s\n", labelStyle.Render("Priority:"), project.Priority)
 	fmt.Printf("%s %s\n", labelStyle.Render("Created:"), project.Created.Format("2006-01-02 15:04"))
@@ -266,13 +589,55 @@ func runProjectShow(cmd *cobra.Command, args []string) error {
 		fmt.Printf("%s %s\n", labelStyle.Render("Tags:"), strings.Join(project.Tags, ", "))
 	}
 
+	if project.Notify != "" {
+		fmt.Printf("%s %s\n", labelStyle.Render("Notify:"), project.Notify)
+	}
+
+	if project.GoalID != "" {
+		goalName := project.GoalID
+		if goal, err := client.GetGoal(ctx, project.GoalID); err == nil {
+			goalName = goal.Title
+		}
+		fmt.Printf("%s %s\n", labelStyle.Render("Goal:"), goalName)
+	}
+
+	if project.ExternalRef != nil {
+		fmt.Printf("%s %s\n", labelStyle.Render("External:"), formatExternalRef(project.ExternalRef))
+	}
+	printMetadata(project.Metadata, labelStyle)
+
 	fmt.Println()
-	fmt.Printf("%s %d/%d completed\n", labelStyle.Render("Tasks:"), completedTasks, len(tasks))
+	fmt.Printf("%s %d/%d completed (%d%%)\n", labelStyle.Render("Tasks:"), health.CompletedTasks, health.TotalTasks, health.PercentComplete)
+	healthInfo := renderHealth(health.Status) + ", last activity " + formatActivity(health)
+	if health.OverdueTasks > 0 {
+		healthInfo += fmt.Sprintf(", %d overdue", health.OverdueTasks)
+	}
+	fmt.Printf("%s %s\n", labelStyle.Render("Health:"), healthInfo)
+	if spent, estimated := trackedTime(tasks); spent > 0 || estimated > 0 {
+		timeInfo := domain.FormatTimeSpec(spent) + " spent"
+		if estimated > 0 {
+			timeInfo += " / " + domain.FormatTimeSpec(estimated) + " estimated"
+		}
+		fmt.Printf("%s %s\n", labelStyle.Render("Time:"), timeInfo)
+	}
 	fmt.Println()
 
-	if project.Content != "" {
-		fmt.Println(labelStyle.Render("Description:"))
-		fmt.Println(project.Content)
+	printDescription(project.Content, labelStyle)
+
+	var subProjects []*domain.Project
+	if siblings, err := client.ListProjects(ctx, project.AreaID); err == nil {
+		for _, p := range siblings {
+			if p.ParentProjectID == project.ID {
+				subProjects = append(subProjects, p)
+			}
+		}
+	}
+	if len(subProjects) > 0 {
+		fmt.Println(labelStyle.Render("Sub-projects:"))
+		for _, p := range subProjects {
+			h := projectHealth(ctx, p)
+			fmt.Printf("  %s %s\n", p.Title, dimStyle.Render(fmt.Sprintf("%d/%d", h.CompletedTasks, h.TotalTasks)))
+		}
 		fmt.Println()
 	}
 
@@ -286,17 +651,21 @@ func runProjectShow(cmd *cobra.Command, args []string) error {
 				statusIcon = "◐"
 			} else if t.Status == domain.TaskStatusBlocked {
 				statusIcon = "⊘"
+			} else if t.Status == domain.TaskStatusWaiting {
+				statusIcon = "⧗"
 			}
 			fmt.Printf("  %s %s\n", statusIcon, t.Title)
 		}
 		fmt.Println()
 	}
 
+	printNotes(ctx, project.ID, labelStyle)
+
 	return nil
 }
 
 func runProjectComplete(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	slug := args[0]
 
 	// Find project
@@ -311,7 +680,7 @@ func runProjectComplete(cmd *cobra.Command, args []string) error {
 	}
 
 	if project == nil {
-		return fmt.Errorf("project not found: %s", slug)
+		return domain.Errorf(domain.ErrNotFound, "project not found: %s", slug)
 	}
 
 	if err := client.CompleteProject(ctx, project.ID); err != nil {
@@ -322,8 +691,196 @@ func runProjectComplete(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runProjectDefer(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	project, err := findProject(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case project.IsSomeday():
+		fmt.Printf("%s is already someday\n", project.Title)
+		return nil
+	case project.IsComplete() || project.Status == domain.ProjectStatusArchived:
+		return fmt.Errorf("project %s is %s", project.Title, project.Status)
+	}
+
+	project.Defer()
+	if err := client.UpdateProject(ctx, project); err != nil {
+		return fmt.Errorf("failed to update project: %w", err)
+	}
+
+	fmt.Printf("%s Deferred to someday: %s\n", successStyle.Render("✓"), project.Title)
+	return nil
+}
+
+func runProjectRevive(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	project, err := findProject(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	if !project.IsSomeday() && project.Status != domain.ProjectStatusOnHold {
+		fmt.Printf("%s is %s\n", project.Title, project.Status)
+		return nil
+	}
+
+	project.Revive()
+	if err := client.UpdateProject(ctx, project); err != nil {
+		return fmt.Errorf("failed to update project: %w", err)
+	}
+
+	fmt.Printf("%s Revived: %s\n", successStyle.Render("✓"), project.Title)
+	return nil
+}
+
+// inSomedayProject reports whether a task belongs to a someday project,
+// which the default views leave out
+func inSomedayProject(r *service.TaskRef) bool {
+	return r.Project != nil && r.Project.IsSomeday()
+}
+
+func runProjectMove(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	project, err := findProject(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	area, err := client.GetAreaBySlug(ctx, projectAreaFlag)
+	if err != nil {
+		return domain.Errorf(domain.ErrNotFound, "area not found: %s", projectAreaFlag)
+	}
+
+	if project.AreaID == area.ID {
+		fmt.Printf("%s is already in %s\n", project.Title, area.Title)
+		return nil
+	}
+
+	if err := client.MoveProject(ctx, project.ID, area.ID); err != nil {
+		return fmt.Errorf("failed to move project: %w", err)
+	}
+
+	fmt.Printf("%s Moved %s to %s\n", successStyle.Render("✓"), project.Title, area.Title)
+	return nil
+}
+
+func runProjectUpdate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	project, err := findProject(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	if len(projectAddTagsFlag) == 0 && len(projectRmTagsFlag) == 0 && len(projectMetaFlag) == 0 && projectGoalFlag == "" && projectParentFlag == "" {
+		return fmt.Errorf("nothing to change: use --add-tag, --remove-tag, --goal, --parent or --meta")
+	}
+
+	meta, err := domain.ParseMetadata(projectMetaFlag)
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(projectGoalFlag) {
+	case "":
+	case "none":
+		project.GoalID = ""
+	default:
+		goal, err := findGoal(ctx, projectGoalFlag)
+		if err != nil {
+			return err
+		}
+		project.GoalID = goal.ID
+	}
+
+	switch strings.ToLower(projectParentFlag) {
+	case "":
+	case "none":
+		project.ParentProjectID = ""
+	default:
+		parent, err := findProject(ctx, projectParentFlag)
+		if err != nil {
+			return err
+		}
+		project.ParentProjectID = parent.ID
+	}
+
+	for _, tag := range projectAddTagsFlag {
+		project.AddTag(tag)
+	}
+	for _, tag := range projectRmTagsFlag {
+		project.RemoveTag(tag)
+	}
+	domain.SetMetadata(&project.Metadata, meta)
+	project.UpdateTimestamp()
+
+	if err := client.UpdateProject(ctx, project); err != nil {
+		return fmt.Errorf("failed to update project: %w", err)
+	}
+
+	fmt.Printf("%s Updated project: %s\n", successStyle.Render("✓"), project.Title)
+	return nil
+}
+
+func runProjectNotify(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	project, err := findProject(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	channel := ""
+	if len(args) > 1 {
+		channel = args[1]
+	}
+
+	notifier, err := notify.ForChannel(channel)
+	if err != nil {
+		return err
+	}
+
+	if project.Notify != channel {
+		project.Notify = channel
+		if err := client.UpdateProject(ctx, project); err != nil {
+			return fmt.Errorf("failed to update project: %w", err)
+		}
+	}
+
+	if channel == "" {
+		fmt.Printf("%s %s uses the default notification channel\n", successStyle.Render("✓"), project.Title)
+	} else {
+		fmt.Printf("%s %s notifies %s\n", successStyle.Render("✓"), project.Title, channel)
+	}
+
+	if projectTestFlag {
+		if channel == "" {
+			notifier, err = notify.ForChannel(viper.GetString("notifications.default"))
+			if err != nil {
+				return err
+			}
+		}
+		err := notifier.Notify(ctx, notify.Message{
+			Title: fmt.Sprintf("reorg: %s", project.Title),
+			Body:  "Test notification",
+		})
+		if err != nil {
+			return fmt.Errorf("test notification failed: %w", err)
+		}
+		fmt.Println(dimStyle.Render("  Test notification sent"))
+	}
+
+	return nil
+}
+
 func runProjectDelete(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	slug := args[0]
 
 	// Find project
@@ -338,7 +895,7 @@ func runProjectDelete(cmd *cobra.Command, args []string) error {
 	}
 
 	if project == nil {
-		return fmt.Errorf("project not found: %s", slug)
+		return domain.Errorf(domain.ErrNotFound, "project not found: %s", slug)
 	}
 
 	// Check for tasks
@@ -354,3 +911,20 @@ func runProjectDelete(cmd *cobra.Command, args []string) error {
 	fmt.Printf("%s Deleted project: %s\n", successStyle.Render("✓"), project.Title)
 	return nil
 }
+
+// findProject looks up a project by slug across all areas
+func findProject(ctx context.Context, slug string) (*domain.Project, error) {
+	areas, err := client.ListAreas(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list areas: %w", err)
+	}
+
+	for _, area := range areas {
+		p, err := client.GetProjectBySlug(ctx, area.ID, slug)
+		if err == nil {
+			return p, nil
+		}
+	}
+
+	return nil, domain.Errorf(domain.ErrNotFound, "project not found: %s", slug)
+}
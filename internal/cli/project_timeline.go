@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/timeline"
+)
+
+var (
+	timelineFormatFlag string
+	timelineOutputFlag string
+)
+
+var projectTimelineCmd = &cobra.Command{
+	Use:   "timeline [project]",
+	Short: "Export a project's tasks as a gantt chart",
+	Long: `Lay a project's tasks out over days and write them as a Mermaid gantt
+chart, for pasting into GitHub, GitLab or Notion, or as an SVG image.
+
+Each task takes its estimate (eight hours a day) or a day. Tasks with a due
+date end on it, tasks that depend on others start after them, and the rest
+start when they were created, or today if they are still open.
+
+Examples:
+  reorg project timeline website
+  reorg project timeline website --format svg -o website.svg`,
+	Args: cobra.ExactArgs(1),
+	RunE: runProjectTimeline,
+}
+
+func init() {
+	projectCmd.AddCommand(projectTimelineCmd)
+
+	projectTimelineCmd.Flags().StringVarP(&timelineFormatFlag, "format", "f", "mermaid", "Output format (mermaid, svg)")
+	projectTimelineCmd.Flags().StringVarP(&timelineOutputFlag, "output", "o", "-", "Output file (- for stdout)")
+	projectTimelineCmd.ValidArgsFunction = completeFirstArg(completeProjects)
+}
+
+func runProjectTimeline(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	format, err := timeline.ParseFormat(timelineFormatFlag)
+	if err != nil {
+		return err
+	}
+
+	project, err := findProject(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	tasks, err := client.ListTasks(ctx, project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list tasks: %w", err)
+	}
+	if len(tasks) == 0 {
+		return fmt.Errorf("project %s has no tasks", project.Title)
+	}
+
+	now := time.Now().In(timezone)
+	tl := timeline.Build(project, tasks, now)
+
+	var out io.Writer = os.Stdout
+	if timelineOutputFlag != "-" {
+		f, err := os.Create(timelineOutputFlag)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", timelineOutputFlag, err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if format == timeline.FormatSVG {
+		err = tl.WriteSVG(out, now)
+	} else {
+		err = tl.WriteMermaid(out)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write timeline: %w", err)
+	}
+
+	if timelineOutputFlag != "-" {
+		fmt.Printf("%s Wrote %s timeline of %d task(s) to %s\n", successStyle.Render("✓"), format, len(tl.Bars), timelineOutputFlag)
+	}
+	return nil
+}
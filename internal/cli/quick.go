@@ -0,0 +1,183 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/service"
+)
+
+var quickFormatFlag string
+
+var quickCmd = &cobra.Command{
+	Use:   "quick",
+	Short: "Commands for launchers such as Alfred and Raycast",
+	Long: `Commands with output meant for launchers: list open tasks in the JSON
+Alfred's script filters and Raycast expect, and complete or start a task
+by ID with a one-line answer for the launcher to show.
+
+In Alfred, add a script filter running
+  reorg quick list --format alfred "{query}"
+and connect it to a Run Script action running
+  reorg quick complete "{query}"`,
+}
+
+var quickListCmd = &cobra.Command{
+	Use:   "list [text]",
+	Short: "List open tasks for a launcher",
+	Long: `List open tasks, outside someday projects, with in-progress and due
+tasks first. Text narrows the list with fuzzy matching on the title and
+project. --format alfred prints an Alfred script filter response
+({"items": [{"uid", "title", "subtitle", "arg"}]}), --format raycast a JSON
+array of {"id", "title", "subtitle", "arg"}; the arg is the task ID.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runQuickList,
+}
+
+var quickCompleteCmd = &cobra.Command{
+	Use:   "complete [task-id]",
+	Short: "Complete a task from a launcher",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runQuickAction(domain.TaskStatusCompleted),
+}
+
+var quickStartCmd = &cobra.Command{
+	Use:   "start [task-id]",
+	Short: "Start a task from a launcher",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runQuickAction(domain.TaskStatusInProgress),
+}
+
+func init() {
+	rootCmd.AddCommand(quickCmd)
+	quickCmd.AddCommand(quickListCmd)
+	quickCmd.AddCommand(quickCompleteCmd)
+	quickCmd.AddCommand(quickStartCmd)
+
+	quickListCmd.Flags().StringVarP(&quickFormatFlag, "format", "f", "text", "Output format (text, alfred, raycast)")
+}
+
+// launcherItem is a task as Alfred and Raycast list it
+type launcherItem struct {
+	UID      string `json:"uid,omitempty"`
+	ID       string `json:"id,omitempty"`
+	Title    string `json:"title"`
+	Subtitle string `json:"subtitle"`
+	Arg      string `json:"arg"`
+}
+
+func runQuickList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	switch quickFormatFlag {
+	case "text", "alfred", "raycast":
+	default:
+		return fmt.Errorf("invalid format %q (use text, alfred or raycast)", quickFormatFlag)
+	}
+
+	refs, err := client.ListTasksWithRefs(ctx, "")
+	if err != nil {
+		return fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	var open []*service.TaskRef
+	for _, r := range refs {
+		if r.Task.IsComplete() || r.Task.Status == domain.TaskStatusCancelled || inSomedayProject(r) {
+			continue
+		}
+		if len(args) == 1 {
+			if _, ok := fuzzyScore(args[0], r.Task.Title+" "+refProjectTitle(r)); !ok {
+				continue
+			}
+		}
+		open = append(open, r)
+	}
+	sort.SliceStable(open, func(i, j int) bool {
+		a, b := open[i].Task, open[j].Task
+		if started := a.Status == domain.TaskStatusInProgress; started != (b.Status == domain.TaskStatusInProgress) {
+			return started
+		}
+		if (a.DueDate == nil) != (b.DueDate == nil) {
+			return a.DueDate != nil
+		}
+		if a.DueDate != nil && !a.DueDate.Equal(*b.DueDate) {
+			return a.DueDate.Before(*b.DueDate)
+		}
+		return a.Priority.Rank() > b.Priority.Rank()
+	})
+
+	items := make([]launcherItem, 0, len(open))
+	for _, r := range open {
+		t := r.Task
+		subtitle := []string{string(t.Status)}
+		if project := refProjectTitle(r); project != "" {
+			subtitle = append(subtitle, project)
+		}
+		if t.DueDate != nil {
+			subtitle = append(subtitle, "due "+t.DueDate.Format("Mon Jan 2"))
+		}
+		subtitle = append(subtitle, string(t.Priority))
+
+		item := launcherItem{Title: t.Title, Subtitle: strings.Join(subtitle, " · "), Arg: t.ID}
+		if quickFormatFlag == "alfred" {
+			item.UID = t.ID
+		} else {
+			item.ID = t.ID
+		}
+		items = append(items, item)
+	}
+
+	var out any = items
+	switch quickFormatFlag {
+	case "text":
+		for _, item := range items {
+			fmt.Printf("%s\t%s\t%s\n", item.Arg, item.Title, item.Subtitle)
+		}
+		return nil
+	case "alfred":
+		out = map[string]any{"items": items}
+	}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+// refProjectTitle is the title of a task's project, or "" if it has none
+func refProjectTitle(r *service.TaskRef) string {
+	if r.Project == nil {
+		return ""
+	}
+	return r.Project.Title
+}
+
+// runQuickAction sets a task's status and prints one plain line about it.
+// An unknown task exits with 2.
+func runQuickAction(status domain.TaskStatus) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		cmd.SilenceUsage = true
+
+		task, err := findTask(ctx, args[0])
+		if err != nil {
+			return exitCode(2, err)
+		}
+		if err := setTaskStatus(ctx, task, status); err != nil {
+			return fmt.Errorf("failed to update task: %w", err)
+		}
+
+		verb := "Started"
+		if status == domain.TaskStatusCompleted {
+			verb = "Completed"
+		}
+		fmt.Printf("%s: %s\n", verb, task.Title)
+		return nil
+	}
+}
@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/dateparse"
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/reminder"
+	"github.com/ihavespoons/reorg/internal/service"
+)
+
+var remindDryRunFlag bool
+
+var remindCmd = &cobra.Command{
+	Use:   "remind",
+	Short: "Send notifications for tasks that are due soon or overdue",
+	Long: `Check for tasks that are due soon or overdue and send a notification for
+each one through the project's notification channel. Every reminder is sent
+once per due date.
+
+Tasks delegated with 'reorg task assign' get a reminder to follow up with
+the assignee every reminders.follow_up (1w by default, or off) they keep
+waiting.
+
+'reorg serve' runs this check on a schedule when reminders.enabled is set.
+Without a server, run it from cron or launchd, e.g. every 15 minutes:
+
+  */15 * * * * reorg remind
+
+How early a task counts as due soon depends on its priority and can be set
+under reminders.lead_times in config.yaml.`,
+	RunE: runRemind,
+}
+
+func init() {
+	rootCmd.AddCommand(remindCmd)
+	remindCmd.Flags().BoolVar(&remindDryRunFlag, "dry-run", false, "Show reminders without sending them")
+}
+
+func runRemind(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	checker := newReminderChecker(client)
+
+	if remindDryRunFlag {
+		pending, err := checker.Pending(ctx, time.Now().In(timezone))
+		if err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			fmt.Println("No reminders due.")
+			return nil
+		}
+		for _, r := range pending {
+			msg := r.Message("")
+			fmt.Printf("  • %s %s\n", msg.Title, dimStyle.Render("("+msg.Body+")"))
+		}
+		return nil
+	}
+
+	sent, err := checker.Check(ctx, time.Now().In(timezone))
+	if sent > 0 {
+		fmt.Printf("%s Sent %d reminder(s)\n", successStyle.Render("✓"), sent)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to send reminders: %w", err)
+	}
+	if sent == 0 {
+		fmt.Println("No reminders due.")
+	}
+	return nil
+}
+
+// newReminderChecker creates a checker using the configured lead times
+// and follow-up interval
+func newReminderChecker(c service.ReorgClient) *reminder.Checker {
+	checker := reminder.NewChecker(c, newNotifier(), reminderLeadTimes(), filepath.Join(stateDir(), "reminders.yaml"))
+	checker.SetFollowUp(reminderFollowUp())
+	return checker
+}
+
+// reminderFollowUp returns how long a delegated task waits before each
+// follow-up reminder, from reminders.follow_up (1w by default, "off" for
+// none). An invalid value is reported and treated as the default.
+func reminderFollowUp() time.Duration {
+	value := viper.GetString("reminders.follow_up")
+	switch value {
+	case "":
+		value = "1w"
+	case "off", "0":
+		return 0
+	}
+	now := time.Now().In(timezone)
+	next, err := dateparse.Add(now, value)
+	if err != nil || !next.After(now) {
+		fmt.Fprintf(os.Stderr, "invalid reminders.follow_up %q, using 1w\n", value)
+		return 7 * 24 * time.Hour
+	}
+	return next.Sub(now)
+}
+
+// reminderLeadTimes returns the default lead times overridden by
+// reminders.lead_times in the config
+func reminderLeadTimes() map[domain.Priority]time.Duration {
+	leadTimes := reminder.DefaultLeadTimes()
+	for _, p := range []domain.Priority{domain.PriorityLow, domain.PriorityMedium, domain.PriorityHigh, domain.PriorityUrgent} {
+		key := "reminders.lead_times." + string(p)
+		if viper.IsSet(key) {
+			leadTimes[p] = viper.GetDuration(key)
+		}
+	}
+	return leadTimes
+}
+
+// reminderInterval returns how often 'reorg serve' checks for reminders,
+// from reminders.interval (15m by default). A bare number is taken as
+// minutes, as in task estimates.
+func reminderInterval() (time.Duration, error) {
+	value := viper.GetString("reminders.interval")
+	if value == "" {
+		return 15 * time.Minute, nil
+	}
+	interval, err := domain.ParseTimeSpec(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid reminders.interval: %w", err)
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("invalid reminders.interval: %s (must be longer than zero)", value)
+	}
+	return interval, nil
+}
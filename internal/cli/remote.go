@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	apiclient "github.com/ihavespoons/reorg/internal/api/client"
+)
+
+var remotePingCountFlag int
+
+var remoteCmd = &cobra.Command{
+	Use:   "remote",
+	Short: "Check the connection to a reorg server",
+	Long: `Commands for remote mode, where reorg talks to a 'reorg serve' at
+server.address instead of reading the files itself.
+
+How the client connects is set under remote in config.yaml: TLS, the
+deadline of each call, retries of calls that only read, and keepalive
+pings that keep idle connections open through NATs and proxies.`,
+}
+
+var remotePingCmd = &cobra.Command{
+	Use:   "ping",
+	Short: "Check that the server can be reached",
+	Long: `Connect to the server at server.address (or --server) with the remote
+settings and make a call that only reads, reporting how long each one
+takes. Calls aren't retried, so every failure shows.`,
+	Args: cobra.NoArgs,
+	RunE: runRemotePing,
+}
+
+func init() {
+	rootCmd.AddCommand(remoteCmd)
+	remoteCmd.AddCommand(remotePingCmd)
+	remotePingCmd.Flags().IntVarP(&remotePingCountFlag, "count", "c", 3, "Number of calls to make")
+}
+
+func runRemotePing(cmd *cobra.Command, args []string) error {
+	options, err := remoteOptions()
+	if err != nil {
+		return err
+	}
+	options.Retries = 0
+	cmd.SilenceUsage = true
+
+	security := "without TLS"
+	if options.TLS.Enabled {
+		security = "with TLS"
+	}
+	fmt.Printf("Connecting to %s %s\n", serverAddress, dimStyle.Render(security))
+
+	remote, err := apiclient.NewRemoteClient(serverAddress, options)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = remote.Close() }()
+
+	failed := 0
+	for i := 0; i < remotePingCountFlag; i++ {
+		if i > 0 {
+			time.Sleep(time.Second)
+		}
+		start := time.Now()
+		areas, err := remote.ListAreas(cmd.Context())
+		elapsed := time.Since(start).Round(time.Millisecond)
+		if err != nil {
+			failed++
+			fmt.Printf("  ✗ %s: %v\n", elapsed, err)
+			continue
+		}
+		fmt.Printf("  %s %s %s\n", successStyle.Render("✓"), elapsed, dimStyle.Render(fmt.Sprintf("(%d areas)", len(areas))))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d calls failed", failed, remotePingCountFlag)
+	}
+	return nil
+}
+
+// remoteOptions reads how to connect to the server from the config
+func remoteOptions() (apiclient.Options, error) {
+	options := apiclient.Options{
+		Token: viper.GetString("server.token"),
+		Actor: auditActor(),
+		TLS: apiclient.TLSOptions{
+			Enabled:            viper.GetBool("remote.tls.enabled"),
+			CAFile:             expandHome(viper.GetString("remote.tls.ca_file")),
+			CertFile:           expandHome(viper.GetString("remote.tls.cert_file")),
+			KeyFile:            expandHome(viper.GetString("remote.tls.key_file")),
+			ServerName:         viper.GetString("remote.tls.server_name"),
+			InsecureSkipVerify: viper.GetBool("remote.tls.insecure_skip_verify"),
+		},
+		Timeout:          30 * time.Second,
+		Retries:          3,
+		RetryBackoff:     200 * time.Millisecond,
+		KeepaliveTime:    time.Minute,
+		KeepaliveTimeout: 20 * time.Second,
+		Location:         timezone,
+	}
+
+	durations := map[string]*time.Duration{
+		"remote.timeout":           &options.Timeout,
+		"remote.retry_backoff":     &options.RetryBackoff,
+		"remote.keepalive.time":    &options.KeepaliveTime,
+		"remote.keepalive.timeout": &options.KeepaliveTimeout,
+	}
+	for key, d := range durations {
+		if !viper.IsSet(key) {
+			continue
+		}
+		value, err := time.ParseDuration(viper.GetString(key))
+		if err != nil || value < 0 {
+			return options, fmt.Errorf("invalid %s %q (use a duration such as 30s, or 0 to turn it off)", key, viper.GetString(key))
+		}
+		*d = value
+	}
+	if viper.IsSet("remote.retries") {
+		options.Retries = viper.GetInt("remote.retries")
+	}
+	if options.KeepaliveTime > 0 && options.KeepaliveTime < 10*time.Second {
+		return options, fmt.Errorf("remote.keepalive.time must be at least 10s, or the server drops the connection")
+	}
+	return options, nil
+}
@@ -0,0 +1,180 @@
+// Package render sorts, groups and prints the tables of the list commands
+package render
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// Fields are the values a list item is sorted and grouped by. Fields an
+// item doesn't have are left zero.
+type Fields struct {
+	Due      *time.Time
+	Priority domain.Priority
+	Created  time.Time
+	Updated  time.Time
+	Project  string
+	Area     string
+	Status   string
+}
+
+// Options are the --sort and --group-by flags of a list command
+type Options struct {
+	Sort    string
+	GroupBy string
+
+	sortKeys  []string
+	groupKeys []string
+}
+
+// AddFlags adds --sort and, when groupKeys are given, --group-by to cmd,
+// accepting the given keys
+func (o *Options) AddFlags(cmd *cobra.Command, sortKeys, groupKeys []string) {
+	o.sortKeys, o.groupKeys = sortKeys, groupKeys
+
+	cmd.Flags().StringVar(&o.Sort, "sort", "", "Sort by "+strings.Join(sortKeys, ", "))
+	_ = cmd.RegisterFlagCompletionFunc("sort", cobra.FixedCompletions(sortKeys, cobra.ShellCompDirectiveNoFileComp))
+	if len(groupKeys) > 0 {
+		cmd.Flags().StringVar(&o.GroupBy, "group-by", "", "Group by "+strings.Join(groupKeys, ", "))
+		_ = cmd.RegisterFlagCompletionFunc("group-by", cobra.FixedCompletions(groupKeys, cobra.ShellCompDirectiveNoFileComp))
+	}
+}
+
+// Validate checks the flags name keys the command accepts
+func (o *Options) Validate() error {
+	if o.Sort != "" && !slices.Contains(o.sortKeys, o.Sort) {
+		return fmt.Errorf("invalid --sort: %s (use %s)", o.Sort, strings.Join(o.sortKeys, ", "))
+	}
+	if o.GroupBy != "" && !slices.Contains(o.groupKeys, o.GroupBy) {
+		return fmt.Errorf("invalid --group-by: %s (use %s)", o.GroupBy, strings.Join(o.groupKeys, ", "))
+	}
+	return nil
+}
+
+// Group is a named run of items
+type Group[T any] struct {
+	Name  string
+	Items []T
+}
+
+// Apply sorts items by o.Sort and splits them by o.GroupBy. Due dates
+// sort soonest first with undated items last, priorities most urgent
+// first, and created and updated times newest first; ties keep their
+// order. Groups are ordered by name, with items lacking the field last
+// under "(none)". Without --group-by everything is in one unnamed group.
+func Apply[T any](items []T, o Options, fields func(T) Fields) []Group[T] {
+	items = slices.Clone(items)
+	if compare := comparer(o.Sort); compare != nil {
+		slices.SortStableFunc(items, func(a, b T) int {
+			return compare(fields(a), fields(b))
+		})
+	}
+
+	key := grouper(o.GroupBy)
+	if key == nil {
+		return []Group[T]{{Items: items}}
+	}
+	var groups []Group[T]
+	index := make(map[string]int)
+	for _, item := range items {
+		name := key(fields(item))
+		i, ok := index[name]
+		if !ok {
+			i = len(groups)
+			index[name] = i
+			groups = append(groups, Group[T]{Name: name})
+		}
+		groups[i].Items = append(groups[i].Items, item)
+	}
+	slices.SortStableFunc(groups, func(a, b Group[T]) int {
+		if (a.Name == "") != (b.Name == "") {
+			if a.Name == "" {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+	for i := range groups {
+		if groups[i].Name == "" {
+			groups[i].Name = "(none)"
+		}
+	}
+	return groups
+}
+
+func comparer(by string) func(a, b Fields) int {
+	switch by {
+	case "due":
+		return func(a, b Fields) int {
+			switch {
+			case a.Due == nil && b.Due == nil:
+				return 0
+			case a.Due == nil:
+				return 1
+			case b.Due == nil:
+				return -1
+			}
+			return a.Due.Compare(*b.Due)
+		}
+	case "priority":
+		return func(a, b Fields) int { return cmp.Compare(b.Priority.Rank(), a.Priority.Rank()) }
+	case "updated":
+		return func(a, b Fields) int { return b.Updated.Compare(a.Updated) }
+	case "created":
+		return func(a, b Fields) int { return b.Created.Compare(a.Created) }
+	}
+	return nil
+}
+
+func grouper(by string) func(Fields) string {
+	switch by {
+	case "project":
+		return func(f Fields) string { return f.Project }
+	case "area":
+		return func(f Fields) string { return f.Area }
+	case "status":
+		return func(f Fields) string { return f.Status }
+	}
+	return nil
+}
+
+var headingStyle = lipgloss.NewStyle().Bold(true)
+
+// Table writes each group as tab-aligned columns under header, with the
+// group's name and size above it when the items are grouped
+func Table[T any](out io.Writer, groups []Group[T], header []string, row func(T) []string) error {
+	underline := make([]string, len(header))
+	for i, h := range header {
+		underline[i] = strings.Repeat("-", len(h))
+	}
+
+	for i, g := range groups {
+		if g.Name != "" {
+			if i > 0 {
+				_, _ = fmt.Fprintln(out)
+			}
+			_, _ = fmt.Fprintf(out, "%s (%d)\n", headingStyle.Render(g.Name), len(g.Items))
+		}
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, strings.Join(header, "\t"))
+		_, _ = fmt.Fprintln(w, strings.Join(underline, "\t"))
+		for _, item := range g.Items {
+			_, _ = fmt.Fprintln(w, strings.Join(row(item), "\t"))
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
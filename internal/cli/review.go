@@ -0,0 +1,180 @@
+package cli
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/review"
+)
+
+var reviewAIFlag bool
+
+var reviewCmd = &cobra.Command{
+	Use:   "review",
+	Short: "Review parked work",
+}
+
+var reviewSomedayCmd = &cobra.Command{
+	Use:   "someday",
+	Short: "Go through the someday/maybe backlog",
+	Long: `Go through the projects in the someday/maybe backlog, oldest first, and
+decide for each whether to activate it, keep it for later or drop it
+(archive it). With --ai the AI suggests a decision for each project, given
+the active projects and goals.
+
+Projects are moved to the backlog with 'reorg project defer'.
+
+Examples:
+  reorg review someday
+  reorg review someday --ai`,
+	Args: cobra.NoArgs,
+	RunE: runReviewSomeday,
+}
+
+func init() {
+	rootCmd.AddCommand(reviewCmd)
+	reviewCmd.AddCommand(reviewSomedayCmd)
+
+	reviewSomedayCmd.Flags().BoolVar(&reviewAIFlag, "ai", false, "Ask the AI for a suggestion on each project")
+}
+
+func runReviewSomeday(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	overview, err := client.GetOverview(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read projects: %w", err)
+	}
+
+	now := time.Now().In(timezone)
+	var items []review.Item
+	var active []string
+	for _, area := range overview.Areas {
+		for _, po := range area.Projects {
+			p := po.Project
+			if p.IsActive() {
+				active = append(active, p.Title)
+			}
+			if !p.IsSomeday() {
+				continue
+			}
+			item := review.Item{Project: p, Area: area.Area.Title, Idle: now.Sub(p.Updated)}
+			for _, t := range po.Tasks {
+				if openTask(t) {
+					item.OpenTasks++
+				}
+				if idle := now.Sub(t.Updated); idle < item.Idle {
+					item.Idle = idle
+				}
+			}
+			items = append(items, item)
+		}
+	}
+
+	if len(items) == 0 {
+		fmt.Println("The someday backlog is empty. Park a project with 'reorg project defer <project>'")
+		return nil
+	}
+
+	// Longest parked first, since those most need a decision
+	slices.SortStableFunc(items, func(a, b review.Item) int {
+		return cmp.Compare(b.Idle, a.Idle)
+	})
+
+	var advice map[string]review.Advice
+	if reviewAIFlag {
+		llmClient, err := getLLMClient()
+		if err == nil {
+			advice, err = review.Advise(ctx, llmClient, items, reviewSituation(ctx, active))
+		}
+		if err != nil {
+			fmt.Println(dimStyle.Render(fmt.Sprintf("  Could not ask the AI (%v), reviewing without suggestions", err)))
+		}
+	}
+
+	fmt.Println(titleStyle.Render(fmt.Sprintf("\n  Someday backlog (%d projects)\n", len(items))))
+
+	reader := bufio.NewReader(os.Stdin)
+	var activated, dropped, kept int
+	for i, item := range items {
+		p := item.Project
+		fmt.Printf("  %d/%d %s %s\n", i+1, len(items), p.Title,
+			dimStyle.Render(fmt.Sprintf("(%s, %d open tasks, untouched for %d days)", item.Area, item.OpenTasks, int(item.Idle.Hours()/24))))
+
+		suggested := review.ActionKeep
+		if a, ok := advice[p.ID]; ok {
+			suggested = a.Action
+			fmt.Println(dimStyle.Render(fmt.Sprintf("      AI: %s, %s", a.Action, a.Reason)))
+		}
+
+		fmt.Print(promptStyle.Render(fmt.Sprintf("      [a]ctivate, [k]eep, [d]rop, [q]uit (%s): ", suggested)))
+		input, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if err == io.EOF && input == "" {
+			fmt.Println()
+			break
+		}
+
+		action := suggested
+		switch strings.ToLower(strings.TrimSpace(input)) {
+		case "":
+		case "a", "activate":
+			action = review.ActionActivate
+		case "k", "keep":
+			action = review.ActionKeep
+		case "d", "drop":
+			action = review.ActionDrop
+		case "q", "quit":
+			action = ""
+		default:
+			fmt.Println(dimStyle.Render("      Not understood, keeping it"))
+			action = review.ActionKeep
+		}
+		if action == "" {
+			break
+		}
+
+		switch action {
+		case review.ActionActivate:
+			p.Revive()
+			activated++
+		case review.ActionDrop:
+			p.Archive()
+			dropped++
+		default:
+			kept++
+			continue
+		}
+		if err := client.UpdateProject(ctx, p); err != nil {
+			return fmt.Errorf("failed to update project: %w", err)
+		}
+	}
+
+	fmt.Printf("\n%s %d activated, %d kept, %d dropped\n", successStyle.Render("✓"), activated, kept, dropped)
+	return nil
+}
+
+// reviewSituation describes the current workload for the AI
+func reviewSituation(ctx context.Context, active []string) string {
+	var b strings.Builder
+	if len(active) > 0 {
+		fmt.Fprintf(&b, "Active projects: %s\n", strings.Join(active, ", "))
+	} else {
+		b.WriteString("There are no active projects.\n")
+	}
+	if goals := goalSummaries(ctx, client); len(goals) > 0 {
+		fmt.Fprintf(&b, "Current goals: %s\n", strings.Join(goals, "; "))
+	}
+	return b.String()
+}
@@ -1,14 +1,21 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
+	_ "time/tzdata" // for the timezone setting where the system has no zoneinfo
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	apiclient "github.com/ihavespoons/reorg/internal/api/client"
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/notify"
+	"github.com/ihavespoons/reorg/internal/rules"
 	"github.com/ihavespoons/reorg/internal/service"
 	"github.com/ihavespoons/reorg/internal/storage/markdown"
 )
@@ -18,8 +25,14 @@ var (
 	dataDir       string
 	mode          string
 	serverAddress string
+	dryRun        bool
 	store         *markdown.Store
 	client        service.ReorgClient
+	workspaceErr  error
+
+	// timezone is the configured timezone, which dates are shown and days
+	// counted in: the timezone setting, or the system's without one
+	timezone = time.Local
 
 	// Version info set by main
 	version = "dev"
@@ -47,24 +60,90 @@ It supports a hierarchical structure:
 All data is stored as markdown files with YAML frontmatter,
 making it easy to edit manually and track with version control.`,
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		llmCaller = strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+		startTracing(cmd)
+		if err := setTimezone(); err != nil {
+			return err
+		}
+
+		if cmd.Parent() == workspaceCmd {
+			return nil
+		}
+		if workspaceErr != nil {
+			return workspaceErr
+		}
+
+		// Backups work on the files, and restore may run before there are any
+		if cmd.Parent() == backupCmd {
+			return nil
+		}
+
+		// Remote commands make their own connection to the server
+		if cmd.Parent() == remoteCmd {
+			return nil
+		}
+
 		// Skip client initialization for commands that don't need it
 		switch cmd.Name() {
-		case "init", "serve", "version", "help", "completion":
+		case "init", "serve", "version", "help", "completion", cobra.ShellCompRequestCmd:
 			return nil
 		}
 
 		// Initialize client based on mode
-		return initClient()
+		if err := initClient(); err != nil {
+			return err
+		}
+		if dryRun {
+			startDryRun()
+		}
+		return nil
 	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
+	registerFlagCompletions(rootCmd)
+	err := rootCmd.Execute()
+	finishTracing(err)
+	if dryRun && err == nil {
+		finishDryRun()
+	}
+	if err != nil {
+		var exit *exitError
+		if errors.As(err, &exit) {
+			os.Exit(exit.code)
+		}
+		if code, ok := kindExitCodes[domain.Kind(err)]; ok {
+			os.Exit(code)
+		}
 		os.Exit(1)
 	}
 }
 
+// kindExitCodes are the exit codes of the kinds of domain error, the same
+// in embedded and remote mode. Other errors exit with 1.
+var kindExitCodes = map[error]int{
+	domain.ErrInvalid:       2,
+	domain.ErrNotFound:      3,
+	domain.ErrConflict:      4,
+	domain.ErrAlreadyExists: 5,
+}
+
+// exitError is an error that ends the program with a specific exit code,
+// for commands that scripts depend on
+type exitError struct {
+	code int
+	err  error
+}
+
+// exitCode wraps err so the program exits with code
+func exitCode(code int, err error) error {
+	return &exitError{code: code, err: err}
+}
+
+func (e *exitError) Error() string { return e.err.Error() }
+func (e *exitError) Unwrap() error { return e.err }
+
 func init() {
 	cobra.OnInitialize(initConfig)
 
@@ -73,6 +152,8 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&dataDir, "data-dir", "", "data directory (default is ~/.reorg)")
 	rootCmd.PersistentFlags().StringVar(&mode, "mode", "", "operation mode: embedded or remote (default is embedded)")
 	rootCmd.PersistentFlags().StringVar(&serverAddress, "server", "", "server address for remote mode (default is localhost:50051)")
+	rootCmd.PersistentFlags().StringVar(&workspace, "workspace", "", "workspace to use (default is the one chosen with 'reorg workspace use')")
+	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "show the changes a command would make without making them")
 
 	// Bind flags to viper
 	_ = viper.BindPFlag("data_dir", rootCmd.PersistentFlags().Lookup("data-dir"))
@@ -80,6 +161,22 @@ func init() {
 	_ = viper.BindPFlag("server.address", rootCmd.PersistentFlags().Lookup("server"))
 }
 
+// setTimezone loads the timezone setting into timezone, which the store
+// and the remote client are then given to read dates into
+func setTimezone() error {
+	timezone = time.Local
+	name := viper.GetString("timezone")
+	if name == "" {
+		return nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return fmt.Errorf("invalid timezone: %w", err)
+	}
+	timezone = loc
+	return nil
+}
+
 // initConfig reads in config file and ENV variables if set.
 func initConfig() {
 	if cfgFile != "" {
@@ -107,6 +204,11 @@ func initConfig() {
 	// Read config file if it exists (ignore error if not found)
 	_ = viper.ReadInConfig()
 
+	// Layer the active workspace's settings over the rest. An unknown
+	// workspace is reported once the command is known, since the workspace
+	// commands still have to work to fix it.
+	workspaceErr = applyWorkspace()
+
 	// Set data directory
 	if dataDir == "" {
 		dataDir = viper.GetString("data_dir")
@@ -144,7 +246,11 @@ func initClient() error {
 	switch mode {
 	case "remote":
 		// Connect to remote server
-		remoteClient, err := apiclient.NewRemoteClient(serverAddress)
+		options, err := remoteOptions()
+		if err != nil {
+			return err
+		}
+		remoteClient, err := apiclient.NewRemoteClient(serverAddress, options)
 		if err != nil {
 			return fmt.Errorf("failed to connect to server: %w", err)
 		}
@@ -161,11 +267,79 @@ func initClient() error {
 
 		// Initialize local store and client
 		store = markdown.NewStore(dataDir)
-		client = service.NewLocalClient(store)
+		localClient, err := newLocalClient(store)
+		if err != nil {
+			return err
+		}
+		client = localClient
 		return nil
 	}
 }
 
+// newLocalClient creates a local client for the store with files named and
+// dates read in the timezone as configured, notifications routed according
+// to the config, the config's shell hooks and the automation rules of the
+// config and the store's rules/ folder
+func newLocalClient(store *markdown.Store) (*service.LocalClient, error) {
+	switch naming := markdown.FileNaming(viper.GetString("storage.filenames")); naming {
+	case "":
+	case markdown.NameBySlug, markdown.NameByID:
+		store.SetFileNaming(naming)
+	default:
+		return nil, fmt.Errorf("storage.filenames must be %q or %q, not %q", markdown.NameBySlug, markdown.NameByID, naming)
+	}
+	store.SetLocation(timezone)
+	localClient := service.NewLocalClient(store)
+	localClient.SetNotifier(newNotifier())
+	localClient.SetCommitExternalEdits(viper.GetBool("git.commit_external_edits"))
+	localClient.SetHooks(newHooks())
+	localClient.SetAudit(newAuditLog())
+
+	automation, err := loadRules(store.RootDir())
+	if err != nil {
+		return nil, err
+	}
+	localClient.SetRules(automation)
+	return localClient, nil
+}
+
+// loadRules reads the automation rules of the config and of the rules/
+// folder in a data directory
+func loadRules(dir string) ([]rules.Rule, error) {
+	var config []rules.Rule
+	if err := viper.UnmarshalKey("rules", &config); err != nil {
+		return nil, fmt.Errorf("invalid rules: %w", err)
+	}
+	return rules.Load(config, filepath.Join(dir, "rules"))
+}
+
+// newNotifier creates a notification router using the configured default channel
+func newNotifier() *notify.Router {
+	return notify.NewRouter(viper.GetString("notifications.default"))
+}
+
+// expandHome replaces a leading ~/ in path with the home directory
+func expandHome(path string) string {
+	if len(path) >= 2 && path[:2] == "~/" {
+		home, _ := os.UserHomeDir()
+		path = filepath.Join(home, path[2:])
+	}
+	return path
+}
+
+// stateDir returns the directory for local state that should not be
+// committed with the data, such as which reminders were sent
+func stateDir() string {
+	if dir := viper.GetString("state_dir"); dir != "" {
+		return expandHome(dir)
+	}
+	if dir := os.Getenv("XDG_STATE_HOME"); dir != "" {
+		return filepath.Join(dir, "reorg")
+	}
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".local", "state", "reorg")
+}
+
 // GetClient returns the initialized client
 func GetClient() service.ReorgClient {
 	return client
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/rules"
+)
+
+var rulesCmd = &cobra.Command{
+	Use:   "rules",
+	Short: "Automation rules for new and completed tasks",
+	Long: `Automation rules change tasks as they are created, imported or
+completed. Each rule has triggers (on: create, import or complete), a query
+the task must match (if:, the syntax of 'reorg task list -q', empty for
+every task) and actions (then:):
+
+  add_tags, remove_tags   Tags to add or remove
+  priority                low, medium, high or urgent
+  project                 Slug of a project to move the task to
+  notify                  A message for the task's project notification
+                          channel; {title} is replaced by the task's title
+
+create runs on every new task, import only on those an import created, and
+complete when a task is completed. Rules run in the order they are listed,
+each on the task as the ones before left it.
+
+Rules are read from the rules key of config.yaml and from YAML files in the
+rules/ folder of the data directory, each holding a list of rules. They run
+wherever tasks change: the CLI, 'reorg serve' and 'reorg mcp'.
+
+Example rules/errands.yaml:
+  - name: Errands
+    on: [create, import]
+    if: "context:errands"
+    then:
+      add_tags: [out]
+      project: errands
+  - name: Tell the team
+    on: [complete]
+    if: "tag:client priority>=high"
+    then:
+      notify: "Done: {title}"`,
+}
+
+var rulesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the automation rules",
+	Args:  cobra.NoArgs,
+	RunE:  runRulesList,
+}
+
+func init() {
+	rootCmd.AddCommand(rulesCmd)
+	rulesCmd.AddCommand(rulesListCmd)
+}
+
+func runRulesList(cmd *cobra.Command, args []string) error {
+	all, err := loadRules(dataDir)
+	if err != nil {
+		return err
+	}
+	if len(all) == 0 {
+		fmt.Println("No rules. Add them to the rules key of config.yaml or to " + filepath.Join(dataDir, "rules") + "/.")
+		return nil
+	}
+
+	for _, r := range all {
+		source := "config.yaml"
+		if r.File != "" {
+			source = filepath.Join("rules", filepath.Base(r.File))
+		}
+		fmt.Printf("%s %s\n", titleStyle.Render(r.Label()), dimStyle.Render(source))
+
+		on := make([]string, len(r.On))
+		for i, t := range r.On {
+			on[i] = string(t)
+		}
+		fmt.Printf("  on:   %s\n", strings.Join(on, ", "))
+		if r.If != "" {
+			fmt.Printf("  if:   %s\n", r.If)
+		}
+		for _, action := range describeActions(r.Then) {
+			fmt.Printf("  then: %s\n", action)
+		}
+		fmt.Println()
+	}
+	return nil
+}
+
+// describeActions returns a line for each action of a rule
+func describeActions(a rules.Actions) []string {
+	var lines []string
+	if len(a.AddTags) > 0 {
+		lines = append(lines, "add tags "+strings.Join(a.AddTags, ", "))
+	}
+	if len(a.RemoveTags) > 0 {
+		lines = append(lines, "remove tags "+strings.Join(a.RemoveTags, ", "))
+	}
+	if a.Priority != "" {
+		lines = append(lines, "set priority "+a.Priority)
+	}
+	if a.Project != "" {
+		lines = append(lines, "move to "+a.Project)
+	}
+	if a.Notify != "" {
+		lines = append(lines, fmt.Sprintf("notify %q", a.Notify))
+	}
+	return lines
+}
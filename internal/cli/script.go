@@ -0,0 +1,183 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/scripts"
+	"github.com/ihavespoons/reorg/internal/service"
+)
+
+var scriptCmd = &cobra.Command{
+	Use:   "script",
+	Short: "Run Starlark scripts",
+	Long: `Scripts are Starlark files (a small Python dialect) in the scripts/ folder
+of the data directory, for logic too big for a hook. They reach reorg only
+through the reorg module:
+
+  reorg.tasks(query="")       Tasks matching a query, as in 'task list -q'
+  reorg.projects(query="")    Projects matching a query
+  reorg.task(id)              A task by ID
+  reorg.create_task(title, project, priority="", due="", tags=[], context="")
+  reorg.update_task(id, title=, status=, priority=, due=, context=,
+                    add_tags=[], remove_tags=[])
+  reorg.complete_task(id)
+
+and have the time module and print. They can't read files, use the network,
+run commands or load other scripts, and are stopped after scripts.timeout
+(30s) or scripts.max_steps.
+
+'reorg serve' runs scripts on the schedules under scripts.schedule, each
+an interval or a daily HH:MM time:
+
+  scripts:
+    schedule:
+      stale: "08:00"
+      tidy: 1h
+
+Example scripts/stale.star:
+  for t in reorg.tasks("status:pending updated<-30d"):
+      reorg.update_task(t.id, add_tags=["stale"])
+      print("stale:", t.title)`,
+}
+
+var scriptListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List scripts and their schedules",
+	Args:  cobra.NoArgs,
+	RunE:  runScriptList,
+}
+
+var scriptRunCmd = &cobra.Command{
+	Use:   "run <name>",
+	Short: "Run a script now",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runScriptRun,
+}
+
+func init() {
+	rootCmd.AddCommand(scriptCmd)
+	scriptCmd.AddCommand(scriptListCmd)
+	scriptCmd.AddCommand(scriptRunCmd)
+}
+
+func runScriptList(cmd *cobra.Command, args []string) error {
+	all, err := scripts.List(scriptsDir())
+	if err != nil {
+		return err
+	}
+	if len(all) == 0 {
+		fmt.Printf("No scripts. Add .star files to %s/.\n", scriptsDir())
+		return nil
+	}
+
+	schedules, err := scriptSchedules()
+	if err != nil {
+		return err
+	}
+	for _, s := range all {
+		when := "-"
+		if schedule, ok := schedules[strings.ToLower(s.Name)]; ok {
+			when = schedule.String()
+		}
+		fmt.Printf("%-24s %s\n", s.Name, dimStyle.Render(when))
+	}
+	return nil
+}
+
+func runScriptRun(cmd *cobra.Command, args []string) error {
+	s, err := scripts.Find(scriptsDir(), args[0])
+	if err != nil {
+		return err
+	}
+	if err := newScriptRunner(client).Run(cmd.Context(), s); err != nil {
+		return fmt.Errorf("script %s failed: %w", s.Name, err)
+	}
+	return nil
+}
+
+// scriptsDir is the folder scripts are read from
+func scriptsDir() string {
+	return filepath.Join(dataDir, "scripts")
+}
+
+// newScriptRunner creates a script runner with the configured limits
+func newScriptRunner(c service.ReorgClient) *scripts.Runner {
+	return &scripts.Runner{
+		Client:   c,
+		Timeout:  viper.GetDuration("scripts.timeout"),
+		MaxSteps: viper.GetUint64("scripts.max_steps"),
+	}
+}
+
+// scriptSchedule is when serve runs a script: every interval, or every day
+// at an HH:MM time
+type scriptSchedule struct {
+	every time.Duration
+	at    string
+}
+
+func (s scriptSchedule) String() string {
+	if s.at != "" {
+		return "every day at " + s.at
+	}
+	return "every " + s.every.String()
+}
+
+// next returns the next run after now
+func (s scriptSchedule) next(now time.Time) time.Time {
+	if s.at != "" {
+		next, _ := nextDailyRun(now, s.at)
+		return next
+	}
+	return now.Add(s.every)
+}
+
+// scriptSchedules reads scripts.schedule, keyed by lowercased script name
+func scriptSchedules() (map[string]scriptSchedule, error) {
+	schedules := make(map[string]scriptSchedule)
+	for name, value := range viper.GetStringMapString("scripts.schedule") {
+		if _, err := nextDailyRun(time.Now().In(timezone), value); err == nil {
+			schedules[strings.ToLower(name)] = scriptSchedule{at: value}
+			continue
+		}
+		every, err := time.ParseDuration(value)
+		if err != nil || every < time.Minute {
+			return nil, fmt.Errorf("invalid scripts.schedule.%s %q (use an interval of at least 1m or HH:MM)", name, value)
+		}
+		schedules[strings.ToLower(name)] = scriptSchedule{every: every}
+	}
+	return schedules, nil
+}
+
+// runScriptSchedules runs each scheduled script in dir on its schedule until
+// ctx is cancelled. Scripts that fail are reported and run again next time.
+func runScriptSchedules(ctx context.Context, c service.ReorgClient, dir string, schedules map[string]scriptSchedule) {
+	runner := newScriptRunner(c)
+	for name, schedule := range schedules {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(time.Until(schedule.next(time.Now().In(timezone)))):
+				}
+
+				s, err := scripts.Find(dir, name)
+				if err == nil {
+					err = runner.Run(ctx, s)
+				}
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "script %s: %v\n", name, err)
+				}
+			}
+		}()
+	}
+}
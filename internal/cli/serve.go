@@ -2,23 +2,28 @@ package cli
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	grpcserver "github.com/ihavespoons/reorg/internal/api/grpc"
 	"github.com/ihavespoons/reorg/internal/api/rest"
-	"github.com/ihavespoons/reorg/internal/service"
+	"github.com/ihavespoons/reorg/internal/capture"
+	"github.com/ihavespoons/reorg/internal/digest"
 	"github.com/ihavespoons/reorg/internal/storage/markdown"
 )
 
 var (
-	grpcPort string
-	httpPort string
+	grpcPort          string
+	httpPort          string
+	serveReadOnlyFlag bool
 )
 
 var serveCmd = &cobra.Command{
@@ -29,17 +34,34 @@ var serveCmd = &cobra.Command{
 This runs a gRPC server (default port 50051) and optionally a REST gateway
 (default port 8080) that other clients can connect to.
 
+Only one server runs per state directory; its process ID is kept in
+serve.pid there, and 'reorg serve stop' stops it.
+
+--read-only (or server.read_only) refuses every call that would change
+data, for dashboards and shared setups: only Get, List and Find calls are
+answered, the capture endpoint is off and no plans are written.
+
 Examples:
   reorg serve
-  reorg serve --grpc-port 50051 --http-port 8080`,
+  reorg serve --grpc-port 50051 --http-port 8080
+  reorg serve --read-only`,
 	RunE: runServe,
 }
 
+var serveStopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop the running server",
+	Args:  cobra.NoArgs,
+	RunE:  runServeStop,
+}
+
 func init() {
 	rootCmd.AddCommand(serveCmd)
+	serveCmd.AddCommand(serveStopCmd)
 
 	serveCmd.Flags().StringVar(&grpcPort, "grpc-port", "50051", "gRPC server port")
 	serveCmd.Flags().StringVar(&httpPort, "http-port", "8080", "HTTP REST gateway port")
+	serveCmd.Flags().BoolVar(&serveReadOnlyFlag, "read-only", false, "Refuse calls that change data")
 }
 
 func runServe(cmd *cobra.Command, args []string) error {
@@ -48,12 +70,69 @@ func runServe(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("reorg not initialized. Run 'reorg init' first")
 	}
 
+	// Reminders are opt-in, and a bad interval should stop the server
+	// before it starts rather than when the ticker is created
+	remindersEnabled := viper.GetBool("reminders.enabled")
+	var interval time.Duration
+	if remindersEnabled {
+		var err error
+		if interval, err = reminderInterval(); err != nil {
+			return err
+		}
+	}
+
+	digestEnabled := viper.GetBool("digest.enabled")
+	var digestPeriod digest.Period
+	var digestAt string
+	var digestDay time.Weekday
+	if digestEnabled {
+		var err error
+		if digestPeriod, digestAt, digestDay, err = digestSchedule(); err != nil {
+			return err
+		}
+	}
+
+	readOnly := serveReadOnlyFlag || viper.GetBool("server.read_only")
+	captureEnabled := viper.GetBool("capture.enabled") && !readOnly
+	if captureEnabled && viper.GetString("capture.token") == "" {
+		return fmt.Errorf("capture.enabled needs a capture.token")
+	}
+
+	users, err := loadServeUsers()
+	if err != nil {
+		return err
+	}
+
+	schedules, err := scriptSchedules()
+	if err != nil {
+		return err
+	}
+
+	serverTLS, err := serveTLSConfig()
+	if err != nil {
+		return err
+	}
+
+	// Two servers would send every reminder twice and race on the files
+	release, err := acquirePIDFile(servePIDFile())
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	// Initialize store and local client
 	store := markdown.NewStore(dataDir)
-	localClient := service.NewLocalClient(store)
+	localClient, err := newLocalClient(store)
+	if err != nil {
+		return err
+	}
 
 	// Create gRPC server
 	grpcServer := grpcserver.NewServer(localClient)
+	grpcServer.SetReadOnly(readOnly)
+	if serverTLS != nil {
+		grpcServer.SetTLS(serverTLS)
+	}
 
 	grpcAddress := ":" + grpcPort
 	httpAddress := ":" + httpPort
@@ -61,12 +140,38 @@ func runServe(cmd *cobra.Command, args []string) error {
 	fmt.Println(titleStyle.Render("\n  Reorg Server\n"))
 	fmt.Printf("Starting gRPC server on %s\n", grpcAddress)
 	fmt.Printf("Starting REST gateway on %s\n", httpAddress)
-	fmt.Printf("Data directory: %s\n\n", dataDir)
+	fmt.Printf("Data directory: %s\n", dataDir)
+	if serverTLS != nil {
+		fmt.Println("TLS: gRPC clients must connect with TLS")
+	}
+	if readOnly {
+		fmt.Println("Read-only: calls that change data are refused")
+	}
+	fmt.Println()
 
 	// Handle shutdown signals
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	// Keep cached items in step with edits made outside the server
+	if err := localClient.Watch(ctx); err != nil {
+		return err
+	}
+
+	for _, u := range users {
+		userClient := localClient
+		if u.DataDir != dataDir {
+			if userClient, err = newLocalClient(markdown.NewStore(u.DataDir)); err != nil {
+				return err
+			}
+			if err := userClient.Watch(ctx); err != nil {
+				return err
+			}
+		}
+		grpcServer.AddUser(u.Name, u.Token, userClient)
+		fmt.Printf("Serving %s from %s\n", u.Name, u.DataDir)
+	}
+
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 
@@ -80,13 +185,69 @@ func runServe(cmd *cobra.Command, args []string) error {
 	}()
 
 	// Start REST gateway
+	gateway := rest.NewGateway("localhost"+grpcAddress, httpAddress)
+	if serverTLS != nil {
+		gateway.SetGRPCTLS()
+	}
+	if viper.GetBool("ical.serve") {
+		path := viper.GetString("ical.path")
+		if path == "" {
+			path = "/calendar.ics"
+		}
+		gateway.Handle(path, calendarHandler(grpcServer.ClientForToken))
+		if len(users) > 0 {
+			fmt.Printf("Serving calendar at http://localhost%s%s?token=<token>\n", httpAddress, path)
+		} else {
+			fmt.Printf("Serving calendar at http://localhost%s%s\n", httpAddress, path)
+		}
+	}
+	if captureEnabled {
+		gateway.Handle(capturePath(), capture.Handler(viper.GetString("capture.token"), captureSaver(store)))
+		fmt.Printf("Accepting captures at http://localhost%s%s\n", httpAddress, capturePath())
+	}
 	go func() {
-		gateway := rest.NewGateway("localhost"+grpcAddress, httpAddress)
 		if err := gateway.Start(ctx); err != nil {
 			errCh <- fmt.Errorf("REST gateway error: %w", err)
 		}
 	}()
 
+	// Send due date reminders on a schedule
+	if remindersEnabled {
+		checker := newReminderChecker(localClient)
+		fmt.Printf("Checking reminders every %s\n", interval)
+		go checker.Run(ctx, interval, func(err error) {
+			fmt.Fprintf(os.Stderr, "reminder error: %v\n", err)
+		})
+	}
+
+	// Write tomorrow's plan every evening
+	if viper.GetBool("planning.enabled") && !readOnly {
+		at := viper.GetString("planning.time")
+		if at == "" {
+			at = "18:00"
+		}
+		fmt.Printf("Planning tomorrow every day at %s\n", at)
+		go runPlanSchedule(ctx, localClient, store, at)
+	}
+
+	// Send the digest every day or week
+	if digestEnabled {
+		if digestPeriod == digest.PeriodWeekly {
+			fmt.Printf("Sending the digest every %s at %s\n", digestDay, digestAt)
+		} else {
+			fmt.Printf("Sending the digest every day at %s\n", digestAt)
+		}
+		go runDigestSchedule(ctx, localClient, digestPeriod, digestAt, digestDay)
+	}
+
+	// Run scheduled scripts, which change data
+	if len(schedules) > 0 && !readOnly {
+		for name, schedule := range schedules {
+			fmt.Printf("Running script %s %s\n", name, schedule)
+		}
+		runScriptSchedules(ctx, localClient, scriptsDir(), schedules)
+	}
+
 	// Wait for signal or error
 	select {
 	case sig := <-sigCh:
@@ -96,3 +257,89 @@ func runServe(cmd *cobra.Command, args []string) error {
 		return err
 	}
 }
+
+func runServeStop(cmd *cobra.Command, args []string) error {
+	pid, err := runningServer(servePIDFile())
+	if err != nil {
+		return err
+	}
+	if pid == 0 {
+		return fmt.Errorf("reorg serve is not running")
+	}
+
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+	// Windows can't send SIGTERM, so the server is killed there
+	if err := p.Signal(syscall.SIGTERM); err != nil {
+		if err := p.Kill(); err != nil {
+			return fmt.Errorf("failed to stop reorg serve (pid %d): %w", pid, err)
+		}
+	}
+
+	// Wait for it to shut down
+	for i := 0; i < 50 && processAlive(pid); i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	if processAlive(pid) {
+		return fmt.Errorf("reorg serve (pid %d) is still running", pid)
+	}
+	fmt.Printf("%s Stopped reorg serve (pid %d)\n", successStyle.Render("✓"), pid)
+	return nil
+}
+
+// serveUser is a user of a server hosting several, from server.users
+type serveUser struct {
+	Name    string `mapstructure:"name"`
+	Token   string `mapstructure:"token"`
+	DataDir string `mapstructure:"data_dir"`
+}
+
+// loadServeUsers reads server.users, checking that every user has a
+// token of their own and an initialized data directory
+func loadServeUsers() ([]serveUser, error) {
+	var users []serveUser
+	if err := viper.UnmarshalKey("server.users", &users); err != nil {
+		return nil, fmt.Errorf("invalid server.users: %w", err)
+	}
+
+	names := make(map[string]bool)
+	tokens := make(map[string]bool)
+	for i, u := range users {
+		switch {
+		case u.Name == "":
+			return nil, fmt.Errorf("server.users entry %d has no name", i+1)
+		case names[u.Name]:
+			return nil, fmt.Errorf("server.users has %s twice", u.Name)
+		case u.Token == "":
+			return nil, fmt.Errorf("user %s has no token", u.Name)
+		case tokens[u.Token]:
+			return nil, fmt.Errorf("user %s has the same token as another user", u.Name)
+		case u.DataDir == "":
+			return nil, fmt.Errorf("user %s has no data_dir", u.Name)
+		}
+		names[u.Name], tokens[u.Token] = true, true
+
+		users[i].DataDir = expandHome(u.DataDir)
+		if _, err := os.Stat(filepath.Join(users[i].DataDir, "areas")); os.IsNotExist(err) {
+			return nil, fmt.Errorf("data directory of %s isn't initialized; run 'reorg --data-dir %s init'", u.Name, users[i].DataDir)
+		}
+	}
+	return users, nil
+}
+
+// serveTLSConfig reads the certificate of serve's gRPC server from
+// server.tls, or returns nil when there is none
+func serveTLSConfig() (*tls.Config, error) {
+	certFile := expandHome(viper.GetString("server.tls.cert_file"))
+	keyFile := expandHome(viper.GetString("server.tls.key_file"))
+	if certFile == "" && keyFile == "" {
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server.tls certificate: %w", err)
+	}
+	return &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}, nil
+}
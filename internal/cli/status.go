@@ -8,21 +8,43 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/service"
+)
+
+var (
+	statusFocusFlag bool
+	statusJSONFlag  bool
 )
 
 var statusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Show an overview of your organization",
-	Long:  `Display a summary of all areas, projects, and tasks.`,
-	RunE:  runStatus,
+	Long: `Display a summary of all areas, projects, and tasks, and the progress of active goals.
+
+With --focus, show what needs attention on one screen instead: the number of
+overdue tasks, tasks due or scheduled today, tasks in progress with any
+running timer, stalled projects, the last import and this month's AI
+spending. --json prints the same as JSON, with a one-line "text" summary for
+status bars such as tmux or SketchyBar.
+
+Examples:
+  reorg status
+  reorg status --focus
+  reorg status --json | jq -r .text`,
+	RunE: runStatus,
 }
 
 func init() {
 	rootCmd.AddCommand(statusCmd)
+	statusCmd.Flags().BoolVar(&statusFocusFlag, "focus", false, "Show a one-screen dashboard of what needs attention")
+	statusCmd.Flags().BoolVar(&statusJSONFlag, "json", false, "Print the --focus dashboard as JSON")
 }
 
 func runStatus(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
+	if statusFocusFlag || statusJSONFlag {
+		return runStatusFocus(ctx)
+	}
 
 	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12"))
 	areaStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("14"))
@@ -33,30 +55,41 @@ func runStatus(cmd *cobra.Command, args []string) error {
 	fmt.Println(headerStyle.Render("  Reorg Status"))
 	fmt.Println()
 
-	areas, err := client.ListAreas(ctx)
+	overview, err := client.GetOverview(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to list areas: %w", err)
+		return fmt.Errorf("failed to read areas: %w", err)
 	}
+	areas := overview.Areas
 
 	if len(areas) == 0 {
 		fmt.Println("  No areas found. Run 'reorg init' to get started.")
 		return nil
 	}
 
-	var totalProjects, totalTasks, completedTasks, inProgressTasks, overdueTasks int
+	var totalProjects, somedayProjects, totalTasks, completedTasks, inProgressTasks, overdueTasks int
+	var allTasks []*domain.Task
 
 	for _, area := range areas {
-		projects, _ := client.ListProjects(ctx, area.ID)
+		// Someday projects are only counted, so they don't crowd the view
+		var projects []*service.ProjectOverview
+		for _, po := range area.Projects {
+			if po.Project.IsSomeday() {
+				somedayProjects++
+				continue
+			}
+			projects = append(projects, po)
+		}
 
 		var areaTasksTotal, areaTasksComplete int
 
-		fmt.Printf("  %s\n", areaStyle.Render(area.Title))
+		fmt.Printf("  %s\n", areaStyle.Render(area.Area.Title))
 
 		if len(projects) == 0 {
 			fmt.Println(countStyle.Render("    No projects"))
 		} else {
-			for _, p := range projects {
-				tasks, _ := client.ListTasks(ctx, p.ID)
+			for _, po := range projects {
+				p, tasks := po.Project, po.Tasks
+				allTasks = append(allTasks, tasks...)
 
 				var projectComplete, projectInProgress int
 				for _, t := range tasks {
@@ -103,6 +136,8 @@ func runStatus(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	printGoalStatus(ctx, countStyle)
+
 	// Overall summary
 	fmt.Println(lipgloss.NewStyle().Foreground(lipgloss.Color("8")).Render("  ─────────────────────────"))
 	fmt.Println()
@@ -112,10 +147,18 @@ func runStatus(cmd *cobra.Command, args []string) error {
 		countStyle.Render("Tasks:"), completedTasks, totalTasks,
 	)
 
+	if somedayProjects > 0 {
+		fmt.Printf("  %s %d projects (reorg review someday)\n", countStyle.Render("Someday:"), somedayProjects)
+	}
+
 	if inProgressTasks > 0 {
 		fmt.Printf("  %s %d in progress\n", countStyle.Render("Active:"), inProgressTasks)
 	}
 
+	if spent, _ := trackedTime(allTasks); spent > 0 {
+		fmt.Printf("  %s %s tracked\n", countStyle.Render("Time:"), domain.FormatTimeSpec(spent))
+	}
+
 	if overdueTasks > 0 {
 		overdueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
 		fmt.Printf("  %s\n", overdueStyle.Render(fmt.Sprintf("⚠ %d overdue tasks", overdueTasks)))
@@ -125,3 +168,33 @@ func runStatus(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// printGoalStatus prints the progress of each active goal
+func printGoalStatus(ctx context.Context, countStyle lipgloss.Style) {
+	goals, err := client.ListGoals(ctx)
+	if err != nil {
+		return
+	}
+
+	var active []*domain.Goal
+	for _, g := range goals {
+		if g.IsActive() {
+			active = append(active, g)
+		}
+	}
+	if len(active) == 0 {
+		return
+	}
+
+	goalStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("13"))
+	fmt.Printf("  %s\n", goalStyle.Render("Goals"))
+	for _, g := range active {
+		p := goalProgress(g)
+		info := fmt.Sprintf(" %3d%% [%d/%d projects]", p.PercentComplete, p.CompletedProjects, p.Projects)
+		if g.Quarter != "" {
+			info += " " + g.Quarter
+		}
+		fmt.Printf("    %s %s%s\n", progressBar(p.PercentComplete, 10), g.Title, countStyle.Render(info))
+	}
+	fmt.Println()
+}
@@ -0,0 +1,274 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ihavespoons/reorg/internal/audit"
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/plan"
+)
+
+// importRunGap is the longest pause between the changes of one import run
+// in the audit log
+const importRunGap = 15 * time.Minute
+
+// focusDashboard is what 'reorg status --focus' shows, and prints with
+// --json
+type focusDashboard struct {
+	Time       time.Time      `json:"time"`
+	Text       string         `json:"text"` // one line, for status bars
+	Overdue    int            `json:"overdue"`
+	Today      []focusTask    `json:"today"` // due or scheduled today
+	InProgress []focusTask    `json:"in_progress"`
+	Stalled    []focusProject `json:"stalled"`
+	LastImport *focusImport   `json:"last_import,omitempty"`
+	LLM        focusBudget    `json:"llm"`
+}
+
+type focusTask struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	Project string `json:"project,omitempty"`
+	Reason  string `json:"reason,omitempty"` // due or scheduled, for today's tasks
+	Due     string `json:"due,omitempty"`    // the time, if due at one
+	Timer   string `json:"timer,omitempty"`  // how long the running timer has run
+}
+
+type focusProject struct {
+	ID                string `json:"id"`
+	Title             string `json:"title"`
+	DaysSinceActivity int    `json:"days_since_activity"`
+}
+
+// focusImport sums the changes of the latest import run
+type focusImport struct {
+	Command string    `json:"command"`
+	Time    time.Time `json:"time"` // of its last change
+	Created int       `json:"created"`
+	Updated int       `json:"updated"`
+}
+
+type focusBudget struct {
+	Spent  float64 `json:"spent"`            // this month, in US dollars
+	Budget float64 `json:"budget,omitempty"` // llm.budget.monthly, if set
+	Paused bool    `json:"paused"`           // paid requests stopped by the budget
+}
+
+func runStatusFocus(ctx context.Context) error {
+	d, err := buildFocusDashboard(ctx, time.Now().In(timezone))
+	if err != nil {
+		return err
+	}
+	if statusJSONFlag {
+		data, err := json.Marshal(d)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+	printFocusDashboard(d)
+	return nil
+}
+
+// buildFocusDashboard gathers the dashboard at now. Someday projects and
+// their tasks are left out.
+func buildFocusDashboard(ctx context.Context, now time.Time) (*focusDashboard, error) {
+	overview, err := client.GetOverview(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read areas: %w", err)
+	}
+
+	d := &focusDashboard{Time: now, Today: []focusTask{}, InProgress: []focusTask{}, Stalled: []focusProject{}}
+	var tasks []*domain.Task
+	titles := make(map[string]string)
+	for _, area := range overview.Areas {
+		for _, po := range area.Projects {
+			p := po.Project
+			if p.IsSomeday() {
+				continue
+			}
+			titles[p.ID] = p.Title
+			tasks = append(tasks, po.Tasks...)
+			if p.IsActive() && p.Health != nil && p.Health.Status == domain.HealthStalled {
+				d.Stalled = append(d.Stalled, focusProject{ID: p.ID, Title: p.Title, DaysSinceActivity: p.Health.DaysSinceActivity})
+			}
+		}
+	}
+	sort.Slice(d.Stalled, func(i, j int) bool { return d.Stalled[i].DaysSinceActivity > d.Stalled[j].DaysSinceActivity })
+
+	for _, t := range tasks {
+		if t.IsOverdueAt(now) && t.Status != domain.TaskStatusCancelled {
+			d.Overdue++
+		}
+		if t.Status == domain.TaskStatusInProgress {
+			d.InProgress = append(d.InProgress, newFocusTask(t, titles, now))
+		}
+	}
+	for _, item := range plan.Build(tasks, titles, now).Items {
+		if item.Reason == plan.ReasonDue || item.Reason == plan.ReasonScheduled {
+			ft := newFocusTask(item.Task, titles, now)
+			ft.Reason = string(item.Reason)
+			d.Today = append(d.Today, ft)
+		}
+	}
+
+	// The state directory is this machine's, so in remote mode there may be
+	// no import or usage recorded
+	if entries, err := audit.NewLog(filepath.Join(stateDir(), "audit.jsonl")).Entries(); err == nil {
+		d.LastImport = lastImport(entries)
+	}
+	ledger := usageLedger()
+	d.LLM.Budget = ledger.MonthlyBudget
+	if spent, err := ledger.MonthSpend(now); err == nil {
+		d.LLM.Spent = spent
+		d.LLM.Paused = ledger.MonthlyBudget > 0 && spent >= ledger.MonthlyBudget
+	}
+
+	d.Text = focusText(d)
+	return d, nil
+}
+
+func newFocusTask(t *domain.Task, titles map[string]string, now time.Time) focusTask {
+	ft := focusTask{ID: t.ID, Title: t.Title, Project: titles[t.ProjectID]}
+	if t.DueDate != nil && !t.AllDay {
+		ft.Due = t.DueDate.Format("15:04")
+	}
+	if s := t.ActiveSession(); s != nil {
+		ft.Timer = domain.FormatTimeSpec(s.Duration(now).Truncate(time.Minute))
+	}
+	return ft
+}
+
+// lastImport sums the newest import run in the audit log: the changes made
+// by one import command, each within importRunGap of the next. Other
+// commands' changes in between are passed over.
+func lastImport(entries []audit.Entry) *focusImport {
+	var run *focusImport
+	var earliest time.Time
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+		command, ok := strings.CutPrefix(e.Actor, "cli:")
+		if !ok || !strings.HasPrefix(command, "import ") {
+			continue
+		}
+		if run == nil {
+			run = &focusImport{Command: command, Time: e.Time}
+		} else if command != run.Command || earliest.Sub(e.Time) > importRunGap {
+			break
+		}
+		earliest = e.Time
+		switch e.Action {
+		case audit.Create:
+			run.Created++
+		case audit.Update:
+			run.Updated++
+		}
+	}
+	return run
+}
+
+// focusText sums up the dashboard in a line short enough for a status bar
+func focusText(d *focusDashboard) string {
+	var parts []string
+	if d.Overdue > 0 {
+		parts = append(parts, fmt.Sprintf("⚠ %d overdue", d.Overdue))
+	}
+	parts = append(parts, fmt.Sprintf("%d today", len(d.Today)))
+	for _, t := range d.InProgress {
+		if t.Timer != "" {
+			parts = append(parts, fmt.Sprintf("▶ %s %s", t.Title, t.Timer))
+			break
+		}
+	}
+	if d.LLM.Paused {
+		parts = append(parts, "AI paused")
+	}
+	return strings.Join(parts, " · ")
+}
+
+func printFocusDashboard(d *focusDashboard) {
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("14"))
+	overdueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+
+	fmt.Println()
+	fmt.Println(titleStyle.Render("  Focus · " + d.Time.Format("Mon Jan 2")))
+	fmt.Println()
+	if d.Overdue > 0 {
+		fmt.Printf("  %s\n\n", overdueStyle.Render(fmt.Sprintf("⚠ %d overdue tasks", d.Overdue)))
+	}
+
+	fmt.Println(headerStyle.Render("  Today"))
+	if len(d.Today) == 0 {
+		fmt.Println(dimStyle.Render("    Nothing due or scheduled"))
+	}
+	for _, t := range d.Today {
+		details := t.Reason
+		if t.Due != "" {
+			details += " " + t.Due
+		}
+		fmt.Printf("    ○ %s %s\n", t.Title, dimStyle.Render(focusDetails(t.Project, details)))
+	}
+	fmt.Println()
+
+	if len(d.InProgress) > 0 {
+		fmt.Println(headerStyle.Render("  In progress"))
+		for _, t := range d.InProgress {
+			line := fmt.Sprintf("    ◐ %s %s", t.Title, dimStyle.Render(focusDetails(t.Project, "")))
+			if t.Timer != "" {
+				line += successStyle.Render(" ▶ " + t.Timer)
+			}
+			fmt.Println(line)
+		}
+		fmt.Println()
+	}
+
+	if len(d.Stalled) > 0 {
+		fmt.Println(headerStyle.Render("  Stalled projects"))
+		for _, p := range d.Stalled {
+			fmt.Printf("    ⏸ %s %s\n", p.Title, dimStyle.Render(fmt.Sprintf("(%d days quiet)", p.DaysSinceActivity)))
+		}
+		fmt.Println()
+	}
+
+	if d.LastImport != nil {
+		fmt.Printf("  %s %s %s, %d created, %d updated\n", headerStyle.Render("Last import:"), d.LastImport.Command,
+			dimStyle.Render(importAge(d.Time.Sub(d.LastImport.Time))), d.LastImport.Created, d.LastImport.Updated)
+	}
+	budget := fmt.Sprintf("$%.2f this month", d.LLM.Spent)
+	if d.LLM.Budget > 0 {
+		budget = fmt.Sprintf("$%.2f of $%.2f this month", d.LLM.Spent, d.LLM.Budget)
+	}
+	if d.LLM.Paused {
+		budget += overdueStyle.Render(" (paid AI requests paused)")
+	}
+	fmt.Printf("  %s %s\n\n", headerStyle.Render("AI usage:"), budget)
+}
+
+// importAge formats how long ago an import ran, e.g. just now or 3h ago
+func importAge(d time.Duration) string {
+	if d < time.Hour {
+		return "just now"
+	}
+	return waitingAge(d) + " ago"
+}
+
+// focusDetails joins a task's project and details in parentheses, or
+// returns "" without either
+func focusDetails(project, details string) string {
+	switch {
+	case project != "" && details != "":
+		return "(" + project + ", " + details + ")"
+	case project != "" || details != "":
+		return "(" + project + details + ")"
+	}
+	return ""
+}
@@ -0,0 +1,24 @@
+package cli
+
+import (
+	"testing"
+	"time"
+)
+
+func TestImportAge(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "just now"},
+		{59 * time.Minute, "just now"},
+		{time.Hour, "1h ago"},
+		{30 * time.Hour, "1d ago"},
+		{21 * 24 * time.Hour, "3w ago"},
+	}
+	for _, tt := range tests {
+		if got := importAge(tt.d); got != tt.want {
+			t.Errorf("importAge(%s) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
@@ -1,18 +1,19 @@
 package cli
 
 import (
-	"bufio"
 	"context"
 	"fmt"
 	"os"
-	"strconv"
+	"slices"
 	"strings"
-	"text/tabwriter"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/spf13/cobra"
 
+	"github.com/ihavespoons/reorg/internal/cli/render"
 	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/service"
 )
 
 var (
@@ -20,6 +21,17 @@ var (
 	taskPriorityFlag string
 	taskTagsFlag     []string
 	taskStatusFlag   string
+	taskQueryFlag    string
+	taskEstimateFlag string
+	taskMetaFlag     []string
+	taskAddTagsFlag  []string
+	taskRmTagsFlag   []string
+	taskDueFlag      string
+	taskContextFlag  string
+	taskEffortFlag   string
+	taskListRender   render.Options
+	taskSetPriority  string
+	taskAllFlag      bool
 )
 
 var taskCmd = &cobra.Command{
@@ -31,7 +43,28 @@ var taskCmd = &cobra.Command{
 var taskListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List tasks",
-	RunE:  runTaskList,
+	Long: `List tasks, optionally narrowed down with a query expression.
+
+Query fields: status, priority, tag, project, area, assignee, context,
+effort, title, due, scheduled, created, updated, overdue and has. Use : or
+= to match (comma-separated alternatives are allowed), != to exclude, and <, <=, >, >= to compare
+priorities and dates. Prefix a term with - to negate it; bare words match
+the title. meta.<key> matches a metadata value, or any/none to test whether
+the key is set.
+
+Tasks in someday projects are left out unless --all, --project or a query
+is given.
+
+Examples:
+  reorg task list -q "status:pending priority>=high due<2025-02-01 tag:client"
+  reorg task list -q "status:pending,in_progress due<=+1w"
+  reorg task list -q "due:none -tag:someday"
+  reorg task list -q "scheduled<=today status:pending"
+  reorg task list -q "meta.jira:WEB-12"
+  reorg task list --due week
+  reorg task list --context errands
+  reorg task list --sort due --group-by project`,
+	RunE: runTaskList,
 }
 
 var taskCreateCmd = &cobra.Command{
@@ -62,6 +95,41 @@ var taskStartCmd = &cobra.Command{
 	RunE:  runTaskStart,
 }
 
+var taskMoveCmd = &cobra.Command{
+	Use:   "move [task-id]",
+	Short: "Move a task to another project",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runTaskMove,
+}
+
+var taskUpdateCmd = &cobra.Command{
+	Use:   "update [task-id]",
+	Short: "Change a task's priority, context, effort, tags or metadata",
+	Long: `Change a task's priority, context, effort, tags or metadata. Metadata
+is free-form key=value data, such as the ID of a linked issue, that can be
+queried with meta.<key>. An empty --context or --effort clears it.
+
+Examples:
+  reorg task update <id> --priority high --add-tag client
+  reorg task update <id> --context @errands
+  reorg task update <id> --effort small
+  reorg task update <id> --meta jira=WEB-12
+  reorg task update <id> --meta jira=`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTaskUpdate,
+}
+
+var taskAttachCmd = &cobra.Command{
+	Use:   "attach [task-id] [path|url]",
+	Short: "Attach a file or link to a task",
+	Long: `Attach a file or link to a task.
+
+Files are copied into the assets/ folder of the task's project so they are
+versioned along with it. Links are stored as they are.`,
+	Args: cobra.ExactArgs(2),
+	RunE: runTaskAttach,
+}
+
 var taskDeleteCmd = &cobra.Command{
 	Use:   "delete [task-id]",
 	Short: "Delete a task",
@@ -76,25 +144,61 @@ func init() {
 	taskCmd.AddCommand(taskShowCmd)
 	taskCmd.AddCommand(taskCompleteCmd)
 	taskCmd.AddCommand(taskStartCmd)
+	taskCmd.AddCommand(taskMoveCmd)
+	taskCmd.AddCommand(taskUpdateCmd)
+	taskCmd.AddCommand(taskAttachCmd)
 	taskCmd.AddCommand(taskDeleteCmd)
 
 	// List flags
 	taskListCmd.Flags().StringVarP(&taskProjectFlag, "project", "p", "", "Filter by project")
-	taskListCmd.Flags().StringVarP(&taskStatusFlag, "status", "s", "", "Filter by status (pending, in_progress, completed, blocked)")
+	taskListCmd.Flags().StringVarP(&taskStatusFlag, "status", "s", "", "Filter by status (pending, in_progress, completed, blocked, waiting)")
+	taskListCmd.Flags().StringVarP(&taskQueryFlag, "query", "q", "", "Filter with a query expression")
+	taskListRender.AddFlags(taskListCmd, []string{"due", "priority", "updated", "created"}, []string{"project", "area", "status"})
+	taskListCmd.Flags().StringVar(&taskDueFlag, "due", "", "Only open tasks due today, this week (both including overdue) or overdue")
+	taskListCmd.Flags().StringVar(&taskContextFlag, "context", "", "Only tasks in this context (e.g. errands)")
+	taskListCmd.Flags().BoolVar(&taskAllFlag, "all", false, "Include tasks in someday projects")
+
+	// Show flags
+	taskShowCmd.Flags().BoolVar(&showRawFlag, "raw", false, "Print the description as written, without markdown rendering")
 
 	// Create flags
 	taskCreateCmd.Flags().StringVarP(&taskProjectFlag, "project", "p", "", "Project for the task")
 	taskCreateCmd.Flags().StringVar(&taskPriorityFlag, "priority", "medium", "Priority (low, medium, high, urgent)")
 	taskCreateCmd.Flags().StringSliceVarP(&taskTagsFlag, "tags", "t", nil, "Tags for the task")
+	taskCreateCmd.Flags().StringVarP(&taskEstimateFlag, "estimate", "e", "", "Time estimate (e.g. 45m, 2h, 1h30m)")
+	taskCreateCmd.Flags().StringVarP(&taskContextFlag, "context", "c", "", "Where or how it can be done (e.g. @home, @errands)")
+	taskCreateCmd.Flags().StringVar(&taskEffortFlag, "effort", "", "Rough size (small, medium, large)")
+	taskCreateCmd.Flags().StringArrayVar(&taskMetaFlag, "meta", nil, metaFlagUsage)
+
+	// Update flags
+	taskUpdateCmd.Flags().StringVar(&taskSetPriority, "priority", "", "New priority (low, medium, high, urgent)")
+	taskUpdateCmd.Flags().StringVar(&taskContextFlag, "context", "", "New context (empty to clear)")
+	taskUpdateCmd.Flags().StringVar(&taskEffortFlag, "effort", "", "New effort: small, medium, large (empty to clear)")
+	taskUpdateCmd.Flags().StringSliceVar(&taskAddTagsFlag, "add-tag", nil, "Tags to add")
+	taskUpdateCmd.Flags().StringSliceVar(&taskRmTagsFlag, "remove-tag", nil, "Tags to remove")
+	taskUpdateCmd.Flags().StringArrayVar(&taskMetaFlag, "meta", nil, metaFlagUsage)
+
+	// Move flags
+	taskMoveCmd.Flags().StringVarP(&taskProjectFlag, "project", "p", "", "Destination project")
+	_ = taskMoveCmd.MarkFlagRequired("project")
 }
 
 func runTaskList(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
+	if err := taskListRender.Validate(); err != nil {
+		return err
+	}
 
-	var tasks []*domain.Task
+	var refs []*service.TaskRef
 	var err error
 
-	if taskProjectFlag != "" {
+	if taskQueryFlag != "" {
+		expr := taskQueryFlag
+		if taskProjectFlag != "" {
+			expr = "project:" + taskProjectFlag + " " + expr
+		}
+		refs, err = client.ListTasksWithRefs(ctx, expr)
+	} else if taskProjectFlag != "" {
 		// Find project by slug
 		var project *domain.Project
 		areas, _ := client.ListAreas(ctx)
@@ -106,11 +210,17 @@ func runTaskList(cmd *cobra.Command, args []string) error {
 			}
 		}
 		if project == nil {
-			return fmt.Errorf("project not found: %s", taskProjectFlag)
+			return domain.Errorf(domain.ErrNotFound, "project not found: %s", taskProjectFlag)
 		}
-		tasks, err = client.ListTasks(ctx, project.ID)
+		refs, err = client.ListTasksWithRefs(ctx, "")
+		refs = slices.DeleteFunc(refs, func(r *service.TaskRef) bool {
+			return r.Task.ProjectID != project.ID
+		})
 	} else {
-		tasks, err = client.ListAllTasks(ctx)
+		refs, err = client.ListTasksWithRefs(ctx, "")
+		if !taskAllFlag {
+			refs = slices.DeleteFunc(refs, inSomedayProject)
+		}
 	}
 
 	if err != nil {
@@ -119,30 +229,54 @@ func runTaskList(cmd *cobra.Command, args []string) error {
 
 	// Filter by status if specified
 	if taskStatusFlag != "" {
-		var filtered []*domain.Task
-		for _, t := range tasks {
-			if string(t.Status) == taskStatusFlag {
-				filtered = append(filtered, t)
-			}
+		refs = slices.DeleteFunc(refs, func(r *service.TaskRef) bool {
+			return string(r.Task.Status) != taskStatusFlag
+		})
+	}
+	if taskDueFlag != "" {
+		due, err := dueFilter(taskDueFlag, time.Now().In(timezone))
+		if err != nil {
+			return err
 		}
-		tasks = filtered
+		refs = slices.DeleteFunc(refs, func(r *service.TaskRef) bool {
+			return !due(r.Task)
+		})
+	}
+	if taskContextFlag != "" {
+		want := domain.NormalizeContext(taskContextFlag)
+		refs = slices.DeleteFunc(refs, func(r *service.TaskRef) bool {
+			return r.Task.Context != want
+		})
 	}
 
-	if len(tasks) == 0 {
+	if len(refs) == 0 {
 		fmt.Println("No tasks found. Create one with 'reorg task create <title>'")
 		return nil
 	}
 
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintln(w, "STATUS\tTASK\tPROJECT\tPRIORITY\tDUE")
-	_, _ = fmt.Fprintln(w, "------\t----\t-------\t--------\t---")
+	groups := render.Apply(refs, taskListRender, func(r *service.TaskRef) render.Fields {
+		f := render.Fields{
+			Due:      r.Task.DueDate,
+			Priority: r.Task.Priority,
+			Created:  r.Task.Created,
+			Updated:  r.Task.Updated,
+			Status:   string(r.Task.Status),
+		}
+		if r.Project != nil {
+			f.Project = r.Project.Title
+		}
+		if r.Area != nil {
+			f.Area = r.Area.Title
+		}
+		return f
+	})
 
-	for _, t := range tasks {
-		// Get project name
-		project, _ := client.GetProject(ctx, t.ProjectID)
+	header := []string{"STATUS", "TASK", "PROJECT", "PRIORITY", "DUE"}
+	return render.Table(os.Stdout, groups, header, func(ref *service.TaskRef) []string {
+		t := ref.Task
 		projectName := ""
-		if project != nil {
-			projectName = project.Title
+		if ref.Project != nil {
+			projectName = ref.Project.Title
 		}
 
 		// Status icon
@@ -154,6 +288,8 @@ func runTaskList(cmd *cobra.Command, args []string) error {
 			statusIcon = "◐"
 		case domain.TaskStatusBlocked:
 			statusIcon = "⊘"
+		case domain.TaskStatusWaiting:
+			statusIcon = "⧗"
 		case domain.TaskStatusCancelled:
 			statusIcon = "✗"
 		}
@@ -167,22 +303,24 @@ func runTaskList(cmd *cobra.Command, args []string) error {
 			}
 		}
 
-		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
-			statusIcon,
-			t.Title,
-			projectName,
-			t.Priority,
-			dueStr,
-		)
-	}
+		title := t.Title
+		if t.Context != "" {
+			title += " " + dimStyle.Render("@"+t.Context)
+		}
 
-	return w.Flush()
+		return []string{statusIcon, title, projectName, string(t.Priority), dueStr}
+	})
 }
 
 func runTaskCreate(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	title := args[0]
 
+	meta, err := domain.ParseMetadata(taskMetaFlag)
+	if err != nil {
+		return err
+	}
+
 	// Get project
 	var projectID, areaID string
 
@@ -198,7 +336,7 @@ func runTaskCreate(cmd *cobra.Command, args []string) error {
 			}
 		}
 		if projectID == "" {
-			return fmt.Errorf("project not found: %s", taskProjectFlag)
+			return domain.Errorf(domain.ErrNotFound, "project not found: %s", taskProjectFlag)
 		}
 	} else {
 		// Interactive project selection
@@ -211,28 +349,22 @@ func runTaskCreate(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("no projects found. Create one first with 'reorg project create <name>'")
 		}
 
-		fmt.Println("Select a project:")
+		labels := make([]string, len(projects))
 		for i, p := range projects {
 			area, _ := client.GetArea(ctx, p.AreaID)
 			areaName := ""
 			if area != nil {
 				areaName = area.Title + "/"
 			}
-			fmt.Printf("  %d. %s%s\n", i+1, areaName, p.Title)
+			labels[i] = areaName + p.Title
 		}
-
-		reader := bufio.NewReader(os.Stdin)
-		fmt.Print("Enter number: ")
-		input, _ := reader.ReadString('\n')
-		input = strings.TrimSpace(input)
-
-		num, err := strconv.Atoi(input)
-		if err != nil || num < 1 || num > len(projects) {
-			return fmt.Errorf("invalid selection")
+		i, err := selectItem("Select a project:", labels)
+		if err != nil {
+			return err
 		}
 
-		projectID = projects[num-1].ID
-		areaID = projects[num-1].AreaID
+		projectID = projects[i].ID
+		areaID = projects[i].AreaID
 	}
 
 	// Create task
@@ -254,6 +386,19 @@ func runTaskCreate(cmd *cobra.Command, args []string) error {
 	for _, tag := range taskTagsFlag {
 		task.AddTag(tag)
 	}
+	domain.SetMetadata(&task.Metadata, meta)
+	task.Context = domain.NormalizeContext(taskContextFlag)
+	if task.Effort, err = domain.ParseEffort(taskEffortFlag); err != nil {
+		return err
+	}
+
+	if taskEstimateFlag != "" {
+		estimate, err := domain.ParseTimeSpec(taskEstimateFlag)
+		if err != nil {
+			return err
+		}
+		task.TimeEstimate = domain.FormatTimeSpec(estimate)
+	}
 
 	created, err := client.CreateTask(ctx, task)
 	if err != nil {
@@ -266,7 +411,7 @@ func runTaskCreate(cmd *cobra.Command, args []string) error {
 }
 
 func runTaskShow(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	taskID := args[0]
 
 	// Try to find by ID first, then by slug
@@ -283,7 +428,7 @@ func runTaskShow(cmd *cobra.Command, args []string) error {
 	}
 
 	if task == nil {
-		return fmt.Errorf("task not found: %s", taskID)
+		return domain.Errorf(domain.ErrNotFound, "task not found: %s", taskID)
 	}
 
 	// Get project and area
@@ -309,23 +454,46 @@ func runTaskShow(cmd *cobra.Command, args []string) error {
 	fmt.Printf("%s %s\n", labelStyle.Render("ID:"), task.ID)
 	fmt.Printf("%s %s / %s\n", labelStyle.Render("Location:"), areaName, projectName)
 	fmt.Printf("%s %s\n", labelStyle.Render("Status:"), task.Status)
+	if task.Assignee != "" {
+		assignee := task.Assignee
+		if since := task.WaitingSince(); since != nil {
+			assignee += fmt.Sprintf(" (waiting %s)", waitingAge(time.Since(*since)))
+		}
+		fmt.Printf("%s %s\n", labelStyle.Render("Assignee:"), assignee)
+	}
 	fmt.Printf("%s %s\n", labelStyle.Render("Priority:"), task.Priority)
+	if task.Context != "" {
+		fmt.Printf("%s @%s\n", labelStyle.Render("Context:"), task.Context)
+	}
 	fmt.Printf("%s %s\n", labelStyle.Render("Created:"), task.Created.Format("2006-01-02 15:04"))
 	fmt.Printf("%s %s\n", labelStyle.Render("Updated:"), task.Updated.Format("2006-01-02 15:04"))
 
 	if task.DueDate != nil {
-		dueStr := task.DueDate.Format("2006-01-02")
+		dueStr := formatDue(task, "2006-01-02")
 		if task.IsOverdue() {
 			dueStr = lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Render(dueStr + " (OVERDUE)")
 		}
 		fmt.Printf("%s %s\n", labelStyle.Render("Due:"), dueStr)
 	}
+	if task.ScheduledDate != nil {
+		fmt.Printf("%s %s\n", labelStyle.Render("Scheduled:"), task.ScheduledDate.Format("2006-01-02"))
+	}
 
+	if task.Effort != "" {
+		fmt.Printf("%s %s\n", labelStyle.Render("Effort:"), task.Effort)
+	}
 	if task.TimeEstimate != "" {
 		fmt.Printf("%s %s\n", labelStyle.Render("Estimate:"), task.TimeEstimate)
 	}
-	if task.TimeSpent != "" {
-		fmt.Printf("%s %s\n", labelStyle.Render("Time Spent:"), task.TimeSpent)
+	if task.TimeSpent != "" || task.ActiveSession() != nil {
+		spent := domain.FormatTimeSpec(task.Spent(time.Now()))
+		if task.IsOverEstimate(time.Now()) {
+			spent = lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Render(spent + " (over estimate)")
+		}
+		fmt.Printf("%s %s\n", labelStyle.Render("Time Spent:"), spent)
+	}
+	if session := task.ActiveSession(); session != nil {
+		fmt.Printf("%s running since %s\n", labelStyle.Render("Timer:"), session.Start.In(timezone).Format("15:04"))
 	}
 
 	if len(task.Tags) > 0 {
@@ -336,19 +504,44 @@ func runTaskShow(cmd *cobra.Command, args []string) error {
 		fmt.Printf("%s %s\n", labelStyle.Render("Dependencies:"), strings.Join(task.Dependencies, ", "))
 	}
 
-	fmt.Println()
+	lookup := func(id string) *domain.Task {
+		dep, _ := client.GetTask(ctx, id)
+		return dep
+	}
+	if reason := task.BlockedReason(lookup); reason != "" {
+		fmt.Printf("%s %s\n", labelStyle.Render("Blocked:"), reason)
+	}
 
-	if task.Content != "" {
-		fmt.Println(labelStyle.Render("Notes:"))
-		fmt.Println(task.Content)
-		fmt.Println()
+	if task.ExternalRef != nil {
+		fmt.Printf("%s %s\n", labelStyle.Render("External:"), formatExternalRef(task.ExternalRef))
 	}
+	printMetadata(task.Metadata, labelStyle)
+
+	if len(task.Attachments) > 0 {
+		fmt.Println(labelStyle.Render("Attachments:"))
+		for _, a := range task.Attachments {
+			if store != nil && !domain.IsLink(a) {
+				if path, err := store.Tasks().AssetPath(ctx, task, a); err == nil {
+					a = path
+				}
+			}
+			fmt.Printf("  - %s\n", a)
+		}
+	}
+
+	fmt.Println()
+
+	printDescription(task.Description(), labelStyle)
+
+	printComments(task.Comments(), labelStyle)
+
+	printNotes(ctx, task.ID, labelStyle)
 
 	return nil
 }
 
 func runTaskComplete(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	taskID := args[0]
 
 	task, err := findTask(ctx, taskID)
@@ -365,7 +558,7 @@ func runTaskComplete(cmd *cobra.Command, args []string) error {
 }
 
 func runTaskStart(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	taskID := args[0]
 
 	task, err := findTask(ctx, taskID)
@@ -381,8 +574,97 @@ func runTaskStart(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runTaskMove(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	taskID := args[0]
+
+	task, err := findTask(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	project, err := findProject(ctx, taskProjectFlag)
+	if err != nil {
+		return err
+	}
+
+	if task.ProjectID == project.ID {
+		fmt.Printf("%s is already in %s\n", task.Title, project.Title)
+		return nil
+	}
+
+	if err := client.MoveTask(ctx, task.ID, project.ID); err != nil {
+		return fmt.Errorf("failed to move task: %w", err)
+	}
+
+	fmt.Printf("%s Moved %s to %s\n", successStyle.Render("✓"), task.Title, project.Title)
+	return nil
+}
+
+func runTaskUpdate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	task, err := findTask(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	update := service.TaskUpdate{
+		AddTags:    taskAddTagsFlag,
+		RemoveTags: taskRmTagsFlag,
+	}
+	if taskSetPriority != "" {
+		priority, err := parsePriority(taskSetPriority)
+		if err != nil {
+			return err
+		}
+		update.Priority = &priority
+	}
+	if cmd.Flags().Changed("context") {
+		update.Context = &taskContextFlag
+	}
+	if cmd.Flags().Changed("effort") {
+		effort, err := domain.ParseEffort(taskEffortFlag)
+		if err != nil {
+			return err
+		}
+		update.Effort = &effort
+	}
+	if update.Metadata, err = domain.ParseMetadata(taskMetaFlag); err != nil {
+		return err
+	}
+	if update.IsEmpty() {
+		return fmt.Errorf("nothing to change: use --priority, --context, --effort, --add-tag, --remove-tag or --meta")
+	}
+
+	update.Apply(task)
+	if err := client.UpdateTask(ctx, task); err != nil {
+		return fmt.Errorf("failed to update task: %w", err)
+	}
+
+	fmt.Printf("%s Updated task: %s\n", successStyle.Render("✓"), task.Title)
+	return nil
+}
+
+func runTaskAttach(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	task, err := findTask(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	task, err = client.AttachToTask(ctx, task.ID, args[1])
+	if err != nil {
+		return fmt.Errorf("failed to attach: %w", err)
+	}
+
+	fmt.Printf("%s Attached %s to %s\n", successStyle.Render("✓"), args[1], task.Title)
+	return nil
+}
+
 func runTaskDelete(cmd *cobra.Command, args []string) error {
-	ctx := context.Background()
+	ctx := cmd.Context()
 	taskID := args[0]
 
 	task, err := findTask(ctx, taskID)
@@ -414,5 +696,5 @@ func findTask(ctx context.Context, identifier string) (*domain.Task, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("task not found: %s", identifier)
+	return nil, domain.Errorf(domain.ErrNotFound, "task not found: %s", identifier)
 }
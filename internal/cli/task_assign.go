@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var taskAssignClearFlag bool
+
+var taskAssignCmd = &cobra.Command{
+	Use:   "assign [task-id] [person]",
+	Short: "Delegate a task to someone",
+	Long: `Delegate a task to someone. The task is set to waiting, shows up in
+'reorg waiting' with how long it has waited, and 'reorg remind' sends a
+reminder to follow up every reminders.follow_up while it waits.
+
+--clear takes the task back: the assignee is removed and a waiting task is
+set to pending.
+
+Examples:
+  reorg task assign fix-header sam
+  reorg task assign fix-header --clear`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runTaskAssign,
+}
+
+func init() {
+	taskCmd.AddCommand(taskAssignCmd)
+	taskAssignCmd.Flags().BoolVar(&taskAssignClearFlag, "clear", false, "Take the task back")
+}
+
+func runTaskAssign(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	switch {
+	case taskAssignClearFlag && len(args) == 2:
+		return fmt.Errorf("--clear doesn't take a person")
+	case !taskAssignClearFlag && len(args) == 1:
+		return fmt.Errorf("person required, or --clear to take the task back")
+	}
+
+	task, err := findTask(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	if task.IsComplete() {
+		return fmt.Errorf("task %s is already completed", task.Title)
+	}
+
+	if taskAssignClearFlag {
+		task.Reclaim()
+	} else {
+		task.Delegate(args[1], time.Now())
+	}
+	if err := client.UpdateTask(ctx, task); err != nil {
+		return fmt.Errorf("failed to update task: %w", err)
+	}
+
+	if taskAssignClearFlag {
+		fmt.Printf("%s Took back %s\n", successStyle.Render("✓"), task.Title)
+	} else {
+		fmt.Printf("%s %s is waiting on %s\n", successStyle.Render("✓"), task.Title, task.Assignee)
+	}
+	return nil
+}
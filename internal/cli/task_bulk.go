@@ -0,0 +1,280 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/dateparse"
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/service"
+	"github.com/ihavespoons/reorg/internal/storage"
+)
+
+var (
+	bulkStatusFlag      string
+	bulkPriorityFlag    string
+	bulkTagsFlag        []string
+	bulkProjectFlag     string
+	bulkAreaFlag        string
+	bulkOverdueFlag     bool
+	bulkSetPriorityFlag string
+	bulkSetStatusFlag   string
+	bulkMoveToFlag      string
+	bulkCompleteFlag    bool
+	bulkDueFlag         string
+	bulkClearDueFlag    bool
+	bulkAddTagsFlag     []string
+	bulkRemoveTagsFlag  []string
+	bulkMetaFlag        []string
+	bulkYesFlag         bool
+)
+
+var taskBulkCmd = &cobra.Command{
+	Use:   "bulk",
+	Short: "Update many tasks at once",
+	Long: `Select tasks with filter flags and apply one or more actions to all of them.
+
+Examples:
+  reorg task bulk --status pending --tag errand --set-priority high
+  reorg task bulk --project website --move-to-project website-v2
+  reorg task bulk --tag chores --overdue --due +1w
+  reorg task bulk --project launch --complete
+  reorg task bulk --tag sprint-12 --meta sprint=12`,
+	RunE: runTaskBulk,
+}
+
+func init() {
+	taskCmd.AddCommand(taskBulkCmd)
+
+	// Filter flags
+	taskBulkCmd.Flags().StringVarP(&bulkStatusFlag, "status", "s", "", "Match tasks with this status")
+	taskBulkCmd.Flags().StringVar(&bulkPriorityFlag, "priority", "", "Match tasks with this priority")
+	taskBulkCmd.Flags().StringSliceVar(&bulkTagsFlag, "tag", nil, "Match tasks with all of these tags")
+	taskBulkCmd.Flags().StringVarP(&bulkProjectFlag, "project", "p", "", "Match tasks in this project")
+	taskBulkCmd.Flags().StringVarP(&bulkAreaFlag, "area", "a", "", "Match tasks in this area")
+	taskBulkCmd.Flags().BoolVar(&bulkOverdueFlag, "overdue", false, "Match only overdue tasks")
+
+	// Action flags
+	taskBulkCmd.Flags().StringVar(&bulkSetPriorityFlag, "set-priority", "", "Set priority (low, medium, high, urgent)")
+	taskBulkCmd.Flags().StringVar(&bulkSetStatusFlag, "set-status", "", "Set status (pending, in_progress, completed, blocked, cancelled)")
+	taskBulkCmd.Flags().StringVar(&bulkMoveToFlag, "move-to-project", "", "Move tasks to another project")
+	taskBulkCmd.Flags().BoolVar(&bulkCompleteFlag, "complete", false, "Mark tasks as completed")
+	taskBulkCmd.Flags().StringVar(&bulkDueFlag, "due", "", "Set due date (YYYY-MM-DD, today, tomorrow, +3d, +1w), optionally with a time such as 17:00")
+	taskBulkCmd.Flags().BoolVar(&bulkClearDueFlag, "clear-due", false, "Remove the due date")
+	taskBulkCmd.Flags().StringSliceVar(&bulkAddTagsFlag, "add-tag", nil, "Add tags")
+	taskBulkCmd.Flags().StringSliceVar(&bulkRemoveTagsFlag, "remove-tag", nil, "Remove tags")
+	taskBulkCmd.Flags().StringArrayVar(&bulkMetaFlag, "meta", nil, metaFlagUsage)
+	taskBulkCmd.Flags().BoolVarP(&bulkYesFlag, "yes", "y", false, "Apply without asking for confirmation")
+}
+
+func runTaskBulk(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	filter, err := buildBulkFilter(ctx, cmd)
+	if err != nil {
+		return err
+	}
+	if filter.IsEmpty() {
+		return fmt.Errorf("at least one filter is required (--status, --priority, --tag, --project, --area, --overdue)")
+	}
+
+	update, err := buildBulkUpdate(ctx)
+	if err != nil {
+		return err
+	}
+	if update.IsEmpty() {
+		return fmt.Errorf("no action specified (--set-priority, --set-status, --move-to-project, --complete, --due, --clear-due, --add-tag, --remove-tag, --meta)")
+	}
+
+	// Preview the matching tasks before changing anything
+	tasks, err := client.ListAllTasks(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	var matched []*domain.Task
+	for _, t := range tasks {
+		if filter.Matches(t) {
+			matched = append(matched, t)
+		}
+	}
+
+	if len(matched) == 0 {
+		fmt.Println("No tasks match the given filters.")
+		return nil
+	}
+
+	fmt.Printf("%d task(s) will be updated:\n", len(matched))
+	for _, t := range matched {
+		fmt.Printf("  • %s %s\n", t.Title, dimStyle.Render("("+t.ID+")"))
+	}
+
+	// A dry run changes nothing, so there is nothing to confirm
+	if !bulkYesFlag && !dryRun {
+		reader := bufio.NewReader(os.Stdin)
+		fmt.Print(promptStyle.Render("Apply changes? [y/N]: "))
+		input, _ := reader.ReadString('\n')
+		input = strings.ToLower(strings.TrimSpace(input))
+		if input != "y" && input != "yes" {
+			fmt.Println("Aborted.")
+			return nil
+		}
+	}
+
+	updated, err := client.BulkUpdateTasks(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update tasks: %w", err)
+	}
+
+	fmt.Printf("%s Updated %d task(s)\n", successStyle.Render("✓"), len(updated))
+	return nil
+}
+
+func buildBulkFilter(ctx context.Context, cmd *cobra.Command) (storage.TaskFilter, error) {
+	var filter storage.TaskFilter
+
+	if bulkStatusFlag != "" {
+		status, err := parseTaskStatus(bulkStatusFlag)
+		if err != nil {
+			return filter, err
+		}
+		filter.Status = &status
+	}
+
+	if bulkPriorityFlag != "" {
+		priority, err := parsePriority(bulkPriorityFlag)
+		if err != nil {
+			return filter, err
+		}
+		filter.Priority = &priority
+	}
+
+	filter.Tags = bulkTagsFlag
+
+	if bulkProjectFlag != "" {
+		project, err := findProject(ctx, bulkProjectFlag)
+		if err != nil {
+			return filter, err
+		}
+		filter.ProjectID = project.ID
+	}
+
+	if bulkAreaFlag != "" {
+		area, err := client.GetAreaBySlug(ctx, bulkAreaFlag)
+		if err != nil {
+			return filter, domain.Errorf(domain.ErrNotFound, "area not found: %s", bulkAreaFlag)
+		}
+		filter.AreaID = area.ID
+	}
+
+	if cmd.Flags().Changed("overdue") {
+		overdue := bulkOverdueFlag
+		filter.Overdue = &overdue
+	}
+
+	return filter, nil
+}
+
+func buildBulkUpdate(ctx context.Context) (service.TaskUpdate, error) {
+	var update service.TaskUpdate
+
+	if bulkSetPriorityFlag != "" {
+		priority, err := parsePriority(bulkSetPriorityFlag)
+		if err != nil {
+			return update, err
+		}
+		update.Priority = &priority
+	}
+
+	if bulkSetStatusFlag != "" && bulkCompleteFlag {
+		return update, fmt.Errorf("--set-status and --complete cannot be used together")
+	}
+	if bulkSetStatusFlag != "" {
+		status, err := parseTaskStatus(bulkSetStatusFlag)
+		if err != nil {
+			return update, err
+		}
+		update.Status = &status
+	}
+	if bulkCompleteFlag {
+		status := domain.TaskStatusCompleted
+		update.Status = &status
+	}
+
+	if bulkMoveToFlag != "" {
+		project, err := findProject(ctx, bulkMoveToFlag)
+		if err != nil {
+			return update, err
+		}
+		update.ProjectID = &project.ID
+	}
+
+	if bulkDueFlag != "" && bulkClearDueFlag {
+		return update, fmt.Errorf("--due and --clear-due cannot be used together")
+	}
+	if bulkDueFlag != "" {
+		due, allDay, err := parseDueMoment(bulkDueFlag)
+		if err != nil {
+			return update, err
+		}
+		update.DueDate = &due
+		update.DueAllDay = allDay
+	}
+	update.ClearDueDate = bulkClearDueFlag
+
+	update.AddTags = bulkAddTagsFlag
+	update.RemoveTags = bulkRemoveTagsFlag
+
+	meta, err := domain.ParseMetadata(bulkMetaFlag)
+	if err != nil {
+		return update, err
+	}
+	update.Metadata = meta
+
+	return update, nil
+}
+
+// parsePriority converts a priority name to a domain priority
+func parsePriority(s string) (domain.Priority, error) {
+	switch strings.ToLower(s) {
+	case "low":
+		return domain.PriorityLow, nil
+	case "medium":
+		return domain.PriorityMedium, nil
+	case "high":
+		return domain.PriorityHigh, nil
+	case "urgent":
+		return domain.PriorityUrgent, nil
+	default:
+		return "", fmt.Errorf("invalid priority: %s (use low, medium, high, urgent)", s)
+	}
+}
+
+// parseTaskStatus converts a status name to a domain task status
+func parseTaskStatus(s string) (domain.TaskStatus, error) {
+	status := domain.TaskStatus(strings.ToLower(s))
+	switch status {
+	case domain.TaskStatusPending, domain.TaskStatusInProgress, domain.TaskStatusCompleted,
+		domain.TaskStatusBlocked, domain.TaskStatusCancelled, domain.TaskStatusWaiting:
+		return status, nil
+	default:
+		return "", fmt.Errorf("invalid status: %s (use pending, in_progress, completed, blocked, cancelled, waiting)", s)
+	}
+}
+
+// parseDueDate parses a due date such as 2025-03-01, tomorrow or +1w
+func parseDueDate(s string) (time.Time, error) {
+	return dateparse.Parse(s, time.Now().In(timezone))
+}
+
+// parseDueMoment parses a due date as parseDueDate does, optionally with a
+// time of day such as "fri 17:00"; allDay is true without one
+func parseDueMoment(s string) (time.Time, bool, error) {
+	return dateparse.ParseMoment(s, time.Now().In(timezone))
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+var taskCommentAuthorFlag string
+
+var taskCommentCmd = &cobra.Command{
+	Use:   "comment [task-id] [text]",
+	Short: "Comment on a task",
+	Long: `Add a timestamped comment to a task. Comments are kept in the task's
+markdown body under a "## Comments" heading, one list item each with the
+time and author, and are shown by 'reorg task show'.
+
+The author is "cli" unless --author is given; comments from the MCP server
+are by "mcp" and those made over the API by "api" unless the caller names
+one.
+
+Examples:
+  reorg task comment <id> "Waiting for the quote from the printer"
+  reorg task comment <id> "Looks good" --author sam`,
+	Args: cobra.ExactArgs(2),
+	RunE: runTaskComment,
+}
+
+func init() {
+	taskCmd.AddCommand(taskCommentCmd)
+	taskCommentCmd.Flags().StringVar(&taskCommentAuthorFlag, "author", "cli", "Who the comment is from")
+}
+
+func runTaskComment(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	task, err := findTask(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	task, err = client.AddTaskComment(ctx, task.ID, taskCommentAuthorFlag, args[1])
+	if err != nil {
+		return fmt.Errorf("failed to comment: %w", err)
+	}
+
+	fmt.Printf("%s Commented on %s\n", successStyle.Render("✓"), task.Title)
+	return nil
+}
+
+// printComments prints a task's comments under a heading, for task show
+func printComments(comments []domain.Comment, labelStyle lipgloss.Style) {
+	if len(comments) == 0 {
+		return
+	}
+
+	fmt.Println(labelStyle.Render(fmt.Sprintf("Comments (%d):", len(comments))))
+	for _, c := range comments {
+		header := c.Time.Format("2006-01-02 15:04") + "  " + c.Author
+		fmt.Printf("  %s\n", lipgloss.NewStyle().Bold(true).Render(header))
+		for _, line := range strings.Split(c.Text, "\n") {
+			fmt.Printf("    %s\n", line)
+		}
+		fmt.Println()
+	}
+}
@@ -0,0 +1,213 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/dateparse"
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/service"
+)
+
+var (
+	taskClearDueFlag       bool
+	taskClearScheduledFlag bool
+)
+
+var taskDueCmd = &cobra.Command{
+	Use:   "due [task-id] [date]",
+	Short: "Set or clear a task's due date",
+	Long: `Set a task's due date. Dates can be YYYY-MM-DD, today, tomorrow, a
+weekday (fri, next-mon) or an offset from today (+3d, +1w, +1m).
+
+A date on its own is due all day, and the task becomes overdue once the
+day is over wherever you are. Add a time of day ("fri 17:00") for a
+deadline at that moment, which stays the same moment in other timezones.
+
+Examples:
+  reorg task due fix-header 2025-03-01
+  reorg task due fix-header next-mon
+  reorg task due fix-header "fri 17:00"
+  reorg task due fix-header +1w
+  reorg task due fix-header --clear`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runTaskDue,
+}
+
+var taskScheduleCmd = &cobra.Command{
+	Use:   "schedule [task-id] [date]",
+	Short: "Set or clear the day to start a task",
+	Long: `Set the day a task is to be started on, as opposed to its due date, the
+day it has to be done by. Until then the task is left out of 'reorg pick'
+and of plans for earlier days, and it shows on its day in 'reorg calendar'.
+Dates are given as for 'reorg task due', without a time of day.
+
+Examples:
+  reorg task schedule fix-header mon
+  reorg task schedule fix-header 2025-03-01
+  reorg task schedule fix-header --clear`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runTaskSchedule,
+}
+
+var taskSnoozeCmd = &cobra.Command{
+	Use:   "snooze [task-id] [duration]",
+	Short: "Push a task's due date back",
+	Long: `Push a task's due date back by a number of days, weeks or months
+(3d, 1w, 1m). Tasks that are overdue or have no due date are pushed back
+from today.
+
+Examples:
+  reorg task snooze fix-header 3d
+  reorg task snooze fix-header 1w`,
+	Args: cobra.ExactArgs(2),
+	RunE: runTaskSnooze,
+}
+
+func init() {
+	taskCmd.AddCommand(taskDueCmd)
+	taskCmd.AddCommand(taskScheduleCmd)
+	taskCmd.AddCommand(taskSnoozeCmd)
+
+	taskDueCmd.Flags().BoolVar(&taskClearDueFlag, "clear", false, "Remove the due date")
+	taskScheduleCmd.Flags().BoolVar(&taskClearScheduledFlag, "clear", false, "Remove the scheduled date")
+}
+
+func runTaskDue(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	var update service.TaskUpdate
+	switch {
+	case taskClearDueFlag && len(args) == 2:
+		return fmt.Errorf("--clear doesn't take a date")
+	case taskClearDueFlag:
+		update.ClearDueDate = true
+	case len(args) == 1:
+		return fmt.Errorf("date required, or --clear to remove the due date")
+	default:
+		due, allDay, err := parseDueMoment(args[1])
+		if err != nil {
+			return err
+		}
+		update.DueDate = &due
+		update.DueAllDay = allDay
+	}
+
+	task, err := findTask(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	return setTaskDue(ctx, task, update)
+}
+
+func runTaskSchedule(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	var update service.TaskUpdate
+	switch {
+	case taskClearScheduledFlag && len(args) == 2:
+		return fmt.Errorf("--clear doesn't take a date")
+	case taskClearScheduledFlag:
+		update.ClearScheduledDate = true
+	case len(args) == 1:
+		return fmt.Errorf("date required, or --clear to remove the scheduled date")
+	default:
+		scheduled, err := parseDueDate(args[1])
+		if err != nil {
+			return err
+		}
+		update.ScheduledDate = &scheduled
+	}
+
+	task, err := findTask(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	update.Apply(task)
+	if err := client.UpdateTask(ctx, task); err != nil {
+		return fmt.Errorf("failed to update task: %w", err)
+	}
+
+	if task.ScheduledDate == nil {
+		fmt.Printf("%s Cleared scheduled date of %s\n", successStyle.Render("✓"), task.Title)
+	} else {
+		fmt.Printf("%s %s is scheduled for %s\n", successStyle.Render("✓"), task.Title, task.ScheduledDate.Format("Mon 2006-01-02"))
+	}
+	return nil
+}
+
+func runTaskSnooze(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	task, err := findTask(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().In(timezone)
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	allDay := true
+	if task.DueDate != nil && task.DueDate.After(from) {
+		from = *task.DueDate
+		allDay = task.AllDay
+	}
+	due, err := dateparse.Add(from, args[1])
+	if err != nil {
+		return err
+	}
+	if !due.After(from) {
+		return fmt.Errorf("snooze needs a positive duration such as 3d or 1w")
+	}
+	return setTaskDue(ctx, task, service.TaskUpdate{DueDate: &due, DueAllDay: allDay})
+}
+
+// setTaskDue applies a due date change to a task and saves it
+func setTaskDue(ctx context.Context, task *domain.Task, update service.TaskUpdate) error {
+	update.Apply(task)
+	if err := client.UpdateTask(ctx, task); err != nil {
+		return fmt.Errorf("failed to update task: %w", err)
+	}
+
+	if task.DueDate == nil {
+		fmt.Printf("%s Cleared due date of %s\n", successStyle.Render("✓"), task.Title)
+	} else {
+		fmt.Printf("%s %s is due %s\n", successStyle.Render("✓"), task.Title, formatDue(task, "Mon 2006-01-02"))
+	}
+	return nil
+}
+
+// formatDue formats a task's due date with layout, adding the time of day
+// if it isn't all-day
+func formatDue(t *domain.Task, layout string) string {
+	if t.AllDay {
+		return t.DueDate.Format(layout)
+	}
+	return t.DueDate.Format(layout + " 15:04")
+}
+
+// dueFilter returns whether an open task's due date falls in a --due range:
+// today (including overdue), week (the next seven days, including overdue)
+// or overdue
+func dueFilter(name string, now time.Time) (func(*domain.Task) bool, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	var before time.Time
+	switch name {
+	case "today":
+		before = today.AddDate(0, 0, 1)
+	case "week":
+		before = today.AddDate(0, 0, 7)
+	case "overdue":
+		return func(t *domain.Task) bool {
+			return openTask(t) && t.IsOverdue()
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid --due: %s (use today, week or overdue)", name)
+	}
+	return func(t *domain.Task) bool {
+		return openTask(t) && t.DueDate != nil && t.DueDate.Before(before)
+	}, nil
+}
@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var taskFindAllFlag bool
+
+var taskFindCmd = &cobra.Command{
+	Use:   "find [query]",
+	Short: "Find and open any task, project, area or goal",
+	Long: `Open a fuzzy finder over every area, project, goal and open task, and
+show the one you pick. A query narrows the list before the finder opens.
+Completed tasks are left out unless --all is given.
+
+Examples:
+  reorg task find
+  reorg task find header
+  reorg task find --all`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runTaskFind,
+}
+
+func init() {
+	taskCmd.AddCommand(taskFindCmd)
+
+	taskFindCmd.Flags().BoolVar(&taskFindAllFlag, "all", false, "Include completed tasks")
+}
+
+// foundItem is an entry in the finder and how to show it
+type foundItem struct {
+	label string
+	show  func(cmd *cobra.Command, args []string) error
+	arg   string
+}
+
+func runTaskFind(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	items, err := findableItems(ctx)
+	if err != nil {
+		return err
+	}
+	if len(args) == 1 {
+		var matched []foundItem
+		for _, item := range items {
+			if _, ok := fuzzyScore(args[0], item.label); ok {
+				matched = append(matched, item)
+			}
+		}
+		items = matched
+	}
+	if len(items) == 0 {
+		fmt.Println(dimStyle.Render("Nothing found"))
+		return nil
+	}
+
+	labels := make([]string, len(items))
+	for i, item := range items {
+		labels[i] = item.label
+	}
+	i, err := selectItem("Open:", labels)
+	if err != nil {
+		return err
+	}
+	return items[i].show(cmd, []string{items[i].arg})
+}
+
+// findableItems lists everything task find can open, with labels that
+// start with the kind of item and include where it lives
+func findableItems(ctx context.Context) ([]foundItem, error) {
+	refs, err := client.ListTasksWithRefs(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	areas, err := client.ListAreas(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list areas: %w", err)
+	}
+	projects, err := client.ListAllProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	goals, err := client.ListGoals(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list goals: %w", err)
+	}
+
+	areaTitles := make(map[string]string, len(areas))
+	var items []foundItem
+	for _, a := range areas {
+		areaTitles[a.ID] = a.Title
+		items = append(items, foundItem{"area     " + a.Title, runAreaShow, a.Slug()})
+	}
+	for _, p := range projects {
+		items = append(items, foundItem{"project  " + areaTitles[p.AreaID] + "/" + p.Title, runProjectShow, p.Slug()})
+	}
+	for _, g := range goals {
+		items = append(items, foundItem{"goal     " + g.Title, runGoalShow, g.ID})
+	}
+	for _, r := range refs {
+		if r.Task.IsComplete() && !taskFindAllFlag {
+			continue
+		}
+		path := ""
+		if r.Area != nil {
+			path += r.Area.Title + "/"
+		}
+		if r.Project != nil {
+			path += r.Project.Title + "/"
+		}
+		items = append(items, foundItem{"task     " + path + r.Task.Title, runTaskShow, r.Task.ID})
+	}
+	return items, nil
+}
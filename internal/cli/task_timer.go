@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+var taskTimerCmd = &cobra.Command{
+	Use:   "timer",
+	Short: "Track time spent on tasks",
+	Long: `Record work sessions on a task. Stopped sessions are added to the task's
+time spent, and you are warned when it exceeds the estimate.
+
+Examples:
+  reorg task timer start task-1a2b3c4d
+  reorg task timer stop task-1a2b3c4d`,
+}
+
+var taskTimerStartCmd = &cobra.Command{
+	Use:   "start [task-id]",
+	Short: "Start the timer for a task",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runTaskTimerStart,
+}
+
+var taskTimerStopCmd = &cobra.Command{
+	Use:   "stop [task-id]",
+	Short: "Stop the timer for a task",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runTaskTimerStop,
+}
+
+func init() {
+	taskCmd.AddCommand(taskTimerCmd)
+	taskTimerCmd.AddCommand(taskTimerStartCmd)
+	taskTimerCmd.AddCommand(taskTimerStopCmd)
+}
+
+func runTaskTimerStart(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	task, err := findTask(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := client.StartTaskTimer(ctx, task.ID); err != nil {
+		return fmt.Errorf("failed to start timer: %w", err)
+	}
+
+	fmt.Printf("%s Started timer: %s\n", successStyle.Render("▶"), task.Title)
+	if task.IsOverEstimate(time.Now()) {
+		printOverEstimate(task)
+	}
+	return nil
+}
+
+func runTaskTimerStop(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	task, err := findTask(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	elapsed, err := client.StopTaskTimer(ctx, task.ID)
+	if err != nil {
+		return fmt.Errorf("failed to stop timer: %w", err)
+	}
+
+	task, err = client.GetTask(ctx, task.ID)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s Stopped timer: %s %s\n", successStyle.Render("■"), task.Title,
+		dimStyle.Render(fmt.Sprintf("(%s, %s total)", domain.FormatTimeSpec(elapsed), task.TimeSpent)))
+	if task.IsOverEstimate(time.Now()) {
+		printOverEstimate(task)
+	}
+	return nil
+}
+
+// printOverEstimate warns that a task has taken longer than estimated
+func printOverEstimate(task *domain.Task) {
+	warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("11"))
+	fmt.Println(warnStyle.Render(fmt.Sprintf("⚠ %s spent, estimate was %s",
+		domain.FormatTimeSpec(task.Spent(time.Now())), task.TimeEstimate)))
+}
+
+// trackedTime sums time spent and estimated across tasks
+func trackedTime(tasks []*domain.Task) (spent, estimated time.Duration) {
+	now := time.Now()
+	for _, t := range tasks {
+		spent += t.Spent(now)
+		if estimate, ok := t.Estimate(); ok {
+			estimated += estimate
+		}
+	}
+	return spent, estimated
+}
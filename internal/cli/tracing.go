@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ihavespoons/reorg/internal/tracing"
+)
+
+var (
+	// commandSpan is the span of the running command, ended by Execute
+	commandSpan trace.Span
+
+	// stopTracing flushes the spans not yet exported, once tracing is set up
+	stopTracing func(context.Context) error
+)
+
+// startTracing exports spans if tracing.enabled is set, and starts the
+// span of the command in cmd's context, which commands pass on to the
+// client. The servers of 'reorg serve' and 'reorg mcp' run until stopped,
+// so they get a trace per request instead.
+func startTracing(cmd *cobra.Command) {
+	if !viper.GetBool("tracing.enabled") || stopTracing != nil {
+		return
+	}
+	shutdown, err := tracing.Setup(context.Background(), tracing.Config{
+		Endpoint:    viper.GetString("tracing.endpoint"),
+		Insecure:    viper.GetBool("tracing.insecure"),
+		Headers:     viper.GetStringMapString("tracing.headers"),
+		SampleRatio: viper.GetFloat64("tracing.sample_ratio"),
+		Version:     version,
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, dimStyle.Render(fmt.Sprintf("Tracing off: %v", err)))
+		return
+	}
+	stopTracing = shutdown
+
+	if cmd == serveCmd || cmd == mcpCmd {
+		return
+	}
+	ctx, span := tracing.Start(cmd.Context(), "reorg "+llmCaller)
+	commandSpan = span
+	cmd.SetContext(ctx)
+}
+
+// finishTracing ends the command's span and exports what is left
+func finishTracing(err error) {
+	if commandSpan != nil {
+		tracing.End(commandSpan, err)
+	}
+	if stopTracing != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = stopTracing(ctx)
+	}
+}
@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/storage/git"
+)
+
+var (
+	undoLimitFlag  int
+	undoDryRunFlag bool
+)
+
+var undoCmd = &cobra.Command{
+	Use:   "undo [changeset]",
+	Short: "Reverse an earlier change",
+	Long: `Reverse one changeset recorded in the data directory's git history.
+
+Every change reorg makes is committed, and operations that touch many files
+(imports, bulk edits, moves, inbox triage) are recorded as one changeset.
+Changesets are named by operation and time, e.g. import:2025-06-01T09:00,
+and can also be given by commit hash.
+
+Undo is selective: only files that still look the way the changeset left
+them are restored, so edits made afterwards are kept. Files changed again
+since are reported and left alone.
+
+Without an argument, recent changesets are listed.
+
+Examples:
+  reorg undo
+  reorg undo import:2025-06-01T09:00
+  reorg undo bulk:2025-06-02T14:30 --dry-run
+  reorg undo 3f2a9c1`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runUndo,
+}
+
+func init() {
+	rootCmd.AddCommand(undoCmd)
+	undoCmd.Flags().IntVarP(&undoLimitFlag, "limit", "n", 15, "Number of changesets to list")
+	undoCmd.Flags().BoolVar(&undoDryRunFlag, "dry-run", false, "Show what would be reversed")
+}
+
+func runUndo(cmd *cobra.Command, args []string) error {
+	if store == nil {
+		return fmt.Errorf("undo is only available in embedded mode")
+	}
+	gitClient := store.Git()
+	if gitClient == nil || !gitClient.IsEnabled() {
+		return fmt.Errorf("undo needs git; run 'git init' in %s", dataDir)
+	}
+
+	if len(args) == 0 {
+		changesets, err := gitClient.Changesets(undoLimitFlag)
+		if err != nil {
+			return err
+		}
+		if len(changesets) == 0 {
+			fmt.Println("No changes recorded.")
+			return nil
+		}
+		for _, cs := range changesets {
+			fmt.Printf("  %s  %-28s %s\n", dimStyle.Render(cs.Hash[:7]), cs.Name, cs.Action)
+		}
+		return nil
+	}
+
+	changeset, err := findChangeset(gitClient, args[0])
+	if err != nil {
+		return err
+	}
+
+	result, err := gitClient.Revert(changeset.Hash, undoDryRunFlag)
+	if err != nil {
+		return fmt.Errorf("failed to undo: %w", err)
+	}
+
+	verb := "Reverted"
+	if undoDryRunFlag {
+		verb = "Would revert"
+	}
+	for _, path := range result.Reverted {
+		fmt.Printf("  %s %s\n", verb, path)
+	}
+	for _, path := range result.Conflicts {
+		fmt.Printf("  %s %s\n", dimStyle.Render("Kept (changed since)"), path)
+	}
+
+	if undoDryRunFlag {
+		return nil
+	}
+	if len(result.Reverted) == 0 {
+		fmt.Println("Nothing to undo.")
+		return nil
+	}
+
+	if err := gitClient.AutoCommit("undo " + changeset.Name + ": " + changeset.Action); err != nil {
+		return err
+	}
+	fmt.Printf("%s Undid %s (%s)\n", successStyle.Render("✓"), changeset.Name, changeset.Action)
+	return nil
+}
+
+// findChangeset looks up a changeset by name or commit hash prefix
+func findChangeset(gitClient *git.Client, identifier string) (*git.Changeset, error) {
+	changesets, err := gitClient.Changesets(500)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []git.Changeset
+	for _, cs := range changesets {
+		if cs.Name == identifier || (len(identifier) >= 4 && strings.HasPrefix(cs.Hash, identifier)) {
+			matches = append(matches, cs)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, domain.Errorf(domain.ErrNotFound, "changeset not found: %s (run 'reorg undo' to list recent changes)", identifier)
+	case 1:
+		return &matches[0], nil
+	}
+
+	var hashes []string
+	for _, cs := range matches {
+		hashes = append(hashes, cs.Hash[:7]+" "+cs.Action)
+	}
+	return nil, fmt.Errorf("%s matches %d changesets, use a commit hash:\n  %s",
+		identifier, len(matches), strings.Join(hashes, "\n  "))
+}
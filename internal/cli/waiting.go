@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/service"
+)
+
+var waitingPersonFlag string
+
+var waitingCmd = &cobra.Command{
+	Use:   "waiting",
+	Short: "List tasks delegated to others",
+	Long: `List the tasks waiting on someone else, grouped by person with the
+longest waiting first, and how long each has waited. Tasks are delegated
+with 'reorg task assign'.
+
+Examples:
+  reorg waiting
+  reorg waiting --person sam`,
+	Args: cobra.NoArgs,
+	RunE: runWaiting,
+}
+
+func init() {
+	rootCmd.AddCommand(waitingCmd)
+	waitingCmd.Flags().StringVar(&waitingPersonFlag, "person", "", "Only tasks waiting on this person")
+}
+
+func runWaiting(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	refs, err := client.ListTasksWithRefs(ctx, "status:"+string(domain.TaskStatusWaiting))
+	if err != nil {
+		return fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	byPerson := make(map[string][]*service.TaskRef)
+	for _, r := range refs {
+		person := r.Task.Assignee
+		if person == "" {
+			person = "(nobody)"
+		}
+		if waitingPersonFlag != "" && !strings.EqualFold(person, waitingPersonFlag) {
+			continue
+		}
+		byPerson[person] = append(byPerson[person], r)
+	}
+	if len(byPerson) == 0 {
+		fmt.Println("Nothing is waiting on anyone.")
+		return nil
+	}
+
+	people := make([]string, 0, len(byPerson))
+	for person, tasks := range byPerson {
+		sort.Slice(tasks, func(i, j int) bool {
+			return tasks[i].Task.WaitingSince().Before(*tasks[j].Task.WaitingSince())
+		})
+		people = append(people, person)
+	}
+	sort.Strings(people)
+
+	now := time.Now().In(timezone)
+	for _, person := range people {
+		fmt.Println(titleStyle.Render(person))
+		for _, r := range byPerson[person] {
+			line := fmt.Sprintf("  %-6s %s", waitingAge(now.Sub(*r.Task.WaitingSince())), r.Task.Title)
+			if project := refProjectTitle(r); project != "" {
+				line += dimStyle.Render(" · " + project)
+			}
+			if r.Task.DueDate != nil {
+				line += dimStyle.Render(" · due " + r.Task.DueDate.Format("Mon Jan 2"))
+			}
+			fmt.Println(line)
+		}
+		fmt.Println()
+	}
+	return nil
+}
+
+// waitingAge formats how long a task has waited, e.g. 3h, 5d or 2w
+func waitingAge(d time.Duration) string {
+	switch {
+	case d < time.Hour:
+		return "now"
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	case d < 14*24*time.Hour:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	default:
+		return fmt.Sprintf("%dw", int(d.Hours()/24/7))
+	}
+}
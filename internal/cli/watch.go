@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// watchSchedule spaces out the runs of an import with --watch: each wait
+// gets a random jitter added, so imports started together don't all run
+// at once, and runs falling in the quiet hours wait until they end
+type watchSchedule struct {
+	interval   time.Duration
+	jitter     time.Duration
+	quiet      bool
+	quietStart time.Duration // since midnight
+	quietEnd   time.Duration
+}
+
+// loadWatchSchedule reads import.watch.jitter and import.watch.quiet_hours
+// ("23:00-07:00")
+func loadWatchSchedule(interval time.Duration) (*watchSchedule, error) {
+	s := &watchSchedule{interval: interval, jitter: viper.GetDuration("import.watch.jitter")}
+	hours := viper.GetString("import.watch.quiet_hours")
+	if hours == "" {
+		return s, nil
+	}
+
+	start, end, ok := strings.Cut(hours, "-")
+	from, err1 := time.Parse("15:04", strings.TrimSpace(start))
+	to, err2 := time.Parse("15:04", strings.TrimSpace(end))
+	if !ok || err1 != nil || err2 != nil {
+		return nil, fmt.Errorf("invalid import.watch.quiet_hours %q (use e.g. 23:00-07:00)", hours)
+	}
+	s.quiet = true
+	s.quietStart = time.Duration(from.Hour())*time.Hour + time.Duration(from.Minute())*time.Minute
+	s.quietEnd = time.Duration(to.Hour())*time.Hour + time.Duration(to.Minute())*time.Minute
+	return s, nil
+}
+
+// next returns when the run after one at now is due
+func (s *watchSchedule) next(now time.Time) time.Time {
+	at := now.Add(s.interval + s.randomJitter())
+	if end, ok := s.quietUntil(at); ok {
+		at = end.Add(s.randomJitter())
+	}
+	return at
+}
+
+func (s *watchSchedule) randomJitter() time.Duration {
+	if s.jitter <= 0 {
+		return 0
+	}
+	return rand.N(s.jitter)
+}
+
+// quietUntil reports whether t is in the quiet hours, and when they end
+func (s *watchSchedule) quietUntil(t time.Time) (time.Time, bool) {
+	if !s.quiet || s.quietStart == s.quietEnd {
+		return time.Time{}, false
+	}
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	since := t.Sub(midnight)
+
+	switch {
+	case s.quietStart < s.quietEnd && since >= s.quietStart && since < s.quietEnd:
+		return midnight.Add(s.quietEnd), true
+	case s.quietStart > s.quietEnd && since >= s.quietStart:
+		// The window runs past midnight
+		return midnight.AddDate(0, 0, 1).Add(s.quietEnd), true
+	case s.quietStart > s.quietEnd && since < s.quietEnd:
+		return midnight.Add(s.quietEnd), true
+	}
+	return time.Time{}, false
+}
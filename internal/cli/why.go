@@ -0,0 +1,263 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/ihavespoons/reorg/internal/audit"
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/storage/git"
+)
+
+var whyCmd = &cobra.Command{
+	Use:   "why [id]",
+	Short: "Explain where a task, project or area came from",
+	Long: `Show the provenance of a task, project or area: which import created it,
+the original note it came from, the AI categorization at the time, and every
+change since: the git history, with the command, script, MCP client or API
+call that made each change and the fields it changed, from the audit log.
+
+Examples:
+  reorg why task-1a2b3c4d
+  reorg why buy-milk
+  reorg why website-redesign`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWhy,
+}
+
+func init() {
+	rootCmd.AddCommand(whyCmd)
+}
+
+// sourceNames maps importer identifiers to display names
+var sourceNames = map[string]string{
+	"apple_notes": "Apple Notes",
+	"obsidian":    "Obsidian",
+	"inbox":       "Inbox",
+	"audio":       "Voice memos",
+	"images":      "Images",
+	"folder":      "Folder",
+	"bear":        "Bear",
+	"journal":     "Journal",
+}
+
+func runWhy(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	identifier := args[0]
+
+	var (
+		kind     string
+		id       string
+		title    string
+		created  string
+		metadata map[string]string
+	)
+
+	if task, err := findTask(ctx, identifier); err == nil {
+		kind, id, title, metadata = "Task", task.ID, task.Title, task.Metadata
+		created = task.Created.Format("2006-01-02 15:04")
+	} else if project, err := findProjectByIDOrSlug(ctx, identifier); err == nil {
+		kind, id, title, metadata = "Project", project.ID, project.Title, project.Metadata
+		created = project.Created.Format("2006-01-02 15:04")
+	} else if area, err := findAreaByIDOrSlug(ctx, identifier); err == nil {
+		kind, id, title, metadata = "Area", area.ID, area.Title, area.Metadata
+		created = area.Created.Format("2006-01-02 15:04")
+	} else {
+		return fmt.Errorf("nothing found matching: %s", identifier)
+	}
+
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12"))
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+
+	fmt.Println()
+	fmt.Printf("%s %s\n", headerStyle.Render(title), dimStyle.Render("("+strings.ToLower(kind)+")"))
+	fmt.Println()
+	fmt.Printf("%s %s\n", labelStyle.Render("ID:"), id)
+	fmt.Printf("%s %s\n", labelStyle.Render("Created:"), created)
+	fmt.Println()
+
+	// Origin
+	fmt.Println(labelStyle.Render("Origin:"))
+	provenance := domain.ProvenanceFromMetadata(metadata)
+	if provenance.IsEmpty() {
+		fmt.Println("  Created directly (not imported)")
+	} else {
+		source := provenance.Source
+		if name, ok := sourceNames[source]; ok {
+			source = name
+		}
+		if source != "" {
+			fmt.Printf("  %s %s\n", labelStyle.Render("Source:"), source)
+		}
+		if provenance.SourceTitle != "" {
+			fmt.Printf("  %s %s\n", labelStyle.Render("Original:"), provenance.SourceTitle)
+		}
+		if provenance.SourceRef != "" {
+			fmt.Printf("  %s %s\n", labelStyle.Render("Ref:"), provenance.SourceRef)
+		}
+		if provenance.ImportedAt != nil {
+			fmt.Printf("  %s %s\n", labelStyle.Render("Imported:"), provenance.ImportedAt.In(timezone).Format("2006-01-02 15:04"))
+		}
+		if provenance.AIProvider != "" {
+			ai := provenance.AIProvider
+			if provenance.AIConfidence != nil {
+				ai += fmt.Sprintf(" (%.0f%% confidence)", *provenance.AIConfidence*100)
+			}
+			fmt.Printf("  %s %s\n", labelStyle.Render("Categorized by:"), ai)
+		}
+		if provenance.AISummary != "" {
+			fmt.Printf("  %s %s\n", labelStyle.Render("Summary:"), provenance.AISummary)
+		}
+	}
+	fmt.Println()
+
+	// Commits only exist for the local data directory, and the audit log
+	// only for changes made on this machine
+	var commits []git.CommitInfo
+	var rel string
+	if store != nil {
+		path, err := entityPath(ctx, kind, id)
+		if err != nil {
+			return err
+		}
+		if rel, err = filepath.Rel(store.RootDir(), path); err != nil {
+			return err
+		}
+		if commits, err = store.Git().History(rel, "\nid: "+id+"\n"); err != nil {
+			return err
+		}
+	}
+	entries, err := audit.NewLog(filepath.Join(stateDir(), "audit.jsonl")).Entries()
+	if err != nil {
+		return err
+	}
+	history := whyHistory(commits, entries, id)
+	if store == nil && len(history) == 0 {
+		fmt.Println(dimStyle.Render("Change history is only available in embedded mode."))
+		fmt.Println()
+		return nil
+	}
+
+	fmt.Printf("%s %s\n", labelStyle.Render("History:"), dimStyle.Render(rel))
+	if len(history) == 0 {
+		fmt.Println("  No changes recorded")
+	}
+	for _, e := range history {
+		hash := e.Hash
+		if hash == "" {
+			hash = strings.Repeat(" ", 7)
+		}
+		who := ""
+		if e.By != "" {
+			who = dimStyle.Render(" by " + e.By)
+		}
+		fmt.Printf("  %s  %s  %s%s\n", dimStyle.Render(hash), e.Time.In(timezone).Format("2006-01-02 15:04"), e.What, who)
+		for _, change := range e.Changes {
+			fmt.Printf("      %s\n", dimStyle.Render(change))
+		}
+	}
+	fmt.Println()
+
+	return nil
+}
+
+// commitWindow is how long after an audited change its commit may be
+// made: a batch, such as an import, commits once at its end
+const commitWindow = 5 * time.Minute
+
+// whyEvent is one change in the history 'reorg why' shows
+type whyEvent struct {
+	Time    time.Time
+	Hash    string   // of the commit, shortened, if there is one
+	What    string   // the commit message, or the action
+	By      string   // the actor in the audit log, or the commit's author
+	Changes []string // the fields changed, from the audit log
+}
+
+// whyHistory merges the commits that changed an item with its audit log
+// entries, oldest first. An entry and the commit that followed it are one
+// change, with the actor and changed fields only the audit log records.
+// Commits without an entry, such as edits made outside reorg, and entries
+// without a commit, as with git off, are shown on their own.
+func whyHistory(commits []git.CommitInfo, entries []audit.Entry, id string) []whyEvent {
+	// History lists the commits newest first, and several may share a second
+	commits = slices.Clone(commits)
+	slices.Reverse(commits)
+
+	var events []whyEvent
+	next := 0
+	for _, e := range entries {
+		if e.ID != id {
+			continue
+		}
+		// Commit times are in whole seconds
+		for next < len(commits) && commits[next].When.Before(e.Time.Add(-time.Second)) {
+			events = append(events, commitEvent(commits[next]))
+			next++
+		}
+
+		by := e.Actor
+		if e.User != "" {
+			by += " (" + e.User + ")"
+		}
+		event := whyEvent{Time: e.Time, What: string(e.Action) + " " + e.Kind, By: by, Changes: auditChanges(e.Changes)}
+		if next < len(commits) && commits[next].When.Before(e.Time.Add(commitWindow)) {
+			commit := commitEvent(commits[next])
+			event.Hash, event.What = commit.Hash, commit.What
+			next++
+		}
+		events = append(events, event)
+	}
+	for ; next < len(commits); next++ {
+		events = append(events, commitEvent(commits[next]))
+	}
+	return events
+}
+
+// commitEvent is the change a commit made, without an audit log entry
+func commitEvent(c git.CommitInfo) whyEvent {
+	event := whyEvent{
+		Time: c.When,
+		Hash: c.Hash[:min(7, len(c.Hash))],
+		What: strings.TrimPrefix(strings.SplitN(c.Message, "\n", 2)[0], "reorg: "),
+	}
+	if c.Author != "reorg" {
+		event.By = c.Author
+	}
+	return event
+}
+
+// entityPath returns the file an entity is stored in
+func entityPath(ctx context.Context, kind, id string) (string, error) {
+	switch kind {
+	case "Task":
+		return store.Tasks().Path(ctx, id)
+	case "Project":
+		return store.Projects().Path(ctx, id)
+	default:
+		return store.Areas().Path(ctx, id)
+	}
+}
+
+// findProjectByIDOrSlug looks up a project by exact ID or slug
+func findProjectByIDOrSlug(ctx context.Context, identifier string) (*domain.Project, error) {
+	if project, err := client.GetProject(ctx, identifier); err == nil {
+		return project, nil
+	}
+	return findProject(ctx, identifier)
+}
+
+// findAreaByIDOrSlug looks up an area by exact ID or slug
+func findAreaByIDOrSlug(ctx context.Context, identifier string) (*domain.Area, error) {
+	if area, err := client.GetArea(ctx, identifier); err == nil {
+		return area, nil
+	}
+	return client.GetAreaBySlug(ctx, identifier)
+}
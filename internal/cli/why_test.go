@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/ihavespoons/reorg/internal/audit"
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/service"
+	"github.com/ihavespoons/reorg/internal/storage/markdown"
+)
+
+func TestWhyHistoryShowsAuditedChanges(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s := markdown.NewStore(dir)
+	if err := s.Initialize(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Git().Init(); err != nil {
+		t.Fatal(err)
+	}
+	log := audit.NewLog(filepath.Join(t.TempDir(), "audit.jsonl"))
+	c := service.NewLocalClient(s)
+	c.SetAudit(log)
+
+	area, err := c.CreateArea(ctx, domain.NewArea("Work"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	project, err := c.CreateProject(ctx, domain.NewProject("Site", area.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := c.CreateTask(ctx, domain.NewTask("Fix header", project.ID, area.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	task, err := c.GetTask(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	task.Priority = domain.PriorityHigh
+	if err := c.UpdateTask(audit.WithActor(ctx, "cli:task update"), task); err != nil {
+		t.Fatal(err)
+	}
+
+	path, err := s.Tasks().Path(ctx, task.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commits, err := s.Git().History(rel, "\nid: "+task.ID+"\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries, err := log.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	history := whyHistory(commits, entries, task.ID)
+	if len(history) != 2 {
+		t.Fatalf("whyHistory() = %+v, want the create and the update", history)
+	}
+	if history[0].Hash == "" || history[0].What != "create task: Fix header" {
+		t.Errorf("first change = %+v, want the create with its commit", history[0])
+	}
+	update := history[1]
+	if update.Hash == "" {
+		t.Errorf("update has no commit, want the one it was recorded in")
+	}
+	if update.By != "cli:task update" {
+		t.Errorf("update by %q, want %q", update.By, "cli:task update")
+	}
+	if want := []string{"priority: medium → high"}; !slices.Equal(update.Changes, want) {
+		t.Errorf("update changes = %q, want %q", update.Changes, want)
+	}
+}
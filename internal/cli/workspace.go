@@ -0,0 +1,205 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ihavespoons/reorg/internal/audit"
+)
+
+var (
+	workspace          string
+	workspaceUnsetFlag bool
+
+	// workspaceFile records the workspace chosen with `reorg workspace use`.
+	// It is found before the workspace's own settings are applied, so a
+	// workspace with its own state_dir doesn't hide it.
+	workspaceFile string
+)
+
+var workspaceCmd = &cobra.Command{
+	Use:   "workspace",
+	Short: "Switch between named workspaces",
+	Long: `Workspaces are named sets of settings, each with its own data directory,
+configured under workspaces in the config file:
+
+  workspaces:
+    work:
+      data_dir: ~/reorg-work
+      llm:
+        provider: claude
+    personal:
+      data_dir: ~/reorg-personal
+
+A workspace's settings override the top-level ones while it is active.
+The active workspace is the --workspace flag, then REORG_WORKSPACE, then
+the one chosen with 'reorg workspace use', then the workspace config key.`,
+}
+
+var workspaceListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the configured workspaces",
+	Args:  cobra.NoArgs,
+	RunE:  runWorkspaceList,
+}
+
+var workspaceUseCmd = &cobra.Command{
+	Use:   "use [name]",
+	Short: "Make a workspace the active one",
+	Long: `Make a workspace the active one for every later command, including
+reorg serve and reorg mcp. --unset goes back to the top-level settings.
+
+Examples:
+  reorg workspace use work
+  reorg workspace use --unset`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runWorkspaceUse,
+}
+
+var workspaceCurrentCmd = &cobra.Command{
+	Use:   "current",
+	Short: "Show the active workspace",
+	Args:  cobra.NoArgs,
+	RunE:  runWorkspaceCurrent,
+}
+
+func init() {
+	rootCmd.AddCommand(workspaceCmd)
+	workspaceCmd.AddCommand(workspaceListCmd)
+	workspaceCmd.AddCommand(workspaceUseCmd)
+	workspaceCmd.AddCommand(workspaceCurrentCmd)
+
+	workspaceUseCmd.Flags().BoolVar(&workspaceUnsetFlag, "unset", false, "Stop using a workspace")
+}
+
+// workspaceNames returns the configured workspaces, sorted
+func workspaceNames() []string {
+	var names []string
+	for name := range viper.GetStringMap("workspaces") {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// applyWorkspace works out the active workspace and layers its settings
+// over the top-level ones. It must run after the config file is read and
+// before anything reads the settings.
+func applyWorkspace() error {
+	workspaceFile = filepath.Join(stateDir(), "workspace")
+
+	saved := false
+	if workspace == "" {
+		workspace = os.Getenv("REORG_WORKSPACE")
+	}
+	if workspace == "" {
+		if data, err := os.ReadFile(workspaceFile); err == nil {
+			workspace = strings.TrimSpace(string(data))
+			saved = workspace != ""
+		}
+	}
+	if workspace == "" {
+		workspace = viper.GetString("workspace")
+	}
+	if workspace == "" {
+		return nil
+	}
+
+	// Config keys are case-insensitive
+	workspace = strings.ToLower(workspace)
+	key := "workspaces." + workspace
+	if !viper.IsSet(key) {
+		if saved {
+			// The workspace was removed from the config after it was chosen.
+			// Fall back to the top-level settings rather than failing every
+			// command, including the one that would pick another.
+			fmt.Fprintf(os.Stderr, "Warning: workspace %q no longer exists, using the default data dir. Run 'reorg workspace use' to choose another.\n", workspace)
+			workspace = ""
+			return nil
+		}
+		return fmt.Errorf("unknown workspace %q (configured: %s)", workspace, strings.Join(workspaceNames(), ", "))
+	}
+	settings := viper.GetStringMap(key)
+	if _, ok := settings["state_dir"]; !ok {
+		// Keep approvals, reminders and usage apart from other workspaces
+		settings["state_dir"] = filepath.Join(stateDir(), "workspaces", workspace)
+	}
+	return viper.MergeConfigMap(settings)
+}
+
+func runWorkspaceList(cmd *cobra.Command, args []string) error {
+	names := workspaceNames()
+	if len(names) == 0 {
+		fmt.Println("No workspaces configured. Add them under workspaces in the config file.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "\tNAME\tDATA DIR")
+	_, _ = fmt.Fprintln(w, "\t----\t--------")
+	for _, name := range names {
+		active := ""
+		if name == workspace {
+			active = "*"
+		}
+		dir := viper.GetString("workspaces." + name + ".data_dir")
+		if dir == "" {
+			dir = dimStyle.Render("(default)")
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", active, name, dir)
+	}
+	_ = w.Flush()
+	return nil
+}
+
+func runWorkspaceUse(cmd *cobra.Command, args []string) error {
+	if workspaceUnsetFlag {
+		if len(args) > 0 {
+			return fmt.Errorf("--unset doesn't take a workspace name")
+		}
+		if dryRunFile(audit.Delete, workspaceFile) {
+			return nil
+		}
+		if err := os.Remove(workspaceFile); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to unset workspace: %w", err)
+		}
+		fmt.Println(successStyle.Render("✓ No workspace in use"))
+		return nil
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("workspace name required")
+	}
+
+	name := strings.ToLower(args[0])
+	if !viper.IsSet("workspaces." + name) {
+		return fmt.Errorf("unknown workspace %q (configured: %s)", name, strings.Join(workspaceNames(), ", "))
+	}
+	if dryRunFile(audit.Update, workspaceFile) {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(workspaceFile), 0755); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+	if err := os.WriteFile(workspaceFile, []byte(name+"\n"), 0644); err != nil {
+		return fmt.Errorf("failed to save workspace: %w", err)
+	}
+	fmt.Println(successStyle.Render("✓ Using workspace " + name))
+	return nil
+}
+
+func runWorkspaceCurrent(cmd *cobra.Command, args []string) error {
+	if workspace == "" {
+		fmt.Println(dimStyle.Render("No workspace in use"))
+	} else {
+		fmt.Println(workspace)
+	}
+	fmt.Printf("Data dir: %s\n", dataDir)
+	return nil
+}
@@ -0,0 +1,95 @@
+package dateparse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// weekdays maps the full and short weekday names
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+// Parse parses a date relative to now. It accepts an absolute date
+// (YYYY-MM-DD), "today", "tomorrow", "yesterday", a weekday such as "fri"
+// or "next-mon" (the next one after today), or an offset from today such
+// as +3d, +2w, +1m or -1w. The result is midnight in now's location.
+func Parse(s string, now time.Time) (time.Time, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	name := strings.ToLower(strings.TrimSpace(s))
+	switch name {
+	case "today":
+		return today, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	}
+
+	name = strings.TrimPrefix(strings.TrimPrefix(name, "next-"), "next ")
+	if day, ok := weekdays[name]; ok {
+		days := (int(day)-int(today.Weekday())+6)%7 + 1
+		return today.AddDate(0, 0, days), nil
+	}
+
+	if strings.HasPrefix(s, "+") || strings.HasPrefix(s, "-") {
+		return Add(today, s)
+	}
+
+	date, err := time.ParseInLocation("2006-01-02", s, now.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date: %s (use YYYY-MM-DD, today, tomorrow, a weekday or +Nd/+Nw)", s)
+	}
+	return date, nil
+}
+
+// ParseMoment parses a date as Parse does, optionally followed by a time
+// of day such as "fri 17:00" or "2025-03-01T09:30", or an RFC 3339
+// timestamp. allDay is true when no time of day was given.
+func ParseMoment(s string, now time.Time) (t time.Time, allDay bool, err error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, false, nil
+	}
+	if i := strings.LastIndexAny(s, " T"); i > 0 {
+		if clock, err := time.Parse("15:04", s[i+1:]); err == nil {
+			day, err := Parse(s[:i], now)
+			if err != nil {
+				return time.Time{}, false, err
+			}
+			return time.Date(day.Year(), day.Month(), day.Day(), clock.Hour(), clock.Minute(), 0, 0, day.Location()), false, nil
+		}
+	}
+	day, err := Parse(s, now)
+	return day, true, err
+}
+
+// Add moves t by an offset of days, weeks or months such as 3d, +2w or
+// -1m. The sign is optional.
+func Add(t time.Time, offset string) (time.Time, error) {
+	if len(offset) < 2 {
+		return time.Time{}, fmt.Errorf("invalid date offset: %s", offset)
+	}
+	n, err := strconv.Atoi(offset[:len(offset)-1])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date offset: %s", offset)
+	}
+	switch offset[len(offset)-1] {
+	case 'd':
+		return t.AddDate(0, 0, n), nil
+	case 'w':
+		return t.AddDate(0, 0, 7*n), nil
+	case 'm':
+		return t.AddDate(0, n, 0), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid date offset: %s (use d, w or m)", offset)
+}
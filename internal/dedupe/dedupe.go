@@ -0,0 +1,179 @@
+// Package dedupe finds existing projects and tasks that an imported item
+// duplicates. Titles are compared by similarity; near misses can be passed
+// to an LLM to decide.
+package dedupe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/llm"
+)
+
+const (
+	// DefaultThreshold is the similarity at which titles are duplicates
+	DefaultThreshold = 0.85
+
+	// askFloor is the lowest similarity passed to the LLM
+	askFloor = 0.5
+)
+
+// Candidate is an existing project or task an item is compared with
+type Candidate struct {
+	ID    string
+	Title string
+}
+
+// Match is a candidate found to duplicate an item
+type Match struct {
+	Candidate
+	Score float64 // title similarity from 0-1
+	ByLLM bool    // true if the LLM decided, rather than the score
+}
+
+// Detector finds duplicates among candidates
+type Detector struct {
+	Threshold float64    // zero means DefaultThreshold
+	LLM       llm.Client // optional, asked about near misses
+}
+
+// Projects returns the projects as candidates
+func Projects(projects []*domain.Project) []Candidate {
+	candidates := make([]Candidate, len(projects))
+	for i, p := range projects {
+		candidates[i] = Candidate{ID: p.ID, Title: p.Title}
+	}
+	return candidates
+}
+
+// Tasks returns the tasks as candidates
+func Tasks(tasks []*domain.Task) []Candidate {
+	candidates := make([]Candidate, len(tasks))
+	for i, t := range tasks {
+		candidates[i] = Candidate{ID: t.ID, Title: t.Title}
+	}
+	return candidates
+}
+
+// Find returns the candidate that title duplicates, or nil. The most
+// similar candidate at or above the threshold wins; otherwise, with an
+// LLM, candidates scoring above askFloor are offered to it.
+func (d *Detector) Find(ctx context.Context, kind, title string, candidates []Candidate) *Match {
+	threshold := d.Threshold
+	if threshold == 0 {
+		threshold = DefaultThreshold
+	}
+
+	var best *Match
+	var near []Match
+	for _, c := range candidates {
+		score := Similarity(title, c.Title)
+		if best == nil || score > best.Score {
+			best = &Match{Candidate: c, Score: score}
+		}
+		if score >= askFloor && score < threshold {
+			near = append(near, Match{Candidate: c, Score: score})
+		}
+	}
+
+	if best != nil && best.Score >= threshold {
+		return best
+	}
+	if d.LLM == nil || len(near) == 0 {
+		return nil
+	}
+
+	match, err := d.ask(ctx, kind, title, near)
+	if err != nil {
+		return nil // fall back to the score alone
+	}
+	return match
+}
+
+// ask has the LLM pick which, if any, of the near misses is the same item
+func (d *Detector) ask(ctx context.Context, kind, title string, near []Match) (*Match, error) {
+	var list strings.Builder
+	for _, m := range near {
+		fmt.Fprintf(&list, "- %s: %q\n", m.ID, m.Title)
+	}
+
+	prompt := fmt.Sprintf(`A new %s titled %q is being imported. Is it the same %s as one of these existing ones, just worded differently?
+
+%s
+Only pick one if they clearly refer to the same thing. Respond with JSON only: {"match": "id"} or {"match": ""}`, kind, title, kind, list.String())
+
+	response, err := d.LLM.Chat(ctx, prompt)
+	if err != nil {
+		return nil, err
+	}
+
+	start := strings.Index(response, "{")
+	end := strings.LastIndex(response, "}")
+	if start < 0 || end <= start {
+		return nil, fmt.Errorf("unexpected response from %s", d.LLM.Provider())
+	}
+
+	var result struct {
+		Match string `json:"match"`
+	}
+	if err := json.Unmarshal([]byte(response[start:end+1]), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	for _, m := range near {
+		if m.ID == result.Match {
+			m.ByLLM = true
+			return &m, nil
+		}
+	}
+	return nil, nil
+}
+
+// Similarity scores how alike two titles are from 0-1, using the Dice
+// coefficient of character bigrams after normalizing case, punctuation and
+// spacing. Titles that only differ in those score 1.
+func Similarity(a, b string) float64 {
+	a, b = normalize(a), normalize(b)
+	if a == b {
+		return 1
+	}
+	if len([]rune(a)) < 2 || len([]rune(b)) < 2 {
+		return 0
+	}
+
+	counts := make(map[string]int)
+	for _, bg := range bigrams(a) {
+		counts[bg]++
+	}
+	shared := 0
+	bb := bigrams(b)
+	for _, bg := range bb {
+		if counts[bg] > 0 {
+			counts[bg]--
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(bigrams(a))+len(bb))
+}
+
+// normalize lowercases a title and reduces punctuation and runs of spaces
+// to single spaces
+func normalize(s string) string {
+	fields := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(fields, " ")
+}
+
+func bigrams(s string) []string {
+	runes := []rune(s)
+	pairs := make([]string, 0, len(runes)-1)
+	for i := 0; i < len(runes)-1; i++ {
+		pairs = append(pairs, string(runes[i:i+2]))
+	}
+	return pairs
+}
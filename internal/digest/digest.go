@@ -0,0 +1,194 @@
+// Package digest summarizes what needs attention for a daily or weekly
+// message: tasks due and overdue, what was completed, and stalled projects.
+package digest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/llm"
+	"github.com/ihavespoons/reorg/internal/notify"
+	"github.com/ihavespoons/reorg/internal/service"
+)
+
+// Period is how much time a digest covers
+type Period string
+
+const (
+	PeriodDaily  Period = "daily"
+	PeriodWeekly Period = "weekly"
+)
+
+// ParsePeriod parses "daily" or "weekly", defaulting to daily
+func ParsePeriod(s string) (Period, error) {
+	switch Period(strings.ToLower(strings.TrimSpace(s))) {
+	case "", PeriodDaily:
+		return PeriodDaily, nil
+	case PeriodWeekly:
+		return PeriodWeekly, nil
+	}
+	return "", fmt.Errorf("invalid digest schedule %q (use daily or weekly)", s)
+}
+
+// Item is a task in the digest
+type Item struct {
+	Task    *domain.Task
+	Project string
+}
+
+// Digest is the summary for the period ending or starting at Date
+type Digest struct {
+	Period Period
+	Date   time.Time
+	// Due are open tasks due today, or in the coming week for a weekly digest
+	Due     []Item
+	Overdue []Item
+	// Completed are tasks completed yesterday, or in the past week
+	Completed []Item
+	Stalled   []*domain.Project
+	// Summary is the AI's take on the digest, if it was asked
+	Summary string
+}
+
+// Build assembles the digest at now from every task and project. Tasks in
+// someday projects are left out. A task counts as completed when it was
+// last changed, as tasks don't record when they were completed.
+func Build(tasks []*domain.Task, projects []*domain.Project, period Period, now time.Time) *Digest {
+	d := &Digest{Period: period, Date: now}
+
+	days := 1
+	if period == PeriodWeekly {
+		days = 7
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	dueBefore := today.AddDate(0, 0, days)
+	completedAfter := today.AddDate(0, 0, -days)
+
+	titles := make(map[string]string, len(projects))
+	for _, p := range projects {
+		titles[p.ID] = p.Title
+	}
+
+	tasks = service.WithoutSomeday(tasks, projects)
+	byProject := make(map[string][]*domain.Task)
+	for _, t := range tasks {
+		byProject[t.ProjectID] = append(byProject[t.ProjectID], t)
+
+		item := Item{Task: t, Project: titles[t.ProjectID]}
+		switch {
+		case t.IsComplete():
+			if !t.Updated.Before(completedAfter) && t.Updated.Before(today) {
+				d.Completed = append(d.Completed, item)
+			}
+		case t.Status == domain.TaskStatusCancelled || t.DueDate == nil:
+		case t.IsOverdueAt(now):
+			d.Overdue = append(d.Overdue, item)
+		case t.DueDate.Before(dueBefore):
+			d.Due = append(d.Due, item)
+		}
+	}
+
+	for _, p := range projects {
+		if p.IsActive() && service.ProjectHealth(p, byProject[p.ID], now).Status == domain.HealthStalled {
+			d.Stalled = append(d.Stalled, p)
+		}
+	}
+
+	byDue := func(items []Item) {
+		sort.SliceStable(items, func(i, j int) bool { return items[i].Task.DueDate.Before(*items[j].Task.DueDate) })
+	}
+	byDue(d.Due)
+	byDue(d.Overdue)
+	sort.Slice(d.Stalled, func(i, j int) bool { return d.Stalled[i].Title < d.Stalled[j].Title })
+	return d
+}
+
+// Empty reports whether there is nothing to tell
+func (d *Digest) Empty() bool {
+	return len(d.Due) == 0 && len(d.Overdue) == 0 && len(d.Completed) == 0 && len(d.Stalled) == 0
+}
+
+// Title is the heading of the digest, e.g. "Daily digest for Mon Jan 2"
+func (d *Digest) Title() string {
+	if d.Period == PeriodWeekly {
+		return "Weekly digest for the week of " + d.Date.Format("Mon Jan 2")
+	}
+	return "Daily digest for " + d.Date.Format("Mon Jan 2")
+}
+
+// Text renders the digest as plain text, one section per kind of item
+func (d *Digest) Text() string {
+	var b strings.Builder
+	if d.Summary != "" {
+		b.WriteString(d.Summary + "\n\n")
+	}
+
+	dueHeading := "Due today"
+	completedHeading := "Completed yesterday"
+	if d.Period == PeriodWeekly {
+		dueHeading = "Due this week"
+		completedHeading = "Completed last week"
+	}
+
+	section := func(heading string, items []Item, showDue bool) {
+		if len(items) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "%s (%d)\n", heading, len(items))
+		for _, item := range items {
+			line := "- " + item.Task.Title
+			if item.Project != "" {
+				line += " [" + item.Project + "]"
+			}
+			if showDue {
+				line += " · due " + item.Task.DueDate.Format("Mon Jan 2")
+			}
+			b.WriteString(line + "\n")
+		}
+		b.WriteString("\n")
+	}
+	section("Overdue", d.Overdue, true)
+	section(dueHeading, d.Due, d.Period == PeriodWeekly)
+	section(completedHeading, d.Completed, false)
+
+	if len(d.Stalled) > 0 {
+		fmt.Fprintf(&b, "Stalled projects (%d)\n", len(d.Stalled))
+		for _, p := range d.Stalled {
+			b.WriteString("- " + p.Title + "\n")
+		}
+		b.WriteString("\n")
+	}
+
+	if d.Empty() {
+		b.WriteString("Nothing due, overdue or stalled.\n")
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// Message returns the digest as a notification
+func (d *Digest) Message() notify.Message {
+	return notify.Message{Title: d.Title(), Body: d.Text()}
+}
+
+// Summarize asks the language model for a short overview of the digest
+// and stores it in Summary
+func (d *Digest) Summarize(ctx context.Context, client llm.Client) error {
+	if d.Empty() {
+		return nil
+	}
+
+	prompt := fmt.Sprintf(`Here is my %s task digest. Write two or three sentences summarizing what needs my attention most and anything worth celebrating. Respond with the summary only, no lists or headings.
+
+%s`, d.Period, d.Text())
+
+	response, err := client.Chat(ctx, prompt)
+	if err != nil {
+		return err
+	}
+	d.Summary = strings.TrimSpace(response)
+	return nil
+}
@@ -2,25 +2,37 @@ package domain
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/ihavespoons/reorg/internal/slug"
 )
 
 // Area represents a high-level category for organizing projects
 // Examples: Work, Personal, Life Admin
 type Area struct {
-	ID        string            `yaml:"id"`
-	Title     string            `yaml:"title"`
-	Type      string            `yaml:"type"`
-	Color     string            `yaml:"color,omitempty"`
-	Icon      string            `yaml:"icon,omitempty"`
-	SortOrder int               `yaml:"sort_order"`
-	Metadata  map[string]string `yaml:"metadata,omitempty"`
+	ID        string            `yaml:"id" json:"id"`
+	Title     string            `yaml:"title" json:"title"`
+	Type      string            `yaml:"type" json:"type"`
+	Color     string            `yaml:"color,omitempty" json:"color,omitempty"`
+	Icon      string            `yaml:"icon,omitempty" json:"icon,omitempty"`
+	SortOrder int               `yaml:"sort_order" json:"sort_order"`
+	Priority  Priority          `yaml:"priority,omitempty" json:"priority,omitempty"`
+	Tags      []string          `yaml:"tags,omitempty" json:"tags,omitempty"`
+	Metadata  map[string]string `yaml:"metadata,omitempty" json:"metadata,omitempty"`
+
+	// Review is how often the area should be looked over; LastReviewed is
+	// when it last was
+	Review       ReviewCadence `yaml:"review,omitempty" json:"review,omitempty"`
+	LastReviewed *time.Time    `yaml:"last_reviewed,omitempty" json:"last_reviewed,omitempty"`
 	Timestamps
 
 	// Content holds the markdown body (not stored in frontmatter)
-	Content string `yaml:"-"`
+	Content string `yaml:"-" json:"content,omitempty"`
 }
 
 // NewArea creates a new Area with generated ID and timestamps
@@ -30,40 +42,100 @@ func NewArea(title string) *Area {
 		Title:     title,
 		Type:      "area",
 		SortOrder: 0,
+		Priority:  PriorityMedium,
+		Tags:      []string{},
 		Metadata:  make(map[string]string),
 	}
 	a.SetCreated()
 	return a
 }
 
+// Clone returns a copy of the area that shares no slices, maps or
+// pointers with it
+func (a *Area) Clone() *Area {
+	c := *a
+	c.Tags = slices.Clone(a.Tags)
+	c.Metadata = maps.Clone(a.Metadata)
+	c.LastReviewed = clonePtr(a.LastReviewed)
+	return &c
+}
+
 // Slug returns a URL-safe identifier derived from the title
 func (a *Area) Slug() string {
-	slug := strings.ToLower(a.Title)
-	slug = strings.ReplaceAll(slug, " ", "-")
-	// Remove any characters that aren't alphanumeric or hyphens
-	var result strings.Builder
-	for _, r := range slug {
-		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' {
-			result.WriteRune(r)
-		}
-	}
-	return result.String()
+	return slug.Make(a.Title)
 }
 
 // Validate checks if the area has all required fields
 func (a *Area) Validate() error {
 	if a.ID == "" {
-		return fmt.Errorf("area ID is required")
+		return Errorf(ErrInvalid, "area ID is required")
 	}
 	if a.Title == "" {
-		return fmt.Errorf("area title is required")
+		return Errorf(ErrInvalid, "area title is required")
 	}
 	if a.Type != "area" {
-		return fmt.Errorf("area type must be 'area', got '%s'", a.Type)
+		return Errorf(ErrInvalid, "area type must be 'area', got '%s'", a.Type)
 	}
 	return nil
 }
 
+// AddTag adds a tag if it doesn't already exist
+func (a *Area) AddTag(tag string) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	for _, t := range a.Tags {
+		if t == tag {
+			return
+		}
+	}
+	a.Tags = append(a.Tags, tag)
+	a.UpdateTimestamp()
+}
+
+// RemoveTag removes a tag if it exists
+func (a *Area) RemoveTag(tag string) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	for i, t := range a.Tags {
+		if t == tag {
+			a.Tags = append(a.Tags[:i], a.Tags[i+1:]...)
+			a.UpdateTimestamp()
+			return
+		}
+	}
+}
+
+// HasTag returns true if the area has the specified tag
+func (a *Area) HasTag(tag string) bool {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	for _, t := range a.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// NextReview returns when the area is next due for review, counting from
+// the last review or, if it was never reviewed, from its creation. It
+// returns nil if the area has no review cadence.
+func (a *Area) NextReview() *time.Time {
+	if a.Review == "" {
+		return nil
+	}
+	from := a.Created
+	if a.LastReviewed != nil {
+		from = *a.LastReviewed
+	}
+	next := a.Review.Next(from)
+	return &next
+}
+
+// MarkReviewed records that the area was reviewed now
+func (a *Area) MarkReviewed() {
+	now := time.Now().UTC()
+	a.LastReviewed = &now
+	a.UpdateTimestamp()
+}
+
 // DefaultAreas returns the suggested default areas for interactive init
 func DefaultAreas() []*Area {
 	work := NewArea("Work")
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// CommentsHeading starts the section of a task's body that holds its
+// comments
+const CommentsHeading = "## Comments"
+
+// commentTimeFormat is how comment times are written, in local time
+const commentTimeFormat = "2006-01-02 15:04"
+
+// Comment is a timestamped remark on a task, kept in its markdown body as
+// "- 2006-01-02 15:04 **author**: text", with further lines indented
+type Comment struct {
+	Time   time.Time `json:"time"`
+	Author string    `json:"author"` // cli, a source such as mcp, or a person
+	Text   string    `json:"text"`
+}
+
+var commentLine = regexp.MustCompile(`^- (\d{4}-\d{2}-\d{2} \d{2}:\d{2}) \*\*(.+?)\*\*: ?(.*)$`)
+
+// Markdown returns the comment as a list item
+func (c Comment) Markdown() string {
+	lines := strings.Split(strings.TrimSpace(c.Text), "\n")
+	for i := 1; i < len(lines); i++ {
+		lines[i] = "  " + strings.TrimRight(lines[i], " ")
+	}
+	return fmt.Sprintf("- %s **%s**: %s", c.Time.Local().Format(commentTimeFormat), c.Author, strings.Join(lines, "\n"))
+}
+
+// Comments returns the comments in the task's body, oldest first
+func (t *Task) Comments() []Comment {
+	lines := strings.Split(t.Content, "\n")
+	start, end := commentsSection(lines)
+	if start < 0 {
+		return nil
+	}
+
+	var comments []Comment
+	for _, line := range lines[start:end] {
+		if m := commentLine.FindStringSubmatch(line); m != nil {
+			at, err := time.ParseInLocation(commentTimeFormat, m[1], time.Local)
+			if err != nil {
+				continue
+			}
+			comments = append(comments, Comment{Time: at, Author: m[2], Text: m[3]})
+			continue
+		}
+		if len(comments) > 0 && strings.HasPrefix(line, "  ") {
+			last := &comments[len(comments)-1]
+			last.Text += "\n" + strings.TrimSpace(line)
+		}
+	}
+	return comments
+}
+
+// Description returns the task's body without its Comments section
+func (t *Task) Description() string {
+	lines := strings.Split(t.Content, "\n")
+	start, end := commentsSection(lines)
+	if start < 0 {
+		return t.Content
+	}
+	kept := append(lines[:start-1:start-1], lines[end:]...)
+	return strings.TrimSpace(strings.Join(kept, "\n"))
+}
+
+// AddComment appends a comment to the Comments section of the task's body,
+// starting the section if there is none
+func (t *Task) AddComment(author, text string, now time.Time) (Comment, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return Comment{}, Errorf(ErrInvalid, "comment is empty")
+	}
+	author = strings.TrimSpace(author)
+	if author == "" {
+		author = "cli"
+	}
+	c := Comment{Time: now.Truncate(time.Minute), Author: author, Text: text}
+
+	content := strings.TrimRight(t.Content, "\n ")
+	lines := strings.Split(content, "\n")
+	start, end := commentsSection(lines)
+	switch {
+	case start < 0 && content == "":
+		content = CommentsHeading + "\n\n" + c.Markdown()
+	case start < 0:
+		content += "\n\n" + CommentsHeading + "\n\n" + c.Markdown()
+	default:
+		// Add after the last non-empty line of the section
+		at := end
+		for at > start && strings.TrimSpace(lines[at-1]) == "" {
+			at--
+		}
+		if at == start {
+			lines = append(lines[:start], append([]string{"", c.Markdown()}, lines[start:]...)...)
+		} else {
+			lines = append(lines[:at], append([]string{c.Markdown()}, lines[at:]...)...)
+		}
+		content = strings.Join(lines, "\n")
+	}
+	t.Content = content
+	t.UpdateTimestamp()
+	return c, nil
+}
+
+// commentsSection returns the lines after the Comments heading up to the
+// next heading of the same or a higher level, or -1 if there is no heading
+func commentsSection(lines []string) (start, end int) {
+	start = -1
+	for i, line := range lines {
+		if start < 0 {
+			if strings.TrimSpace(line) == CommentsHeading {
+				start = i + 1
+			}
+			continue
+		}
+		if strings.HasPrefix(line, "# ") || strings.HasPrefix(line, "## ") {
+			return start, i
+		}
+	}
+	return start, len(lines)
+}
@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// Day returns midnight in loc of the calendar day t falls on in its own
+// location, so a date keeps its day when read in another timezone
+func Day(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// IsMidnight returns true if t is the start of a day in its own location,
+// as dates written without a time are
+func IsMidnight(t time.Time) bool {
+	h, m, s := t.Clock()
+	return h == 0 && m == 0 && s == 0 && t.Nanosecond() == 0
+}
+
+// DayOver returns true once now is past the end of day's calendar day,
+// taken in now's location
+func DayOver(day, now time.Time) bool {
+	return !now.Before(Day(day, now.Location()).AddDate(0, 0, 1))
+}
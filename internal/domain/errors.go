@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The kinds of error callers can act on. Errors of a kind match it with
+// errors.Is, keep their own message, and are reported with the kind's code
+// by the API, the CLI's exit status and MCP tools. Other errors are
+// internal.
+var (
+	// ErrNotFound is returned when no area, project, task, goal or note has
+	// an ID or slug
+	ErrNotFound = errors.New("not found")
+
+	// ErrInvalid is returned for values that can't be stored, such as a
+	// task without a title
+	ErrInvalid = errors.New("invalid")
+
+	// ErrAlreadyExists is returned when another item already has the slug
+	// an item would be stored under
+	ErrAlreadyExists = errors.New("already exists")
+
+	// ErrConflict is returned when an update carries the revision of a task
+	// or project that has changed since it was read, by another client or
+	// in an editor. Read it again and reapply the change.
+	ErrConflict = errors.New("changed since it was read")
+)
+
+// kinds maps each kind to its code
+var kinds = []struct {
+	err  error
+	code string
+}{
+	{ErrNotFound, "not_found"},
+	{ErrInvalid, "invalid"},
+	{ErrAlreadyExists, "already_exists"},
+	{ErrConflict, "conflict"},
+}
+
+// Errorf formats an error of a kind, one of the Err values above. The kind
+// isn't added to the message.
+func Errorf(kind error, format string, args ...any) error {
+	return &kindError{kind: kind, err: fmt.Errorf(format, args...)}
+}
+
+type kindError struct {
+	kind error
+	err  error
+}
+
+func (e *kindError) Error() string   { return e.err.Error() }
+func (e *kindError) Unwrap() []error { return []error{e.kind, e.err} }
+
+// Kind returns the kind of err, or nil for internal errors
+func Kind(err error) error {
+	for _, k := range kinds {
+		if errors.Is(err, k.err) {
+			return k.err
+		}
+	}
+	return nil
+}
+
+// Code returns the machine-readable code of err's kind: not_found, invalid,
+// already_exists or conflict, or empty for internal errors
+func Code(err error) string {
+	for _, k := range kinds {
+		if errors.Is(err, k.err) {
+			return k.code
+		}
+	}
+	return ""
+}
+
+// KindOf returns the kind with a code, or nil for an unknown code
+func KindOf(code string) error {
+	for _, k := range kinds {
+		if k.code == code {
+			return k.err
+		}
+	}
+	return nil
+}
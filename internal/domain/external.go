@@ -0,0 +1,28 @@
+package domain
+
+// ExternalRef links a task or project to the item it was imported from or
+// mirrors in another system, such as a Jira issue or a Things to-do.
+// Importers use it to find the same item again on later runs.
+type ExternalRef struct {
+	Source string `yaml:"source" json:"source"`               // system the item lives in, e.g. things or jira
+	ID     string `yaml:"id" json:"id"`                       // ID of the item in that system
+	URL    string `yaml:"url,omitempty" json:"url,omitempty"` // link to the item, if it has one
+}
+
+// Is returns true if the reference points at the given item
+func (r *ExternalRef) Is(source, id string) bool {
+	return r != nil && r.Source == source && r.ID == id
+}
+
+// Validate checks that the reference identifies an item
+func (r *ExternalRef) Validate() error {
+	if r.Source == "" || r.ID == "" {
+		return Errorf(ErrInvalid, "external reference needs a source and an ID")
+	}
+	return nil
+}
+
+// String formats the reference as source:id
+func (r *ExternalRef) String() string {
+	return r.Source + ":" + r.ID
+}
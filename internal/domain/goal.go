@@ -0,0 +1,185 @@
+package domain
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ihavespoons/reorg/internal/slug"
+)
+
+// GoalStatus represents the state of a goal
+type GoalStatus string
+
+const (
+	GoalStatusActive   GoalStatus = "active"
+	GoalStatusAchieved GoalStatus = "achieved"
+	GoalStatusDropped  GoalStatus = "dropped"
+)
+
+// Goal is an objective, usually for a quarter, that projects work towards.
+// Projects link to a goal with their goal_id.
+type Goal struct {
+	ID       string            `yaml:"id" json:"id"`
+	Title    string            `yaml:"title" json:"title"`
+	Type     string            `yaml:"type" json:"type"`
+	Status   GoalStatus        `yaml:"status" json:"status"`
+	Quarter  string            `yaml:"quarter,omitempty" json:"quarter,omitempty"` // e.g. 2025-Q1
+	DueDate  *time.Time        `yaml:"due_date,omitempty" json:"due_date,omitempty"`
+	Tags     []string          `yaml:"tags,omitempty" json:"tags,omitempty"`
+	Metadata map[string]string `yaml:"metadata,omitempty" json:"metadata,omitempty"`
+	Timestamps
+
+	// Content holds the markdown body (not stored in frontmatter)
+	Content string `yaml:"-" json:"content,omitempty"`
+
+	// Progress is filled in by the service layer when the goal is read
+	Progress *GoalProgress `yaml:"-" json:"progress,omitempty"`
+}
+
+// GoalProgress is the rollup of a goal's linked projects. It is derived by
+// the service layer and never written to disk.
+type GoalProgress struct {
+	Projects          int `json:"projects"`
+	CompletedProjects int `json:"completed_projects"`
+	TotalTasks        int `json:"total_tasks"`
+	CompletedTasks    int `json:"completed_tasks"`
+	PercentComplete   int `json:"percent_complete"`
+}
+
+// NewGoal creates a new Goal with generated ID and timestamps
+func NewGoal(title string) *Goal {
+	g := &Goal{
+		ID:       fmt.Sprintf("goal-%s", uuid.New().String()[:8]),
+		Title:    title,
+		Type:     "goal",
+		Status:   GoalStatusActive,
+		Tags:     []string{},
+		Metadata: make(map[string]string),
+	}
+	g.SetCreated()
+	return g
+}
+
+// Clone returns a copy of the goal that shares no slices, maps or pointers
+// with it
+func (g *Goal) Clone() *Goal {
+	c := *g
+	c.DueDate = clonePtr(g.DueDate)
+	c.Tags = slices.Clone(g.Tags)
+	c.Metadata = maps.Clone(g.Metadata)
+	c.Progress = clonePtr(g.Progress)
+	return &c
+}
+
+// LocalizeDates moves the goal's due date, a calendar day, into loc
+func (g *Goal) LocalizeDates(loc *time.Location) {
+	if g.DueDate != nil {
+		due := Day(*g.DueDate, loc)
+		g.DueDate = &due
+	}
+}
+
+// Slug returns a URL-safe identifier derived from the title
+func (g *Goal) Slug() string {
+	return slug.Make(g.Title)
+}
+
+// Validate checks if the goal has all required fields
+func (g *Goal) Validate() error {
+	if g.ID == "" {
+		return Errorf(ErrInvalid, "goal ID is required")
+	}
+	if g.Title == "" {
+		return Errorf(ErrInvalid, "goal title is required")
+	}
+	if g.Type != "goal" {
+		return Errorf(ErrInvalid, "goal type must be 'goal', got '%s'", g.Type)
+	}
+	return nil
+}
+
+// IsActive returns true if the goal is still being worked towards
+func (g *Goal) IsActive() bool {
+	return g.Status == GoalStatusActive
+}
+
+// Achieve marks the goal as achieved
+func (g *Goal) Achieve() {
+	g.Status = GoalStatusAchieved
+	g.UpdateTimestamp()
+}
+
+// Drop marks the goal as dropped
+func (g *Goal) Drop() {
+	g.Status = GoalStatusDropped
+	g.UpdateTimestamp()
+}
+
+// AddTag adds a tag if it doesn't already exist
+func (g *Goal) AddTag(tag string) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if !slices.Contains(g.Tags, tag) {
+		g.Tags = append(g.Tags, tag)
+		g.UpdateTimestamp()
+	}
+}
+
+// RemoveTag removes a tag if present
+func (g *Goal) RemoveTag(tag string) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	for i, t := range g.Tags {
+		if t == tag {
+			g.Tags = append(g.Tags[:i], g.Tags[i+1:]...)
+			g.UpdateTimestamp()
+			return
+		}
+	}
+}
+
+// ParseGoalStatus parses a goal status
+func ParseGoalStatus(s string) (GoalStatus, error) {
+	switch status := GoalStatus(strings.ToLower(strings.TrimSpace(s))); status {
+	case GoalStatusActive, GoalStatusAchieved, GoalStatusDropped:
+		return status, nil
+	default:
+		return "", Errorf(ErrInvalid, "invalid goal status %q (use active, achieved or dropped)", s)
+	}
+}
+
+var quarterPattern = regexp.MustCompile(`(?i)^(?:(\d{4})[- ]?q([1-4])|q([1-4])[- ]?(\d{4}))$`)
+
+// ParseQuarter parses a quarter such as 2025-Q1, 2025q1 or Q1-2025 into
+// the 2025-Q1 form
+func ParseQuarter(s string) (string, error) {
+	m := quarterPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return "", Errorf(ErrInvalid, "invalid quarter %q (use e.g. 2025-Q1)", s)
+	}
+	if m[1] != "" {
+		return m[1] + "-Q" + m[2], nil
+	}
+	return m[4] + "-Q" + m[3], nil
+}
+
+// QuarterOf returns the quarter t falls in, in the 2025-Q1 form
+func QuarterOf(t time.Time) string {
+	return fmt.Sprintf("%d-Q%d", t.Year(), (int(t.Month())-1)/3+1)
+}
+
+// QuarterEnd returns the last day of a quarter in the 2025-Q1 form
+func QuarterEnd(quarter string, loc *time.Location) (time.Time, error) {
+	quarter, err := ParseQuarter(quarter)
+	if err != nil {
+		return time.Time{}, err
+	}
+	year, _ := strconv.Atoi(quarter[:4])
+	q := int(quarter[6] - '0')
+	return time.Date(year, time.Month(q*3+1), 1, 0, 0, 0, 0, loc).AddDate(0, 0, -1), nil
+}
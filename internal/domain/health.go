@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// StalledAfterDays is how many days a project can go without activity
+// before its health is reported as stalled
+const StalledAfterDays = 14
+
+// HealthStatus summarises how a project is doing
+type HealthStatus string
+
+const (
+	HealthOnTrack HealthStatus = "on_track"
+	HealthAtRisk  HealthStatus = "at_risk" // has overdue tasks or is past its due date
+	HealthStalled HealthStatus = "stalled" // no activity for StalledAfterDays
+	HealthDone    HealthStatus = "done"    // completed or archived
+	HealthSomeday HealthStatus = "someday" // parked in the someday/maybe backlog
+)
+
+// ProjectHealth is the progress rollup of a project. It is derived from
+// the project's tasks by the service layer and never written to disk.
+type ProjectHealth struct {
+	TotalTasks        int          `json:"total_tasks"`
+	CompletedTasks    int          `json:"completed_tasks"`
+	OverdueTasks      int          `json:"overdue_tasks"`
+	PercentComplete   int          `json:"percent_complete"`
+	LastActivity      time.Time    `json:"last_activity"`
+	DaysSinceActivity int          `json:"days_since_activity"`
+	Status            HealthStatus `json:"status"`
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ValidMetaKey returns true if a metadata key can be set by users and
+// referred to in queries as meta.<key>
+func ValidMetaKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, r := range key {
+		if !IsMetaKeyRune(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsMetaKeyRune returns true for characters allowed in metadata keys
+func IsMetaKeyRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' || r == '.'
+}
+
+// ParseMetadata parses key=value pairs, as given to --meta flags
+func ParseMetadata(pairs []string) (map[string]string, error) {
+	meta := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !ok {
+			return nil, Errorf(ErrInvalid, "invalid metadata %q (use key=value)", pair)
+		}
+		if !ValidMetaKey(key) {
+			return nil, Errorf(ErrInvalid, "invalid metadata key %q (use letters, digits, _, - and .)", key)
+		}
+		meta[key] = strings.TrimSpace(value)
+	}
+	return meta, nil
+}
+
+// SetMetadata copies values into an entity's metadata, creating the map if
+// needed. Empty values remove the key.
+func SetMetadata(meta *map[string]string, values map[string]string) {
+	for key, value := range values {
+		if value == "" {
+			delete(*meta, key)
+			continue
+		}
+		if *meta == nil {
+			*meta = make(map[string]string)
+		}
+		(*meta)[key] = value
+	}
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Note is a timestamped markdown snippet attached to a project or task
+type Note struct {
+	ID       string `yaml:"id" json:"id"`
+	Type     string `yaml:"type" json:"type"`
+	ParentID string `yaml:"parent_id" json:"parent_id"` // ID of the project or task
+	Timestamps
+
+	// Content holds the markdown body (not stored in frontmatter)
+	Content string `yaml:"-" json:"content,omitempty"`
+}
+
+// NewNote creates a new Note with generated ID and timestamps
+func NewNote(parentID, content string) *Note {
+	n := &Note{
+		ID:       fmt.Sprintf("note-%s", uuid.New().String()[:8]),
+		Type:     "note",
+		ParentID: parentID,
+		Content:  strings.TrimSpace(content),
+	}
+	n.SetCreated()
+	return n
+}
+
+// Validate checks if the note has all required fields
+func (n *Note) Validate() error {
+	if n.ID == "" {
+		return Errorf(ErrInvalid, "note ID is required")
+	}
+	if n.Type != "note" {
+		return Errorf(ErrInvalid, "note type must be 'note', got '%s'", n.Type)
+	}
+	if n.ParentID == "" {
+		return Errorf(ErrInvalid, "note parent_id is required")
+	}
+	if strings.TrimSpace(n.Content) == "" {
+		return Errorf(ErrInvalid, "note is empty")
+	}
+	return nil
+}
+
+// Summary returns the first line of the note, shortened to 60 characters
+func (n *Note) Summary() string {
+	line, _, _ := strings.Cut(strings.TrimSpace(n.Content), "\n")
+	if r := []rune(line); len(r) > 60 {
+		line = string(r[:57]) + "..."
+	}
+	return line
+}
+
+// Matches returns true if the note contains text, ignoring case
+func (n *Note) Matches(text string) bool {
+	return strings.Contains(strings.ToLower(n.Content), strings.ToLower(text))
+}
@@ -2,27 +2,43 @@ package domain
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/ihavespoons/reorg/internal/slug"
 )
 
 // Project represents a collection of related tasks within an area
 type Project struct {
-	ID       string            `yaml:"id"`
-	Title    string            `yaml:"title"`
-	Type     string            `yaml:"type"`
-	AreaID   string            `yaml:"area_id"`
-	Status   ProjectStatus     `yaml:"status"`
-	DueDate  *time.Time        `yaml:"due_date,omitempty"`
-	Priority Priority          `yaml:"priority"`
-	Tags     []string          `yaml:"tags,omitempty"`
-	Metadata map[string]string `yaml:"metadata,omitempty"`
+	ID              string            `yaml:"id" json:"id"`
+	Title           string            `yaml:"title" json:"title"`
+	Type            string            `yaml:"type" json:"type"`
+	AreaID          string            `yaml:"area_id" json:"area_id"`
+	ParentProjectID string            `yaml:"parent_project_id,omitempty" json:"parent_project_id,omitempty"` // set for sub-projects
+	Status          ProjectStatus     `yaml:"status" json:"status"`
+	DueDate         *time.Time        `yaml:"due_date,omitempty" json:"due_date,omitempty"`
+	Priority        Priority          `yaml:"priority" json:"priority"`
+	Tags            []string          `yaml:"tags,omitempty" json:"tags,omitempty"`
+	Notify          string            `yaml:"notify,omitempty" json:"notify,omitempty"` // desktop, none, or a webhook URL
+	GoalID          string            `yaml:"goal_id,omitempty" json:"goal_id,omitempty"`
+	ExternalRef     *ExternalRef      `yaml:"external_ref,omitempty" json:"external_ref,omitempty"`
+	Metadata        map[string]string `yaml:"metadata,omitempty" json:"metadata,omitempty"`
 	Timestamps
 
 	// Content holds the markdown body (not stored in frontmatter)
-	Content string `yaml:"-"`
+	Content string `yaml:"-" json:"content,omitempty"`
+
+	// Health is filled in by the service layer when the project is read
+	Health *ProjectHealth `yaml:"-" json:"health,omitempty"`
+
+	// Revision identifies the stored version the project was read from.
+	// Updates that carry one fail with ErrConflict if the project has
+	// changed since.
+	Revision string `yaml:"-" json:"-"`
 }
 
 // NewProject creates a new Project with generated ID and timestamps
@@ -41,36 +57,48 @@ func NewProject(title, areaID string) *Project {
 	return p
 }
 
+// Clone returns a copy of the project that shares no slices, maps or
+// pointers with it
+func (p *Project) Clone() *Project {
+	c := *p
+	c.DueDate = clonePtr(p.DueDate)
+	c.Tags = slices.Clone(p.Tags)
+	c.ExternalRef = clonePtr(p.ExternalRef)
+	c.Metadata = maps.Clone(p.Metadata)
+	c.Health = clonePtr(p.Health)
+	return &c
+}
+
 // Slug returns a URL-safe identifier derived from the title
 func (p *Project) Slug() string {
-	slug := strings.ToLower(p.Title)
-	slug = strings.ReplaceAll(slug, " ", "-")
-	var result strings.Builder
-	for _, r := range slug {
-		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' {
-			result.WriteRune(r)
-		}
-	}
-	return result.String()
+	return slug.Make(p.Title)
 }
 
 // Validate checks if the project has all required fields
 func (p *Project) Validate() error {
 	if p.ID == "" {
-		return fmt.Errorf("project ID is required")
+		return Errorf(ErrInvalid, "project ID is required")
 	}
 	if p.Title == "" {
-		return fmt.Errorf("project title is required")
+		return Errorf(ErrInvalid, "project title is required")
 	}
 	if p.Type != "project" {
-		return fmt.Errorf("project type must be 'project', got '%s'", p.Type)
+		return Errorf(ErrInvalid, "project type must be 'project', got '%s'", p.Type)
 	}
 	if p.AreaID == "" {
-		return fmt.Errorf("project area_id is required")
+		return Errorf(ErrInvalid, "project area_id is required")
+	}
+	if p.ParentProjectID == p.ID {
+		return Errorf(ErrInvalid, "project can't be its own parent")
 	}
 	return nil
 }
 
+// IsSubProject returns true if the project is nested in another one
+func (p *Project) IsSubProject() bool {
+	return p.ParentProjectID != ""
+}
+
 // IsActive returns true if the project is in an active state
 func (p *Project) IsActive() bool {
 	return p.Status == ProjectStatusActive
@@ -93,6 +121,37 @@ func (p *Project) Archive() {
 	p.UpdateTimestamp()
 }
 
+// IsOverdueAt returns true if the project is still open at the end of its
+// due date's day, in now's timezone
+func (p *Project) IsOverdueAt(now time.Time) bool {
+	return p.DueDate != nil && !p.IsComplete() && p.Status != ProjectStatusArchived && DayOver(*p.DueDate, now)
+}
+
+// LocalizeDates moves the project's due date, a calendar day, into loc
+func (p *Project) LocalizeDates(loc *time.Location) {
+	if p.DueDate != nil {
+		due := Day(*p.DueDate, loc)
+		p.DueDate = &due
+	}
+}
+
+// IsSomeday returns true if the project is in the someday/maybe backlog
+func (p *Project) IsSomeday() bool {
+	return p.Status == ProjectStatusSomeday
+}
+
+// Defer moves the project to the someday/maybe backlog
+func (p *Project) Defer() {
+	p.Status = ProjectStatusSomeday
+	p.UpdateTimestamp()
+}
+
+// Revive makes a someday project active again
+func (p *Project) Revive() {
+	p.Status = ProjectStatusActive
+	p.UpdateTimestamp()
+}
+
 // AddTag adds a tag if it doesn't already exist
 func (p *Project) AddTag(tag string) {
 	tag = strings.ToLower(strings.TrimSpace(tag))
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"strconv"
+	"time"
+)
+
+// Metadata keys recording where an entity came from
+const (
+	MetaSource       = "source"        // importer that created the entity, e.g. apple_notes
+	MetaSourceRef    = "source_ref"    // ID, path or link of the original item
+	MetaSourceTitle  = "source_title"  // title of the original item
+	MetaImportedAt   = "imported_at"   // RFC 3339 time of the import
+	MetaAIProvider   = "ai_provider"   // LLM provider that categorized the item
+	MetaAIConfidence = "ai_confidence" // area confidence from 0-1
+	MetaAISummary    = "ai_summary"    // summary produced at categorization
+)
+
+// Provenance describes where an entity came from
+type Provenance struct {
+	Source       string
+	SourceRef    string
+	SourceTitle  string
+	ImportedAt   *time.Time
+	AIProvider   string
+	AIConfidence *float64
+	AISummary    string
+}
+
+// ProvenanceFromMetadata reads provenance from an entity's metadata
+func ProvenanceFromMetadata(meta map[string]string) Provenance {
+	p := Provenance{
+		Source:      meta[MetaSource],
+		SourceRef:   meta[MetaSourceRef],
+		SourceTitle: meta[MetaSourceTitle],
+		AIProvider:  meta[MetaAIProvider],
+		AISummary:   meta[MetaAISummary],
+	}
+	if t, err := time.Parse(time.RFC3339, meta[MetaImportedAt]); err == nil {
+		p.ImportedAt = &t
+	}
+	if c, err := strconv.ParseFloat(meta[MetaAIConfidence], 64); err == nil {
+		p.AIConfidence = &c
+	}
+	return p
+}
+
+// IsEmpty returns true if no provenance was recorded
+func (p Provenance) IsEmpty() bool {
+	return p.Source == "" && p.SourceRef == "" && p.AIProvider == ""
+}
+
+// WriteTo stores the provenance in an entity's metadata
+func (p Provenance) WriteTo(meta map[string]string) {
+	set := func(key, value string) {
+		if value != "" {
+			meta[key] = value
+		}
+	}
+	set(MetaSource, p.Source)
+	set(MetaSourceRef, p.SourceRef)
+	set(MetaSourceTitle, p.SourceTitle)
+	set(MetaAIProvider, p.AIProvider)
+	set(MetaAISummary, p.AISummary)
+	if p.ImportedAt != nil {
+		meta[MetaImportedAt] = p.ImportedAt.Format(time.RFC3339)
+	}
+	if p.AIConfidence != nil {
+		meta[MetaAIConfidence] = strconv.FormatFloat(*p.AIConfidence, 'f', 2, 64)
+	}
+}
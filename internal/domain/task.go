@@ -2,35 +2,56 @@ package domain
 
 import (
 	"fmt"
+	"maps"
+	"net/url"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/ihavespoons/reorg/internal/slug"
 )
 
 // Task represents a single actionable item within a project
 type Task struct {
-	ID           string            `yaml:"id"`
-	Title        string            `yaml:"title"`
-	Type         string            `yaml:"type"`
-	ProjectID    string            `yaml:"project_id"`
-	AreaID       string            `yaml:"area_id"`
-	Status       TaskStatus        `yaml:"status"`
-	DueDate      *time.Time        `yaml:"due_date,omitempty"`
-	Priority     Priority          `yaml:"priority"`
-	Assignee     string            `yaml:"assignee,omitempty"`
-	Tags         []string          `yaml:"tags,omitempty"`
-	Dependencies []string          `yaml:"dependencies,omitempty"`
-	TimeEstimate string            `yaml:"time_estimate,omitempty"`
-	TimeSpent    string            `yaml:"time_spent,omitempty"`
-	Recurrence   *string           `yaml:"recurrence,omitempty"`
-	Metadata     map[string]string `yaml:"metadata,omitempty"`
+	ID            string            `yaml:"id" json:"id"`
+	Title         string            `yaml:"title" json:"title"`
+	Type          string            `yaml:"type" json:"type"`
+	ProjectID     string            `yaml:"project_id" json:"project_id"`
+	AreaID        string            `yaml:"area_id" json:"area_id"`
+	Status        TaskStatus        `yaml:"status" json:"status"`
+	DueDate       *time.Time        `yaml:"due_date,omitempty" json:"due_date,omitempty"`             // the deadline
+	AllDay        bool              `yaml:"all_day,omitempty" json:"all_day,omitempty"`               // the due date is a whole day, not a moment
+	ScheduledDate *time.Time        `yaml:"scheduled_date,omitempty" json:"scheduled_date,omitempty"` // the day to start on
+	Priority      Priority          `yaml:"priority" json:"priority"`
+	Assignee      string            `yaml:"assignee,omitempty" json:"assignee,omitempty"`
+	Context       string            `yaml:"context,omitempty" json:"context,omitempty"` // where or how it can be done, e.g. errands
+	Tags          []string          `yaml:"tags,omitempty" json:"tags,omitempty"`
+	Dependencies  []string          `yaml:"dependencies,omitempty" json:"dependencies,omitempty"`
+	Effort        Effort            `yaml:"effort,omitempty" json:"effort,omitempty"`
+	TimeEstimate  string            `yaml:"time_estimate,omitempty" json:"time_estimate,omitempty"`
+	TimeSpent     string            `yaml:"time_spent,omitempty" json:"time_spent,omitempty"`
+	TimeLog       []TimeSession     `yaml:"time_log,omitempty" json:"time_log,omitempty"`
+	Recurrence    *string           `yaml:"recurrence,omitempty" json:"recurrence,omitempty"`
+	Attachments   []string          `yaml:"attachments,omitempty" json:"attachments,omitempty"` // URLs, or files under the project's assets/
+	ExternalRef   *ExternalRef      `yaml:"external_ref,omitempty" json:"external_ref,omitempty"`
+	Metadata      map[string]string `yaml:"metadata,omitempty" json:"metadata,omitempty"`
 	Timestamps
 
 	// Content holds the markdown body (not stored in frontmatter)
-	Content string `yaml:"-"`
+	Content string `yaml:"-" json:"content,omitempty"`
+
+	// Revision identifies the stored version the task was read from.
+	// Updates that carry one fail with ErrConflict if the task has changed
+	// since.
+	Revision string `yaml:"-" json:"-"`
 }
 
+// MetaWaitingSince is the metadata key holding when a task was delegated,
+// as RFC 3339
+const MetaWaitingSince = "waiting_since"
+
 // NewTask creates a new Task with generated ID and timestamps
 func NewTask(title, projectID, areaID string) *Task {
 	t := &Task{
@@ -49,35 +70,46 @@ func NewTask(title, projectID, areaID string) *Task {
 	return t
 }
 
+// Clone returns a copy of the task that shares no slices, maps or pointers
+// with it
+func (t *Task) Clone() *Task {
+	c := *t
+	c.DueDate = clonePtr(t.DueDate)
+	c.ScheduledDate = clonePtr(t.ScheduledDate)
+	c.Tags = slices.Clone(t.Tags)
+	c.Dependencies = slices.Clone(t.Dependencies)
+	c.TimeLog = slices.Clone(t.TimeLog)
+	for i := range c.TimeLog {
+		c.TimeLog[i].End = clonePtr(c.TimeLog[i].End)
+	}
+	c.Recurrence = clonePtr(t.Recurrence)
+	c.Attachments = slices.Clone(t.Attachments)
+	c.ExternalRef = clonePtr(t.ExternalRef)
+	c.Metadata = maps.Clone(t.Metadata)
+	return &c
+}
+
 // Slug returns a URL-safe identifier derived from the title
 func (t *Task) Slug() string {
-	slug := strings.ToLower(t.Title)
-	slug = strings.ReplaceAll(slug, " ", "-")
-	var result strings.Builder
-	for _, r := range slug {
-		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' {
-			result.WriteRune(r)
-		}
-	}
-	return result.String()
+	return slug.Make(t.Title)
 }
 
 // Validate checks if the task has all required fields
 func (t *Task) Validate() error {
 	if t.ID == "" {
-		return fmt.Errorf("task ID is required")
+		return Errorf(ErrInvalid, "task ID is required")
 	}
 	if t.Title == "" {
-		return fmt.Errorf("task title is required")
+		return Errorf(ErrInvalid, "task title is required")
 	}
 	if t.Type != "task" {
-		return fmt.Errorf("task type must be 'task', got '%s'", t.Type)
+		return Errorf(ErrInvalid, "task type must be 'task', got '%s'", t.Type)
 	}
 	if t.ProjectID == "" {
-		return fmt.Errorf("task project_id is required")
+		return Errorf(ErrInvalid, "task project_id is required")
 	}
 	if t.AreaID == "" {
-		return fmt.Errorf("task area_id is required")
+		return Errorf(ErrInvalid, "task area_id is required")
 	}
 	return nil
 }
@@ -121,6 +153,39 @@ func (t *Task) Cancel() {
 	t.UpdateTimestamp()
 }
 
+// Delegate hands the task to person and marks it as waiting on them from
+// now
+func (t *Task) Delegate(person string, now time.Time) {
+	t.Assignee = person
+	t.Status = TaskStatusWaiting
+	SetMetadata(&t.Metadata, map[string]string{MetaWaitingSince: now.UTC().Format(time.RFC3339)})
+	t.UpdateTimestamp()
+}
+
+// Reclaim takes a delegated task back, setting it to pending
+func (t *Task) Reclaim() {
+	t.Assignee = ""
+	if t.Status == TaskStatusWaiting {
+		t.Status = TaskStatusPending
+	}
+	SetMetadata(&t.Metadata, map[string]string{MetaWaitingSince: ""})
+	t.UpdateTimestamp()
+}
+
+// WaitingSince returns when the task was delegated, or nil if it isn't
+// waiting on anyone. Tasks set to waiting by hand count from their last
+// update.
+func (t *Task) WaitingSince() *time.Time {
+	if t.Status != TaskStatusWaiting {
+		return nil
+	}
+	if since, err := time.Parse(time.RFC3339, t.Metadata[MetaWaitingSince]); err == nil {
+		return &since
+	}
+	updated := t.Updated
+	return &updated
+}
+
 // Reopen sets the task back to pending
 func (t *Task) Reopen() {
 	t.Status = TaskStatusPending
@@ -139,6 +204,49 @@ func (t *Task) AddTag(tag string) {
 	t.UpdateTimestamp()
 }
 
+// MergeFrom fills in details from a duplicate of this task: its tags, and
+// its due and scheduled dates, external reference, context and metadata
+// keys where this task has none
+func (t *Task) MergeFrom(other *Task) {
+	for _, tag := range other.Tags {
+		t.AddTag(tag)
+	}
+	if t.DueDate == nil && other.DueDate != nil {
+		due := *other.DueDate
+		t.DueDate = &due
+		t.AllDay = other.AllDay
+	}
+	if t.ScheduledDate == nil {
+		t.ScheduledDate = clonePtr(other.ScheduledDate)
+	}
+	if t.ExternalRef == nil {
+		t.ExternalRef = other.ExternalRef
+	}
+	if t.Context == "" {
+		t.Context = other.Context
+	}
+	for key, value := range other.Metadata {
+		if _, ok := t.Metadata[key]; !ok {
+			SetMetadata(&t.Metadata, map[string]string{key: value})
+		}
+	}
+	t.UpdateTimestamp()
+}
+
+// SetContext sets the task's context, given with or without its leading @.
+// An empty context clears it.
+func (t *Task) SetContext(context string) {
+	t.Context = NormalizeContext(context)
+	t.UpdateTimestamp()
+}
+
+// NormalizeContext lowercases a context, drops its leading @ and joins
+// words with dashes, so @Deep Work and deep-work are the same context
+func NormalizeContext(context string) string {
+	context = strings.TrimPrefix(strings.TrimSpace(context), "@")
+	return strings.Join(strings.Fields(strings.ToLower(context)), "-")
+}
+
 // RemoveTag removes a tag if it exists
 func (t *Task) RemoveTag(tag string) {
 	tag = strings.ToLower(strings.TrimSpace(tag))
@@ -162,6 +270,25 @@ func (t *Task) HasTag(tag string) bool {
 	return false
 }
 
+// AddAttachment adds a link or asset path if it isn't attached already.
+// It returns false if it was.
+func (t *Task) AddAttachment(ref string) bool {
+	for _, existing := range t.Attachments {
+		if existing == ref {
+			return false
+		}
+	}
+	t.Attachments = append(t.Attachments, ref)
+	t.UpdateTimestamp()
+	return true
+}
+
+// IsLink returns true if an attachment is a URL rather than a file
+func IsLink(attachment string) bool {
+	u, err := url.Parse(attachment)
+	return err == nil && u.Scheme != "" && (u.Host != "" || u.Scheme == "mailto")
+}
+
 // AddDependency adds a task ID as a dependency
 func (t *Task) AddDependency(taskID string) {
 	for _, dep := range t.Dependencies {
@@ -194,12 +321,72 @@ func (t *Task) HasDependency(taskID string) bool {
 	return false
 }
 
+// BlockedReason explains why the task can't proceed, or returns "" if it
+// isn't blocked. lookup resolves dependency IDs and returns nil for unknown tasks.
+func (t *Task) BlockedReason(lookup func(id string) *Task) string {
+	var waiting []string
+	for _, id := range t.Dependencies {
+		dep := lookup(id)
+		switch {
+		case dep == nil:
+			waiting = append(waiting, id+" (missing)")
+		case !dep.IsComplete() && dep.Status != TaskStatusCancelled:
+			waiting = append(waiting, fmt.Sprintf("%s (%s)", dep.Title, dep.Status))
+		}
+	}
+
+	if len(waiting) > 0 {
+		return "Waiting on " + strings.Join(waiting, ", ")
+	}
+	if t.IsBlocked() {
+		return "Marked as blocked"
+	}
+	return ""
+}
+
 // IsOverdue returns true if the task has a due date that has passed
 func (t *Task) IsOverdue() bool {
+	return t.IsOverdueAt(time.Now())
+}
+
+// IsOverdueAt returns true if the task's due date has passed at now. An
+// all-day due date passes at the end of its day in now's timezone, a timed
+// one at its moment.
+func (t *Task) IsOverdueAt(now time.Time) bool {
 	if t.DueDate == nil || t.IsComplete() {
 		return false
 	}
-	return time.Now().After(*t.DueDate)
+	if t.AllDay {
+		return DayOver(*t.DueDate, now)
+	}
+	return now.After(*t.DueDate)
+}
+
+// LocalizeDates moves the task's dates into loc. All-day due dates and
+// scheduled dates keep their calendar day; timed due dates keep their
+// moment.
+func (t *Task) LocalizeDates(loc *time.Location) {
+	if t.DueDate != nil {
+		due := t.DueDate.In(loc)
+		if t.AllDay {
+			due = Day(*t.DueDate, loc)
+		}
+		t.DueDate = &due
+	}
+	if t.ScheduledDate != nil {
+		scheduled := Day(*t.ScheduledDate, loc)
+		t.ScheduledDate = &scheduled
+	}
+}
+
+// IsScheduledLater returns true if the task is scheduled to start on a day
+// after now's, so isn't to be worked on yet
+func (t *Task) IsScheduledLater(now time.Time) bool {
+	if t.ScheduledDate == nil {
+		return false
+	}
+	tomorrow := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location())
+	return !t.ScheduledDate.Before(tomorrow)
 }
 
 // DaysUntilDue returns the number of days until the due date
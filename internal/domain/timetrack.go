@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeSession is a period of work recorded by the task timer
+type TimeSession struct {
+	Start time.Time  `yaml:"start" json:"start"`
+	End   *time.Time `yaml:"end,omitempty" json:"end,omitempty"`
+}
+
+// Duration returns the length of the session, measured up to now if it is
+// still running
+func (s TimeSession) Duration(now time.Time) time.Duration {
+	if s.End != nil {
+		return s.End.Sub(s.Start)
+	}
+	return now.Sub(s.Start)
+}
+
+// ActiveSession returns the running timer session, or nil
+func (t *Task) ActiveSession() *TimeSession {
+	for i := range t.TimeLog {
+		if t.TimeLog[i].End == nil {
+			return &t.TimeLog[i]
+		}
+	}
+	return nil
+}
+
+// StartTimer begins a new time tracking session
+func (t *Task) StartTimer(now time.Time) error {
+	if t.ActiveSession() != nil {
+		return fmt.Errorf("timer already running for task: %s", t.Title)
+	}
+	t.TimeLog = append(t.TimeLog, TimeSession{Start: now.UTC()})
+	t.UpdateTimestamp()
+	return nil
+}
+
+// StopTimer ends the running session and adds it to TimeSpent
+func (t *Task) StopTimer(now time.Time) (time.Duration, error) {
+	session := t.ActiveSession()
+	if session == nil {
+		return 0, fmt.Errorf("no timer running for task: %s", t.Title)
+	}
+
+	end := now.UTC()
+	session.End = &end
+	elapsed := session.Duration(now)
+
+	spent, _ := ParseTimeSpec(t.TimeSpent)
+	t.TimeSpent = FormatTimeSpec(spent + elapsed)
+	t.UpdateTimestamp()
+	return elapsed, nil
+}
+
+// Spent returns the recorded time spent, including a running session
+func (t *Task) Spent(now time.Time) time.Duration {
+	spent, _ := ParseTimeSpec(t.TimeSpent)
+	if session := t.ActiveSession(); session != nil {
+		spent += session.Duration(now)
+	}
+	return spent
+}
+
+// Estimate returns the parsed time estimate, if one is set
+func (t *Task) Estimate() (time.Duration, bool) {
+	estimate, err := ParseTimeSpec(t.TimeEstimate)
+	return estimate, err == nil && estimate > 0
+}
+
+// IsOverEstimate returns true if more time was spent than estimated
+func (t *Task) IsOverEstimate(now time.Time) bool {
+	estimate, ok := t.Estimate()
+	return ok && t.Spent(now) > estimate
+}
+
+// ParseTimeSpec parses durations such as 1h30m, 45m or 2h. A bare number is
+// taken as minutes.
+func ParseTimeSpec(s string) (time.Duration, error) {
+	s = strings.TrimSpace(strings.ReplaceAll(s, " ", ""))
+	if s == "" {
+		return 0, nil
+	}
+	if minutes, err := strconv.Atoi(s); err == nil {
+		return time.Duration(minutes) * time.Minute, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, Errorf(ErrInvalid, "invalid duration: %s (use e.g. 45m, 2h, 1h30m)", s)
+	}
+	return d, nil
+}
+
+// FormatTimeSpec formats a duration to the minute, e.g. 1h30m or 45m
+func FormatTimeSpec(d time.Duration) string {
+	d = d.Round(time.Minute)
+	hours := int(d / time.Hour)
+	minutes := int((d % time.Hour) / time.Minute)
+
+	switch {
+	case hours == 0:
+		return fmt.Sprintf("%dm", minutes)
+	case minutes == 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dh%dm", hours, minutes)
+	}
+}
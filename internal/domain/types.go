@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // Priority represents the urgency level of a project or task
 type Priority string
@@ -12,6 +15,122 @@ const (
 	PriorityUrgent Priority = "urgent"
 )
 
+// Rank returns the priority's position in the order low < medium < high < urgent.
+// Unknown priorities rank as 0.
+func (p Priority) Rank() int {
+	switch p {
+	case PriorityLow:
+		return 1
+	case PriorityMedium:
+		return 2
+	case PriorityHigh:
+		return 3
+	case PriorityUrgent:
+		return 4
+	default:
+		return 0
+	}
+}
+
+// Effort is a rough size for a task, for when a time estimate would be
+// too precise
+type Effort string
+
+const (
+	EffortSmall  Effort = "small"
+	EffortMedium Effort = "medium"
+	EffortLarge  Effort = "large"
+)
+
+// ParseEffort parses an effort. An empty string or "none" means no effort
+// is set.
+func ParseEffort(s string) (Effort, error) {
+	switch e := Effort(strings.ToLower(strings.TrimSpace(s))); e {
+	case "", "none":
+		return "", nil
+	case EffortSmall, EffortMedium, EffortLarge:
+		return e, nil
+	case "s":
+		return EffortSmall, nil
+	case "m":
+		return EffortMedium, nil
+	case "l":
+		return EffortLarge, nil
+	default:
+		return "", Errorf(ErrInvalid, "invalid effort %q (use small, medium, large or none)", s)
+	}
+}
+
+// Rank returns the effort's position in the order small < medium < large.
+// An unset effort ranks as 0.
+func (e Effort) Rank() int {
+	switch e {
+	case EffortSmall:
+		return 1
+	case EffortMedium:
+		return 2
+	case EffortLarge:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Typical returns roughly how long a task of this effort takes, or 0 if
+// the effort is unset
+func (e Effort) Typical() time.Duration {
+	switch e {
+	case EffortSmall:
+		return 30 * time.Minute
+	case EffortMedium:
+		return 2 * time.Hour
+	case EffortLarge:
+		return 4 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// ReviewCadence is how often an area is reviewed
+type ReviewCadence string
+
+const (
+	ReviewWeekly    ReviewCadence = "weekly"
+	ReviewBiweekly  ReviewCadence = "biweekly"
+	ReviewMonthly   ReviewCadence = "monthly"
+	ReviewQuarterly ReviewCadence = "quarterly"
+	ReviewYearly    ReviewCadence = "yearly"
+)
+
+// ParseReviewCadence parses a review cadence. An empty string or "none"
+// means no regular review.
+func ParseReviewCadence(s string) (ReviewCadence, error) {
+	switch c := ReviewCadence(strings.ToLower(strings.TrimSpace(s))); c {
+	case "", "none":
+		return "", nil
+	case ReviewWeekly, ReviewBiweekly, ReviewMonthly, ReviewQuarterly, ReviewYearly:
+		return c, nil
+	default:
+		return "", Errorf(ErrInvalid, "invalid review cadence %q (use weekly, biweekly, monthly, quarterly, yearly or none)", s)
+	}
+}
+
+// Next returns the first review after from
+func (c ReviewCadence) Next(from time.Time) time.Time {
+	switch c {
+	case ReviewWeekly:
+		return from.AddDate(0, 0, 7)
+	case ReviewBiweekly:
+		return from.AddDate(0, 0, 14)
+	case ReviewQuarterly:
+		return from.AddDate(0, 3, 0)
+	case ReviewYearly:
+		return from.AddDate(1, 0, 0)
+	default:
+		return from.AddDate(0, 1, 0)
+	}
+}
+
 // ProjectStatus represents the current state of a project
 type ProjectStatus string
 
@@ -20,6 +139,9 @@ const (
 	ProjectStatusOnHold    ProjectStatus = "on_hold"
 	ProjectStatusCompleted ProjectStatus = "completed"
 	ProjectStatusArchived  ProjectStatus = "archived"
+	// ProjectStatusSomeday parks a project in the someday/maybe backlog,
+	// out of the default views until it is revived or dropped
+	ProjectStatusSomeday ProjectStatus = "someday"
 )
 
 // TaskStatus represents the current state of a task
@@ -31,12 +153,15 @@ const (
 	TaskStatusCompleted  TaskStatus = "completed"
 	TaskStatusBlocked    TaskStatus = "blocked"
 	TaskStatusCancelled  TaskStatus = "cancelled"
+	// TaskStatusWaiting is a task delegated to its assignee, waiting on
+	// them to do it
+	TaskStatusWaiting TaskStatus = "waiting"
 )
 
 // Timestamps holds common timestamp fields
 type Timestamps struct {
-	Created time.Time `yaml:"created"`
-	Updated time.Time `yaml:"updated"`
+	Created time.Time `yaml:"created" json:"created"`
+	Updated time.Time `yaml:"updated" json:"updated"`
 }
 
 // UpdateTimestamp sets the Updated field to now
@@ -50,3 +175,12 @@ func (t *Timestamps) SetCreated() {
 	t.Created = now
 	t.Updated = now
 }
+
+// clonePtr returns a pointer to a copy of *p, or nil
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
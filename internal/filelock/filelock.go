@@ -0,0 +1,24 @@
+// Package filelock takes advisory locks on open files. The operating system
+// releases a lock when its process exits, so a process that crashes leaves
+// no lock behind to be judged stale.
+package filelock
+
+import (
+	"errors"
+	"os"
+)
+
+// ErrLocked is returned by TryLock when another process holds the lock
+var ErrLocked = errors.New("file is locked by another process")
+
+// TryLock takes an exclusive lock on f without waiting, returning
+// ErrLocked if another process holds it. The lock doesn't keep other
+// processes from reading or writing f.
+func TryLock(f *os.File) error {
+	return tryLock(f)
+}
+
+// Unlock releases the lock taken on f. Closing f releases it as well.
+func Unlock(f *os.File) error {
+	return unlock(f)
+}
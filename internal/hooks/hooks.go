@@ -0,0 +1,102 @@
+// Package hooks runs the shell commands configured for events, such as a
+// task being completed, with the event's entity as JSON on stdin.
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/tracing"
+)
+
+// Event is something a hook can run on
+type Event string
+
+const (
+	TaskComplete  Event = "on_task_complete"  // gets the completed task
+	ImportFinish  Event = "on_import_finish"  // gets an ImportResult
+	ProjectCreate Event = "on_project_create" // gets the new project
+)
+
+// Events are the events hooks can be configured for
+var Events = []Event{TaskComplete, ImportFinish, ProjectCreate}
+
+// DefaultTimeout is how long a hook may run when no timeout is set
+const DefaultTimeout = 30 * time.Second
+
+// Runner runs the command configured for each event with sh -c. A nil
+// Runner runs nothing.
+type Runner struct {
+	Commands map[Event]string
+	Timeout  time.Duration
+
+	// Output receives what hooks print and why they failed, as a hook
+	// can't fail what triggered it. Defaults to stderr.
+	Output io.Writer
+}
+
+// ImportResult is what on_import_finish hooks get: the import that ran and
+// the tasks and projects it created
+type ImportResult struct {
+	Command  string    `json:"command"`
+	Started  time.Time `json:"started"`
+	Finished time.Time `json:"finished"`
+	Tasks    any       `json:"tasks"`
+	Projects any       `json:"projects"`
+}
+
+// Has returns true if a command is configured for the event
+func (r *Runner) Has(event Event) bool {
+	return r != nil && r.Commands[event] != ""
+}
+
+// Run runs the event's command, if any, with entity as JSON on stdin and
+// the event's name in REORG_EVENT, and waits for it to finish
+func (r *Runner) Run(ctx context.Context, event Event, entity any) {
+	if !r.Has(event) {
+		return
+	}
+	out := r.Output
+	if out == nil {
+		out = os.Stderr
+	}
+	if err := r.run(ctx, event, entity, out); err != nil {
+		_, _ = fmt.Fprintf(out, "hook %s failed: %v\n", event, err)
+	}
+}
+
+func (r *Runner) run(ctx context.Context, event Event, entity any, out io.Writer) (err error) {
+	ctx, span := tracing.Start(ctx, "hook "+string(event))
+	defer func() { tracing.End(span, err) }()
+
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return err
+	}
+
+	timeout := r.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", r.Commands[event])
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	cmd.Env = append(os.Environ(), "REORG_EVENT="+string(event))
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("timed out after %s", timeout)
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+// Package ical renders task and project due dates as an iCalendar (RFC 5545)
+// feed that calendar apps can import or subscribe to.
+package ical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// Options controls which entries are included in the calendar
+type Options struct {
+	// Name is shown by calendar apps for the subscription
+	Name string
+	// Completed includes completed tasks and projects
+	Completed bool
+}
+
+// Calendar builds an iCalendar document with one event per due date.
+// Someday projects and their tasks are left out.
+func Calendar(tasks []*domain.Task, projects []*domain.Project, opts Options) []byte {
+	name := opts.Name
+	if name == "" {
+		name = "reorg"
+	}
+
+	projectTitles := make(map[string]string, len(projects))
+	someday := make(map[string]bool)
+	for _, p := range projects {
+		projectTitles[p.ID] = p.Title
+		someday[p.ID] = p.IsSomeday()
+	}
+
+	var b strings.Builder
+	line(&b, "BEGIN:VCALENDAR")
+	line(&b, "VERSION:2.0")
+	line(&b, "PRODID:-//reorg//reorg//EN")
+	line(&b, "CALSCALE:GREGORIAN")
+	line(&b, "X-WR-CALNAME:"+escape(name))
+
+	for _, p := range projects {
+		if p.DueDate == nil || p.Status == domain.ProjectStatusArchived || p.IsSomeday() {
+			continue
+		}
+		if p.Status == domain.ProjectStatusCompleted && !opts.Completed {
+			continue
+		}
+
+		description := fmt.Sprintf("Project · %s priority · %s", p.Priority, p.Status)
+		writeEvent(&b, p.ID, "Project: "+p.Title, description, p.Tags, *p.DueDate, true, p.Updated,
+			p.Status == domain.ProjectStatusCompleted)
+	}
+
+	for _, t := range tasks {
+		if t.DueDate == nil || t.Status == domain.TaskStatusCancelled || someday[t.ProjectID] {
+			continue
+		}
+		if t.IsComplete() && !opts.Completed {
+			continue
+		}
+
+		description := fmt.Sprintf("%s priority · %s", t.Priority, t.Status)
+		if title := projectTitles[t.ProjectID]; title != "" {
+			description = "Project: " + title + " · " + description
+		}
+		writeEvent(&b, t.ID, t.Title, description, t.Tags, *t.DueDate, t.AllDay, t.Updated, t.IsComplete())
+	}
+
+	line(&b, "END:VCALENDAR")
+	return []byte(b.String())
+}
+
+// writeEvent writes a VEVENT. All-day due dates are all-day events,
+// anything else is a zero-length event at the due time.
+func writeEvent(b *strings.Builder, id, summary, description string, tags []string, due time.Time, allDay bool, updated time.Time, done bool) {
+	if done {
+		summary = "✓ " + summary
+	}
+	if updated.IsZero() {
+		updated = time.Now()
+	}
+
+	line(b, "BEGIN:VEVENT")
+	line(b, "UID:"+id+"@reorg")
+	line(b, "DTSTAMP:"+updated.UTC().Format("20060102T150405Z"))
+
+	if allDay {
+		line(b, "DTSTART;VALUE=DATE:"+due.Format("20060102"))
+		line(b, "DTEND;VALUE=DATE:"+due.AddDate(0, 0, 1).Format("20060102"))
+	} else {
+		line(b, "DTSTART:"+due.UTC().Format("20060102T150405Z"))
+		line(b, "DTEND:"+due.UTC().Format("20060102T150405Z"))
+	}
+
+	line(b, "SUMMARY:"+escape(summary))
+	line(b, "DESCRIPTION:"+escape(description))
+	if len(tags) > 0 {
+		escaped := make([]string, len(tags))
+		for i, tag := range tags {
+			escaped[i] = escape(tag)
+		}
+		line(b, "CATEGORIES:"+strings.Join(escaped, ","))
+	}
+	line(b, "TRANSP:TRANSPARENT")
+	line(b, "END:VEVENT")
+}
+
+// line writes a content line, folded at 75 octets as RFC 5545 requires
+func line(b *strings.Builder, s string) {
+	limit := 75
+	for len(s) > limit {
+		cut := limit
+		// Don't split a multi-byte character
+		for cut > 0 && s[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(s[:cut])
+		b.WriteString("\r\n ")
+		s = s[cut:]
+		// Continuation lines start with a space
+		limit = 74
+	}
+	b.WriteString(s)
+	b.WriteString("\r\n")
+}
+
+// escape escapes a TEXT value
+func escape(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+	return r.Replace(s)
+}
@@ -0,0 +1,137 @@
+package apple_notes
+
+import (
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// ChecklistItem is an item of a checklist in a note
+type ChecklistItem struct {
+	Text string `json:"text"`
+	Done bool   `json:"done"`
+}
+
+// Image is an image embedded in a note's body
+type Image struct {
+	Name string
+	Data []byte
+}
+
+// checkboxPrefixes mark checklist lines in plain text, as pasted from
+// other apps or markdown
+var checkboxPrefixes = map[string]bool{
+	"☐": false, "□": false, "- [ ]": false, "[ ]": false,
+	"☑": true, "☒": true, "✅": true, "- [x]": true, "[x]": true,
+}
+
+// parseBody finds the checklist items and embedded images in a note's
+// HTML body. Notes marks checklists with a "checklist" class on the list
+// (and "checked" on done items) in newer versions; checkbox characters at
+// the start of a line are understood too.
+func parseBody(body string) ([]ChecklistItem, []Image) {
+	doc, err := html.Parse(strings.NewReader(body))
+	if err != nil {
+		return nil, nil
+	}
+
+	var items []ChecklistItem
+	var images []Image
+	var walk func(n *html.Node, inChecklist bool)
+	walk = func(n *html.Node, inChecklist bool) {
+		if n.Type == html.ElementNode {
+			switch n.Data {
+			case "ul", "ol":
+				inChecklist = inChecklist || slices.ContainsFunc(classes(n), func(c string) bool {
+					return strings.Contains(c, "checklist")
+				})
+			case "li":
+				text := strings.TrimSpace(nodeText(n))
+				if item, ok := checkboxLine(text); ok {
+					items = append(items, item)
+					return
+				}
+				if inChecklist && text != "" {
+					items = append(items, ChecklistItem{Text: text, Done: slices.Contains(classes(n), "checked") || slices.Contains(classes(n), "done")})
+					return
+				}
+			case "div", "p":
+				if n.FirstChild != nil && n.FirstChild == n.LastChild && n.FirstChild.Type == html.TextNode {
+					if item, ok := checkboxLine(strings.TrimSpace(n.FirstChild.Data)); ok {
+						items = append(items, item)
+						return
+					}
+				}
+			case "img":
+				if img, ok := dataImage(attr(n, "src"), len(images)+1); ok {
+					images = append(images, img)
+				}
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c, inChecklist)
+		}
+	}
+	walk(doc, false)
+	return items, images
+}
+
+// checkboxLine reads a line starting with a checkbox as a checklist item
+func checkboxLine(line string) (ChecklistItem, bool) {
+	lower := strings.ToLower(line)
+	for prefix, done := range checkboxPrefixes {
+		if strings.HasPrefix(lower, prefix) {
+			if rest := strings.TrimSpace(line[len(prefix):]); rest != "" {
+				return ChecklistItem{Text: rest, Done: done}, true
+			}
+		}
+	}
+	return ChecklistItem{}, false
+}
+
+// dataImage decodes an image embedded as a data: URL, naming it after its
+// position in the note
+func dataImage(src string, n int) (Image, bool) {
+	meta, data, ok := strings.Cut(strings.TrimPrefix(src, "data:"), ",")
+	if !ok || !strings.HasPrefix(src, "data:image/") || !strings.HasSuffix(meta, ";base64") {
+		return Image{}, false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return Image{}, false
+	}
+	ext := ".png"
+	if exts, _ := mime.ExtensionsByType(strings.TrimSuffix(meta, ";base64")); len(exts) > 0 {
+		ext = exts[0]
+	}
+	return Image{Name: fmt.Sprintf("image-%d%s", n, ext), Data: decoded}, true
+}
+
+func nodeText(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var b strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		b.WriteString(nodeText(c))
+	}
+	return b.String()
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// classes returns an element's classes, lowercased
+func classes(n *html.Node) []string {
+	return strings.Fields(strings.ToLower(attr(n, "class")))
+}
@@ -0,0 +1,123 @@
+// Reads Apple Notes and prints JSON for the reader. Run with:
+//   osascript -l JavaScript notes.js info <folder> <seconds>
+//   osascript -l JavaScript notes.js notes <id>...
+//   osascript -l JavaScript notes.js folders
+//   osascript -l JavaScript notes.js save <id> <dir> <skip-extensions>
+// An empty folder means all folders, and zero seconds any modification date.
+
+const Notes = Application('Notes')
+
+// folders lists every folder of every account, nested ones included, with
+// its path such as "Work/Ideas"
+function folders() {
+	const result = []
+	const seen = {}
+	function walk(list, account, parent) {
+		for (const folder of list) {
+			const id = folder.id()
+			if (seen[id]) continue
+			seen[id] = true
+			const path = parent ? parent + '/' + folder.name() : folder.name()
+			result.push({ folder, account, path })
+			walk(folder.folders(), account, path)
+		}
+	}
+	for (const account of Notes.accounts()) {
+		walk(account.folders(), account.name(), '')
+	}
+	return result
+}
+
+// location finds the account and folder path of a note by walking up its
+// containers
+function location(note) {
+	const names = []
+	let account = ''
+	let container = note.container()
+	for (let depth = 0; container && depth < 32; depth++) {
+		if (ObjectSpecifier.classOf(container) === 'account') {
+			account = container.name()
+			break
+		}
+		names.unshift(container.name())
+		container = container.container()
+	}
+	return { account, path: names.join('/') }
+}
+
+function info(folderName, seconds) {
+	const cutoff = new Date(Date.now() - seconds * 1000)
+	const result = []
+	for (const { folder, account, path } of folders()) {
+		if (folderName && folder.name().toLowerCase() !== folderName.toLowerCase() && path.toLowerCase() !== folderName.toLowerCase()) {
+			continue
+		}
+		// Each property is read for all the folder's notes in one event
+		const notes = seconds > 0 ? folder.notes.whose({ modificationDate: { _greaterThan: cutoff } }) : folder.notes
+		const ids = notes.id()
+		const dates = notes.modificationDate()
+		for (let i = 0; i < ids.length; i++) {
+			result.push({
+				id: ids[i],
+				folder: folder.name(),
+				folder_path: path,
+				account,
+				modification_date: dates[i].toISOString(),
+			})
+		}
+	}
+	return result
+}
+
+function notes(ids) {
+	const result = []
+	for (const id of ids) {
+		try {
+			const note = Notes.notes.byId(id)
+			const { account, path } = location(note)
+			result.push({
+				id,
+				name: note.name(),
+				body: note.body(),
+				folder: path.split('/').pop() || 'Notes',
+				folder_path: path,
+				account,
+				creation_date: note.creationDate().toISOString(),
+				modification_date: note.modificationDate().toISOString(),
+			})
+		} catch (e) {
+			// Deleted since it was listed
+		}
+	}
+	return result
+}
+
+function save(id, dir, skip) {
+	const saved = []
+	const note = Notes.notes.byId(id)
+	for (const attachment of note.attachments()) {
+		try {
+			const name = attachment.name()
+			if (skip.some(ext => name.toLowerCase().endsWith(ext))) continue
+			Notes.save(attachment, { in: Path(dir + '/' + name) })
+			saved.push(name)
+		} catch (e) {
+			// Not every attachment can be saved, e.g. links and tables
+		}
+	}
+	return saved
+}
+
+function run(argv) {
+	switch (argv[0]) {
+	case 'info':
+		return JSON.stringify(info(argv[1], Number(argv[2])))
+	case 'notes':
+		return JSON.stringify(notes(argv.slice(1)))
+	case 'folders':
+		return JSON.stringify(folders().map(f => f.path))
+	case 'save':
+		return JSON.stringify(save(argv[1], argv[2], argv[3] ? argv[3].split(',') : []))
+	}
+	throw new Error('unknown command: ' + argv[0])
+}
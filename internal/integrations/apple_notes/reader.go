@@ -2,9 +2,13 @@ package apple_notes
 
 import (
 	"context"
+	_ "embed"
 	"encoding/json"
 	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -18,6 +22,14 @@ type Note struct {
 	CreationDate time.Time `json:"-"`
 	ModDate      time.Time `json:"-"`
 	Folder       string    `json:"folder"`
+	// FolderPath is the folder with its parents, e.g. "Work/Ideas", and
+	// Account the account it is in, e.g. "iCloud"
+	FolderPath string `json:"folder_path"`
+	Account    string `json:"account"`
+	// Checklist holds the note's checklist items, and Images the images
+	// embedded in its body
+	Checklist []ChecklistItem `json:"checklist,omitempty"`
+	Images    []Image         `json:"-"`
 }
 
 // noteJSON is used for JSON unmarshaling with string dates
@@ -26,17 +38,18 @@ type noteJSON struct {
 	Name         string `json:"name"`
 	Body         string `json:"body"`
 	Folder       string `json:"folder"`
+	FolderPath   string `json:"folder_path"`
+	Account      string `json:"account"`
 	CreationDate string `json:"creation_date"`
 	ModDate      string `json:"modification_date"`
 }
 
-// parseAppleScriptDate parses dates from AppleScript's ISO format (without timezone)
-func parseAppleScriptDate(s string) time.Time {
-	// AppleScript returns dates in local time without timezone: 2026-01-22T09:22:08
+// parseNoteDate parses the ISO dates the script prints, falling back to
+// local time for dates without a timezone
+func parseNoteDate(s string) time.Time {
 	layouts := []string{
-		"2006-01-02T15:04:05",
-		"2006-01-02T15:04:05Z07:00",
 		time.RFC3339,
+		"2006-01-02T15:04:05",
 	}
 
 	for _, layout := range layouts {
@@ -48,7 +61,32 @@ func parseAppleScriptDate(s string) time.Time {
 	return time.Time{}
 }
 
-// Reader reads notes from Apple Notes via AppleScript
+// toNote converts a note as the script printed it, parsing its dates and
+// body
+func (jn noteJSON) toNote() Note {
+	n := Note{
+		ID:           jn.ID,
+		Name:         jn.Name,
+		Body:         jn.Body,
+		Folder:       jn.Folder,
+		FolderPath:   jn.FolderPath,
+		Account:      jn.Account,
+		CreationDate: parseNoteDate(jn.CreationDate),
+		ModDate:      parseNoteDate(jn.ModDate),
+		PlainText:    stripHTML(jn.Body),
+	}
+	n.Checklist, n.Images = parseBody(jn.Body)
+	return n
+}
+
+// notesScript is the JavaScript for Automation script the reader runs. It
+// builds its JSON with JSON.stringify, so quotes, emoji and other
+// characters in notes come through as they are.
+//
+//go:embed notes.js
+var notesScript string
+
+// Reader reads notes from Apple Notes via JavaScript for Automation
 type Reader struct{}
 
 // NewReader creates a new Apple Notes reader
@@ -56,268 +94,165 @@ func NewReader() *Reader {
 	return &Reader{}
 }
 
+// batchSize is how many notes are fetched with their bodies per osascript
+// run, so the output of each run stays small
+const batchSize = 25
+
+// NoteInfo identifies a note and when it last changed, without its body
+type NoteInfo struct {
+	ID         string    `json:"id"`
+	Folder     string    `json:"folder"`
+	FolderPath string    `json:"folder_path"`
+	Account    string    `json:"account"`
+	ModDate    time.Time `json:"-"`
+}
+
 // ListNotes returns all notes from Apple Notes
 func (r *Reader) ListNotes(ctx context.Context) ([]Note, error) {
-	// AppleScript to get all notes by iterating through folders first
-	// This is more reliable and gets folder names correctly
-	script := `
-tell application "Notes"
-	set noteList to ""
-
-	-- Iterate through all accounts and their folders
-	repeat with acc in accounts
-		repeat with fld in folders of acc
-			set folderName to name of fld
-
-			repeat with n in notes of fld
-				try
-					set noteID to id of n
-					set noteName to name of n
-					set noteBody to body of n
-					set noteCreation to creation date of n
-					set noteMod to modification date of n
-
-					-- Escape special characters for JSON
-					set noteName to my escapeForJSON(noteName)
-					set noteBody to my escapeForJSON(noteBody)
-					set escapedFolder to my escapeForJSON(folderName)
-
-					set noteJSON to "{\"id\":\"" & noteID & "\",\"name\":\"" & noteName & "\",\"body\":\"" & noteBody & "\",\"folder\":\"" & escapedFolder & "\",\"creation_date\":\"" & (noteCreation as «class isot» as string) & "\",\"modification_date\":\"" & (noteMod as «class isot» as string) & "\"}"
-
-					if noteList is "" then
-						set noteList to noteJSON
-					else
-						set noteList to noteList & "," & noteJSON
-					end if
-				end try
-			end repeat
-		end repeat
-	end repeat
-
-	return "[" & noteList & "]"
-end tell
-
-on escapeForJSON(theText)
-	set theText to my replaceText(theText, "\\", "\\\\")
-	set theText to my replaceText(theText, "\"", "\\\"")
-	set theText to my replaceText(theText, return, "\\n")
-	set theText to my replaceText(theText, linefeed, "\\n")
-	set theText to my replaceText(theText, tab, "\\t")
-	return theText
-end escapeForJSON
-
-on replaceText(theText, searchString, replacementString)
-	set AppleScript's text item delimiters to searchString
-	set theTextItems to every text item of theText
-	set AppleScript's text item delimiters to replacementString
-	set theText to theTextItems as string
-	set AppleScript's text item delimiters to ""
-	return theText
-end replaceText
-`
-
-	cmd := exec.CommandContext(ctx, "osascript", "-e", script)
-	output, err := cmd.Output()
+	infos, err := r.ListNoteInfo(ctx, "", 0)
 	if err != nil {
-		if exitErr, ok := err.(*exec.ExitError); ok {
-			return nil, fmt.Errorf("osascript error: %s", string(exitErr.Stderr))
-		}
-		return nil, fmt.Errorf("failed to execute osascript: %w", err)
+		return nil, err
 	}
+	return r.GetNotes(ctx, noteIDs(infos))
+}
 
-	// Parse into intermediate struct with string dates
-	var jsonNotes []noteJSON
-	if err := json.Unmarshal(output, &jsonNotes); err != nil {
-		return nil, fmt.Errorf("failed to parse notes: %w (output: %s)", err, string(output))
+// ListRecentNotes returns notes modified within the given duration
+func (r *Reader) ListRecentNotes(ctx context.Context, since time.Duration) ([]Note, error) {
+	infos, err := r.ListNoteInfo(ctx, "", since)
+	if err != nil {
+		return nil, err
 	}
+	return r.GetNotes(ctx, noteIDs(infos))
+}
 
-	// Convert to Note structs with proper date parsing
-	notes := make([]Note, len(jsonNotes))
-	for i, jn := range jsonNotes {
-		notes[i] = Note{
-			ID:           jn.ID,
-			Name:         jn.Name,
-			Body:         jn.Body,
-			Folder:       jn.Folder,
-			CreationDate: parseAppleScriptDate(jn.CreationDate),
-			ModDate:      parseAppleScriptDate(jn.ModDate),
-			PlainText:    stripHTML(jn.Body),
-		}
+// ListNotesByFolder returns notes from a specific folder
+func (r *Reader) ListNotesByFolder(ctx context.Context, folder string) ([]Note, error) {
+	infos, err := r.ListNoteInfo(ctx, folder, 0)
+	if err != nil {
+		return nil, err
 	}
-
-	return notes, nil
+	return r.GetNotes(ctx, noteIDs(infos))
 }
 
-// ListRecentNotes returns notes modified within the given duration
-// This version uses AppleScript's 'whose' clause for potentially faster filtering
-func (r *Reader) ListRecentNotes(ctx context.Context, since time.Duration) ([]Note, error) {
-	// Calculate seconds ago for AppleScript (avoids locale-dependent date parsing)
-	secondsAgo := int(since.Seconds())
-
-	// AppleScript using 'whose' clause for filtering - may be faster if Notes supports it
-	script := fmt.Sprintf(`
-tell application "Notes"
-	set cutoffDate to (current date) - %d
-	set noteList to ""
-
-	-- Try to get recent notes using whose clause (faster if supported)
-	try
-		set recentNotes to every note whose modification date > cutoffDate
-	on error
-		-- Fallback: get all notes and filter manually
-		set recentNotes to {}
-		repeat with n in notes
-			try
-				if modification date of n > cutoffDate then
-					set end of recentNotes to n
-				end if
-			end try
-		end repeat
-	end try
-
-	repeat with n in recentNotes
-		try
-			set noteID to id of n
-			set noteName to name of n
-			set noteBody to body of n
-			set noteCreation to creation date of n
-			set noteMod to modification date of n
-
-			-- Get folder name
-			set noteFolder to "Notes"
-			try
-				set noteFolder to name of container of n
-			end try
-
-			-- Escape special characters for JSON
-			set noteName to my escapeForJSON(noteName)
-			set noteBody to my escapeForJSON(noteBody)
-			set escapedFolder to my escapeForJSON(noteFolder)
-
-			set noteJSON to "{\"id\":\"" & noteID & "\",\"name\":\"" & noteName & "\",\"body\":\"" & noteBody & "\",\"folder\":\"" & escapedFolder & "\",\"creation_date\":\"" & (noteCreation as «class isot» as string) & "\",\"modification_date\":\"" & (noteMod as «class isot» as string) & "\"}"
-
-			if noteList is "" then
-				set noteList to noteJSON
-			else
-				set noteList to noteList & "," & noteJSON
-			end if
-		end try
-	end repeat
-
-	return "[" & noteList & "]"
-end tell
-
-on escapeForJSON(theText)
-	set theText to my replaceText(theText, "\\", "\\\\")
-	set theText to my replaceText(theText, "\"", "\\\"")
-	set theText to my replaceText(theText, return, "\\n")
-	set theText to my replaceText(theText, linefeed, "\\n")
-	set theText to my replaceText(theText, tab, "\\t")
-	return theText
-end escapeForJSON
-
-on replaceText(theText, searchString, replacementString)
-	set AppleScript's text item delimiters to searchString
-	set theTextItems to every text item of theText
-	set AppleScript's text item delimiters to replacementString
-	set theText to theTextItems as string
-	set AppleScript's text item delimiters to ""
-	return theText
-end replaceText
-`, secondsAgo)
-
-	cmd := exec.CommandContext(ctx, "osascript", "-e", script)
-	output, err := cmd.Output()
+// GetNote returns a specific note by ID
+func (r *Reader) GetNote(ctx context.Context, id string) (*Note, error) {
+	notes, err := r.GetNotes(ctx, []string{id})
 	if err != nil {
-		if exitErr, ok := err.(*exec.ExitError); ok {
-			return nil, fmt.Errorf("osascript error: %s", string(exitErr.Stderr))
-		}
-		return nil, fmt.Errorf("failed to execute osascript: %w", err)
+		return nil, err
+	}
+	if len(notes) == 0 {
+		return nil, fmt.Errorf("note not found: %s", id)
 	}
+	return &notes[0], nil
+}
 
-	// Parse into intermediate struct with string dates
-	var jsonNotes []noteJSON
-	if err := json.Unmarshal(output, &jsonNotes); err != nil {
-		return nil, fmt.Errorf("failed to parse notes: %w (output: %s)", err, string(output))
+// ListNoteInfo lists the notes in folder (all folders if empty) modified
+// within since (any time if zero). The folder is matched by name or path,
+// ignoring case, in every account, nested folders included. Only IDs,
+// folders and dates are read, which is quick even for large libraries;
+// GetNotes fetches the notes.
+func (r *Reader) ListNoteInfo(ctx context.Context, folder string, since time.Duration) ([]NoteInfo, error) {
+	var jsonInfos []noteJSON
+	if err := runScript(ctx, &jsonInfos, "info", folder, strconv.Itoa(int(since.Seconds()))); err != nil {
+		return nil, err
 	}
 
-	// Convert to Note structs with proper date parsing
-	notes := make([]Note, len(jsonNotes))
-	for i, jn := range jsonNotes {
-		notes[i] = Note{
-			ID:           jn.ID,
-			Name:         jn.Name,
-			Body:         jn.Body,
-			Folder:       jn.Folder,
-			CreationDate: parseAppleScriptDate(jn.CreationDate),
-			ModDate:      parseAppleScriptDate(jn.ModDate),
-			PlainText:    stripHTML(jn.Body),
+	infos := make([]NoteInfo, len(jsonInfos))
+	for i, ji := range jsonInfos {
+		infos[i] = NoteInfo{
+			ID:         ji.ID,
+			Folder:     ji.Folder,
+			FolderPath: ji.FolderPath,
+			Account:    ji.Account,
+			ModDate:    parseNoteDate(ji.ModDate),
 		}
 	}
-
-	return notes, nil
+	return infos, nil
 }
 
-// ListNotesByFolder returns notes from a specific folder
-func (r *Reader) ListNotesByFolder(ctx context.Context, folder string) ([]Note, error) {
-	notes, err := r.ListNotes(ctx)
-	if err != nil {
-		return nil, err
-	}
+// GetNotes fetches notes by ID, with their bodies, batchSize at a time.
+// Notes that no longer exist are left out.
+func (r *Reader) GetNotes(ctx context.Context, ids []string) ([]Note, error) {
+	var notes []Note
+	for start := 0; start < len(ids); start += batchSize {
+		batch := ids[start:min(start+batchSize, len(ids))]
 
-	folder = strings.ToLower(folder)
-	var filtered []Note
-	for _, note := range notes {
-		if strings.ToLower(note.Folder) == folder {
-			filtered = append(filtered, note)
+		var jsonNotes []noteJSON
+		if err := runScript(ctx, &jsonNotes, append([]string{"notes"}, batch...)...); err != nil {
+			return nil, err
+		}
+		for _, jn := range jsonNotes {
+			notes = append(notes, jn.toNote())
 		}
 	}
+	return notes, nil
+}
 
-	return filtered, nil
+// noteIDs returns the IDs of notes
+func noteIDs(infos []NoteInfo) []string {
+	ids := make([]string, len(infos))
+	for i, info := range infos {
+		ids[i] = info.ID
+	}
+	return ids
 }
 
-// GetNote returns a specific note by ID
-func (r *Reader) GetNote(ctx context.Context, id string) (*Note, error) {
-	notes, err := r.ListNotes(ctx)
-	if err != nil {
+// imageExtensions are attachments that are also embedded in the body
+var imageExtensions = []string{".png", ".jpg", ".jpeg", ".heic", ".gif", ".tiff"}
+
+// SaveAttachments writes a note's images and attached files into dir and
+// returns their paths. Images come from the body; other attachments, such
+// as PDFs, are saved by Notes itself.
+func (r *Reader) SaveAttachments(ctx context.Context, note Note, dir string) ([]string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
 		return nil, err
 	}
 
-	for _, note := range notes {
-		if note.ID == id {
-			return &note, nil
+	var paths []string
+	for _, img := range note.Images {
+		path := filepath.Join(dir, img.Name)
+		if err := os.WriteFile(path, img.Data, 0644); err != nil {
+			return nil, fmt.Errorf("failed to save image: %w", err)
 		}
+		paths = append(paths, path)
 	}
 
-	return nil, fmt.Errorf("note not found: %s", id)
+	var saved []string
+	if err := runScript(ctx, &saved, "save", note.ID, dir, strings.Join(imageExtensions, ",")); err != nil {
+		return paths, err
+	}
+	for _, name := range saved {
+		paths = append(paths, filepath.Join(dir, name))
+	}
+	return paths, nil
 }
 
-// ListFolders returns all folder names in Apple Notes
+// ListFolders returns the paths of all folders in Apple Notes, e.g.
+// "Work/Ideas"
 func (r *Reader) ListFolders(ctx context.Context) ([]string, error) {
-	script := `
-tell application "Notes"
-	set folderNames to {}
-	repeat with f in folders
-		set end of folderNames to name of f
-	end repeat
-	return folderNames
-end tell
-`
-
-	cmd := exec.CommandContext(ctx, "osascript", "-e", script)
-	output, err := cmd.Output()
-	if err != nil {
+	var folders []string
+	if err := runScript(ctx, &folders, "folders"); err != nil {
 		return nil, fmt.Errorf("failed to list folders: %w", err)
 	}
+	return folders, nil
+}
 
-	// Parse AppleScript list output (comma-separated)
-	result := strings.TrimSpace(string(output))
-	if result == "" {
-		return []string{}, nil
+// runScript runs the notes script with args and decodes the JSON it
+// prints into v
+func runScript(ctx context.Context, v any, args ...string) error {
+	cmd := exec.CommandContext(ctx, "osascript", append([]string{"-l", "JavaScript", "-e", notesScript}, args...)...)
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return fmt.Errorf("osascript error: %s", string(exitErr.Stderr))
+		}
+		return fmt.Errorf("failed to execute osascript: %w", err)
 	}
 
-	folders := strings.Split(result, ", ")
-	return folders, nil
+	if err := json.Unmarshal(output, v); err != nil {
+		return fmt.Errorf("failed to parse notes: %w (output: %s)", err, string(output))
+	}
+	return nil
 }
 
 // stripHTML removes HTML tags from a string
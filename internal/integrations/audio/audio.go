@@ -0,0 +1,217 @@
+// Package audio finds voice memos and other recordings in a folder and
+// transcribes them with whisper.cpp or the OpenAI transcription API.
+package audio
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Extensions are the audio files that are picked up
+var Extensions = []string{".m4a", ".mp3", ".wav", ".ogg", ".oga", ".opus", ".flac", ".webm", ".aac", ".mp4"}
+
+// File is a recording found in a folder
+type File struct {
+	Path    string
+	Name    string // file name without the extension
+	ModTime time.Time
+}
+
+// List returns the recordings in dir, oldest first. Subdirectories are not
+// searched.
+func List(dir string) ([]File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+
+	var files []File
+	for _, e := range entries {
+		ext := strings.ToLower(filepath.Ext(e.Name()))
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") || !slices.Contains(Extensions, ext) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, File{
+			Path:    filepath.Join(dir, e.Name()),
+			Name:    strings.TrimSuffix(e.Name(), filepath.Ext(e.Name())),
+			ModTime: info.ModTime(),
+		})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].ModTime.Before(files[j].ModTime) })
+	return files, nil
+}
+
+// Transcriber turns a recording into text
+type Transcriber interface {
+	Transcribe(ctx context.Context, path string) (string, error)
+}
+
+// Config selects and configures a transcriber
+type Config struct {
+	// Provider is "whisper-cpp" (the default) or "openai"
+	Provider string
+	// Binary is the whisper.cpp command, whisper-cli by default
+	Binary string
+	// Model is the whisper.cpp model file, or the OpenAI model name
+	// (whisper-1 by default)
+	Model    string
+	APIKey   string
+	BaseURL  string
+	Language string
+}
+
+// NewTranscriber returns the transcriber for cfg
+func NewTranscriber(cfg Config) (Transcriber, error) {
+	switch cfg.Provider {
+	case "", "whisper-cpp":
+		if cfg.Model == "" {
+			return nil, fmt.Errorf("whisper.cpp needs a model file (import.audio.model)")
+		}
+		binary := cfg.Binary
+		if binary == "" {
+			binary = "whisper-cli"
+		}
+		return &WhisperCPP{binary: binary, model: cfg.Model, language: cfg.Language}, nil
+	case "openai":
+		if cfg.APIKey == "" {
+			cfg.APIKey = os.Getenv("OPENAI_API_KEY")
+		}
+		if cfg.APIKey == "" {
+			return nil, fmt.Errorf("OpenAI transcription needs an API key (import.audio.api_key or OPENAI_API_KEY)")
+		}
+		if cfg.Model == "" {
+			cfg.Model = "whisper-1"
+		}
+		if cfg.BaseURL == "" {
+			cfg.BaseURL = "https://api.openai.com/v1"
+		}
+		return &OpenAI{cfg: cfg, httpClient: &http.Client{Timeout: 5 * time.Minute}}, nil
+	}
+	return nil, fmt.Errorf("unknown transcription provider %q (use whisper-cpp or openai)", cfg.Provider)
+}
+
+// WhisperCPP transcribes locally with the whisper.cpp command line tool
+type WhisperCPP struct {
+	binary   string
+	model    string
+	language string
+}
+
+// whisperFormats are the inputs whisper.cpp reads directly; anything else
+// is converted to WAV with ffmpeg first
+var whisperFormats = []string{".wav", ".mp3", ".ogg", ".flac"}
+
+// Transcribe runs whisper.cpp on the file and returns the text it prints
+func (w *WhisperCPP) Transcribe(ctx context.Context, path string) (string, error) {
+	input := path
+	if !slices.Contains(whisperFormats, strings.ToLower(filepath.Ext(path))) {
+		tmp, err := os.MkdirTemp("", "reorg-audio-")
+		if err != nil {
+			return "", err
+		}
+		defer func() { _ = os.RemoveAll(tmp) }()
+
+		input = filepath.Join(tmp, "input.wav")
+		ffmpeg := exec.CommandContext(ctx, "ffmpeg", "-loglevel", "error", "-i", path, "-ar", "16000", "-ac", "1", input)
+		if out, err := ffmpeg.CombinedOutput(); err != nil {
+			return "", fmt.Errorf("failed to convert %s with ffmpeg: %w: %s", filepath.Base(path), err, strings.TrimSpace(string(out)))
+		}
+	}
+
+	args := []string{"-m", w.model, "-f", input, "--no-timestamps", "--no-prints"}
+	if w.language != "" {
+		args = append(args, "-l", w.language)
+	}
+	cmd := exec.CommandContext(ctx, w.binary, args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("whisper.cpp failed on %s: %w: %s", filepath.Base(path), err, strings.TrimSpace(stderr.String()))
+	}
+	return cleanTranscript(string(out)), nil
+}
+
+// OpenAI transcribes with the OpenAI audio transcription API
+type OpenAI struct {
+	cfg        Config
+	httpClient *http.Client
+}
+
+// Transcribe uploads the file and returns the transcript
+func (o *OpenAI) Transcribe(ctx context.Context, path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("file", filepath.Base(path))
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(part, f); err != nil {
+		return "", err
+	}
+	_ = form.WriteField("model", o.cfg.Model)
+	_ = form.WriteField("response_format", "text")
+	if o.cfg.Language != "" {
+		_ = form.WriteField("language", o.cfg.Language)
+	}
+	if err := form.Close(); err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(o.cfg.BaseURL, "/")+"/audio/transcriptions", &body)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+o.cfg.APIKey)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("transcription request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("transcription failed: %s: %s", resp.Status, strings.TrimSpace(string(data)))
+	}
+	return cleanTranscript(string(data)), nil
+}
+
+// cleanTranscript joins the transcript's lines and drops whisper's
+// markers for silence and noise, such as [BLANK_AUDIO]
+func cleanTranscript(s string) string {
+	var parts []string
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || (strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]")) {
+			continue
+		}
+		parts = append(parts, line)
+	}
+	return strings.Join(parts, " ")
+}
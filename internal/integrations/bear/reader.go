@@ -0,0 +1,133 @@
+// Package bear reads notes from the Bear database on macOS. Like the Things
+// reader, it queries the database with the sqlite3 command line tool,
+// which ships with macOS.
+package bear
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Note is a Bear note
+type Note struct {
+	ID       string
+	Title    string
+	Text     string // markdown, including the title line
+	Tags     []string
+	Created  time.Time
+	Modified time.Time
+}
+
+// DefaultPath finds the Bear database in the user's group containers
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	path := filepath.Join(home, "Library", "Group Containers", "9K33E3U3T4.net.shinyfrog.bear",
+		"Application Data", "database.sqlite")
+	if _, err := os.Stat(path); err != nil {
+		return "", fmt.Errorf("Bear database not found (looked for %s)", path)
+	}
+	return path, nil
+}
+
+// query lists the notes that aren't trashed, archived or encrypted
+const query = `
+SELECT ZUNIQUEIDENTIFIER AS id, ZTITLE AS title, ZTEXT AS text,
+       ZCREATIONDATE AS created, ZMODIFICATIONDATE AS modified
+FROM ZSFNOTE
+WHERE ZTRASHED = 0 AND ZARCHIVED = 0 AND ZPERMANENTLYDELETED = 0 AND ZTEXT IS NOT NULL
+ORDER BY ZMODIFICATIONDATE;`
+
+type row struct {
+	ID       string   `json:"id"`
+	Title    *string  `json:"title"`
+	Text     string   `json:"text"`
+	Created  *float64 `json:"created"`
+	Modified *float64 `json:"modified"`
+}
+
+// Read loads the notes from the database at path
+func Read(ctx context.Context, path string) ([]Note, error) {
+	cmd := exec.CommandContext(ctx, "sqlite3", "-readonly", "-json", path, query)
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return nil, fmt.Errorf("sqlite3 error: %s", strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, fmt.Errorf("failed to execute sqlite3: %w", err)
+	}
+
+	var rows []row
+	if len(strings.TrimSpace(string(output))) > 0 {
+		if err := json.Unmarshal(output, &rows); err != nil {
+			return nil, fmt.Errorf("failed to parse sqlite3 output: %w", err)
+		}
+	}
+
+	notes := make([]Note, 0, len(rows))
+	for _, r := range rows {
+		title := ""
+		if r.Title != nil {
+			title = *r.Title
+		}
+		notes = append(notes, Note{
+			ID:       r.ID,
+			Title:    title,
+			Text:     r.Text,
+			Tags:     ParseTags(r.Text),
+			Created:  decodeDate(r.Created),
+			Modified: decodeDate(r.Modified),
+		})
+	}
+	return notes, nil
+}
+
+var (
+	// #multi word tags# are closed with a second hash
+	multiWordTag = regexp.MustCompile(`(?m)(?:^|\s)#([^\s#][^#\n]*[^\s#])#`)
+	singleTag    = regexp.MustCompile(`(?m)(?:^|\s)#([^\s#]+)`)
+)
+
+// ParseTags finds Bear's tags in a note's text, such as #idea,
+// #work/website and #reading list#. Headings ("# Title") aren't tags.
+func ParseTags(text string) []string {
+	var tags []string
+	seen := make(map[string]bool)
+	add := func(tag string) {
+		tag = strings.Trim(tag, "/.,;:!?)")
+		if tag != "" && !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+
+	for _, m := range multiWordTag.FindAllStringSubmatch(text, -1) {
+		add(m[1])
+	}
+	rest := multiWordTag.ReplaceAllString(text, " ")
+	for _, m := range singleTag.FindAllStringSubmatch(rest, -1) {
+		add(m[1])
+	}
+	return tags
+}
+
+// coreDataEpoch is where Core Data timestamps count from
+var coreDataEpoch = time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// decodeDate decodes a Core Data timestamp, in seconds since 2001
+func decodeDate(v *float64) time.Time {
+	if v == nil {
+		return time.Time{}
+	}
+	return coreDataEpoch.Add(time.Duration(*v * float64(time.Second)))
+}
@@ -0,0 +1,106 @@
+// Package folder finds text and markdown files dropped into a folder, by
+// a sync tool, a scanner or a download, for importing as notes.
+package folder
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/adrg/frontmatter"
+
+	"github.com/ihavespoons/reorg/internal/slug"
+)
+
+// Extensions are the files that are picked up
+var Extensions = []string{".txt", ".text", ".md", ".markdown"}
+
+// settle is how long a file must be left alone before it is read, so files
+// still being written or synced are picked up on a later run
+const settle = 5 * time.Second
+
+// File is a text file found in a folder
+type File struct {
+	Path    string
+	Name    string // file name without the extension
+	ModTime time.Time
+}
+
+// List returns the text files in dir, oldest first, leaving out hidden
+// files, temporary files and files changed in the last few seconds.
+// Subdirectories are not searched.
+func List(dir string) ([]File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+
+	cutoff := time.Now().Add(-settle)
+	var files []File
+	for _, e := range entries {
+		ext := strings.ToLower(filepath.Ext(e.Name()))
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") || strings.HasPrefix(e.Name(), "~") || !slices.Contains(Extensions, ext) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil || info.ModTime().After(cutoff) {
+			continue
+		}
+		files = append(files, File{
+			Path:    filepath.Join(dir, e.Name()),
+			Name:    strings.TrimSuffix(e.Name(), filepath.Ext(e.Name())),
+			ModTime: info.ModTime(),
+		})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].ModTime.Before(files[j].ModTime) })
+	return files, nil
+}
+
+// Read returns the text of a file, without any YAML frontmatter, and its
+// title: the frontmatter's title if it has one
+func Read(f File) (title, text string, err error) {
+	data, err := os.ReadFile(f.Path)
+	if err != nil {
+		return "", "", err
+	}
+
+	var meta struct {
+		Title string `yaml:"title"`
+	}
+	body, err := frontmatter.Parse(bytes.NewReader(data), &meta)
+	if err != nil {
+		// Not valid frontmatter; keep the file as it is
+		body = data
+	}
+
+	title = f.Name
+	if meta.Title != "" {
+		title = meta.Title
+	}
+	return title, strings.TrimSpace(string(body)), nil
+}
+
+// Move moves a processed file into dir, numbering the name from -2 if a
+// file with the same name is there already, and returns its new path
+func Move(f File, dir string) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	base := filepath.Base(f.Path)
+	ext := filepath.Ext(base)
+	name := slug.Unique(strings.TrimSuffix(base, ext), func(name string) bool {
+		_, err := os.Stat(filepath.Join(dir, name+ext))
+		return !os.IsNotExist(err)
+	})
+	dest := filepath.Join(dir, name+ext)
+	if err := os.Rename(f.Path, dest); err != nil {
+		return "", fmt.Errorf("failed to move %s: %w", base, err)
+	}
+	return dest, nil
+}
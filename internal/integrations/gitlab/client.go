@@ -0,0 +1,158 @@
+// Package gitlab reads the issues assigned to you and the merge requests
+// you were asked to review from GitLab, on gitlab.com or a self-hosted
+// instance, through the REST API.
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultURL is gitlab.com
+const DefaultURL = "https://gitlab.com"
+
+// Issue is a GitLab issue or merge request
+type Issue struct {
+	ID          int       `json:"id"`
+	IID         int       `json:"iid"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	State       string    `json:"state"` // opened, closed, and for merge requests merged or locked
+	WebURL      string    `json:"web_url"`
+	Labels      []string  `json:"labels"`
+	DueDate     string    `json:"due_date"` // YYYY-MM-DD, issues only
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	References  struct {
+		Full string `json:"full"` // e.g. group/project#12 or group/project!5
+	} `json:"references"`
+	Milestone *struct {
+		DueDate string `json:"due_date"`
+	} `json:"milestone"`
+}
+
+// Project returns the path of the issue's project, e.g. group/project
+func (i *Issue) Project() string {
+	if n := strings.LastIndexAny(i.References.Full, "#!"); n > 0 {
+		return i.References.Full[:n]
+	}
+	return ""
+}
+
+// Open reports whether the issue or merge request is still open
+func (i *Issue) Open() bool {
+	return i.State == "opened"
+}
+
+// Due returns the issue's due date, or its milestone's if it has none
+func (i *Issue) Due() *time.Time {
+	due := i.DueDate
+	if due == "" && i.Milestone != nil {
+		due = i.Milestone.DueDate
+	}
+	if due == "" {
+		return nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", due, time.Local)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// Client talks to the GitLab API with a personal access token
+type Client struct {
+	token      string
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient creates a client for the instance at baseURL, gitlab.com if
+// it is empty
+func NewClient(token, baseURL string) *Client {
+	if baseURL == "" {
+		baseURL = DefaultURL
+	}
+	return &Client{
+		token:      token,
+		url:        strings.TrimSuffix(baseURL, "/") + "/api/v4",
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// AssignedIssues returns the issues assigned to the token's user, open
+// and closed, so closing can be synced
+func (c *Client) AssignedIssues(ctx context.Context) ([]Issue, error) {
+	return c.list(ctx, "/issues", url.Values{"scope": {"assigned_to_me"}, "state": {"all"}})
+}
+
+// ReviewRequests returns the merge requests the token's user is a
+// reviewer of, including merged and closed ones
+func (c *Client) ReviewRequests(ctx context.Context) ([]Issue, error) {
+	var user struct {
+		ID int `json:"id"`
+	}
+	if _, err := c.get(ctx, "/user", nil, &user); err != nil {
+		return nil, err
+	}
+	return c.list(ctx, "/merge_requests", url.Values{
+		"scope":       {"all"},
+		"state":       {"all"},
+		"reviewer_id": {fmt.Sprint(user.ID)},
+	})
+}
+
+// list reads every page of a list endpoint
+func (c *Client) list(ctx context.Context, path string, query url.Values) ([]Issue, error) {
+	query.Set("per_page", "100")
+	var all []Issue
+	for page := "1"; page != ""; {
+		query.Set("page", page)
+		var items []Issue
+		next, err := c.get(ctx, path, query, &items)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		page = next
+	}
+	return all, nil
+}
+
+// get decodes a GET request's response into v and returns the next page
+// number, or "" on the last page
+func (c *Client) get(ctx context.Context, path string, query url.Values, v any) (string, error) {
+	u := c.url + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("PRIVATE-TOKEN", c.token)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("GitLab request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("GitLab request failed: %s: %s", resp.Status, strings.TrimSpace(string(data)))
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return "", fmt.Errorf("failed to parse GitLab response: %w", err)
+	}
+	return resp.Header.Get("X-Next-Page"), nil
+}
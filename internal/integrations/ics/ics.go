@@ -0,0 +1,312 @@
+// Package ics reads events from iCalendar (.ics) files, such as calendar
+// exports and subscription feeds, and expands simple recurring events.
+package ics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Event is a calendar event, or one occurrence of a recurring event
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	Status      string // TENTATIVE, CONFIRMED or CANCELLED
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+	// Recurring is set on occurrences of recurring events
+	Recurring bool
+
+	rrule        string
+	exdates      []time.Time
+	recurrenceID *time.Time
+}
+
+// Ref identifies the event across imports: the UID, plus the original
+// start time for an occurrence of a recurring event
+func (e *Event) Ref() string {
+	if !e.Recurring {
+		return e.UID
+	}
+	start := e.Start
+	if e.recurrenceID != nil {
+		start = *e.recurrenceID
+	}
+	return e.UID + "/" + start.UTC().Format("20060102T150405Z")
+}
+
+// Cancelled reports whether the event was cancelled
+func (e *Event) Cancelled() bool {
+	return strings.EqualFold(e.Status, "CANCELLED")
+}
+
+// Parse reads the events of a calendar. Times in time zones that can't be
+// loaded are read as local times.
+func Parse(r io.Reader) ([]Event, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+	var ev *Event
+	depth := 0 // components nested in the event, such as alarms
+	for _, line := range lines {
+		name, params, value := parseLine(line)
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			ev = &Event{}
+			continue
+		case ev == nil:
+			continue
+		case name == "BEGIN":
+			depth++
+			continue
+		case name == "END" && depth > 0:
+			depth--
+			continue
+		case depth > 0:
+			continue
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			if ev.UID != "" && !ev.Start.IsZero() {
+				events = append(events, *ev)
+			}
+			ev = nil
+			continue
+		}
+
+		switch name {
+		case "UID":
+			ev.UID = value
+		case "SUMMARY":
+			ev.Summary = unescape(value)
+		case "DESCRIPTION":
+			ev.Description = unescape(value)
+		case "LOCATION":
+			ev.Location = unescape(value)
+		case "URL":
+			ev.URL = value
+		case "STATUS":
+			ev.Status = strings.ToUpper(value)
+		case "RRULE":
+			ev.rrule = value
+		case "DTSTART":
+			ev.Start, ev.AllDay, err = parseTime(value, params)
+		case "DTEND":
+			ev.End, _, err = parseTime(value, params)
+		case "RECURRENCE-ID":
+			var t time.Time
+			if t, _, err = parseTime(value, params); err == nil {
+				ev.recurrenceID = &t
+			}
+		case "EXDATE":
+			for _, v := range strings.Split(value, ",") {
+				var t time.Time
+				if t, _, err = parseTime(v, params); err != nil {
+					break
+				}
+				ev.exdates = append(ev.exdates, t)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s in event %q: %w", name, ev.Summary, err)
+		}
+	}
+	return events, nil
+}
+
+// unfold joins continuation lines, which start with a space or tab
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
+
+// parseLine splits a content line into its upper-case name, parameters
+// and value
+func parseLine(line string) (string, map[string]string, string) {
+	quoted := false
+	colon := -1
+	for i, r := range line {
+		if r == '"' {
+			quoted = !quoted
+		} else if r == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return "", nil, ""
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	params := make(map[string]string)
+	for _, p := range parts[1:] {
+		if k, v, ok := strings.Cut(p, "="); ok {
+			params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+		}
+	}
+	return strings.ToUpper(parts[0]), params, line[colon+1:]
+}
+
+// parseTime reads a DATE or DATE-TIME value, and reports whether it is a
+// date without a time
+func parseTime(value string, params map[string]string) (time.Time, bool, error) {
+	value = strings.TrimSpace(value)
+	if params["VALUE"] == "DATE" || len(value) == 8 {
+		t, err := time.ParseInLocation("20060102", value, time.Local)
+		return t, true, err
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		return t, false, err
+	}
+	loc := time.Local
+	if tzid := params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	return t, false, err
+}
+
+// unescape decodes the escapes of TEXT values
+func unescape(s string) string {
+	return strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(s)
+}
+
+// Expand returns the events, and occurrences of recurring events, that
+// start between from and to, in start order. Recurrence rules repeating
+// DAILY, WEEKLY (with BYDAY), MONTHLY or YEARLY on the start date, with
+// INTERVAL, COUNT and UNTIL, are expanded; for others only the first
+// occurrence is used. Modified occurrences replace the ones they change.
+func Expand(events []Event, from, to time.Time) []Event {
+	overrides := make(map[string]Event)
+	for _, e := range events {
+		if e.recurrenceID != nil {
+			e.Recurring = true
+			overrides[e.UID+"/"+e.recurrenceID.UTC().Format(time.RFC3339)] = e
+		}
+	}
+
+	var out []Event
+	add := func(e Event) {
+		if !e.Start.Before(from) && e.Start.Before(to) {
+			out = append(out, e)
+		}
+	}
+	for _, e := range events {
+		if e.recurrenceID != nil {
+			continue
+		}
+		if e.rrule == "" {
+			add(e)
+			continue
+		}
+		for _, start := range occurrences(e, to) {
+			if slices.ContainsFunc(e.exdates, start.Equal) {
+				continue
+			}
+			if o, ok := overrides[e.UID+"/"+start.UTC().Format(time.RFC3339)]; ok {
+				add(o)
+				continue
+			}
+			occ := e
+			occ.Recurring = true
+			occ.Start = start
+			if !e.End.IsZero() {
+				occ.End = start.Add(e.End.Sub(e.Start))
+			}
+			add(occ)
+		}
+	}
+	slices.SortStableFunc(out, func(a, b Event) int { return a.Start.Compare(b.Start) })
+	return out
+}
+
+// occurrences returns the start times of a recurring event up to before
+func occurrences(e Event, before time.Time) []time.Time {
+	rule := make(map[string]string)
+	for _, part := range strings.Split(e.rrule, ";") {
+		if k, v, ok := strings.Cut(part, "="); ok {
+			rule[strings.ToUpper(k)] = strings.ToUpper(v)
+		}
+	}
+	interval, _ := strconv.Atoi(rule["INTERVAL"])
+	if interval < 1 {
+		interval = 1
+	}
+	count, _ := strconv.Atoi(rule["COUNT"])
+	if until, ok := rule["UNTIL"]; ok {
+		if t, _, err := parseTime(until, nil); err == nil && t.Before(before) {
+			before = t.Add(time.Second)
+		}
+	}
+	var byDay []time.Weekday
+	for _, d := range strings.Split(rule["BYDAY"], ",") {
+		if wd, ok := weekdays[d]; ok {
+			byDay = append(byDay, wd)
+		}
+	}
+
+	start := e.Start
+	first := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	// day is the number of days since the first occurrence
+	day := func(d time.Time) int { return int(d.Sub(first).Hours() / 24) }
+	match := func(d time.Time) bool {
+		switch rule["FREQ"] {
+		case "DAILY":
+			return day(d)%interval == 0 && (len(byDay) == 0 || slices.Contains(byDay, d.Weekday()))
+		case "WEEKLY":
+			if len(byDay) == 0 {
+				return d.Weekday() == start.Weekday() && (day(d)/7)%interval == 0
+			}
+			// Weeks start on Monday
+			monday := (int(start.Weekday()) + 6) % 7
+			return slices.Contains(byDay, d.Weekday()) && ((day(d)+monday)/7)%interval == 0
+		case "MONTHLY":
+			months := (d.Year()-start.Year())*12 + int(d.Month()-start.Month())
+			return d.Day() == start.Day() && months%interval == 0
+		case "YEARLY":
+			return d.Month() == start.Month() && d.Day() == start.Day() && (d.Year()-start.Year())%interval == 0
+		}
+		return day(d) == 0
+	}
+
+	var starts []time.Time
+	for d := first; ; d = d.AddDate(0, 0, 1) {
+		t := time.Date(d.Year(), d.Month(), d.Day(), start.Hour(), start.Minute(), start.Second(), 0, start.Location())
+		if !t.Before(before) || (count > 0 && len(starts) >= count) {
+			return starts
+		}
+		if match(d) {
+			starts = append(starts, t)
+		}
+	}
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
@@ -0,0 +1,161 @@
+// Package images finds photos and scans in a folder and reads their text
+// with OCR, using the macOS Vision framework or Tesseract.
+package images
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Extensions are the image files that are picked up
+var Extensions = []string{".png", ".jpg", ".jpeg", ".heic", ".tif", ".tiff", ".bmp", ".gif", ".webp"}
+
+// File is an image found in a folder
+type File struct {
+	Path    string
+	Name    string // file name without the extension
+	ModTime time.Time
+}
+
+// List returns the images in dir, oldest first. Subdirectories are not
+// searched.
+func List(dir string) ([]File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+
+	var files []File
+	for _, e := range entries {
+		ext := strings.ToLower(filepath.Ext(e.Name()))
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") || !slices.Contains(Extensions, ext) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, File{
+			Path:    filepath.Join(dir, e.Name()),
+			Name:    strings.TrimSuffix(e.Name(), filepath.Ext(e.Name())),
+			ModTime: info.ModTime(),
+		})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].ModTime.Before(files[j].ModTime) })
+	return files, nil
+}
+
+// Recognizer reads the text in an image
+type Recognizer interface {
+	Recognize(ctx context.Context, path string) (string, error)
+}
+
+// Config selects and configures a recognizer
+type Config struct {
+	// Provider is "vision" (the default on macOS) or "tesseract" (the
+	// default elsewhere)
+	Provider string
+	// Binary is the tesseract command, tesseract by default
+	Binary string
+	// Language is a Tesseract language such as "eng+deu", or for Vision a
+	// comma-separated list such as "en-US,de-DE"
+	Language string
+}
+
+// NewRecognizer returns the recognizer for cfg
+func NewRecognizer(cfg Config) (Recognizer, error) {
+	provider := cfg.Provider
+	if provider == "" {
+		provider = "tesseract"
+		if runtime.GOOS == "darwin" {
+			provider = "vision"
+		}
+	}
+
+	switch provider {
+	case "vision":
+		if runtime.GOOS != "darwin" {
+			return nil, fmt.Errorf("the vision OCR provider needs macOS (use tesseract)")
+		}
+		return &Vision{language: cfg.Language}, nil
+	case "tesseract":
+		binary := cfg.Binary
+		if binary == "" {
+			binary = "tesseract"
+		}
+		return &Tesseract{binary: binary, language: cfg.Language}, nil
+	}
+	return nil, fmt.Errorf("unknown OCR provider %q (use vision or tesseract)", cfg.Provider)
+}
+
+// Tesseract reads text with the tesseract command line tool
+type Tesseract struct {
+	binary   string
+	language string
+}
+
+// Recognize runs tesseract on the image and returns the text it prints
+func (t *Tesseract) Recognize(ctx context.Context, path string) (string, error) {
+	args := []string{path, "stdout"}
+	if t.language != "" {
+		args = append(args, "-l", t.language)
+	}
+	return run(ctx, "tesseract", path, t.binary, args...)
+}
+
+//go:embed vision.js
+var visionScript string
+
+// Vision reads text with the macOS Vision framework, through a JavaScript
+// for Automation helper run by osascript
+type Vision struct {
+	language string
+}
+
+// Recognize runs the Vision helper on the image and returns the lines it
+// recognized
+func (v *Vision) Recognize(ctx context.Context, path string) (string, error) {
+	return run(ctx, "Vision", path, "osascript", "-l", "JavaScript", "-e", visionScript, path, v.language)
+}
+
+// run runs an OCR command on path and returns its cleaned up output
+func run(ctx context.Context, name, path, binary string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, binary, args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("%s failed on %s: %w: %s", name, filepath.Base(path), err, strings.TrimSpace(stderr.String()))
+	}
+	return cleanText(string(out)), nil
+}
+
+// cleanText trims each line and collapses runs of blank lines, which OCR
+// output is full of
+func cleanText(s string) string {
+	var lines []string
+	blank := false
+	for _, line := range strings.Split(strings.ReplaceAll(s, "\f", "\n"), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			blank = len(lines) > 0
+			continue
+		}
+		if blank {
+			lines = append(lines, "")
+			blank = false
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,30 @@
+// Prints the text the Vision framework recognizes in an image, one line per
+// observation. Run with: osascript -l JavaScript vision.js <image> [languages]
+ObjC.import('Foundation')
+ObjC.import('Vision')
+
+function run(argv) {
+	const url = $.NSURL.fileURLWithPath(argv[0])
+	const handler = $.VNImageRequestHandler.alloc.initWithURLOptions(url, $({}))
+	const request = $.VNRecognizeTextRequest.alloc.init
+	request.recognitionLevel = $.VNRequestTextRecognitionLevelAccurate
+	request.usesLanguageCorrection = true
+	if (argv.length > 1 && argv[1] !== '') {
+		request.recognitionLanguages = $(argv[1].split(','))
+	}
+
+	const error = $()
+	if (!handler.performRequestsError($([request]), error)) {
+		throw new Error('could not read image: ' + error.localizedDescription.js)
+	}
+
+	const lines = []
+	const results = request.results
+	for (let i = 0; i < results.count; i++) {
+		const candidates = results.objectAtIndex(i).topCandidates(1)
+		if (candidates.count > 0) {
+			lines.push(candidates.objectAtIndex(0).string.js)
+		}
+	}
+	return lines.join('\n')
+}
@@ -0,0 +1,159 @@
+// Package journal reads Apple Journal entries from an export. Journal keeps
+// its entries encrypted and has no scripting support, so entries are read
+// from the HTML or text files of an export folder.
+package journal
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// Extensions are the entry files that are read
+var Extensions = []string{".html", ".htm", ".txt", ".md"}
+
+// Entry is a journal entry
+type Entry struct {
+	Path    string
+	Title   string
+	Text    string
+	Date    time.Time
+	ModTime time.Time
+}
+
+// Read loads the entries in an export folder and its subfolders, oldest
+// first. Empty entries are left out.
+func Read(dir string) ([]Entry, error) {
+	var entries []Entry
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".") || !slices.Contains(Extensions, strings.ToLower(filepath.Ext(path))) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+
+		entry, err := readEntry(path)
+		if err != nil {
+			return err
+		}
+		if entry.Text == "" {
+			return nil
+		}
+		entry.ModTime = info.ModTime()
+		if entry.Date.IsZero() {
+			entry.Date = info.ModTime()
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read journal export: %w", err)
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Date.Before(entries[j].Date) })
+	return entries, nil
+}
+
+// readEntry reads one entry file. The title is the page title or first
+// heading of an HTML entry, or the first line of a text entry; the date
+// comes from a YYYY-MM-DD in the file name, if there is one.
+func readEntry(path string) (Entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	entry := Entry{Path: path, Date: dateFromName(filepath.Base(path))}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".html", ".htm":
+		entry.Title, entry.Text = htmlText(data)
+	default:
+		entry.Text = strings.TrimSpace(string(data))
+	}
+	if entry.Title == "" {
+		first, _, _ := strings.Cut(entry.Text, "\n")
+		entry.Title = strings.TrimSpace(strings.TrimLeft(first, "# "))
+	}
+	if r := []rune(entry.Title); len(r) > 80 {
+		entry.Title = string(r[:80]) + "…"
+	}
+	return entry, nil
+}
+
+// htmlText returns the title and the text of an HTML page, a line per
+// block, leaving out scripts and styles
+func htmlText(data []byte) (string, string) {
+	doc, err := html.Parse(bytes.NewReader(data))
+	if err != nil {
+		return "", string(data)
+	}
+
+	var title string
+	var lines []string
+	var line strings.Builder
+	flush := func() {
+		if s := strings.Join(strings.Fields(line.String()), " "); s != "" {
+			lines = append(lines, s)
+		}
+		line.Reset()
+	}
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch n.Data {
+			case "script", "style", "head":
+				if n.Data == "head" {
+					for c := n.FirstChild; c != nil; c = c.NextSibling {
+						if c.Type == html.ElementNode && c.Data == "title" && c.FirstChild != nil {
+							title = strings.TrimSpace(c.FirstChild.Data)
+						}
+					}
+				}
+				return
+			case "p", "div", "br", "li", "h1", "h2", "h3", "h4", "h5", "h6", "tr":
+				flush()
+				for c := n.FirstChild; c != nil; c = c.NextSibling {
+					walk(c)
+				}
+				flush()
+				if title == "" && n.Data == "h1" && len(lines) > 0 {
+					title = lines[len(lines)-1]
+				}
+				return
+			}
+		}
+		if n.Type == html.TextNode {
+			line.WriteString(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+	flush()
+	return title, strings.Join(lines, "\n")
+}
+
+// dateFromName finds a date such as 2026-03-14 in a file name
+func dateFromName(name string) time.Time {
+	for i := 0; i+10 <= len(name); i++ {
+		if t, err := time.ParseInLocation("2006-01-02", name[i:i+10], time.Local); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,221 @@
+// Package linear reads the issues assigned to you from Linear and updates
+// their state, through Linear's GraphQL API.
+package linear
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultURL is Linear's GraphQL endpoint
+const DefaultURL = "https://api.linear.app/graphql"
+
+// State types, which group a team's workflow states
+const (
+	StateBacklog   = "backlog"
+	StateUnstarted = "unstarted"
+	StateStarted   = "started"
+	StateCompleted = "completed"
+	StateCanceled  = "canceled"
+	StateTriage    = "triage"
+)
+
+// Issue is a Linear issue
+type Issue struct {
+	ID          string    `json:"id"`
+	Identifier  string    `json:"identifier"` // e.g. ENG-123
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	URL         string    `json:"url"`
+	Priority    int       `json:"priority"` // 0 none, 1 urgent, 2 high, 3 medium, 4 low
+	DueDate     string    `json:"dueDate"`  // YYYY-MM-DD
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	State       struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+	} `json:"state"`
+	Team struct {
+		ID   string `json:"id"`
+		Key  string `json:"key"`
+		Name string `json:"name"`
+	} `json:"team"`
+	Project *struct {
+		Name string `json:"name"`
+	} `json:"project"`
+	Cycle *struct {
+		EndsAt time.Time `json:"endsAt"`
+	} `json:"cycle"`
+	Labels struct {
+		Nodes []struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"labels"`
+}
+
+// Due returns the issue's due date, or the end of its cycle if it has none
+func (i *Issue) Due() *time.Time {
+	if i.DueDate != "" {
+		if t, err := time.ParseInLocation("2006-01-02", i.DueDate, time.Local); err == nil {
+			return &t
+		}
+	}
+	if i.Cycle != nil && !i.Cycle.EndsAt.IsZero() {
+		t := i.Cycle.EndsAt.Local()
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+		return &t
+	}
+	return nil
+}
+
+// WorkflowState is one of a team's issue states
+type WorkflowState struct {
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	Type     string  `json:"type"`
+	Position float64 `json:"position"`
+}
+
+// Client talks to the Linear API with a personal API key
+type Client struct {
+	apiKey     string
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient creates a client. An empty url is Linear's own API.
+func NewClient(apiKey, url string) *Client {
+	if url == "" {
+		url = DefaultURL
+	}
+	return &Client{apiKey: apiKey, url: url, httpClient: &http.Client{Timeout: 30 * time.Second}}
+}
+
+const issueFields = `id identifier title description url priority dueDate createdAt updatedAt
+state { type name } team { id key name } project { name } cycle { endsAt }
+labels { nodes { name } }`
+
+// AssignedIssues returns the issues assigned to the API key's user. Done
+// and canceled issues are included, so their state can be synced.
+func (c *Client) AssignedIssues(ctx context.Context) ([]Issue, error) {
+	query := `query($after: String) {
+  viewer {
+    assignedIssues(first: 100, after: $after) {
+      nodes { ` + issueFields + ` }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`
+
+	var issues []Issue
+	var after *string
+	for {
+		var data struct {
+			Viewer struct {
+				AssignedIssues struct {
+					Nodes    []Issue `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"assignedIssues"`
+			} `json:"viewer"`
+		}
+		if err := c.do(ctx, query, map[string]any{"after": after}, &data); err != nil {
+			return nil, err
+		}
+		page := data.Viewer.AssignedIssues
+		issues = append(issues, page.Nodes...)
+		if !page.PageInfo.HasNextPage {
+			return issues, nil
+		}
+		after = &page.PageInfo.EndCursor
+	}
+}
+
+// TeamStates returns a team's workflow states, in board order
+func (c *Client) TeamStates(ctx context.Context, teamID string) ([]WorkflowState, error) {
+	query := `query($id: String!) { team(id: $id) { states { nodes { id name type position } } } }`
+	var data struct {
+		Team struct {
+			States struct {
+				Nodes []WorkflowState `json:"nodes"`
+			} `json:"states"`
+		} `json:"team"`
+	}
+	if err := c.do(ctx, query, map[string]any{"id": teamID}, &data); err != nil {
+		return nil, err
+	}
+	states := data.Team.States.Nodes
+	sort.Slice(states, func(i, j int) bool { return states[i].Position < states[j].Position })
+	return states, nil
+}
+
+// SetState moves an issue to a workflow state
+func (c *Client) SetState(ctx context.Context, issueID, stateID string) error {
+	query := `mutation($id: String!, $state: String!) { issueUpdate(id: $id, input: { stateId: $state }) { success } }`
+	var data struct {
+		IssueUpdate struct {
+			Success bool `json:"success"`
+		} `json:"issueUpdate"`
+	}
+	if err := c.do(ctx, query, map[string]any{"id": issueID, "state": stateID}, &data); err != nil {
+		return err
+	}
+	if !data.IssueUpdate.Success {
+		return fmt.Errorf("Linear did not update the issue")
+	}
+	return nil
+}
+
+// do runs a GraphQL request and decodes its data into v
+func (c *Client) do(ctx context.Context, query string, variables map[string]any, v any) error {
+	body, err := json.Marshal(map[string]any{"query": query, "variables": variables})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("Linear request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return fmt.Errorf("Linear request failed: %s: %s", resp.Status, strings.TrimSpace(string(data)))
+	}
+	if len(result.Errors) > 0 {
+		msgs := make([]string, len(result.Errors))
+		for i, e := range result.Errors {
+			msgs[i] = e.Message
+		}
+		return fmt.Errorf("Linear API error: %s", strings.Join(msgs, "; "))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Linear request failed: %s", resp.Status)
+	}
+	return json.Unmarshal(result.Data, v)
+}
@@ -0,0 +1,120 @@
+// Package omnifocus reads projects and tasks from OmniFocus on macOS via
+// JavaScript for Automation.
+package omnifocus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"time"
+)
+
+// Project is an OmniFocus project. Folder is the top-level folder, which
+// maps to a reorg area.
+type Project struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Note      string     `json:"note"`
+	Folder    string     `json:"folder"`
+	Due       *time.Time `json:"due"`
+	Completed bool       `json:"completed"`
+	Dropped   bool       `json:"dropped"`
+}
+
+// Task is an OmniFocus action. Project is empty for inbox actions.
+type Task struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Note      string     `json:"note"`
+	Project   string     `json:"project"`
+	Folder    string     `json:"folder"`
+	Tags      []string   `json:"tags"`
+	Due       *time.Time `json:"due"`
+	Flagged   bool       `json:"flagged"`
+	Completed bool       `json:"completed"`
+	Dropped   bool       `json:"dropped"`
+	Created   *time.Time `json:"created"`
+	Modified  *time.Time `json:"modified"`
+}
+
+// Database is what was read from OmniFocus
+type Database struct {
+	Projects []Project `json:"projects"`
+	Tasks    []Task    `json:"tasks"`
+}
+
+// script collects projects and actions as JSON. Dates are serialized as
+// ISO 8601 by JSON.stringify.
+const script = `
+const of = Application("OmniFocus");
+const doc = of.defaultDocument;
+
+function topFolder(p) {
+	let folder = null;
+	try {
+		let c = p.container();
+		while (c && c.class() === "folder") { folder = c; c = c.container(); }
+	} catch (e) {}
+	return folder ? folder.name() : "";
+}
+
+const projects = doc.flattenedProjects().map(p => ({
+	id: p.id(),
+	name: p.name(),
+	note: p.note(),
+	folder: topFolder(p),
+	due: p.dueDate(),
+	completed: p.completed(),
+	dropped: String(p.status()) === "dropped status",
+}));
+
+const tasks = doc.flattenedTasks().filter(t => {
+	try { return t.containingProject() === null || t.containingProject().rootTask().id() !== t.id(); }
+	catch (e) { return true; }
+}).map(t => {
+	const project = t.containingProject();
+	return {
+		id: t.id(),
+		name: t.name(),
+		note: t.note(),
+		project: project ? project.name() : "",
+		folder: project ? topFolder(project) : "",
+		tags: t.tags().map(tag => tag.name()),
+		due: t.dueDate(),
+		flagged: t.flagged(),
+		completed: t.completed(),
+		dropped: t.dropped ? t.dropped() : false,
+		created: t.creationDate(),
+		modified: t.modificationDate(),
+	};
+});
+
+JSON.stringify({projects: projects, tasks: tasks});
+`
+
+// Reader reads from the running OmniFocus application
+type Reader struct{}
+
+// NewReader creates a new OmniFocus reader
+func NewReader() *Reader {
+	return &Reader{}
+}
+
+// Read returns all projects and actions
+func (r *Reader) Read(ctx context.Context) (*Database, error) {
+	cmd := exec.CommandContext(ctx, "osascript", "-l", "JavaScript", "-e", script)
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return nil, fmt.Errorf("osascript error: %s", string(exitErr.Stderr))
+		}
+		return nil, fmt.Errorf("failed to execute osascript: %w", err)
+	}
+
+	var db Database
+	if err := json.Unmarshal(output, &db); err != nil {
+		return nil, fmt.Errorf("failed to parse OmniFocus data: %w", err)
+	}
+	return &db, nil
+}
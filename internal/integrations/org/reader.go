@@ -0,0 +1,406 @@
+// Package org reads Emacs org-mode files and extracts their TODO headings
+// with priorities, tags and SCHEDULED/DEADLINE timestamps.
+package org
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DefaultKeywords are the TODO keywords recognised when a file doesn't set
+// its own with #+TODO. Keywords after "|" mark finished headings.
+var DefaultKeywords = Keywords{
+	Active: []string{"TODO", "NEXT", "STARTED", "WAITING", "HOLD"},
+	Done:   []string{"DONE", "CANCELLED", "CANCELED"},
+}
+
+// Keywords are the TODO states of a file
+type Keywords struct {
+	Active []string
+	Done   []string
+}
+
+// has reports whether word is one of the keywords
+func (k Keywords) has(word string) bool {
+	for _, list := range [][]string{k.Active, k.Done} {
+		for _, kw := range list {
+			if kw == word {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Heading is an outline heading
+type Heading struct {
+	Level    int
+	Keyword  string // TODO keyword, empty for plain headings
+	Done     bool   // the keyword is a done state
+	Priority string // A, B or C
+	Title    string
+	// Tags holds the heading's own tags followed by inherited ones
+	Tags       []string
+	Scheduled  *time.Time
+	Deadline   *time.Time
+	Closed     *time.Time
+	Properties map[string]string
+	Body       string
+	// Path lists the titles of the parent headings
+	Path []string
+	Line int
+}
+
+// IsTask returns true if the heading has a TODO keyword
+func (h Heading) IsTask() bool {
+	return h.Keyword != ""
+}
+
+// File is a parsed org file
+type File struct {
+	Path         string
+	RelativePath string
+	Title        string
+	Tags         []string
+	Headings     []Heading
+	ModTime      time.Time
+}
+
+// Tasks returns the headings that have a TODO keyword
+func (f *File) Tasks() []Heading {
+	var tasks []Heading
+	for _, h := range f.Headings {
+		if h.IsTask() {
+			tasks = append(tasks, h)
+		}
+	}
+	return tasks
+}
+
+// Reader reads org files from a directory or a single file
+type Reader struct {
+	root string
+}
+
+// NewReader creates a reader for a directory of .org files or a single file
+func NewReader(root string) (*Reader, error) {
+	// Expand ~ if present
+	if strings.HasPrefix(root, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get home directory: %w", err)
+		}
+		root = filepath.Join(home, root[2:])
+	}
+
+	if _, err := os.Stat(root); err != nil {
+		return nil, fmt.Errorf("org path not found: %w", err)
+	}
+
+	return &Reader{root: root}, nil
+}
+
+// ListFiles reads every .org file under the root, skipping hidden
+// directories
+func (r *Reader) ListFiles(ctx context.Context) ([]File, error) {
+	var files []File
+
+	err := filepath.WalkDir(r.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && path != r.root && strings.HasPrefix(d.Name(), ".") {
+			return fs.SkipDir
+		}
+		if d.IsDir() || !strings.HasSuffix(strings.ToLower(d.Name()), ".org") {
+			return nil
+		}
+
+		file, err := r.ReadFile(ctx, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, *file)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read org files: %w", err)
+	}
+
+	return files, nil
+}
+
+// ReadFile reads and parses one org file
+func (r *Reader) ReadFile(ctx context.Context, path string) (*File, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	file.Path = path
+	file.ModTime = info.ModTime()
+	if rel, err := filepath.Rel(r.root, path); err == nil && rel != "." {
+		file.RelativePath = rel
+	} else {
+		file.RelativePath = filepath.Base(path)
+	}
+	if file.Title == "" {
+		file.Title = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return file, nil
+}
+
+var (
+	headingPattern  = regexp.MustCompile(`^(\*+)\s+(.*)$`)
+	priorityPattern = regexp.MustCompile(`^\[#([A-Za-z])\]\s*`)
+	tagsPattern     = regexp.MustCompile(`\s+(:[\w@#%:]+:)\s*$`)
+	planningPattern = regexp.MustCompile(`(SCHEDULED|DEADLINE|CLOSED):\s*[<\[](\d{4}-\d{2}-\d{2})(?:\s+[^\s>\]\d][^\s>\]]*)?(?:\s+(\d{1,2}:\d{2}))?[^>\]]*[>\]]`)
+	propertyPattern = regexp.MustCompile(`^:([^:\s]+):\s*(.*)$`)
+)
+
+// Parse parses org content
+func Parse(r io.Reader) (*File, error) {
+	file := &File{}
+	keywords := DefaultKeywords
+	customKeywords := false
+
+	var (
+		current    *Heading
+		body       []string
+		stack      []Heading // open parent headings
+		inDrawer   bool
+		afterTitle bool // the previous line was the heading itself
+	)
+
+	finish := func() {
+		if current == nil {
+			return
+		}
+		current.Body = strings.TrimSpace(strings.Join(body, "\n"))
+		file.Headings = append(file.Headings, *current)
+		current, body = nil, nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		trimmed := strings.TrimSpace(text)
+
+		// In-buffer settings
+		if current == nil {
+			if value, ok := setting(trimmed, "TITLE"); ok {
+				file.Title = value
+				continue
+			}
+			if value, ok := setting(trimmed, "FILETAGS"); ok {
+				file.Tags = splitTags(value)
+				continue
+			}
+		}
+		if value, ok := setting(trimmed, "TODO"); ok {
+			if !customKeywords {
+				keywords = Keywords{}
+				customKeywords = true
+			}
+			parseKeywords(value, &keywords)
+			continue
+		}
+		if value, ok := setting(trimmed, "SEQ_TODO"); ok {
+			if !customKeywords {
+				keywords = Keywords{}
+				customKeywords = true
+			}
+			parseKeywords(value, &keywords)
+			continue
+		}
+
+		if m := headingPattern.FindStringSubmatch(text); m != nil {
+			finish()
+			h := parseHeading(len(m[1]), m[2], keywords)
+			h.Line = line
+
+			for len(stack) > 0 && stack[len(stack)-1].Level >= h.Level {
+				stack = stack[:len(stack)-1]
+			}
+			for i := len(stack) - 1; i >= 0; i-- {
+				h.Path = append([]string{stack[i].Title}, h.Path...)
+				h.Tags = appendUnique(h.Tags, stack[i].Tags...)
+			}
+			h.Tags = appendUnique(h.Tags, file.Tags...)
+
+			stack = append(stack, h)
+			current = &h
+			inDrawer = false
+			afterTitle = true
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+
+		// The planning line directly follows the heading
+		if afterTitle && planningPattern.MatchString(trimmed) {
+			for _, m := range planningPattern.FindAllStringSubmatch(trimmed, -1) {
+				t := parseTimestamp(m[2], m[3])
+				switch m[1] {
+				case "SCHEDULED":
+					current.Scheduled = t
+				case "DEADLINE":
+					current.Deadline = t
+				case "CLOSED":
+					current.Closed = t
+				}
+			}
+			continue
+		}
+		afterTitle = false
+
+		// Drawers such as :PROPERTIES: and :LOGBOOK: are not part of the body
+		if !inDrawer && strings.HasPrefix(trimmed, ":") && strings.HasSuffix(trimmed, ":") && len(trimmed) > 2 && !strings.Contains(trimmed[1:len(trimmed)-1], ":") {
+			inDrawer = true
+			continue
+		}
+		if inDrawer {
+			if strings.EqualFold(trimmed, ":END:") {
+				inDrawer = false
+				continue
+			}
+			if m := propertyPattern.FindStringSubmatch(trimmed); m != nil {
+				if current.Properties == nil {
+					current.Properties = make(map[string]string)
+				}
+				current.Properties[strings.ToUpper(m[1])] = m[2]
+			}
+			continue
+		}
+
+		body = append(body, text)
+	}
+	finish()
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// parseHeading splits a heading line into keyword, priority, title and tags
+func parseHeading(level int, text string, keywords Keywords) Heading {
+	h := Heading{Level: level}
+
+	if m := tagsPattern.FindStringSubmatchIndex(text); m != nil {
+		h.Tags = splitTags(text[m[2]:m[3]])
+		text = text[:m[0]]
+	}
+
+	if word, rest, _ := strings.Cut(text, " "); keywords.has(word) {
+		h.Keyword = word
+		text = strings.TrimSpace(rest)
+		for _, done := range keywords.Done {
+			if done == word {
+				h.Done = true
+			}
+		}
+	}
+
+	if m := priorityPattern.FindStringSubmatch(text); m != nil {
+		h.Priority = strings.ToUpper(m[1])
+		text = text[len(m[0]):]
+	}
+
+	h.Title = strings.TrimSpace(text)
+	return h
+}
+
+// setting returns the value of a "#+NAME: value" line
+func setting(line, name string) (string, bool) {
+	prefix := "#+" + name + ":"
+	if len(line) < len(prefix) || !strings.EqualFold(line[:len(prefix)], prefix) {
+		return "", false
+	}
+	return strings.TrimSpace(line[len(prefix):]), true
+}
+
+// parseKeywords adds the states of a #+TODO line, e.g. "TODO NEXT | DONE".
+// Fast-access keys like "TODO(t)" are stripped.
+func parseKeywords(value string, keywords *Keywords) {
+	active, done, hasDone := strings.Cut(value, "|")
+	words := strings.Fields(active)
+	if !hasDone && len(words) > 0 {
+		// Without a separator the last state is the done state
+		done = words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+
+	strip := func(word string) string {
+		if i := strings.Index(word, "("); i > 0 {
+			return word[:i]
+		}
+		return word
+	}
+	for _, w := range words {
+		keywords.Active = append(keywords.Active, strip(w))
+	}
+	for _, w := range strings.Fields(done) {
+		keywords.Done = append(keywords.Done, strip(w))
+	}
+}
+
+// parseTimestamp parses the date and optional time of an org timestamp
+func parseTimestamp(date, clock string) *time.Time {
+	layout, value := "2006-01-02", date
+	if clock != "" {
+		layout, value = "2006-01-02 15:04", date+" "+clock
+	}
+	t, err := time.ParseInLocation(layout, value, time.Local)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// splitTags splits ":work:urgent:" or "work urgent" into tags
+func splitTags(s string) []string {
+	var tags []string
+	for _, tag := range strings.FieldsFunc(s, func(r rune) bool { return r == ':' || r == ' ' }) {
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+func appendUnique(tags []string, more ...string) []string {
+	for _, tag := range more {
+		found := false
+		for _, t := range tags {
+			if t == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
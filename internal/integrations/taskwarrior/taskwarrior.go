@@ -0,0 +1,416 @@
+// Package taskwarrior reads and writes the JSON format used by Taskwarrior's
+// `task export` and `task import`, and maps it to reorg tasks.
+package taskwarrior
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// TimeFormat is the timestamp format Taskwarrior uses in JSON
+const TimeFormat = "20060102T150405Z"
+
+// Metadata keys for Taskwarrior fields that reorg has no field for. UDAs are
+// stored as MetaUDAPrefix + name. MetaScheduled is only read, from tasks
+// imported before tasks had scheduled dates.
+const (
+	MetaUUID      = "taskwarrior.uuid"
+	MetaScheduled = "taskwarrior.scheduled"
+	MetaWait      = "taskwarrior.wait"
+	MetaUDAPrefix = "taskwarrior.uda."
+)
+
+// Task is a task in Taskwarrior's JSON format
+type Task struct {
+	UUID        string
+	Description string
+	Status      string // pending, waiting, completed, deleted, recurring
+	Project     string
+	Tags        []string
+	Priority    string // H, M, L
+	Due         *time.Time
+	Entry       *time.Time
+	Modified    *time.Time
+	Start       *time.Time
+	End         *time.Time
+	Scheduled   *time.Time
+	Wait        *time.Time
+	Recur       string
+	Depends     []string
+	Annotations []Annotation
+	// UDAs holds user defined attributes and any other fields not listed
+	// above, as their string values
+	UDAs map[string]string
+}
+
+// Annotation is a timestamped note on a task
+type Annotation struct {
+	Entry       *time.Time
+	Description string
+}
+
+// ignored are computed or internal fields that are not kept on import
+var ignored = map[string]bool{
+	"id": true, "urgency": true, "mask": true, "imask": true, "parent": true,
+}
+
+// UnmarshalJSON decodes a task, keeping unknown fields as UDAs
+func (t *Task) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	var err error
+	str := func(key string) string {
+		var s string
+		if raw, ok := fields[key]; ok && err == nil {
+			err = json.Unmarshal(raw, &s)
+		}
+		return s
+	}
+	date := func(key string) *time.Time {
+		s := str(key)
+		if s == "" || err != nil {
+			return nil
+		}
+		parsed, perr := time.Parse(TimeFormat, s)
+		if perr != nil {
+			err = fmt.Errorf("invalid %s %q: %w", key, s, perr)
+			return nil
+		}
+		return &parsed
+	}
+
+	t.UUID = str("uuid")
+	t.Description = str("description")
+	t.Status = str("status")
+	t.Project = str("project")
+	t.Priority = str("priority")
+	t.Recur = str("recur")
+	t.Due = date("due")
+	t.Entry = date("entry")
+	t.Modified = date("modified")
+	t.Start = date("start")
+	t.End = date("end")
+	t.Scheduled = date("scheduled")
+	t.Wait = date("wait")
+	if err != nil {
+		return err
+	}
+
+	if raw, ok := fields["tags"]; ok {
+		if err := json.Unmarshal(raw, &t.Tags); err != nil {
+			return fmt.Errorf("invalid tags: %w", err)
+		}
+	}
+
+	// Older versions write depends as a comma separated string
+	if raw, ok := fields["depends"]; ok {
+		if err := json.Unmarshal(raw, &t.Depends); err != nil {
+			var s string
+			if err := json.Unmarshal(raw, &s); err != nil {
+				return fmt.Errorf("invalid depends: %w", err)
+			}
+			t.Depends = strings.Split(s, ",")
+		}
+	}
+
+	if raw, ok := fields["annotations"]; ok {
+		var annotations []struct {
+			Entry       string `json:"entry"`
+			Description string `json:"description"`
+		}
+		if err := json.Unmarshal(raw, &annotations); err != nil {
+			return fmt.Errorf("invalid annotations: %w", err)
+		}
+		for _, a := range annotations {
+			annotation := Annotation{Description: a.Description}
+			if entry, err := time.Parse(TimeFormat, a.Entry); err == nil {
+				annotation.Entry = &entry
+			}
+			t.Annotations = append(t.Annotations, annotation)
+		}
+	}
+
+	known := []string{"uuid", "description", "status", "project", "priority", "recur", "due", "entry",
+		"modified", "start", "end", "scheduled", "wait", "tags", "depends", "annotations"}
+	for _, key := range known {
+		delete(fields, key)
+	}
+	for key, raw := range fields {
+		if ignored[key] {
+			continue
+		}
+		if t.UDAs == nil {
+			t.UDAs = make(map[string]string)
+		}
+		var s string
+		if json.Unmarshal(raw, &s) == nil {
+			t.UDAs[key] = s
+		} else {
+			t.UDAs[key] = string(raw)
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes a task in the format `task import` accepts
+func (t Task) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]any)
+	for key, value := range t.UDAs {
+		fields[key] = value
+	}
+
+	set := func(key, value string) {
+		if value != "" {
+			fields[key] = value
+		}
+	}
+	setDate := func(key string, value *time.Time) {
+		if value != nil {
+			fields[key] = value.UTC().Format(TimeFormat)
+		}
+	}
+
+	set("uuid", t.UUID)
+	set("description", t.Description)
+	set("status", t.Status)
+	set("project", t.Project)
+	set("priority", t.Priority)
+	set("recur", t.Recur)
+	setDate("due", t.Due)
+	setDate("entry", t.Entry)
+	setDate("modified", t.Modified)
+	setDate("start", t.Start)
+	setDate("end", t.End)
+	setDate("scheduled", t.Scheduled)
+	setDate("wait", t.Wait)
+	if len(t.Tags) > 0 {
+		fields["tags"] = t.Tags
+	}
+	if len(t.Depends) > 0 {
+		fields["depends"] = t.Depends
+	}
+	if len(t.Annotations) > 0 {
+		var annotations []map[string]string
+		for _, a := range t.Annotations {
+			annotation := map[string]string{"description": a.Description}
+			if a.Entry != nil {
+				annotation["entry"] = a.Entry.UTC().Format(TimeFormat)
+			}
+			annotations = append(annotations, annotation)
+		}
+		fields["annotations"] = annotations
+	}
+
+	return json.Marshal(fields)
+}
+
+// Read parses `task export` output, which is either a JSON array or, from
+// older versions, one JSON object per line
+func Read(r io.Reader) ([]Task, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var tasks []Task
+	if data[0] == '[' {
+		if err := json.Unmarshal(data, &tasks); err != nil {
+			return nil, fmt.Errorf("failed to parse Taskwarrior export: %w", err)
+		}
+		return tasks, nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSuffix(strings.TrimSpace(scanner.Text()), ",")
+		if text == "" {
+			continue
+		}
+		var t Task
+		if err := json.Unmarshal([]byte(text), &t); err != nil {
+			return nil, fmt.Errorf("failed to parse Taskwarrior export line %d: %w", line, err)
+		}
+		tasks = append(tasks, t)
+	}
+	return tasks, scanner.Err()
+}
+
+// Write writes tasks as a JSON array for `task import`
+func Write(w io.Writer, tasks []Task) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(tasks)
+}
+
+// TaskStatus maps the task's status to reorg. Recurring templates have no
+// equivalent and return false.
+func (t Task) TaskStatus() (domain.TaskStatus, bool) {
+	switch t.Status {
+	case "completed":
+		return domain.TaskStatusCompleted, true
+	case "deleted":
+		return domain.TaskStatusCancelled, true
+	case "recurring":
+		return "", false
+	}
+	if t.Start != nil {
+		return domain.TaskStatusInProgress, true
+	}
+	return domain.TaskStatusPending, true
+}
+
+// TaskPriority maps H, M and L to reorg priorities
+func (t Task) TaskPriority() domain.Priority {
+	switch strings.ToUpper(t.Priority) {
+	case "H":
+		return domain.PriorityHigh
+	case "L":
+		return domain.PriorityLow
+	default:
+		return domain.PriorityMedium
+	}
+}
+
+// ToDomain converts the task to a reorg task in the given project. Fields
+// without a reorg equivalent are kept in metadata so they survive a round
+// trip. Dependencies are left for the caller, which knows the new IDs.
+func (t Task) ToDomain(projectID, areaID string) *domain.Task {
+	task := domain.NewTask(t.Description, projectID, areaID)
+	if status, ok := t.TaskStatus(); ok {
+		task.Status = status
+	}
+	task.Priority = t.TaskPriority()
+	task.DueDate = t.Due
+	// Taskwarrior has no all-day dates; dates given without a time are
+	// local midnight
+	task.AllDay = t.Due != nil && domain.IsMidnight(t.Due.Local())
+	for _, tag := range t.Tags {
+		task.AddTag(tag)
+	}
+	if t.Recur != "" {
+		recur := t.Recur
+		task.Recurrence = &recur
+	}
+	if t.Entry != nil {
+		task.Created = *t.Entry
+	}
+	if t.Modified != nil {
+		task.Updated = *t.Modified
+	}
+
+	if len(t.Annotations) > 0 {
+		var b strings.Builder
+		fmt.Fprintf(&b, "# %s\n\n## Annotations\n\n", t.Description)
+		for _, a := range t.Annotations {
+			if a.Entry != nil {
+				fmt.Fprintf(&b, "- %s: %s\n", a.Entry.Local().Format("2006-01-02"), a.Description)
+			} else {
+				fmt.Fprintf(&b, "- %s\n", a.Description)
+			}
+		}
+		task.Content = b.String()
+	}
+
+	task.Metadata[MetaUUID] = t.UUID
+	task.ScheduledDate = t.Scheduled
+	if t.Wait != nil {
+		task.Metadata[MetaWait] = t.Wait.UTC().Format(TimeFormat)
+	}
+	for key, value := range t.UDAs {
+		task.Metadata[MetaUDAPrefix+key] = value
+	}
+	return task
+}
+
+// UUID returns the Taskwarrior UUID for a reorg task: the one it was imported
+// with, or a stable one derived from its ID
+func UUID(task *domain.Task) string {
+	if id := task.Metadata[MetaUUID]; id != "" {
+		return id
+	}
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte("reorg:"+task.ID)).String()
+}
+
+// FromDomain converts a reorg task to Taskwarrior. project is the
+// Taskwarrior project name; dependencies are resolved with uuidOf.
+func FromDomain(task *domain.Task, project string, uuidOf func(id string) string) Task {
+	t := Task{
+		UUID:        UUID(task),
+		Description: task.Title,
+		Project:     project,
+		Tags:        task.Tags,
+		Due:         task.DueDate,
+		Status:      "pending",
+	}
+
+	created, updated := task.Created, task.Updated
+	t.Entry, t.Modified = &created, &updated
+
+	switch task.Status {
+	case domain.TaskStatusCompleted:
+		t.Status, t.End = "completed", &updated
+	case domain.TaskStatusCancelled:
+		t.Status, t.End = "deleted", &updated
+	case domain.TaskStatusInProgress:
+		t.Start = &updated
+	}
+
+	switch task.Priority {
+	case domain.PriorityUrgent, domain.PriorityHigh:
+		t.Priority = "H"
+	case domain.PriorityLow:
+		t.Priority = "L"
+	case domain.PriorityMedium:
+		t.Priority = "M"
+	}
+
+	if task.Recurrence != nil {
+		t.Recur = *task.Recurrence
+	}
+	for _, dep := range task.Dependencies {
+		t.Depends = append(t.Depends, uuidOf(dep))
+	}
+	sort.Strings(t.Depends)
+
+	t.Scheduled = task.ScheduledDate
+	if s := task.Metadata[MetaScheduled]; s != "" && t.Scheduled == nil {
+		if parsed, err := time.Parse(TimeFormat, s); err == nil {
+			t.Scheduled = &parsed
+		}
+	}
+	if s := task.Metadata[MetaWait]; s != "" {
+		if parsed, err := time.Parse(TimeFormat, s); err == nil {
+			t.Wait = &parsed
+		}
+	}
+	for key, value := range task.Metadata {
+		if name, ok := strings.CutPrefix(key, MetaUDAPrefix); ok {
+			if t.UDAs == nil {
+				t.UDAs = make(map[string]string)
+			}
+			t.UDAs[name] = value
+		}
+	}
+
+	return t
+}
@@ -0,0 +1,237 @@
+// Package things reads areas, projects and to-dos from the Things 3
+// database on macOS. The database is queried with the sqlite3 command line
+// tool, which ships with macOS.
+package things
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Status is the state of a to-do or project
+type Status int
+
+// Values of TMTask.status
+const (
+	StatusOpen      Status = 0
+	StatusCancelled Status = 2
+	StatusCompleted Status = 3
+)
+
+// Project is a Things project
+type Project struct {
+	UUID     string
+	Title    string
+	Notes    string
+	Area     string
+	Tags     []string
+	Deadline *time.Time
+	Status   Status
+	Created  time.Time
+	Modified time.Time
+}
+
+// Task is a Things to-do. Project and Area are titles; a to-do under a
+// heading belongs to the heading's project.
+type Task struct {
+	UUID     string
+	Title    string
+	Notes    string
+	Project  string
+	Area     string
+	Tags     []string
+	Deadline *time.Time
+	Status   Status
+	Created  time.Time
+	Modified time.Time
+}
+
+// Database is the content of a Things database
+type Database struct {
+	Areas    []string
+	Projects []Project
+	Tasks    []Task
+}
+
+// DefaultPath finds the Things 3 database in the user's group containers
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	pattern := filepath.Join(home, "Library", "Group Containers", "JLMPQHK86H.com.culturedcode.ThingsMac",
+		"*", "Things Database.thingsdatabase", "main.sqlite")
+	matches, _ := filepath.Glob(pattern)
+	if len(matches) == 0 {
+		return "", fmt.Errorf("Things 3 database not found (looked for %s)", pattern)
+	}
+	return matches[0], nil
+}
+
+// row is a TMTask row as returned by the query
+type row struct {
+	UUID     string   `json:"uuid"`
+	Type     int      `json:"type"` // 0 to-do, 1 project, 2 heading
+	Title    string   `json:"title"`
+	Notes    *string  `json:"notes"`
+	Status   int      `json:"status"`
+	Area     *string  `json:"area"`
+	Project  *string  `json:"project"`
+	Heading  *string  `json:"heading"`
+	Deadline *int64   `json:"deadline"`
+	Created  *float64 `json:"creationDate"`
+	Modified *float64 `json:"userModificationDate"`
+	Tags     *string  `json:"tags"`
+}
+
+// query lists everything that isn't trashed, with tags joined by newlines
+const query = `
+SELECT t.uuid, t.type, t.title, t.notes, t.status, t.area, t.project, t.heading,
+       t.deadline, t.creationDate, t.userModificationDate,
+       (SELECT group_concat(g.title, char(10)) FROM TMTaskTag tt JOIN TMTag g ON g.uuid = tt.tags
+        WHERE tt.tasks = t.uuid) AS tags
+FROM TMTask t
+WHERE t.trashed = 0;`
+
+// Read loads the database at path
+func Read(ctx context.Context, path string) (*Database, error) {
+	areaRows, err := run[struct {
+		UUID  string `json:"uuid"`
+		Title string `json:"title"`
+	}](ctx, path, `SELECT uuid, title FROM TMArea ORDER BY "index";`)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := run[row](ctx, path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	areas := make(map[string]string, len(areaRows))
+	db := &Database{}
+	for _, a := range areaRows {
+		areas[a.UUID] = a.Title
+		db.Areas = append(db.Areas, a.Title)
+	}
+
+	byUUID := make(map[string]row, len(rows))
+	for _, r := range rows {
+		byUUID[r.UUID] = r
+	}
+
+	for _, r := range rows {
+		switch r.Type {
+		case 1:
+			db.Projects = append(db.Projects, Project{
+				UUID:     r.UUID,
+				Title:    r.Title,
+				Notes:    deref(r.Notes),
+				Area:     areas[deref(r.Area)],
+				Tags:     splitTags(r.Tags),
+				Deadline: decodeDate(r.Deadline),
+				Status:   Status(r.Status),
+				Created:  decodeTimestamp(r.Created),
+				Modified: decodeTimestamp(r.Modified),
+			})
+
+		case 0:
+			projectUUID := deref(r.Project)
+			if projectUUID == "" && r.Heading != nil {
+				projectUUID = deref(byUUID[*r.Heading].Project)
+			}
+			project := byUUID[projectUUID]
+
+			area := areas[deref(r.Area)]
+			if area == "" {
+				area = areas[deref(project.Area)]
+			}
+
+			db.Tasks = append(db.Tasks, Task{
+				UUID:     r.UUID,
+				Title:    r.Title,
+				Notes:    deref(r.Notes),
+				Project:  project.Title,
+				Area:     area,
+				Tags:     splitTags(r.Tags),
+				Deadline: decodeDate(r.Deadline),
+				Status:   Status(r.Status),
+				Created:  decodeTimestamp(r.Created),
+				Modified: decodeTimestamp(r.Modified),
+			})
+		}
+	}
+
+	return db, nil
+}
+
+// run executes a query with sqlite3 in read-only JSON mode
+func run[T any](ctx context.Context, path, query string) ([]T, error) {
+	cmd := exec.CommandContext(ctx, "sqlite3", "-readonly", "-json", path, query)
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return nil, fmt.Errorf("sqlite3 error: %s", strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, fmt.Errorf("failed to execute sqlite3: %w", err)
+	}
+
+	var rows []T
+	if len(strings.TrimSpace(string(output))) == 0 {
+		return rows, nil
+	}
+	if err := json.Unmarshal(output, &rows); err != nil {
+		return nil, fmt.Errorf("failed to parse sqlite3 output: %w", err)
+	}
+	return rows, nil
+}
+
+// decodeDate decodes a deadline. Things 3.15 and later pack dates as
+// year<<16 | month<<12 | day<<7; older versions store a Unix timestamp.
+func decodeDate(v *int64) *time.Time {
+	if v == nil || *v == 0 {
+		return nil
+	}
+
+	var t time.Time
+	if *v > 1_000_000_000 {
+		t = time.Unix(*v, 0)
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+	} else {
+		year := int(*v >> 16)
+		month := time.Month((*v >> 12) & 0xF)
+		day := int((*v >> 7) & 0x1F)
+		t = time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+	}
+	return &t
+}
+
+// decodeTimestamp decodes a Unix timestamp with fractional seconds
+func decodeTimestamp(v *float64) time.Time {
+	if v == nil {
+		return time.Time{}
+	}
+	sec := int64(*v)
+	return time.Unix(sec, int64((*v-float64(sec))*1e9))
+}
+
+func splitTags(s *string) []string {
+	if s == nil || *s == "" {
+		return nil
+	}
+	return strings.Split(*s, "\n")
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
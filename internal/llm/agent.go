@@ -0,0 +1,220 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// maxToolCalls is how many tools an Agent calls for one message before
+// giving up on a reply
+const maxToolCalls = 8
+
+// Tool is something an Agent can do for the user
+type Tool struct {
+	Name        string
+	Description string
+
+	// Arguments describes the JSON arguments, e.g.
+	// {"task": "task ID or slug"}
+	Arguments string
+
+	Run func(ctx context.Context, args json.RawMessage) (string, error)
+}
+
+// ToolCall is a tool the model asked for
+type ToolCall struct {
+	Name      string          `json:"tool"`
+	Arguments json.RawMessage `json:"arguments,omitempty"`
+}
+
+// Agent holds a conversation in which the model can call tools. It works
+// with any Client: tools are described in the prompt and the model answers
+// in JSON with either a tool call or a reply, so no provider-specific
+// function calling is needed.
+type Agent struct {
+	client Client
+	tools  []Tool
+
+	// OnToolCall, if set, is told about each tool call and its result
+	OnToolCall func(call ToolCall, result string, err error)
+
+	transcript []string
+}
+
+// NewAgent creates an agent using client with tools
+func NewAgent(client Client, tools []Tool) *Agent {
+	return &Agent{client: client, tools: tools}
+}
+
+// Send adds a user message to the conversation, calls the tools the model
+// asks for, and returns its reply
+func (a *Agent) Send(ctx context.Context, message string) (string, error) {
+	return a.SendStream(ctx, message, nil)
+}
+
+// SendStream is Send, passing the reply to onText as it is generated. By
+// the time SendStream returns, onText has been given the whole reply.
+func (a *Agent) SendStream(ctx context.Context, message string, onText func(string)) (string, error) {
+	a.transcript = append(a.transcript, "User: "+message)
+
+	for calls := 0; ; calls++ {
+		var stream replyStream
+		response, err := a.respond(ctx, a.prompt(calls >= maxToolCalls), &stream, onText)
+		if err != nil {
+			return "", err
+		}
+
+		var step struct {
+			ToolCall
+			Reply string `json:"reply"`
+		}
+		if json.Unmarshal([]byte(extractJSON(response)), &step) != nil || (step.Name == "" && step.Reply == "") {
+			// Not following the format; take the text as the reply
+			step.Name, step.Reply = "", strings.TrimSpace(response)
+		}
+		if step.Name == "" || calls >= maxToolCalls {
+			if onText != nil && strings.HasPrefix(step.Reply, stream.sent) {
+				if rest := step.Reply[len(stream.sent):]; rest != "" {
+					onText(rest)
+				}
+			}
+			a.transcript = append(a.transcript, "Assistant: "+step.Reply)
+			return step.Reply, nil
+		}
+
+		result, err := a.call(ctx, step.ToolCall)
+		if a.OnToolCall != nil {
+			a.OnToolCall(step.ToolCall, result, err)
+		}
+		if err != nil {
+			result = "error: " + err.Error()
+		}
+		a.transcript = append(a.transcript,
+			fmt.Sprintf("Tool call: %s %s", step.Name, string(step.Arguments)),
+			"Tool result: "+result)
+	}
+}
+
+// respond gets the model's response to prompt, passing the reply text in it
+// to onText as it streams in
+func (a *Agent) respond(ctx context.Context, prompt string, stream *replyStream, onText func(string)) (string, error) {
+	if onText == nil {
+		return a.client.Chat(ctx, prompt)
+	}
+
+	for chunk, err := range StreamChat(ctx, a.client, prompt) {
+		if err != nil {
+			return "", err
+		}
+		if text := stream.write(chunk); text != "" {
+			onText(text)
+		}
+	}
+	return stream.raw.String(), nil
+}
+
+// replyPattern finds the start of the reply string in a response
+var replyPattern = regexp.MustCompile(`"reply"\s*:\s*"`)
+
+// replyStream picks the reply out of a response as it arrives, so it can
+// be shown before the JSON around it is complete
+type replyStream struct {
+	raw  strings.Builder
+	pos  int // start of the undecoded reply, 0 until the reply is found
+	done bool
+	sent string
+}
+
+// write adds a chunk of the response, returning any more of the reply
+// that can be decoded
+func (r *replyStream) write(chunk string) string {
+	r.raw.WriteString(chunk)
+	if r.done {
+		return ""
+	}
+
+	raw := r.raw.String()
+	if r.pos == 0 {
+		loc := replyPattern.FindStringIndex(raw)
+		if loc == nil {
+			return ""
+		}
+		r.pos = loc[1]
+	}
+
+	var b strings.Builder
+	for r.pos < len(raw) {
+		c := raw[r.pos]
+		if c == '"' {
+			r.done = true
+			break
+		}
+		if c != '\\' {
+			b.WriteByte(c)
+			r.pos++
+			continue
+		}
+
+		// Escapes are decoded once complete
+		n := 2
+		if r.pos+1 < len(raw) && raw[r.pos+1] == 'u' {
+			n = 6
+			if r.pos+6 <= len(raw) && (raw[r.pos+2] == 'd' || raw[r.pos+2] == 'D') && strings.ContainsRune("89abAB", rune(raw[r.pos+3])) {
+				// A surrogate pair
+				n = 12
+			}
+		}
+		if r.pos+n > len(raw) {
+			break
+		}
+		var s string
+		if json.Unmarshal([]byte(`"`+raw[r.pos:r.pos+n]+`"`), &s) == nil {
+			b.WriteString(s)
+		}
+		r.pos += n
+	}
+
+	r.sent += b.String()
+	return b.String()
+}
+
+func (a *Agent) call(ctx context.Context, call ToolCall) (string, error) {
+	for _, t := range a.tools {
+		if t.Name == call.Name {
+			args := call.Arguments
+			if len(args) == 0 {
+				args = json.RawMessage("{}")
+			}
+			return t.Run(ctx, args)
+		}
+	}
+	return "", fmt.Errorf("unknown tool %q", call.Name)
+}
+
+// prompt describes the tools and the format, followed by the conversation.
+// With final, the model must reply without calling more tools.
+func (a *Agent) prompt(final bool) string {
+	var b strings.Builder
+	b.WriteString("You are a personal organization assistant with access to the user's areas, projects and tasks through tools.\n\nTools:\n")
+	for _, t := range a.tools {
+		fmt.Fprintf(&b, "- %s: %s Arguments: %s\n", t.Name, t.Description, t.Arguments)
+	}
+	b.WriteString(`
+Respond with JSON only, no markdown formatting. Either call one tool:
+{"tool": "tool_name", "arguments": {...}}
+or reply to the user:
+{"reply": "your answer"}
+
+Tool results are added to the conversation. Use them to answer, and only
+say something was changed once a tool result confirms it. Be concise.
+`)
+	if final {
+		b.WriteString("\nYou have called enough tools; reply to the user now.\n")
+	}
+	b.WriteString("\nConversation:\n")
+	b.WriteString(strings.Join(a.transcript, "\n"))
+	return b.String()
+}
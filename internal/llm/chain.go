@@ -0,0 +1,244 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anthropics/anthropic-sdk-go"
+)
+
+// Circuit breaker defaults for ChainClient
+const (
+	DefaultBreakerFailures = 3
+	DefaultBreakerCooldown = time.Minute
+)
+
+// UnavailableError reports that a provider couldn't serve a request at all:
+// bad credentials, rate limiting, an outage or an unreachable server
+type UnavailableError struct {
+	Provider Provider
+	Err      error
+}
+
+func (e *UnavailableError) Error() string {
+	return fmt.Sprintf("%s unavailable: %v", e.Provider, e.Err)
+}
+
+func (e *UnavailableError) Unwrap() error {
+	return e.Err
+}
+
+// IsUnavailable reports whether err means the provider is down or refusing
+// requests, rather than that it gave a bad answer
+func IsUnavailable(err error) bool {
+	var unavailable *UnavailableError
+	if errors.As(err, &unavailable) {
+		return true
+	}
+
+	var apiErr *anthropic.Error
+	if errors.As(err, &apiErr) {
+		return unavailableStatus(apiErr.StatusCode)
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// unavailableStatus reports whether an HTTP status means the provider
+// can't be used right now
+func unavailableStatus(code int) bool {
+	return code == http.StatusUnauthorized ||
+		code == http.StatusForbidden ||
+		code == http.StatusTooManyRequests ||
+		code >= 500
+}
+
+// ChainClient tries an ordered list of clients, moving on to the next one
+// when a provider is unavailable. A provider that keeps failing is skipped
+// for a cooldown period (a circuit breaker) so every call doesn't wait on
+// it first.
+type ChainClient struct {
+	// Failures is how many unavailable errors in a row open a provider's
+	// breaker, and Cooldown how long it then stays open
+	Failures int
+	Cooldown time.Duration
+
+	mu      sync.Mutex
+	members []*chainMember
+	last    Provider
+}
+
+type chainMember struct {
+	client    Client
+	failures  int
+	openUntil time.Time
+}
+
+// NewChainClient creates a client trying clients in order
+func NewChainClient(clients ...Client) *ChainClient {
+	c := &ChainClient{
+		Failures: DefaultBreakerFailures,
+		Cooldown: DefaultBreakerCooldown,
+	}
+	for _, client := range clients {
+		c.members = append(c.members, &chainMember{client: client})
+	}
+	return c
+}
+
+// Provider returns the provider that served the last call, or the first
+// in the chain
+func (c *ChainClient) Provider() Provider {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.last != "" {
+		return c.last
+	}
+	if len(c.members) > 0 {
+		return c.members[0].client.Provider()
+	}
+	return ""
+}
+
+// Categorize analyzes text and returns categorization
+func (c *ChainClient) Categorize(ctx context.Context, content string) (*CategorizeResult, error) {
+	return chainCall(ctx, c, func(client Client) (*CategorizeResult, error) {
+		return client.Categorize(ctx, content)
+	})
+}
+
+// CategorizeWithContext analyzes text with knowledge of existing projects
+func (c *ChainClient) CategorizeWithContext(ctx context.Context, content string, existingProjects []ProjectContext) (*CategorizeResult, error) {
+	return chainCall(ctx, c, func(client Client) (*CategorizeResult, error) {
+		return client.CategorizeWithContext(ctx, content, existingProjects)
+	})
+}
+
+// ExtractTasks parses content and extracts actionable tasks
+func (c *ChainClient) ExtractTasks(ctx context.Context, content string) ([]ExtractedTask, error) {
+	return chainCall(ctx, c, func(client Client) ([]ExtractedTask, error) {
+		return client.ExtractTasks(ctx, content)
+	})
+}
+
+// Chat sends a message and returns the response
+func (c *ChainClient) Chat(ctx context.Context, message string) (string, error) {
+	return chainCall(ctx, c, func(client Client) (string, error) {
+		return client.Chat(ctx, message)
+	})
+}
+
+// ChatStream sends a message and yields the response as it is generated.
+// Providers are only failed over before the first chunk arrives; after that
+// an error ends the stream.
+func (c *ChainClient) ChatStream(ctx context.Context, message string) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		var failures []string
+		for _, m := range c.members {
+			if !c.closed(m) {
+				failures = append(failures, fmt.Sprintf("%s skipped after repeated failures", m.client.Provider()))
+				continue
+			}
+
+			started, unavailable := false, false
+			for chunk, err := range StreamChat(ctx, m.client, message) {
+				if err == nil {
+					started = true
+					if !yield(chunk, nil) {
+						c.succeeded(m)
+						return
+					}
+					continue
+				}
+				if started || ctx.Err() != nil || !IsUnavailable(err) {
+					yield("", err)
+					return
+				}
+				c.failed(m)
+				if _, ok := err.(*UnavailableError); !ok {
+					err = &UnavailableError{Provider: m.client.Provider(), Err: err}
+				}
+				failures = append(failures, err.Error())
+				unavailable = true
+				break
+			}
+			if !unavailable {
+				c.succeeded(m)
+				return
+			}
+		}
+
+		if len(failures) == 0 {
+			yield("", fmt.Errorf("no LLM providers configured"))
+			return
+		}
+		yield("", fmt.Errorf("all LLM providers failed: %s", strings.Join(failures, "; ")))
+	}
+}
+
+// chainCall runs call on each usable member in turn until one is available.
+// Errors other than unavailability are returned straight away.
+func chainCall[T any](ctx context.Context, c *ChainClient, call func(Client) (T, error)) (T, error) {
+	var zero T
+	var failures []string
+
+	for _, m := range c.members {
+		if !c.closed(m) {
+			failures = append(failures, fmt.Sprintf("%s skipped after repeated failures", m.client.Provider()))
+			continue
+		}
+
+		result, err := call(m.client)
+		if err == nil {
+			c.succeeded(m)
+			return result, nil
+		}
+		if ctx.Err() != nil || !IsUnavailable(err) {
+			return zero, err
+		}
+		c.failed(m)
+		if _, ok := err.(*UnavailableError); !ok {
+			err = &UnavailableError{Provider: m.client.Provider(), Err: err}
+		}
+		failures = append(failures, err.Error())
+	}
+
+	if len(failures) == 0 {
+		return zero, fmt.Errorf("no LLM providers configured")
+	}
+	return zero, fmt.Errorf("all LLM providers failed: %s", strings.Join(failures, "; "))
+}
+
+// closed reports whether m can be tried: its breaker is closed, or its
+// cooldown is over and it gets another chance
+func (c *ChainClient) closed(m *chainMember) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return time.Now().After(m.openUntil)
+}
+
+func (c *ChainClient) succeeded(m *chainMember) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	m.failures = 0
+	c.last = m.client.Provider()
+}
+
+func (c *ChainClient) failed(m *chainMember) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	m.failures++
+	if m.failures >= c.Failures {
+		// Left at the threshold, so one more failure after the cooldown
+		// opens the breaker again
+		m.openUntil = time.Now().Add(c.Cooldown)
+	}
+}
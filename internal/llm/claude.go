@@ -4,16 +4,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/anthropics/anthropic-sdk-go"
 	"github.com/anthropics/anthropic-sdk-go/option"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ihavespoons/reorg/internal/tracing"
 )
 
 // ClaudeClient implements the Client interface using Claude API
 type ClaudeClient struct {
+	prompter
+	meter
 	client anthropic.Client
 	model  string
 }
@@ -33,8 +39,10 @@ func NewClaudeClient(cfg Config) (*ClaudeClient, error) {
 	client := anthropic.NewClient(option.WithAPIKey(apiKey))
 
 	return &ClaudeClient{
-		client: client,
-		model:  model,
+		prompter: newPrompter(cfg),
+		meter:    meter{cfg.Usage},
+		client:   client,
+		model:    model,
 	}, nil
 }
 
@@ -117,199 +125,154 @@ func (c *ClaudeClient) Provider() Provider {
 
 // Categorize analyzes text and returns categorization
 func (c *ClaudeClient) Categorize(ctx context.Context, content string) (*CategorizeResult, error) {
-	prompt := fmt.Sprintf(`Analyze the following content and categorize it for a personal organization system.
-
-Determine:
-1. Which area it belongs to: "work", "personal", or "life-admin"
-   - "work" = professional tasks, job-related, clients, colleagues, meetings
-   - "personal" = hobbies, personal projects, relationships, health, learning
-   - "life-admin" = bills, appointments, paperwork, household tasks, errands
-2. Suggest a project name if this is part of a larger effort
-3. Extract relevant tags
-4. Provide a brief summary
-5. Determine if it contains actionable items
-
-Content:
-%s
-
-Respond with valid JSON only, no markdown formatting:
-{
-  "area": "work|personal|life-admin",
-  "area_confidence": 0.0-1.0,
-  "project_suggestion": "suggested project name or empty",
-  "tags": ["tag1", "tag2"],
-  "summary": "brief summary",
-  "is_actionable": true|false
-}`, content)
+	return c.CategorizeWithContext(ctx, content, nil)
+}
 
-	response, err := c.client.Messages.New(ctx, anthropic.MessageNewParams{
-		Model:     anthropic.Model(c.model),
-		MaxTokens: 1024,
-		Messages: []anthropic.MessageParam{
-			anthropic.NewUserMessage(anthropic.NewTextBlock(prompt)),
-		},
-	})
+// CategorizeWithContext analyzes text with knowledge of existing projects
+func (c *ClaudeClient) CategorizeWithContext(ctx context.Context, content string, existingProjects []ProjectContext) (*CategorizeResult, error) {
+	prompt, err := c.categorizePrompt(content, existingProjects)
 	if err != nil {
-		return nil, fmt.Errorf("claude API error: %w", err)
+		return nil, err
 	}
 
-	// Extract text from response
-	var responseText string
-	for _, block := range response.Content {
-		if block.Type == "text" {
-			responseText = block.Text
-			break
-		}
+	areas := c.areaList()
+	tool := structuredTool{
+		name:        "record_categorization",
+		description: "Record how the content is categorized",
+		schema:      categorizeSchema(areas),
 	}
+	return withRetry(ctx, func(ctx context.Context, correction string) (string, error) {
+		return c.useTool(ctx, prompt+correction, tool, 1024)
+	}, func(response string) (*CategorizeResult, error) {
+		return parseCategorization(response, areas)
+	})
+}
 
-	if responseText == "" {
-		return nil, fmt.Errorf("empty response from Claude")
+// ExtractTasks parses content and extracts actionable tasks
+func (c *ClaudeClient) ExtractTasks(ctx context.Context, content string) ([]ExtractedTask, error) {
+	prompt, err := c.extractTasksPrompt(content)
+	if err != nil {
+		return nil, err
 	}
 
-	var result CategorizeResult
-	if err := json.Unmarshal([]byte(responseText), &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w (response: %s)", err, responseText)
+	tool := structuredTool{
+		name:        "record_tasks",
+		description: "Record the actionable tasks found in the content",
+		schema:      tasksSchema(),
 	}
+	return withRetry(ctx, func(ctx context.Context, correction string) (string, error) {
+		return c.useTool(ctx, prompt+correction, tool, 2048)
+	}, parseTasks)
+}
 
-	return &result, nil
+// structuredTool is a tool Claude is made to call, so its input is a
+// response in the tool's schema
+type structuredTool struct {
+	name        string
+	description string
+	schema      schema
 }
 
-// CategorizeWithContext analyzes text with knowledge of existing projects
-func (c *ClaudeClient) CategorizeWithContext(ctx context.Context, content string, existingProjects []ProjectContext) (*CategorizeResult, error) {
-	// Build project list for the prompt
-	projectList := ""
-	if len(existingProjects) > 0 {
-		projectList = "\n\nExisting projects you can assign this to:\n"
-		for _, p := range existingProjects {
-			projectList += fmt.Sprintf("- ID: %s, Title: \"%s\", Area: %s\n", p.ID, p.Title, p.Area)
-		}
-		projectList += "\nIf the content fits an existing project, use its ID in project_id. Otherwise, suggest a new project name in project_suggestion."
-	}
+// useTool sends prompt with Claude forced to call tool, and returns the
+// tool input as JSON
+func (c *ClaudeClient) useTool(ctx context.Context, prompt string, tool structuredTool, maxTokens int64) (_ string, err error) {
+	ctx, span := c.start(ctx, ProviderClaude, c.model, tool.name)
+	defer func() { tracing.End(span, err) }()
 
-	prompt := fmt.Sprintf(`Analyze the following content and categorize it for a personal organization system.
-
-Determine:
-1. Which area it belongs to: "work", "personal", or "life-admin"
-   - "work" = professional tasks, job-related, clients, colleagues, meetings
-   - "personal" = hobbies, personal projects, relationships, health, learning
-   - "life-admin" = bills, appointments, paperwork, household tasks, errands
-2. Match to an existing project if appropriate, or suggest a new project name
-3. Extract relevant tags
-4. Provide a brief summary
-5. Determine if it contains actionable items
-%s
-Content:
-%s
-
-Respond with valid JSON only, no markdown formatting:
-{
-  "area": "work|personal|life-admin",
-  "area_confidence": 0.0-1.0,
-  "project_id": "existing project ID if matched, or empty",
-  "project_suggestion": "new project name if no match, or empty",
-  "tags": ["tag1", "tag2"],
-  "summary": "brief summary",
-  "is_actionable": true|false
-}`, projectList, content)
+	inputSchema := anthropic.ToolInputSchemaParam{
+		Properties: tool.schema.Properties,
+		Required:   tool.schema.Required,
+	}
+	toolParam := anthropic.ToolUnionParamOfTool(inputSchema, tool.name)
+	toolParam.OfTool.Description = anthropic.String(tool.description)
 
+	if err := c.allow(ProviderClaude, c.model); err != nil {
+		return "", err
+	}
 	response, err := c.client.Messages.New(ctx, anthropic.MessageNewParams{
-		Model:     anthropic.Model(c.model),
-		MaxTokens: 1024,
+		Model:      anthropic.Model(c.model),
+		MaxTokens:  maxTokens,
+		Tools:      []anthropic.ToolUnionParam{toolParam},
+		ToolChoice: anthropic.ToolChoiceParamOfTool(tool.name),
 		Messages: []anthropic.MessageParam{
 			anthropic.NewUserMessage(anthropic.NewTextBlock(prompt)),
 		},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("claude API error: %w", err)
+		return "", fmt.Errorf("claude API error: %w", err)
 	}
+	c.recordUsage(span, response)
 
-	// Extract text from response
-	var responseText string
 	for _, block := range response.Content {
-		if block.Type == "text" {
-			responseText = block.Text
-			break
+		if block.Type == "tool_use" && block.Name == tool.name {
+			return string(block.Input), nil
 		}
 	}
-
-	if responseText == "" {
-		return nil, fmt.Errorf("empty response from Claude")
-	}
-
-	var result CategorizeResult
-	if err := json.Unmarshal([]byte(responseText), &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w (response: %s)", err, responseText)
-	}
-
-	return &result, nil
+	return "", fmt.Errorf("claude did not call %s", tool.name)
 }
 
-// ExtractTasks parses content and extracts actionable tasks
-func (c *ClaudeClient) ExtractTasks(ctx context.Context, content string) ([]ExtractedTask, error) {
-	prompt := fmt.Sprintf(`Extract actionable tasks from the following content.
-
-For each task, determine:
-1. A clear, concise title (action-oriented, starts with verb)
-2. Any additional description/context
-3. Priority if mentioned or implied (low, medium, high, urgent)
-4. Due date if mentioned (format: YYYY-MM-DD)
-5. Relevant tags
-
-Content:
-%s
-
-Respond with valid JSON only, no markdown formatting:
-{
-  "tasks": [
-    {
-      "title": "task title",
-      "description": "additional context",
-      "priority": "medium",
-      "due_date": "2025-01-25",
-      "tags": ["tag1"]
-    }
-  ]
-}
-
-If no actionable tasks are found, return: {"tasks": []}`, content)
+// Chat sends a message and returns the response
+func (c *ClaudeClient) Chat(ctx context.Context, message string) (_ string, err error) {
+	ctx, span := c.start(ctx, ProviderClaude, c.model, "chat")
+	defer func() { tracing.End(span, err) }()
 
-	response, err := c.client.Messages.New(ctx, anthropic.MessageNewParams{
-		Model:     anthropic.Model(c.model),
-		MaxTokens: 2048,
-		Messages: []anthropic.MessageParam{
-			anthropic.NewUserMessage(anthropic.NewTextBlock(prompt)),
-		},
-	})
+	if err := c.allow(ProviderClaude, c.model); err != nil {
+		return "", err
+	}
+	response, err := c.client.Messages.New(ctx, c.chatParams(message))
 	if err != nil {
-		return nil, fmt.Errorf("claude API error: %w", err)
+		return "", fmt.Errorf("claude API error: %w", err)
 	}
+	c.recordUsage(span, response)
 
 	// Extract text from response
-	var responseText string
 	for _, block := range response.Content {
 		if block.Type == "text" {
-			responseText = block.Text
-			break
+			return block.Text, nil
 		}
 	}
 
-	if responseText == "" {
-		return nil, fmt.Errorf("empty response from Claude")
-	}
+	return "", fmt.Errorf("empty response from Claude")
+}
 
-	var result struct {
-		Tasks []ExtractedTask `json:"tasks"`
-	}
-	if err := json.Unmarshal([]byte(responseText), &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w (response: %s)", err, responseText)
-	}
+// ChatStream sends a message and yields the response as it is generated
+func (c *ClaudeClient) ChatStream(ctx context.Context, message string) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		ctx, span := c.start(ctx, ProviderClaude, c.model, "chat")
+		var err error
+		defer func() { tracing.End(span, err) }()
+
+		if err = c.allow(ProviderClaude, c.model); err != nil {
+			yield("", err)
+			return
+		}
 
-	return result.Tasks, nil
+		stream := c.client.Messages.NewStreaming(ctx, c.chatParams(message))
+		defer func() { _ = stream.Close() }()
+
+		var response anthropic.Message
+		for stream.Next() {
+			event := stream.Current()
+			_ = response.Accumulate(event)
+			if e, ok := event.AsAny().(anthropic.ContentBlockDeltaEvent); ok {
+				if delta, ok := e.Delta.AsAny().(anthropic.TextDelta); ok && delta.Text != "" {
+					if !yield(delta.Text, nil) {
+						return
+					}
+				}
+			}
+		}
+		if err = stream.Err(); err != nil {
+			err = fmt.Errorf("claude API error: %w", err)
+			yield("", err)
+			return
+		}
+		c.recordUsage(span, &response)
+	}
 }
 
-// Chat sends a message and returns the response
-func (c *ClaudeClient) Chat(ctx context.Context, message string) (string, error) {
-	response, err := c.client.Messages.New(ctx, anthropic.MessageNewParams{
+func (c *ClaudeClient) chatParams(message string) anthropic.MessageNewParams {
+	return anthropic.MessageNewParams{
 		Model:     anthropic.Model(c.model),
 		MaxTokens: 4096,
 		System: []anthropic.TextBlockParam{
@@ -318,17 +281,14 @@ func (c *ClaudeClient) Chat(ctx context.Context, message string) (string, error)
 		Messages: []anthropic.MessageParam{
 			anthropic.NewUserMessage(anthropic.NewTextBlock(message)),
 		},
-	})
-	if err != nil {
-		return "", fmt.Errorf("claude API error: %w", err)
-	}
-
-	// Extract text from response
-	for _, block := range response.Content {
-		if block.Type == "text" {
-			return block.Text, nil
-		}
 	}
+}
 
-	return "", fmt.Errorf("empty response from Claude")
+func (c *ClaudeClient) recordUsage(span trace.Span, response *anthropic.Message) {
+	c.record(span, Usage{
+		Provider:     ProviderClaude,
+		Model:        c.model,
+		InputTokens:  response.Usage.InputTokens,
+		OutputTokens: response.Usage.OutputTokens,
+	})
 }
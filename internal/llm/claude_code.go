@@ -7,10 +7,14 @@ import (
 	"os/exec"
 	"strings"
 	"time"
+
+	"github.com/ihavespoons/reorg/internal/tracing"
 )
 
 // ClaudeCodeClient implements the Client interface by shelling out to Claude Code CLI
 type ClaudeCodeClient struct {
+	prompter
+	meter
 	model string
 }
 
@@ -53,7 +57,10 @@ func (c *ClaudeCodeClient) Provider() Provider {
 }
 
 // runPrompt executes a prompt via Claude Code CLI and returns the response
-func (c *ClaudeCodeClient) runPrompt(ctx context.Context, prompt string) (string, error) {
+func (c *ClaudeCodeClient) runPrompt(ctx context.Context, prompt string) (_ string, err error) {
+	ctx, span := c.start(ctx, ProviderClaudeCode, c.model, "prompt")
+	defer func() { tracing.End(span, err) }()
+
 	args := []string{
 		"-p",                  // Print mode (non-interactive)
 		"--output-format", "text",
@@ -64,6 +71,10 @@ func (c *ClaudeCodeClient) runPrompt(ctx context.Context, prompt string) (string
 		args = append(args, "--model", c.model)
 	}
 
+	if err := c.allow(ProviderClaudeCode, c.model); err != nil {
+		return "", err
+	}
+
 	cmd := exec.CommandContext(ctx, "claude", args...)
 
 	// Pass prompt via stdin to handle multiline and special characters
@@ -71,96 +82,40 @@ func (c *ClaudeCodeClient) runPrompt(ctx context.Context, prompt string) (string
 
 	output, err := cmd.Output()
 	if err != nil {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		// The CLI exits non-zero when it isn't logged in or is rate limited
 		if exitErr, ok := err.(*exec.ExitError); ok {
-			return "", fmt.Errorf("claude CLI error: %s", string(exitErr.Stderr))
+			return "", &UnavailableError{Provider: ProviderClaudeCode, Err: fmt.Errorf("claude CLI error: %s", string(exitErr.Stderr))}
 		}
-		return "", fmt.Errorf("failed to execute claude CLI: %w", err)
+		return "", &UnavailableError{Provider: ProviderClaudeCode, Err: fmt.Errorf("failed to execute claude CLI: %w", err)}
 	}
 
+	// The text output format carries no token counts
+	c.record(span, Usage{
+		Provider:     ProviderClaudeCode,
+		Model:        c.model,
+		InputTokens:  estimateTokens(prompt),
+		OutputTokens: estimateTokens(string(output)),
+		Estimated:    true,
+	})
+
 	return strings.TrimSpace(string(output)), nil
 }
 
 // Categorize analyzes text and returns categorization
 func (c *ClaudeCodeClient) Categorize(ctx context.Context, content string) (*CategorizeResult, error) {
-	prompt := fmt.Sprintf(`Analyze the following content and categorize it for a personal organization system.
-
-Determine:
-1. Which area it belongs to: "work", "personal", or "life-admin"
-   - "work" = professional tasks, job-related, clients, colleagues, meetings
-   - "personal" = hobbies, personal projects, relationships, health, learning
-   - "life-admin" = bills, appointments, paperwork, household tasks, errands
-2. Suggest a project name if this is part of a larger effort
-3. Extract relevant tags
-4. Provide a brief summary
-5. Determine if it contains actionable items
-
-Content:
-%s
-
-Respond with valid JSON only, no markdown formatting:
-{
-  "area": "work|personal|life-admin",
-  "area_confidence": 0.0-1.0,
-  "project_suggestion": "suggested project name or empty",
-  "tags": ["tag1", "tag2"],
-  "summary": "brief summary",
-  "is_actionable": true|false
-}`, content)
-
-	responseText, err := c.runPrompt(ctx, prompt)
-	if err != nil {
-		return nil, err
-	}
-
-	// Clean up response - remove any markdown code blocks if present
-	responseText = cleanJSONResponse(responseText)
-
-	var result CategorizeResult
-	if err := json.Unmarshal([]byte(responseText), &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w (response: %s)", err, responseText)
-	}
-
-	return &result, nil
+	return c.CategorizeWithContext(ctx, content, nil)
 }
 
 // CategorizeWithContext analyzes text with knowledge of existing projects
 func (c *ClaudeCodeClient) CategorizeWithContext(ctx context.Context, content string, existingProjects []ProjectContext) (*CategorizeResult, error) {
-	// Build project list for the prompt
-	projectList := ""
-	if len(existingProjects) > 0 {
-		projectList = "\n\nExisting projects you can assign this to:\n"
-		for _, p := range existingProjects {
-			projectList += fmt.Sprintf("- ID: %s, Title: \"%s\", Area: %s\n", p.ID, p.Title, p.Area)
-		}
-		projectList += "\nIf the content fits an existing project, use its ID in project_id. Otherwise, suggest a new project name in project_suggestion."
+	prompt, err := c.categorizePrompt(content, existingProjects)
+	if err != nil {
+		return nil, err
 	}
 
-	prompt := fmt.Sprintf(`Analyze the following content and categorize it for a personal organization system.
-
-Determine:
-1. Which area it belongs to: "work", "personal", or "life-admin"
-   - "work" = professional tasks, job-related, clients, colleagues, meetings
-   - "personal" = hobbies, personal projects, relationships, health, learning
-   - "life-admin" = bills, appointments, paperwork, household tasks, errands
-2. Match to an existing project if appropriate, or suggest a new project name
-3. Extract relevant tags
-4. Provide a brief summary
-5. Determine if it contains actionable items
-%s
-Content:
-%s
-
-Respond with valid JSON only, no markdown formatting:
-{
-  "area": "work|personal|life-admin",
-  "area_confidence": 0.0-1.0,
-  "project_id": "existing project ID if matched, or empty",
-  "project_suggestion": "new project name if no match, or empty",
-  "tags": ["tag1", "tag2"],
-  "summary": "brief summary",
-  "is_actionable": true|false
-}`, projectList, content)
-
 	responseText, err := c.runPrompt(ctx, prompt)
 	if err != nil {
 		return nil, err
@@ -179,32 +134,10 @@ Respond with valid JSON only, no markdown formatting:
 
 // ExtractTasks parses content and extracts actionable tasks
 func (c *ClaudeCodeClient) ExtractTasks(ctx context.Context, content string) ([]ExtractedTask, error) {
-	prompt := fmt.Sprintf(`Extract actionable tasks from the following content.
-
-For each task, determine:
-1. A clear, concise title (action-oriented, starts with verb)
-2. Any additional description/context
-3. Priority if mentioned or implied (low, medium, high, urgent)
-4. Due date if mentioned (format: YYYY-MM-DD)
-5. Relevant tags
-
-Content:
-%s
-
-Respond with valid JSON only, no markdown formatting:
-{
-  "tasks": [
-    {
-      "title": "task title",
-      "description": "additional context",
-      "priority": "medium",
-      "due_date": "2025-01-25",
-      "tags": ["tag1"]
-    }
-  ]
-}
-
-If no actionable tasks are found, return: {"tasks": []}`, content)
+	prompt, err := c.extractTasksPrompt(content)
+	if err != nil {
+		return nil, err
+	}
 
 	responseText, err := c.runPrompt(ctx, prompt)
 	if err != nil {
@@ -12,6 +12,7 @@ const (
 	ProviderClaude     Provider = "claude"
 	ProviderClaudeCode Provider = "claude-code"
 	ProviderOllama     Provider = "ollama"
+	ProviderHeuristic  Provider = "heuristic"
 )
 
 // Client defines the interface for LLM operations
@@ -74,9 +75,18 @@ type ExtractedTask struct {
 	// Priority suggests the task priority
 	Priority string `json:"priority,omitempty"`
 
-	// DueDate suggests a due date if mentioned
+	// DueDate suggests a due date, the day it has to be done by, if
+	// mentioned
 	DueDate string `json:"due_date,omitempty"`
 
+	// ScheduledDate suggests the day to start on if mentioned, as opposed
+	// to a deadline
+	ScheduledDate string `json:"scheduled_date,omitempty"`
+
+	// Context suggests where or how the task can be done, such as home,
+	// errands or deep-work, without the @
+	Context string `json:"context,omitempty"`
+
 	// Tags are suggested tags
 	Tags []string `json:"tags,omitempty"`
 }
@@ -87,6 +97,14 @@ type Config struct {
 	APIKey   string
 	Model    string
 	BaseURL  string // For Ollama or custom endpoints
+
+	// Areas are offered when categorizing (default: DefaultAreas), and
+	// Prompts builds the prompts (default: DefaultPrompts)
+	Areas   []AreaContext
+	Prompts *Prompts
+
+	// Usage is told about every request, and can refuse paid ones
+	Usage UsageRecorder
 }
 
 // NewClient creates a new LLM client based on configuration
@@ -95,9 +113,23 @@ func NewClient(cfg Config) (Client, error) {
 	case ProviderClaude:
 		return NewClaudeClient(cfg)
 	case ProviderClaudeCode:
-		return NewClaudeCodeClient(cfg.Model)
+		c, err := NewClaudeCodeClient(cfg.Model)
+		if err != nil {
+			return nil, err
+		}
+		c.prompter = newPrompter(cfg)
+		c.meter = meter{cfg.Usage}
+		return c, nil
 	case ProviderOllama:
-		return NewOllamaClient(cfg.BaseURL, cfg.Model)
+		c, err := NewOllamaClient(cfg.BaseURL, cfg.Model)
+		if err != nil {
+			return nil, err
+		}
+		c.prompter = newPrompter(cfg)
+		c.meter = meter{cfg.Usage}
+		return c, nil
+	case ProviderHeuristic:
+		return NewHeuristicClient().WithAreas(cfg.Areas), nil
 	default:
 		return NewClaudeClient(cfg)
 	}
@@ -106,7 +138,7 @@ func NewClient(cfg Config) (Client, error) {
 // NewClientWithFallback creates a client, preferring Claude Code CLI when no explicit API key is set
 func NewClientWithFallback(cfg Config) (Client, error) {
 	// If explicit API key is provided, use the standard Claude API
-	if cfg.APIKey != "" || cfg.Provider == ProviderOllama {
+	if cfg.APIKey != "" || cfg.Provider == ProviderOllama || cfg.Provider == ProviderHeuristic {
 		return NewClient(cfg)
 	}
 
@@ -119,6 +151,8 @@ func NewClientWithFallback(cfg Config) (Client, error) {
 	// (OAuth tokens from keychain can't be used with the public API)
 	if cfg.Provider == ProviderClaude || cfg.Provider == "" {
 		if codeClient, err := NewClaudeCodeClient(cfg.Model); err == nil {
+			codeClient.prompter = newPrompter(cfg)
+			codeClient.meter = meter{cfg.Usage}
 			return codeClient, nil
 		}
 	}
@@ -0,0 +1,369 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/dateparse"
+)
+
+// HeuristicClient implements the Client interface without a language model.
+// It recognizes checkbox lists, "TODO:"/"Action:" lines and imperative bullet
+// points, which is enough for offline or low-cost imports.
+type HeuristicClient struct {
+	now   func() time.Time
+	areas []AreaContext
+}
+
+// NewHeuristicClient creates a new heuristic client
+func NewHeuristicClient() *HeuristicClient {
+	return &HeuristicClient{now: time.Now}
+}
+
+// WithAreas limits categorization to areas, matched by their keywords and
+// title as well as the built-in hints. Without areas, the default ones are
+// used.
+func (c *HeuristicClient) WithAreas(areas []AreaContext) *HeuristicClient {
+	c.areas = areas
+	return c
+}
+
+// Provider returns the provider type
+func (c *HeuristicClient) Provider() Provider {
+	return ProviderHeuristic
+}
+
+var (
+	checkboxPattern  = regexp.MustCompile(`^\s*(?:[-*+]|\d+[.)])?\s*\[([ xX])\]\s+(.+)$`)
+	bulletPattern    = regexp.MustCompile(`^\s*(?:[-*+]|\d+[.)])\s+(.+)$`)
+	markerPattern    = regexp.MustCompile(`(?i)^(?:todo|to-do|action(?: item)?|next)\s*:\s*(.+)$`)
+	hashtagPattern   = regexp.MustCompile(`(?:^|\s)#([A-Za-z][\w-]*)`)
+	contextPattern   = regexp.MustCompile(`(?:^|\s)@([A-Za-z][\w-]*)`)
+	duePattern       = regexp.MustCompile(`(?i)\s*\b(?:due|by)\s+(\d{4}-\d{2}-\d{2}|today|tomorrow|[+-]?\d+[dwm])\b`)
+	scheduledPattern = regexp.MustCompile(`(?i)\s*\b(?:on|start|starting)\s+(\d{4}-\d{2}-\d{2}|today|tomorrow|[+-]?\d+[dwm])\b`)
+)
+
+// imperativeVerbs are words that usually start an action item
+var imperativeVerbs = map[string]bool{
+	"add": true, "ask": true, "book": true, "buy": true, "call": true,
+	"cancel": true, "check": true, "clean": true, "confirm": true, "contact": true,
+	"create": true, "deploy": true, "draft": true, "email": true, "file": true,
+	"finish": true, "fix": true, "follow": true, "get": true, "implement": true,
+	"investigate": true, "look": true, "make": true, "move": true, "order": true,
+	"organize": true, "pay": true, "pick": true, "plan": true, "prepare": true,
+	"read": true, "remind": true, "remove": true, "renew": true, "reply": true,
+	"research": true, "reschedule": true, "return": true, "review": true, "schedule": true,
+	"send": true, "set": true, "setup": true, "share": true, "sign": true,
+	"submit": true, "test": true, "text": true, "update": true, "upload": true,
+	"write": true,
+}
+
+// areaHint lists words that suggest a note belongs to an area
+type areaHint struct {
+	area     string
+	keywords []string
+}
+
+// areaKeywords hint at the area a note belongs to, in tie-break order
+var areaKeywords = []areaHint{
+	{"work", []string{"meeting", "client", "deadline", "sprint", "standup", "team", "manager", "release", "deploy", "customer", "invoice", "quarterly"}},
+	{"life-admin", []string{"bill", "tax", "taxes", "insurance", "doctor", "dentist", "appointment", "renew", "bank", "rent", "mortgage", "passport", "errand"}},
+}
+
+// ExtractTasks finds action items in content
+func (c *HeuristicClient) ExtractTasks(ctx context.Context, content string) ([]ExtractedTask, error) {
+	var tasks []ExtractedTask
+	seen := make(map[string]bool)
+
+	for _, line := range strings.Split(content, "\n") {
+		text, ok := actionText(line)
+		if !ok {
+			continue
+		}
+
+		task := c.parseTask(text)
+		key := strings.ToLower(task.Title)
+		if task.Title == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
+
+// actionText returns the text of a line if it looks like an action item
+func actionText(line string) (string, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return "", false
+	}
+
+	// Checkboxes: unchecked items are tasks, checked items are done
+	if m := checkboxPattern.FindStringSubmatch(line); m != nil {
+		return m[2], m[1] == " "
+	}
+
+	// Bullets are stripped before looking for markers or verbs
+	bullet := false
+	if m := bulletPattern.FindStringSubmatch(line); m != nil {
+		line = m[1]
+		bullet = true
+	}
+
+	if m := markerPattern.FindStringSubmatch(line); m != nil {
+		return m[1], true
+	}
+
+	if bullet {
+		first := strings.ToLower(strings.Trim(strings.Fields(line)[0], ",.:;!"))
+		if imperativeVerbs[first] {
+			return line, true
+		}
+	}
+
+	return "", false
+}
+
+// parseTask pulls tags, an @context, due and scheduled dates and priority
+// hints out of an action item
+func (c *HeuristicClient) parseTask(text string) ExtractedTask {
+	var task ExtractedTask
+
+	for _, m := range hashtagPattern.FindAllStringSubmatch(text, -1) {
+		task.Tags = append(task.Tags, strings.ToLower(m[1]))
+	}
+	text = hashtagPattern.ReplaceAllString(text, "")
+
+	if m := contextPattern.FindStringSubmatch(text); m != nil {
+		task.Context = strings.ToLower(m[1])
+		text = contextPattern.ReplaceAllString(text, "")
+	}
+
+	if m := duePattern.FindStringSubmatch(text); m != nil {
+		if due, err := dateparse.Parse(m[1], c.now()); err == nil {
+			task.DueDate = due.Format("2006-01-02")
+			text = strings.Replace(text, m[0], "", 1)
+		}
+	}
+	if m := scheduledPattern.FindStringSubmatch(text); m != nil {
+		if scheduled, err := dateparse.Parse(m[1], c.now()); err == nil {
+			task.ScheduledDate = scheduled.Format("2006-01-02")
+			text = strings.Replace(text, m[0], "", 1)
+		}
+	}
+
+	lower := strings.ToLower(text)
+	switch {
+	case strings.Contains(lower, "urgent") || strings.Contains(lower, "asap") || strings.Contains(text, "!!"):
+		task.Priority = "urgent"
+	case strings.Contains(lower, "important") || strings.HasSuffix(strings.TrimSpace(text), "!"):
+		task.Priority = "high"
+	}
+
+	task.Title = strings.TrimSpace(strings.Trim(strings.TrimSpace(text), "!"))
+	return task
+}
+
+// Categorize analyzes text and returns categorization
+func (c *HeuristicClient) Categorize(ctx context.Context, content string) (*CategorizeResult, error) {
+	return c.CategorizeWithContext(ctx, content, nil)
+}
+
+// CategorizeWithContext matches content against existing project titles and
+// falls back to keyword hints for the area
+func (c *HeuristicClient) CategorizeWithContext(ctx context.Context, content string, existingProjects []ProjectContext) (*CategorizeResult, error) {
+	tasks, _ := c.ExtractTasks(ctx, content)
+	result := &CategorizeResult{
+		Area:           c.defaultArea(),
+		AreaConfidence: 0.3,
+		Summary:        summarize(content),
+		IsActionable:   len(tasks) > 0,
+	}
+
+	seen := make(map[string]bool)
+	for _, m := range hashtagPattern.FindAllStringSubmatch(content, -1) {
+		tag := strings.ToLower(m[1])
+		if !seen[tag] {
+			seen[tag] = true
+			result.Tags = append(result.Tags, tag)
+		}
+	}
+
+	lower := strings.ToLower(content)
+
+	// A project mentioned by name is the strongest signal
+	for _, p := range existingProjects {
+		if p.Title != "" && strings.Contains(lower, strings.ToLower(p.Title)) {
+			result.ProjectID = p.ID
+			result.Area = p.Area
+			result.AreaConfidence = 0.7
+			return result, nil
+		}
+	}
+
+	words := make(map[string]bool)
+	for _, w := range strings.FieldsFunc(lower, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r == '-')
+	}) {
+		words[w] = true
+	}
+
+	best := 0
+	for _, candidate := range c.areaHints() {
+		hits := 0
+		for _, k := range candidate.keywords {
+			if words[k] {
+				hits++
+			}
+		}
+		if hits > best {
+			best = hits
+			result.Area = candidate.area
+			result.AreaConfidence = 0.4 + 0.1*float64(min(hits, 4))
+		}
+	}
+
+	result.ProjectSuggestion = firstLine(content)
+	return result, nil
+}
+
+// defaultArea is where content without hints goes: personal if it is one
+// of the areas, otherwise the first area
+func (c *HeuristicClient) defaultArea() string {
+	for _, a := range c.areas {
+		if a.Slug == "personal" {
+			return a.Slug
+		}
+	}
+	if len(c.areas) > 0 {
+		return c.areas[0].Slug
+	}
+	return "personal"
+}
+
+// areaHints returns the keywords of each area that can be picked. Configured
+// areas add their own keywords and the words of their title to the
+// built-in hints.
+func (c *HeuristicClient) areaHints() []areaHint {
+	if len(c.areas) == 0 {
+		return areaKeywords
+	}
+
+	var hints []areaHint
+	for _, a := range c.areas {
+		hint := areaHint{area: a.Slug}
+		for _, builtin := range areaKeywords {
+			if builtin.area == a.Slug {
+				hint.keywords = append(hint.keywords, builtin.keywords...)
+			}
+		}
+		for _, k := range a.Keywords {
+			hint.keywords = append(hint.keywords, strings.ToLower(k))
+		}
+		for _, w := range strings.FieldsFunc(strings.ToLower(a.Title+" "+a.Slug), func(r rune) bool {
+			return !(r >= 'a' && r <= 'z')
+		}) {
+			hint.keywords = append(hint.keywords, w)
+		}
+		hints = append(hints, hint)
+	}
+	return hints
+}
+
+// Chat is not supported without a language model
+func (c *HeuristicClient) Chat(ctx context.Context, message string) (string, error) {
+	return "", fmt.Errorf("chat is not available with the heuristic provider")
+}
+
+// firstLine returns the first non-empty line, without markdown heading marks
+func firstLine(content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "#"))
+		if line != "" {
+			if r := []rune(line); len(r) > 60 {
+				line = strings.TrimSpace(string(r[:60]))
+			}
+			return line
+		}
+	}
+	return ""
+}
+
+// summarize returns the first sentence of content, skipping headings and
+// capped in length
+func summarize(content string) string {
+	var body []string
+	for _, line := range strings.Split(content, "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), "#") {
+			body = append(body, line)
+		}
+	}
+	text := strings.Join(strings.Fields(strings.Join(body, " ")), " ")
+	if i := strings.IndexAny(text, ".!?"); i > 0 {
+		text = text[:i+1]
+	}
+	if r := []rune(text); len(r) > 120 {
+		text = strings.TrimSpace(string(r[:117])) + "..."
+	}
+	return text
+}
+
+// FallbackClient uses a primary client and retries failed calls on a
+// fallback client, typically the heuristic one
+type FallbackClient struct {
+	primary  Client
+	fallback Client
+}
+
+// NewFallbackClient wraps primary so failures are retried on fallback
+func NewFallbackClient(primary, fallback Client) *FallbackClient {
+	return &FallbackClient{primary: primary, fallback: fallback}
+}
+
+// Provider returns the primary provider type
+func (c *FallbackClient) Provider() Provider {
+	return c.primary.Provider()
+}
+
+// Categorize analyzes text and returns categorization
+func (c *FallbackClient) Categorize(ctx context.Context, content string) (*CategorizeResult, error) {
+	result, err := c.primary.Categorize(ctx, content)
+	if err != nil {
+		return c.fallback.Categorize(ctx, content)
+	}
+	return result, nil
+}
+
+// CategorizeWithContext analyzes text with knowledge of existing projects
+func (c *FallbackClient) CategorizeWithContext(ctx context.Context, content string, existingProjects []ProjectContext) (*CategorizeResult, error) {
+	result, err := c.primary.CategorizeWithContext(ctx, content, existingProjects)
+	if err != nil {
+		return c.fallback.CategorizeWithContext(ctx, content, existingProjects)
+	}
+	return result, nil
+}
+
+// ExtractTasks parses content and extracts actionable tasks
+func (c *FallbackClient) ExtractTasks(ctx context.Context, content string) ([]ExtractedTask, error) {
+	tasks, err := c.primary.ExtractTasks(ctx, content)
+	if err != nil {
+		return c.fallback.ExtractTasks(ctx, content)
+	}
+	return tasks, nil
+}
+
+// Chat sends a message and returns the response
+func (c *FallbackClient) Chat(ctx context.Context, message string) (string, error) {
+	return c.primary.Chat(ctx, message)
+}
+
+// ChatStream sends a message and yields the response as it is generated
+func (c *FallbackClient) ChatStream(ctx context.Context, message string) iter.Seq2[string, error] {
+	return StreamChat(ctx, c.primary, message)
+}
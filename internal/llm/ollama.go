@@ -6,12 +6,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ihavespoons/reorg/internal/tracing"
 )
 
 // OllamaClient implements the Client interface using Ollama
 type OllamaClient struct {
+	prompter
+	meter
 	baseURL string
 	model   string
 	client  *http.Client
@@ -43,42 +50,28 @@ type ollamaRequest struct {
 	Model  string `json:"model"`
 	Prompt string `json:"prompt"`
 	Stream bool   `json:"stream"`
+	Format string `json:"format,omitempty"`
 }
 
 type ollamaResponse struct {
-	Response string `json:"response"`
-	Done     bool   `json:"done"`
+	Response        string `json:"response"`
+	Done            bool   `json:"done"`
+	PromptEvalCount int64  `json:"prompt_eval_count"`
+	EvalCount       int64  `json:"eval_count"`
 }
 
-func (c *OllamaClient) generate(ctx context.Context, prompt string) (string, error) {
-	reqBody := ollamaRequest{
-		Model:  c.model,
-		Prompt: prompt,
-		Stream: false,
-	}
-
-	jsonBody, err := json.Marshal(reqBody)
-	if err != nil {
-		return "", fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+"/api/generate", bytes.NewBuffer(jsonBody))
-	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
-	}
-	req.Header.Set("Content-Type", "application/json")
+// generate sends prompt to the model. A format of "json" makes Ollama
+// constrain the response to valid JSON.
+func (c *OllamaClient) generate(ctx context.Context, prompt, format string) (_ string, err error) {
+	ctx, span := c.start(ctx, ProviderOllama, c.model, "generate")
+	defer func() { tracing.End(span, err) }()
 
-	resp, err := c.client.Do(req)
+	resp, err := c.post(ctx, prompt, format, false)
 	if err != nil {
-		return "", fmt.Errorf("ollama request failed: %w", err)
+		return "", err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("ollama error (status %d): %s", resp.StatusCode, string(body))
-	}
-
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return "", fmt.Errorf("failed to read response: %w", err)
@@ -88,107 +81,146 @@ func (c *OllamaClient) generate(ctx context.Context, prompt string) (string, err
 	if err := json.Unmarshal(body, &result); err != nil {
 		return "", fmt.Errorf("failed to parse response: %w", err)
 	}
+	c.recordUsage(span, result)
 
 	return result.Response, nil
 }
 
-// Categorize analyzes text and returns categorization
-func (c *OllamaClient) Categorize(ctx context.Context, content string) (*CategorizeResult, error) {
-	prompt := fmt.Sprintf(`Analyze the following content and categorize it.
-
-Areas: "work", "personal", or "life-admin"
-- work = professional tasks, job-related
-- personal = hobbies, personal projects
-- life-admin = bills, appointments, errands
+// generateStream sends prompt to the model and yields the response as it
+// is generated
+func (c *OllamaClient) generateStream(ctx context.Context, prompt string) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		ctx, span := c.start(ctx, ProviderOllama, c.model, "generate")
+		var err error
+		defer func() { tracing.End(span, err) }()
+
+		resp, err := c.post(ctx, prompt, "", true)
+		if err != nil {
+			yield("", err)
+			return
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		// One JSON object per line, the last with done set
+		decoder := json.NewDecoder(resp.Body)
+		for {
+			var chunk ollamaResponse
+			if err = decoder.Decode(&chunk); err != nil {
+				if err == io.EOF {
+					err = nil
+				} else {
+					err = fmt.Errorf("failed to parse response: %w", err)
+					yield("", err)
+				}
+				return
+			}
+			if chunk.Response != "" && !yield(chunk.Response, nil) {
+				return
+			}
+			if chunk.Done {
+				c.recordUsage(span, chunk)
+				return
+			}
+		}
+	}
+}
 
-Content: %s
+// post makes a generate request, returning the response if it succeeded
+func (c *OllamaClient) post(ctx context.Context, prompt, format string, stream bool) (*http.Response, error) {
+	if err := c.allow(ProviderOllama, c.model); err != nil {
+		return nil, err
+	}
 
-Respond with JSON only:
-{"area": "work", "area_confidence": 0.8, "project_suggestion": "", "tags": [], "summary": "", "is_actionable": false}`, content)
+	reqBody := ollamaRequest{
+		Model:  c.model,
+		Prompt: prompt,
+		Stream: stream,
+		Format: format,
+	}
 
-	response, err := c.generate(ctx, prompt)
+	jsonBody, err := json.Marshal(reqBody)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	response = extractJSON(response)
-
-	var result CategorizeResult
-	if err := json.Unmarshal([]byte(response), &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+"/api/generate", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	req.Header.Set("Content-Type", "application/json")
 
-	return &result, nil
-}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, &UnavailableError{Provider: ProviderOllama, Err: fmt.Errorf("ollama request failed: %w", err)}
+	}
 
-// CategorizeWithContext analyzes text with knowledge of existing projects
-func (c *OllamaClient) CategorizeWithContext(ctx context.Context, content string, existingProjects []ProjectContext) (*CategorizeResult, error) {
-	// Build project list for the prompt
-	projectList := ""
-	if len(existingProjects) > 0 {
-		projectList = "\n\nExisting projects:\n"
-		for _, p := range existingProjects {
-			projectList += fmt.Sprintf("- ID: %s, Title: \"%s\", Area: %s\n", p.ID, p.Title, p.Area)
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		err := fmt.Errorf("ollama error (status %d): %s", resp.StatusCode, string(body))
+		if unavailableStatus(resp.StatusCode) {
+			return nil, &UnavailableError{Provider: ProviderOllama, Err: err}
 		}
-		projectList += "Match to existing project_id if appropriate, otherwise use project_suggestion."
+		return nil, err
 	}
+	return resp, nil
+}
 
-	prompt := fmt.Sprintf(`Analyze content and categorize it.
-
-Areas: "work", "personal", or "life-admin"
-- work = professional tasks, job-related
-- personal = hobbies, personal projects
-- life-admin = bills, appointments, errands
-%s
-Content: %s
+func (c *OllamaClient) recordUsage(span trace.Span, result ollamaResponse) {
+	c.record(span, Usage{
+		Provider:     ProviderOllama,
+		Model:        c.model,
+		InputTokens:  result.PromptEvalCount,
+		OutputTokens: result.EvalCount,
+	})
+}
 
-Respond with JSON only:
-{"area": "work", "area_confidence": 0.8, "project_id": "", "project_suggestion": "", "tags": [], "summary": "", "is_actionable": false}`, projectList, content)
+// Categorize analyzes text and returns categorization
+func (c *OllamaClient) Categorize(ctx context.Context, content string) (*CategorizeResult, error) {
+	return c.CategorizeWithContext(ctx, content, nil)
+}
 
-	response, err := c.generate(ctx, prompt)
+// CategorizeWithContext analyzes text with knowledge of existing projects
+func (c *OllamaClient) CategorizeWithContext(ctx context.Context, content string, existingProjects []ProjectContext) (*CategorizeResult, error) {
+	prompt, err := c.categorizePrompt(content, existingProjects)
 	if err != nil {
 		return nil, err
 	}
 
-	response = extractJSON(response)
-
-	var result CategorizeResult
-	if err := json.Unmarshal([]byte(response), &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	return &result, nil
+	areas := c.areaList()
+	return withRetry(ctx, func(ctx context.Context, correction string) (string, error) {
+		return c.generate(ctx, prompt+correction, "json")
+	}, func(response string) (*CategorizeResult, error) {
+		return parseCategorization(extractJSON(response), areas)
+	})
 }
 
 // ExtractTasks parses content and extracts actionable tasks
 func (c *OllamaClient) ExtractTasks(ctx context.Context, content string) ([]ExtractedTask, error) {
-	prompt := fmt.Sprintf(`Extract tasks from this content. Return JSON only.
-
-Content: %s
-
-Format: {"tasks": [{"title": "", "description": "", "priority": "medium", "due_date": "", "tags": []}]}`, content)
-
-	response, err := c.generate(ctx, prompt)
+	prompt, err := c.extractTasksPrompt(content)
 	if err != nil {
 		return nil, err
 	}
 
-	response = extractJSON(response)
-
-	var result struct {
-		Tasks []ExtractedTask `json:"tasks"`
-	}
-	if err := json.Unmarshal([]byte(response), &result); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
-	}
-
-	return result.Tasks, nil
+	return withRetry(ctx, func(ctx context.Context, correction string) (string, error) {
+		return c.generate(ctx, prompt+correction, "json")
+	}, func(response string) ([]ExtractedTask, error) {
+		return parseTasks(extractJSON(response))
+	})
 }
 
 // Chat sends a message and returns the response
 func (c *OllamaClient) Chat(ctx context.Context, message string) (string, error) {
-	prompt := fmt.Sprintf("You are a helpful personal organization assistant. Be concise.\n\nUser: %s\n\nAssistant:", message)
-	return c.generate(ctx, prompt)
+	return c.generate(ctx, chatPrompt(message), "")
+}
+
+// ChatStream sends a message and yields the response as it is generated
+func (c *OllamaClient) ChatStream(ctx context.Context, message string) iter.Seq2[string, error] {
+	return c.generateStream(ctx, chatPrompt(message))
+}
+
+func chatPrompt(message string) string {
+	return fmt.Sprintf("You are a helpful personal organization assistant. Be concise.\n\nUser: %s\n\nAssistant:", message)
 }
 
 // extractJSON tries to extract JSON from a response that might contain extra text
@@ -0,0 +1,250 @@
+package llm
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// AreaContext describes an area content can be filed into
+type AreaContext struct {
+	Slug        string   `json:"slug"`
+	Title       string   `json:"title"`
+	Description string   `json:"description,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"` // hints for the heuristic provider
+}
+
+// DefaultAreas returns the areas offered when none are configured
+func DefaultAreas() []AreaContext {
+	return []AreaContext{
+		{Slug: "work", Title: "Work", Description: "professional tasks, job-related, clients, colleagues, meetings"},
+		{Slug: "personal", Title: "Personal", Description: "hobbies, personal projects, relationships, health, learning"},
+		{Slug: "life-admin", Title: "Life Admin", Description: "bills, appointments, paperwork, household tasks, errands"},
+	}
+}
+
+// DefaultAreaDescription returns the built-in description of a default
+// area, or an empty string
+func DefaultAreaDescription(slug string) string {
+	for _, a := range DefaultAreas() {
+		if a.Slug == slug {
+			return a.Description
+		}
+	}
+	return ""
+}
+
+// Template files looked for in a templates directory
+const (
+	CategorizeTemplate   = "categorize.tmpl"
+	ExtractTasksTemplate = "extract_tasks.tmpl"
+)
+
+// PromptData is what prompt templates are executed with
+type PromptData struct {
+	Content  string
+	Areas    []AreaContext
+	Projects []ProjectContext
+}
+
+// Prompts builds the prompts sent to language models from text/template
+// templates
+type Prompts struct {
+	categorize   *template.Template
+	extractTasks *template.Template
+}
+
+var promptFuncs = template.FuncMap{
+	// slugs joins area slugs, e.g. "work|personal"
+	"slugs": func(areas []AreaContext, sep string) string {
+		slugs := make([]string, len(areas))
+		for i, a := range areas {
+			slugs[i] = a.Slug
+		}
+		return strings.Join(slugs, sep)
+	},
+	// quoted lists area slugs for a sentence, e.g. "work", "personal" or "studies"
+	"quoted": func(areas []AreaContext) string {
+		quoted := make([]string, len(areas))
+		for i, a := range areas {
+			quoted[i] = fmt.Sprintf("%q", a.Slug)
+		}
+		if len(quoted) < 2 {
+			return strings.Join(quoted, "")
+		}
+		return strings.Join(quoted[:len(quoted)-1], ", ") + " or " + quoted[len(quoted)-1]
+	},
+}
+
+// DefaultPrompts returns the built-in prompts
+func DefaultPrompts() *Prompts {
+	return &Prompts{
+		categorize:   template.Must(template.New(CategorizeTemplate).Funcs(promptFuncs).Parse(defaultCategorizePrompt)),
+		extractTasks: template.Must(template.New(ExtractTasksTemplate).Funcs(promptFuncs).Parse(defaultExtractTasksPrompt)),
+	}
+}
+
+// LoadPrompts returns the built-in prompts, replaced by any templates found
+// in dir
+func LoadPrompts(dir string) (*Prompts, error) {
+	prompts := DefaultPrompts()
+	if dir == "" {
+		return prompts, nil
+	}
+
+	for name, tmpl := range map[string]**template.Template{
+		CategorizeTemplate:   &prompts.categorize,
+		ExtractTasksTemplate: &prompts.extractTasks,
+	} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read prompt template: %w", err)
+		}
+		t, err := template.New(name).Funcs(promptFuncs).Parse(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("invalid prompt template %s: %w", name, err)
+		}
+		*tmpl = t
+	}
+	return prompts, nil
+}
+
+// Categorize returns the prompt for categorizing content
+func (p *Prompts) Categorize(data PromptData) (string, error) {
+	return execute(p.categorize, data)
+}
+
+// ExtractTasks returns the prompt for extracting tasks from content
+func (p *Prompts) ExtractTasks(data PromptData) (string, error) {
+	return execute(p.extractTasks, data)
+}
+
+func execute(t *template.Template, data PromptData) (string, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to build prompt from %s: %w", t.Name(), err)
+	}
+	return buf.String(), nil
+}
+
+// prompter builds the prompts of a model client. The zero value uses the
+// built-in prompts and default areas.
+type prompter struct {
+	prompts *Prompts
+	areas   []AreaContext
+}
+
+func newPrompter(cfg Config) prompter {
+	return prompter{prompts: cfg.Prompts, areas: cfg.Areas}
+}
+
+func (p prompter) categorizePrompt(content string, projects []ProjectContext) (string, error) {
+	return p.get().Categorize(p.data(content, projects))
+}
+
+func (p prompter) extractTasksPrompt(content string) (string, error) {
+	return p.get().ExtractTasks(p.data(content, nil))
+}
+
+func (p prompter) get() *Prompts {
+	if p.prompts == nil {
+		return DefaultPrompts()
+	}
+	return p.prompts
+}
+
+func (p prompter) data(content string, projects []ProjectContext) PromptData {
+	return PromptData{Content: content, Areas: p.areaList(), Projects: projects}
+}
+
+// areaList returns the areas content can be filed into
+func (p prompter) areaList() []AreaContext {
+	if len(p.areas) == 0 {
+		return DefaultAreas()
+	}
+	return p.areas
+}
+
+const defaultCategorizePrompt = `Analyze the following content and categorize it for a personal organization system.
+
+Determine:
+1. Which area it belongs to: {{quoted .Areas}}
+{{- range .Areas}}
+   - "{{.Slug}}" = {{if .Description}}{{.Description}}{{else}}{{.Title}}{{end}}
+{{- end}}
+{{- if .Projects}}
+2. Match to an existing project if appropriate, or suggest a new project name
+{{- else}}
+2. Suggest a project name if this is part of a larger effort
+{{- end}}
+3. Extract relevant tags
+4. Provide a brief summary
+5. Determine if it contains actionable items
+{{- if .Projects}}
+
+Existing projects you can assign this to:
+{{- range .Projects}}
+- ID: {{.ID}}, Title: "{{.Title}}", Area: {{.Area}}
+{{- end}}
+
+If the content fits an existing project, use its ID in project_id. Otherwise, suggest a new project name in project_suggestion.
+{{- end}}
+
+Content:
+{{.Content}}
+
+Respond with valid JSON only, no markdown formatting:
+{
+  "area": "{{slugs .Areas "|"}}",
+  "area_confidence": 0.0-1.0,
+{{- if .Projects}}
+  "project_id": "existing project ID if matched, or empty",
+  "project_suggestion": "new project name if no match, or empty",
+{{- else}}
+  "project_suggestion": "suggested project name or empty",
+{{- end}}
+  "tags": ["tag1", "tag2"],
+  "summary": "brief summary",
+  "is_actionable": true|false
+}`
+
+const defaultExtractTasksPrompt = `Extract actionable tasks from the following content.
+
+For each task, determine:
+1. A clear, concise title (action-oriented, starts with verb)
+2. Any additional description/context
+3. Priority if mentioned or implied (low, medium, high, urgent)
+4. Due date: the deadline it must be done by, if mentioned ("by Friday",
+   "due March 3", "deadline") (format: YYYY-MM-DD)
+5. Scheduled date: the day to start or do it on, if mentioned ("on Monday",
+   "start next week", an appointment) (format: YYYY-MM-DD). Leave it empty
+   when only a deadline is given; a task can have both.
+6. Context: where or how it can be done (e.g. home, errands, computer, phone,
+   deep-work), or empty if it could be done anywhere
+7. Relevant tags
+
+Content:
+{{.Content}}
+
+Respond with valid JSON only, no markdown formatting:
+{
+  "tasks": [
+    {
+      "title": "task title",
+      "description": "additional context",
+      "priority": "medium",
+      "due_date": "2025-01-25",
+      "scheduled_date": "2025-01-23",
+      "context": "errands",
+      "tags": ["tag1"]
+    }
+  ]
+}
+
+If no actionable tasks are found, return: {"tasks": []}`
@@ -0,0 +1,171 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// maxAttempts is how many times a structured request is made before giving
+// up on a response that doesn't fit its schema
+const maxAttempts = 3
+
+// schema is the JSON schema of an object response
+type schema struct {
+	Properties map[string]any
+	Required   []string
+}
+
+// MarshalJSON writes the schema as a JSON schema object
+func (s schema) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"type":       "object",
+		"properties": s.Properties,
+		"required":   s.Required,
+	})
+}
+
+// categorizeSchema is the JSON schema of a CategorizeResult, limited to the
+// areas given
+func categorizeSchema(areas []AreaContext) schema {
+	area := map[string]any{"type": "string", "description": "Slug of the area the content belongs to"}
+	if len(areas) > 0 {
+		slugs := make([]string, len(areas))
+		for i, a := range areas {
+			slugs[i] = a.Slug
+		}
+		area["enum"] = slugs
+	}
+
+	return schema{
+		Properties: map[string]any{
+			"area":               area,
+			"area_confidence":    map[string]any{"type": "number", "minimum": 0, "maximum": 1},
+			"project_id":         map[string]any{"type": "string", "description": "ID of a matching existing project, or empty"},
+			"project_suggestion": map[string]any{"type": "string", "description": "Name for a new project, or empty"},
+			"tags":               map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+			"summary":            map[string]any{"type": "string"},
+			"is_actionable":      map[string]any{"type": "boolean"},
+		},
+		Required: []string{"area", "area_confidence", "summary", "is_actionable"},
+	}
+}
+
+// tasksSchema is the JSON schema of a list of extracted tasks
+func tasksSchema() schema {
+	return schema{
+		Properties: map[string]any{
+			"tasks": map[string]any{
+				"type": "array",
+				"items": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"title":          map[string]any{"type": "string", "description": "Clear, concise and action-oriented"},
+						"description":    map[string]any{"type": "string"},
+						"priority":       map[string]any{"type": "string", "enum": []string{"low", "medium", "high", "urgent"}},
+						"due_date":       map[string]any{"type": "string", "description": "Deadline the task must be done by, as YYYY-MM-DD, or empty"},
+						"scheduled_date": map[string]any{"type": "string", "description": "Day to start or do the task on, as YYYY-MM-DD, or empty"},
+						"context":        map[string]any{"type": "string", "description": "Where or how it can be done, such as home, errands, computer, phone or deep-work, or empty"},
+						"tags":           map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+					},
+					"required": []string{"title"},
+				},
+			},
+		},
+		Required: []string{"tasks"},
+	}
+}
+
+// parseCategorization decodes and checks a categorization response
+func parseCategorization(data string, areas []AreaContext) (*CategorizeResult, error) {
+	var result CategorizeResult
+	if err := json.Unmarshal([]byte(data), &result); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	if result.Area == "" {
+		return nil, fmt.Errorf("area is missing")
+	}
+	if len(areas) > 0 && !hasArea(areas, result.Area) {
+		return nil, fmt.Errorf("area %q is not one of %s", result.Area, slugList(areas))
+	}
+	if result.AreaConfidence < 0 || result.AreaConfidence > 1 {
+		return nil, fmt.Errorf("area_confidence %v is not between 0 and 1", result.AreaConfidence)
+	}
+	return &result, nil
+}
+
+// parseTasks decodes and checks a task extraction response
+func parseTasks(data string) ([]ExtractedTask, error) {
+	var result struct {
+		Tasks []ExtractedTask `json:"tasks"`
+	}
+	if err := json.Unmarshal([]byte(data), &result); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	for i, t := range result.Tasks {
+		if strings.TrimSpace(t.Title) == "" {
+			return nil, fmt.Errorf("task %d has no title", i+1)
+		}
+		switch t.Priority {
+		case "", "low", "medium", "high", "urgent":
+		default:
+			return nil, fmt.Errorf("task %d has invalid priority %q", i+1, t.Priority)
+		}
+		if t.DueDate != "" {
+			if _, err := time.Parse("2006-01-02", t.DueDate); err != nil {
+				return nil, fmt.Errorf("task %d has invalid due_date %q (use YYYY-MM-DD)", i+1, t.DueDate)
+			}
+		}
+		if t.ScheduledDate != "" {
+			if _, err := time.Parse("2006-01-02", t.ScheduledDate); err != nil {
+				return nil, fmt.Errorf("task %d has invalid scheduled_date %q (use YYYY-MM-DD)", i+1, t.ScheduledDate)
+			}
+		}
+	}
+	return result.Tasks, nil
+}
+
+// withRetry calls request until parse accepts its response, up to
+// maxAttempts times. After a rejected response, request is given a
+// correction to add to the prompt.
+func withRetry[T any](ctx context.Context, request func(ctx context.Context, correction string) (string, error), parse func(string) (T, error)) (T, error) {
+	var zero T
+	var correction string
+	var lastErr error
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		response, err := request(ctx, correction)
+		if err != nil {
+			return zero, err
+		}
+
+		result, err := parse(response)
+		if err == nil {
+			return result, nil
+		}
+		lastErr = fmt.Errorf("%w (response: %s)", err, response)
+		correction = fmt.Sprintf("\n\nYour previous response was rejected: %v. Respond again, following the required format exactly.", err)
+	}
+	return zero, fmt.Errorf("failed to parse response after %d attempts: %w", maxAttempts, lastErr)
+}
+
+func hasArea(areas []AreaContext, slug string) bool {
+	for _, a := range areas {
+		if strings.EqualFold(a.Slug, slug) {
+			return true
+		}
+	}
+	return false
+}
+
+func slugList(areas []AreaContext) string {
+	slugs := make([]string, len(areas))
+	for i, a := range areas {
+		slugs[i] = a.Slug
+	}
+	return strings.Join(slugs, ", ")
+}
@@ -0,0 +1,25 @@
+package llm
+
+import (
+	"context"
+	"iter"
+)
+
+// Streamer is implemented by clients that can return a chat response as it
+// is generated
+type Streamer interface {
+	// ChatStream sends a message and yields the response in chunks. An
+	// error ends the stream.
+	ChatStream(ctx context.Context, message string) iter.Seq2[string, error]
+}
+
+// StreamChat sends a message to client, streaming the response if the
+// client supports it and yielding the whole response at once otherwise
+func StreamChat(ctx context.Context, client Client, message string) iter.Seq2[string, error] {
+	if s, ok := client.(Streamer); ok {
+		return s.ChatStream(ctx, message)
+	}
+	return func(yield func(string, error) bool) {
+		yield(client.Chat(ctx, message))
+	}
+}
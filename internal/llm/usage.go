@@ -0,0 +1,73 @@
+package llm
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ihavespoons/reorg/internal/tracing"
+)
+
+// ErrBudgetExceeded is returned instead of making a paid request once the
+// spending budget is used up
+var ErrBudgetExceeded = errors.New("AI budget exceeded")
+
+// Usage is the token count of one model request
+type Usage struct {
+	Provider     Provider
+	Model        string
+	InputTokens  int64
+	OutputTokens int64
+
+	// Estimated is set when the provider doesn't report tokens and they
+	// were worked out from the text length
+	Estimated bool
+}
+
+// UsageRecorder keeps track of model usage
+type UsageRecorder interface {
+	// Allow returns an error wrapping ErrBudgetExceeded when a request to
+	// model shouldn't be made
+	Allow(provider Provider, model string) error
+
+	// Record notes a request that was made
+	Record(usage Usage)
+}
+
+// meter reports the usage of a model client to its recorder, if any
+type meter struct {
+	recorder UsageRecorder
+}
+
+func (m meter) allow(provider Provider, model string) error {
+	if m.recorder == nil {
+		return nil
+	}
+	return m.recorder.Allow(provider, model)
+}
+
+// start starts the span of a model request
+func (m meter) start(ctx context.Context, provider Provider, model, operation string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "llm "+operation,
+		attribute.String("gen_ai.system", string(provider)),
+		attribute.String("gen_ai.request.model", model))
+}
+
+// record notes a request that was made, with its tokens on its span
+func (m meter) record(span trace.Span, usage Usage) {
+	span.SetAttributes(
+		attribute.Int64("gen_ai.usage.input_tokens", usage.InputTokens),
+		attribute.Int64("gen_ai.usage.output_tokens", usage.OutputTokens),
+		attribute.Bool("reorg.usage.estimated", usage.Estimated))
+	if m.recorder != nil {
+		m.recorder.Record(usage)
+	}
+}
+
+// estimateTokens approximates the token count of text for providers that
+// don't report it
+func estimateTokens(text string) int64 {
+	return int64(len(text)+3) / 4
+}
@@ -0,0 +1,126 @@
+package mcp
+
+import (
+	"context"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/ihavespoons/reorg/internal/approval"
+)
+
+// AcceptFunc creates the projects and tasks of an accepted import, filed
+// into area and project if given
+type AcceptFunc func(ctx context.Context, item *approval.Item, area, project string) error
+
+// SetApprovals adds the tools for reviewing imports queued for approval,
+// or only for listing them in read-only mode
+func (s *Server) SetApprovals(queue *approval.Queue, accept AcceptFunc) {
+	s.approvals = queue
+	s.accept = accept
+
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name:        "list_approvals",
+		Description: "List imported notes waiting for approval because the AI was unsure how to categorize them",
+	}, s.listApprovals)
+
+	if s.readOnly {
+		return
+	}
+
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name:        "accept_approval",
+		Description: "Accept a queued import and create its project and tasks",
+	}, s.acceptApproval)
+
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name:        "reject_approval",
+		Description: "Drop a queued import without creating anything",
+	}, s.rejectApproval)
+}
+
+type ApprovalInfo struct {
+	ID          string   `json:"id"`
+	Source      string   `json:"source"`
+	SourceTitle string   `json:"source_title"`
+	Content     string   `json:"content"`
+	Area        string   `json:"area"`
+	Confidence  float64  `json:"confidence"`
+	ProjectID   string   `json:"project_id,omitempty"`
+	Project     string   `json:"project,omitempty"`
+	Summary     string   `json:"summary,omitempty"`
+	Tasks       []string `json:"tasks,omitempty"`
+	Queued      string   `json:"queued"`
+}
+
+type ListApprovalsOutput struct {
+	Approvals []ApprovalInfo `json:"approvals"`
+}
+
+func (s *Server) listApprovals(ctx context.Context, req *mcp.CallToolRequest, input EmptyInput) (*mcp.CallToolResult, ListApprovalsOutput, error) {
+	items, err := s.approvals.List()
+	if err != nil {
+		return nil, ListApprovalsOutput{}, err
+	}
+
+	output := ListApprovalsOutput{Approvals: make([]ApprovalInfo, len(items))}
+	for i, item := range items {
+		info := ApprovalInfo{
+			ID:          item.ID,
+			Source:      item.Source,
+			SourceTitle: item.SourceTitle,
+			Content:     item.Content,
+			Area:        item.Categorization.Area,
+			Confidence:  item.Confidence(),
+			ProjectID:   item.Categorization.ProjectID,
+			Project:     item.Categorization.ProjectSuggestion,
+			Summary:     item.Categorization.Summary,
+			Queued:      item.Queued.Format(time.RFC3339),
+		}
+		for _, t := range item.Tasks {
+			info.Tasks = append(info.Tasks, t.Title)
+		}
+		output.Approvals[i] = info
+	}
+	return nil, output, nil
+}
+
+type AcceptApprovalInput struct {
+	ID      string `json:"id" jsonschema:"required,description=The approval ID"`
+	Area    string `json:"area,omitempty" jsonschema:"description=Area to file into instead of the suggested one"`
+	Project string `json:"project,omitempty" jsonschema:"description=Project ID or title to file into instead of the suggested one"`
+}
+
+type ResolveApprovalOutput struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+}
+
+func (s *Server) acceptApproval(ctx context.Context, req *mcp.CallToolRequest, input AcceptApprovalInput) (*mcp.CallToolResult, ResolveApprovalOutput, error) {
+	item, err := s.approvals.Get(input.ID)
+	if err != nil {
+		return nil, ResolveApprovalOutput{}, err
+	}
+	if err := s.accept(ctx, item, input.Area, input.Project); err != nil {
+		return nil, ResolveApprovalOutput{}, err
+	}
+	if err := s.approvals.Remove(item.ID); err != nil {
+		return nil, ResolveApprovalOutput{}, err
+	}
+	return nil, ResolveApprovalOutput{ID: item.ID, Message: "Imported " + item.SourceTitle}, nil
+}
+
+type RejectApprovalInput struct {
+	ID string `json:"id" jsonschema:"required,description=The approval ID"`
+}
+
+func (s *Server) rejectApproval(ctx context.Context, req *mcp.CallToolRequest, input RejectApprovalInput) (*mcp.CallToolResult, ResolveApprovalOutput, error) {
+	item, err := s.approvals.Get(input.ID)
+	if err != nil {
+		return nil, ResolveApprovalOutput{}, err
+	}
+	if err := s.approvals.Remove(item.ID); err != nil {
+		return nil, ResolveApprovalOutput{}, err
+	}
+	return nil, ResolveApprovalOutput{ID: item.ID, Message: "Rejected " + item.SourceTitle}, nil
+}
@@ -0,0 +1,78 @@
+package mcp
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/ihavespoons/reorg/internal/plan"
+)
+
+// PlanFunc builds the plan for day (today, tomorrow, +2d or a date), or for
+// the week from day, ordered by the AI if ai is set
+type PlanFunc func(ctx context.Context, day string, week, ai bool) (*plan.Plan, error)
+
+// SetPlanner adds the tool for proposing a plan
+func (s *Server) SetPlanner(planner PlanFunc) {
+	s.planner = planner
+
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name:        "plan_tasks",
+		Description: "Propose an ordered plan of the tasks to work on today, another day or this week. Use start_task to act on it.",
+	}, s.planTasks)
+}
+
+type PlanTasksInput struct {
+	Day  string `json:"day,omitempty" jsonschema:"description=Day to plan: today, tomorrow, +2d or YYYY-MM-DD (default today)"`
+	Week bool   `json:"week,omitempty" jsonschema:"description=Plan the seven days from the day"`
+	AI   bool   `json:"ai,omitempty" jsonschema:"description=Have the AI order the plan by deadlines, priority and estimates"`
+}
+
+type PlanItemInfo struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	Project   string `json:"project,omitempty"`
+	Reason    string `json:"reason"`
+	Note      string `json:"note,omitempty"`
+	Priority  string `json:"priority"`
+	DueDate   string `json:"due_date,omitempty"`
+	Scheduled string `json:"scheduled_date,omitempty"`
+	Estimate  string `json:"estimate,omitempty"`
+}
+
+type PlanTasksOutput struct {
+	Period string         `json:"period"`
+	Items  []PlanItemInfo `json:"items"`
+}
+
+func (s *Server) planTasks(ctx context.Context, req *mcp.CallToolRequest, input PlanTasksInput) (*mcp.CallToolResult, PlanTasksOutput, error) {
+	day := input.Day
+	if day == "" {
+		day = "today"
+	}
+	p, err := s.planner(ctx, day, input.Week, input.AI)
+	if err != nil {
+		return nil, PlanTasksOutput{}, err
+	}
+
+	output := PlanTasksOutput{Period: p.Period(), Items: make([]PlanItemInfo, len(p.Items))}
+	for i, item := range p.Items {
+		info := PlanItemInfo{
+			ID:       item.Task.ID,
+			Title:    item.Task.Title,
+			Project:  item.Project,
+			Reason:   string(item.Reason),
+			Note:     item.Note,
+			Priority: string(item.Task.Priority),
+			Estimate: item.Task.TimeEstimate,
+		}
+		if item.Task.DueDate != nil {
+			info.DueDate = item.Task.DueDate.Format("2006-01-02")
+		}
+		if item.Task.ScheduledDate != nil {
+			info.Scheduled = item.Task.ScheduledDate.Format("2006-01-02")
+		}
+		output.Items[i] = info
+	}
+	return nil, output, nil
+}
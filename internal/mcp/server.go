@@ -3,19 +3,34 @@ package mcp
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 
+	"github.com/ihavespoons/reorg/internal/approval"
+	"github.com/ihavespoons/reorg/internal/audit"
 	"github.com/ihavespoons/reorg/internal/domain"
 	"github.com/ihavespoons/reorg/internal/service"
+	"github.com/ihavespoons/reorg/internal/tracing"
 )
 
 // Server wraps the MCP server with reorg functionality
 type Server struct {
-	server *mcp.Server
-	client service.ReorgClient
+	server    *mcp.Server
+	client    service.ReorgClient
+	approvals *approval.Queue
+	accept    AcceptFunc
+	planner   PlanFunc
+	readOnly  bool
+}
+
+// writeTools are the tools that change data, left out in read-only mode
+var writeTools = []string{
+	"create_area", "create_project", "complete_project",
+	"create_task", "complete_task", "start_task", "comment_on_task", "add_note",
+	"accept_approval", "reject_approval",
 }
 
 // NewServer creates a new MCP server with all reorg tools
@@ -31,15 +46,57 @@ func NewServer(client service.ReorgClient) *Server {
 	}
 
 	s.registerTools()
+	server.AddReceivingMiddleware(traced, withActor)
 
 	return s
 }
 
+// traced records a span for each request, named after the tool for tool
+// calls
+func traced(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (result mcp.Result, err error) {
+		name := "mcp " + method
+		if params, ok := req.GetParams().(*mcp.CallToolParamsRaw); ok {
+			name = "mcp " + params.Name
+		}
+		ctx, span := tracing.Start(ctx, name)
+		defer func() { tracing.End(span, err) }()
+		return next(ctx, method, req)
+	}
+}
+
+// withActor names the MCP client that makes a call for the audit log, as
+// mcp:<client name>
+func withActor(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		actor := "mcp"
+		if session, ok := req.GetSession().(*mcp.ServerSession); ok {
+			if params := session.InitializeParams(); params != nil && params.ClientInfo != nil && params.ClientInfo.Name != "" {
+				actor += ":" + params.ClientInfo.Name
+			}
+		}
+		return next(audit.WithActor(ctx, actor), method, req)
+	}
+}
+
+// SetReadOnly removes the tools that change data, so assistants can look
+// but not touch
+func (s *Server) SetReadOnly() {
+	s.readOnly = true
+	s.server.RemoveTools(writeTools...)
+}
+
 // Run starts the MCP server over stdio
 func (s *Server) Run(ctx context.Context) error {
 	return s.server.Run(ctx, &mcp.StdioTransport{})
 }
 
+// RunIO starts the MCP server reading requests from r and writing
+// responses to w
+func (s *Server) RunIO(ctx context.Context, r io.ReadCloser, w io.WriteCloser) error {
+	return s.server.Run(ctx, &mcp.IOTransport{Reader: r, Writer: w})
+}
+
 // registerTools adds all reorg tools to the server
 func (s *Server) registerTools() {
 	// Area tools
@@ -75,6 +132,11 @@ func (s *Server) registerTools() {
 		Description: "List tasks, optionally filtered by project or area",
 	}, s.listTasks)
 
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name:        "get_task",
+		Description: "Get a task's details, including its dependencies and why it is blocked",
+	}, s.getTask)
+
 	mcp.AddTool(s.server, &mcp.Tool{
 		Name:        "create_task",
 		Description: "Create a new task in a project",
@@ -90,9 +152,25 @@ func (s *Server) registerTools() {
 		Description: "Mark a task as in progress",
 	}, s.startTask)
 
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name:        "comment_on_task",
+		Description: "Add a timestamped comment to a task's comment thread",
+	}, s.commentOnTask)
+
+	// Note tools
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name:        "add_note",
+		Description: "Add a timestamped markdown note to a project or task",
+	}, s.addNote)
+
+	mcp.AddTool(s.server, &mcp.Tool{
+		Name:        "search_notes",
+		Description: "Find notes on projects and tasks containing some text",
+	}, s.searchNotes)
+
 	mcp.AddTool(s.server, &mcp.Tool{
 		Name:        "get_status",
-		Description: "Get an overview of all areas, projects, and tasks",
+		Description: "Get an overview of all areas, projects, and tasks. Someday projects are only counted",
 	}, s.getStatus)
 }
 
@@ -105,10 +183,15 @@ type ListAreasOutput struct {
 }
 
 type AreaInfo struct {
-	ID           string `json:"id"`
-	Title        string `json:"title"`
-	Slug         string `json:"slug"`
-	ProjectCount int    `json:"project_count"`
+	ID           string   `json:"id"`
+	Title        string   `json:"title"`
+	Slug         string   `json:"slug"`
+	Priority     string   `json:"priority,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+	Review       string   `json:"review,omitempty"`
+	NextReview   string   `json:"next_review,omitempty"`
+	SortOrder    int      `json:"sort_order"`
+	ProjectCount int      `json:"project_count"`
 }
 
 func (s *Server) listAreas(ctx context.Context, req *mcp.CallToolRequest, input EmptyInput) (*mcp.CallToolResult, ListAreasOutput, error) {
@@ -124,15 +207,25 @@ func (s *Server) listAreas(ctx context.Context, req *mcp.CallToolRequest, input
 			ID:           a.ID,
 			Title:        a.Title,
 			Slug:         a.Slug(),
+			Priority:     string(a.Priority),
+			Tags:         a.Tags,
+			Review:       string(a.Review),
+			SortOrder:    a.SortOrder,
 			ProjectCount: len(projects),
 		}
+		if next := a.NextReview(); next != nil {
+			output.Areas[i].NextReview = next.Format("2006-01-02")
+		}
 	}
 
 	return nil, output, nil
 }
 
 type CreateAreaInput struct {
-	Title string `json:"title" jsonschema:"required,description=The title for the new area"`
+	Title    string   `json:"title" jsonschema:"required,description=The title for the new area"`
+	Priority string   `json:"priority,omitempty" jsonschema:"description=Priority: low, medium, high, urgent (default: medium)"`
+	Tags     []string `json:"tags,omitempty" jsonschema:"description=Tags for the area (optional)"`
+	Review   string   `json:"review,omitempty" jsonschema:"description=Review cadence: weekly, biweekly, monthly, quarterly or yearly (optional)"`
 }
 
 type CreateAreaOutput struct {
@@ -142,7 +235,25 @@ type CreateAreaOutput struct {
 }
 
 func (s *Server) createArea(ctx context.Context, req *mcp.CallToolRequest, input CreateAreaInput) (*mcp.CallToolResult, CreateAreaOutput, error) {
+	review, err := domain.ParseReviewCadence(input.Review)
+	if err != nil {
+		return nil, CreateAreaOutput{}, err
+	}
+
 	area := domain.NewArea(input.Title)
+	area.Review = review
+	switch strings.ToLower(input.Priority) {
+	case "low":
+		area.Priority = domain.PriorityLow
+	case "high":
+		area.Priority = domain.PriorityHigh
+	case "urgent":
+		area.Priority = domain.PriorityUrgent
+	}
+	for _, tag := range input.Tags {
+		area.AddTag(tag)
+	}
+
 	created, err := s.client.CreateArea(ctx, area)
 	if err != nil {
 		return nil, CreateAreaOutput{}, err
@@ -156,7 +267,8 @@ func (s *Server) createArea(ctx context.Context, req *mcp.CallToolRequest, input
 }
 
 type ListProjectsInput struct {
-	Area string `json:"area,omitempty" jsonschema:"description=Filter by area slug (optional)"`
+	Area  string `json:"area,omitempty" jsonschema:"description=Filter by area slug (optional)"`
+	Query string `json:"query,omitempty" jsonschema:"description=Query expression, e.g. status:active priority>=high (optional)"`
 }
 
 type ListProjectsOutput struct {
@@ -170,6 +282,7 @@ type ProjectInfo struct {
 	AreaID    string `json:"area_id"`
 	AreaTitle string `json:"area_title"`
 	Status    string `json:"status"`
+	Priority  string `json:"priority"`
 	TaskCount int    `json:"task_count"`
 }
 
@@ -177,10 +290,19 @@ func (s *Server) listProjects(ctx context.Context, req *mcp.CallToolRequest, inp
 	var projects []*domain.Project
 	var err error
 
-	if input.Area != "" {
+	if input.Query != "" {
+		query := input.Query
+		if input.Area != "" {
+			query = "area:" + input.Area + " " + query
+		}
+		projects, err = s.client.QueryProjects(ctx, query)
+		if err != nil {
+			return nil, ListProjectsOutput{}, err
+		}
+	} else if input.Area != "" {
 		area, err := s.client.GetAreaBySlug(ctx, input.Area)
 		if err != nil {
-			return nil, ListProjectsOutput{}, fmt.Errorf("area not found: %s", input.Area)
+			return nil, ListProjectsOutput{}, domain.Errorf(domain.ErrNotFound, "area not found: %s", input.Area)
 		}
 		projects, err = s.client.ListProjects(ctx, area.ID)
 		if err != nil {
@@ -208,6 +330,7 @@ func (s *Server) listProjects(ctx context.Context, req *mcp.CallToolRequest, inp
 			AreaID:    p.AreaID,
 			AreaTitle: areaTitle,
 			Status:    string(p.Status),
+			Priority:  string(p.Priority),
 			TaskCount: len(tasks),
 		}
 	}
@@ -231,7 +354,7 @@ type CreateProjectOutput struct {
 func (s *Server) createProject(ctx context.Context, req *mcp.CallToolRequest, input CreateProjectInput) (*mcp.CallToolResult, CreateProjectOutput, error) {
 	area, err := s.client.GetAreaBySlug(ctx, input.Area)
 	if err != nil {
-		return nil, CreateProjectOutput{}, fmt.Errorf("area not found: %s", input.Area)
+		return nil, CreateProjectOutput{}, domain.Errorf(domain.ErrNotFound, "area not found: %s", input.Area)
 	}
 
 	project := domain.NewProject(input.Title, area.ID)
@@ -257,11 +380,12 @@ type CompleteProjectInput struct {
 type CompleteProjectOutput struct {
 	Success bool   `json:"success"`
 	Message string `json:"message"`
+	Code    string `json:"code,omitempty"` // not_found, invalid, already_exists or conflict, when it failed for one
 }
 
 func (s *Server) completeProject(ctx context.Context, req *mcp.CallToolRequest, input CompleteProjectInput) (*mcp.CallToolResult, CompleteProjectOutput, error) {
 	if err := s.client.CompleteProject(ctx, input.ID); err != nil {
-		return nil, CompleteProjectOutput{Success: false, Message: err.Error()}, nil
+		return nil, CompleteProjectOutput{Success: false, Message: err.Error(), Code: domain.Code(err)}, nil
 	}
 
 	return nil, CompleteProjectOutput{
@@ -274,6 +398,8 @@ type ListTasksInput struct {
 	Project string `json:"project,omitempty" jsonschema:"description=Filter by project ID (optional)"`
 	Area    string `json:"area,omitempty" jsonschema:"description=Filter by area slug (optional)"`
 	Status  string `json:"status,omitempty" jsonschema:"description=Filter by status: pending, in_progress, completed, blocked (optional)"`
+	Query   string `json:"query,omitempty" jsonschema:"description=Query expression, e.g. status:pending priority>=high due<2025-02-01 tag:client (optional)"`
+	All     bool   `json:"all,omitempty" jsonschema:"description=Include tasks in someday projects, which are left out unless a project or query is given (optional)"`
 }
 
 type ListTasksOutput struct {
@@ -281,26 +407,44 @@ type ListTasksOutput struct {
 }
 
 type TaskInfo struct {
-	ID           string  `json:"id"`
-	Title        string  `json:"title"`
-	Status       string  `json:"status"`
-	Priority     string  `json:"priority"`
-	ProjectID    string  `json:"project_id"`
-	ProjectTitle string  `json:"project_title"`
-	DueDate      *string `json:"due_date,omitempty"`
-	IsOverdue    bool    `json:"is_overdue"`
+	ID            string           `json:"id"`
+	Title         string           `json:"title"`
+	Status        string           `json:"status"`
+	Priority      string           `json:"priority"`
+	ProjectID     string           `json:"project_id"`
+	ProjectTitle  string           `json:"project_title"`
+	DueDate       *string          `json:"due_date,omitempty"` // YYYY-MM-DD if all day, else RFC 3339
+	ScheduledDate *string          `json:"scheduled_date,omitempty"`
+	IsOverdue     bool             `json:"is_overdue"`
+	Dependencies  []DependencyInfo `json:"dependencies,omitempty"`
+	BlockedReason string           `json:"blocked_reason,omitempty"`
+}
+
+type DependencyInfo struct {
+	ID     string `json:"id"`
+	Title  string `json:"title,omitempty"`
+	Status string `json:"status,omitempty"`
 }
 
 func (s *Server) listTasks(ctx context.Context, req *mcp.CallToolRequest, input ListTasksInput) (*mcp.CallToolResult, ListTasksOutput, error) {
 	var tasks []*domain.Task
 	var err error
 
-	if input.Project != "" {
+	if input.Query != "" {
+		query := input.Query
+		if input.Project != "" {
+			query = "project:" + input.Project + " " + query
+		}
+		if input.Area != "" {
+			query = "area:" + input.Area + " " + query
+		}
+		tasks, err = s.client.QueryTasks(ctx, query)
+	} else if input.Project != "" {
 		tasks, err = s.client.ListTasks(ctx, input.Project)
 	} else if input.Area != "" {
 		area, err := s.client.GetAreaBySlug(ctx, input.Area)
 		if err != nil {
-			return nil, ListTasksOutput{}, fmt.Errorf("area not found: %s", input.Area)
+			return nil, ListTasksOutput{}, domain.Errorf(domain.ErrNotFound, "area not found: %s", input.Area)
 		}
 		tasks, err = s.client.ListTasksByArea(ctx, area.ID)
 		if err != nil {
@@ -314,6 +458,14 @@ func (s *Server) listTasks(ctx context.Context, req *mcp.CallToolRequest, input
 		return nil, ListTasksOutput{}, err
 	}
 
+	if input.Query == "" && input.Project == "" && !input.All {
+		projects, err := s.client.ListAllProjects(ctx)
+		if err != nil {
+			return nil, ListTasksOutput{}, err
+		}
+		tasks = service.WithoutSomeday(tasks, projects)
+	}
+
 	// Filter by status if specified
 	if input.Status != "" {
 		filtered := make([]*domain.Task, 0)
@@ -325,40 +477,136 @@ func (s *Server) listTasks(ctx context.Context, req *mcp.CallToolRequest, input
 		tasks = filtered
 	}
 
+	// Dependencies can point at tasks outside the filtered list
+	allTasks, err := s.client.ListAllTasks(ctx)
+	if err != nil {
+		return nil, ListTasksOutput{}, err
+	}
+	lookup := taskLookup(allTasks)
+
 	output := ListTasksOutput{Tasks: make([]TaskInfo, len(tasks))}
 	for i, t := range tasks {
-		projectTitle := ""
-		if project, _ := s.client.GetProject(ctx, t.ProjectID); project != nil {
-			projectTitle = project.Title
-		}
+		output.Tasks[i] = s.taskInfo(ctx, t, lookup)
+	}
+
+	return nil, output, nil
+}
+
+type GetTaskInput struct {
+	ID string `json:"id" jsonschema:"required,description=The task ID"`
+}
+
+type GetTaskOutput struct {
+	TaskInfo
+	Content      string              `json:"content,omitempty"`
+	Tags         []string            `json:"tags,omitempty"`
+	Context      string              `json:"context,omitempty"`
+	Effort       string              `json:"effort,omitempty"`
+	TimeEstimate string              `json:"time_estimate,omitempty"`
+	TimeSpent    string              `json:"time_spent,omitempty"`
+	Attachments  []string            `json:"attachments,omitempty"`
+	Metadata     map[string]string   `json:"metadata,omitempty"`
+	ExternalRef  *domain.ExternalRef `json:"external_ref,omitempty"`
+	Comments     []domain.Comment    `json:"comments,omitempty"`
+	Notes        []NoteInfo          `json:"notes,omitempty"`
+}
+
+func (s *Server) getTask(ctx context.Context, req *mcp.CallToolRequest, input GetTaskInput) (*mcp.CallToolResult, GetTaskOutput, error) {
+	task, err := s.client.GetTask(ctx, input.ID)
+	if err != nil {
+		return nil, GetTaskOutput{}, err
+	}
+
+	allTasks, err := s.client.ListAllTasks(ctx)
+	if err != nil {
+		return nil, GetTaskOutput{}, err
+	}
 
-		var dueDate *string
-		if t.DueDate != nil {
-			d := t.DueDate.Format("2006-01-02")
-			dueDate = &d
+	notes, _ := s.client.ListNotes(ctx, task.ID)
+
+	return nil, GetTaskOutput{
+		TaskInfo:     s.taskInfo(ctx, task, taskLookup(allTasks)),
+		Content:      task.Description(),
+		Tags:         task.Tags,
+		Context:      task.Context,
+		Effort:       string(task.Effort),
+		TimeEstimate: task.TimeEstimate,
+		TimeSpent:    task.TimeSpent,
+		Attachments:  task.Attachments,
+		Metadata:     task.Metadata,
+		ExternalRef:  task.ExternalRef,
+		Comments:     task.Comments(),
+		Notes:        noteInfos(notes),
+	}, nil
+}
+
+// taskInfo summarizes a task, resolving its dependencies with lookup
+func (s *Server) taskInfo(ctx context.Context, t *domain.Task, lookup func(id string) *domain.Task) TaskInfo {
+	projectTitle := ""
+	if project, _ := s.client.GetProject(ctx, t.ProjectID); project != nil {
+		projectTitle = project.Title
+	}
+
+	var dueDate *string
+	if t.DueDate != nil {
+		d := t.DueDate.Format("2006-01-02")
+		if !t.AllDay {
+			d = t.DueDate.Format(time.RFC3339)
 		}
+		dueDate = &d
+	}
+	var scheduledDate *string
+	if t.ScheduledDate != nil {
+		d := t.ScheduledDate.Format("2006-01-02")
+		scheduledDate = &d
+	}
 
-		output.Tasks[i] = TaskInfo{
-			ID:           t.ID,
-			Title:        t.Title,
-			Status:       string(t.Status),
-			Priority:     string(t.Priority),
-			ProjectID:    t.ProjectID,
-			ProjectTitle: projectTitle,
-			DueDate:      dueDate,
-			IsOverdue:    t.IsOverdue(),
+	var dependencies []DependencyInfo
+	for _, id := range t.Dependencies {
+		dep := DependencyInfo{ID: id}
+		if d := lookup(id); d != nil {
+			dep.Title = d.Title
+			dep.Status = string(d.Status)
 		}
+		dependencies = append(dependencies, dep)
 	}
 
-	return nil, output, nil
+	return TaskInfo{
+		ID:            t.ID,
+		Title:         t.Title,
+		Status:        string(t.Status),
+		Priority:      string(t.Priority),
+		ProjectID:     t.ProjectID,
+		ProjectTitle:  projectTitle,
+		DueDate:       dueDate,
+		ScheduledDate: scheduledDate,
+		IsOverdue:     t.IsOverdue(),
+		Dependencies:  dependencies,
+		BlockedReason: t.BlockedReason(lookup),
+	}
+}
+
+// taskLookup indexes tasks by ID for resolving dependencies
+func taskLookup(tasks []*domain.Task) func(id string) *domain.Task {
+	byID := make(map[string]*domain.Task, len(tasks))
+	for _, t := range tasks {
+		byID[t.ID] = t
+	}
+	return func(id string) *domain.Task {
+		return byID[id]
+	}
 }
 
 type CreateTaskInput struct {
-	Title       string `json:"title" jsonschema:"required,description=The task title (should be action-oriented)"`
-	Project     string `json:"project" jsonschema:"required,description=The project ID to add the task to"`
-	Description string `json:"description,omitempty" jsonschema:"description=Optional description or notes"`
-	Priority    string `json:"priority,omitempty" jsonschema:"description=Priority: low, medium, high, urgent (default: medium)"`
-	DueDate     string `json:"due_date,omitempty" jsonschema:"description=Due date in YYYY-MM-DD format (optional)"`
+	Title       string            `json:"title" jsonschema:"required,description=The task title (should be action-oriented)"`
+	Project     string            `json:"project" jsonschema:"required,description=The project ID to add the task to"`
+	Description string            `json:"description,omitempty" jsonschema:"description=Optional description or notes"`
+	Priority    string            `json:"priority,omitempty" jsonschema:"description=Priority: low, medium, high, urgent (default: medium)"`
+	DueDate     string            `json:"due_date,omitempty" jsonschema:"description=Due date: the deadline in YYYY-MM-DD format for all day or RFC 3339 for a moment (optional)"`
+	Scheduled   string            `json:"scheduled_date,omitempty" jsonschema:"description=Scheduled date: the day to start on in YYYY-MM-DD format (optional)"`
+	Context     string            `json:"context,omitempty" jsonschema:"description=Where or how it can be done, e.g. home, errands or deep-work (optional)"`
+	Effort      string            `json:"effort,omitempty" jsonschema:"description=Rough size: small, medium or large (optional)"`
+	Metadata    map[string]string `json:"metadata,omitempty" jsonschema:"description=Key/value data such as external IDs that can be queried with meta.<key> (optional)"`
 }
 
 type CreateTaskOutput struct {
@@ -371,11 +619,15 @@ type CreateTaskOutput struct {
 func (s *Server) createTask(ctx context.Context, req *mcp.CallToolRequest, input CreateTaskInput) (*mcp.CallToolResult, CreateTaskOutput, error) {
 	project, err := s.client.GetProject(ctx, input.Project)
 	if err != nil {
-		return nil, CreateTaskOutput{}, fmt.Errorf("project not found: %s", input.Project)
+		return nil, CreateTaskOutput{}, domain.Errorf(domain.ErrNotFound, "project not found: %s", input.Project)
 	}
 
 	task := domain.NewTask(input.Title, project.ID, project.AreaID)
 	task.Content = input.Description
+	task.Context = domain.NormalizeContext(input.Context)
+	if task.Effort, err = domain.ParseEffort(input.Effort); err != nil {
+		return nil, CreateTaskOutput{}, err
+	}
 
 	if input.Priority != "" {
 		switch strings.ToLower(input.Priority) {
@@ -391,11 +643,26 @@ func (s *Server) createTask(ctx context.Context, req *mcp.CallToolRequest, input
 	}
 
 	if input.DueDate != "" {
-		if due, err := time.Parse("2006-01-02", input.DueDate); err == nil {
+		if due, err := time.ParseInLocation("2006-01-02", input.DueDate, time.Local); err == nil {
 			task.DueDate = &due
+			task.AllDay = true
+		} else if due, err := time.Parse(time.RFC3339, input.DueDate); err == nil {
+			task.DueDate = &due
+		}
+	}
+	if input.Scheduled != "" {
+		if scheduled, err := time.Parse("2006-01-02", input.Scheduled); err == nil {
+			task.ScheduledDate = &scheduled
 		}
 	}
 
+	for key := range input.Metadata {
+		if !domain.ValidMetaKey(key) {
+			return nil, CreateTaskOutput{}, fmt.Errorf("invalid metadata key: %s", key)
+		}
+	}
+	domain.SetMetadata(&task.Metadata, input.Metadata)
+
 	created, err := s.client.CreateTask(ctx, task)
 	if err != nil {
 		return nil, CreateTaskOutput{}, err
@@ -416,11 +683,12 @@ type CompleteTaskInput struct {
 type CompleteTaskOutput struct {
 	Success bool   `json:"success"`
 	Message string `json:"message"`
+	Code    string `json:"code,omitempty"` // not_found, invalid, already_exists or conflict, when it failed for one
 }
 
 func (s *Server) completeTask(ctx context.Context, req *mcp.CallToolRequest, input CompleteTaskInput) (*mcp.CallToolResult, CompleteTaskOutput, error) {
 	if err := s.client.CompleteTask(ctx, input.ID); err != nil {
-		return nil, CompleteTaskOutput{Success: false, Message: err.Error()}, nil
+		return nil, CompleteTaskOutput{Success: false, Message: err.Error(), Code: domain.Code(err)}, nil
 	}
 
 	return nil, CompleteTaskOutput{
@@ -436,11 +704,12 @@ type StartTaskInput struct {
 type StartTaskOutput struct {
 	Success bool   `json:"success"`
 	Message string `json:"message"`
+	Code    string `json:"code,omitempty"` // not_found, invalid, already_exists or conflict, when it failed for one
 }
 
 func (s *Server) startTask(ctx context.Context, req *mcp.CallToolRequest, input StartTaskInput) (*mcp.CallToolResult, StartTaskOutput, error) {
 	if err := s.client.StartTask(ctx, input.ID); err != nil {
-		return nil, StartTaskOutput{Success: false, Message: err.Error()}, nil
+		return nil, StartTaskOutput{Success: false, Message: err.Error(), Code: domain.Code(err)}, nil
 	}
 
 	return nil, StartTaskOutput{
@@ -449,6 +718,33 @@ func (s *Server) startTask(ctx context.Context, req *mcp.CallToolRequest, input
 	}, nil
 }
 
+type CommentOnTaskInput struct {
+	ID     string `json:"id" jsonschema:"required,description=The task ID"`
+	Text   string `json:"text" jsonschema:"required,description=The comment"`
+	Author string `json:"author,omitempty" jsonschema:"description=Who the comment is from (default mcp)"`
+}
+
+type CommentOnTaskOutput struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	Code    string `json:"code,omitempty"` // not_found, invalid, already_exists or conflict, when it failed for one
+}
+
+func (s *Server) commentOnTask(ctx context.Context, req *mcp.CallToolRequest, input CommentOnTaskInput) (*mcp.CallToolResult, CommentOnTaskOutput, error) {
+	author := input.Author
+	if author == "" {
+		author = "mcp"
+	}
+	if _, err := s.client.AddTaskComment(ctx, input.ID, author, input.Text); err != nil {
+		return nil, CommentOnTaskOutput{Success: false, Message: err.Error(), Code: domain.Code(err)}, nil
+	}
+
+	return nil, CommentOnTaskOutput{
+		Success: true,
+		Message: "Comment added",
+	}, nil
+}
+
 type StatusOutput struct {
 	Summary string       `json:"summary"`
 	Areas   []AreaStatus `json:"areas"`
@@ -469,36 +765,41 @@ type ProjectStatus struct {
 }
 
 func (s *Server) getStatus(ctx context.Context, req *mcp.CallToolRequest, input EmptyInput) (*mcp.CallToolResult, StatusOutput, error) {
-	areas, err := s.client.ListAreas(ctx)
+	overview, err := s.client.GetOverview(ctx)
 	if err != nil {
 		return nil, StatusOutput{}, err
 	}
 
 	output := StatusOutput{
-		Areas: make([]AreaStatus, len(areas)),
+		Areas: make([]AreaStatus, len(overview.Areas)),
 	}
 
 	totalProjects := 0
+	somedayProjects := 0
 	totalTasks := 0
 	totalPending := 0
 	totalInProgress := 0
 
-	for i, area := range areas {
-		projects, _ := s.client.ListProjects(ctx, area.ID)
+	for i, area := range overview.Areas {
 		areaStatus := AreaStatus{
-			Title:    area.Title,
-			Projects: make([]ProjectStatus, len(projects)),
+			Title:    area.Area.Title,
+			Projects: make([]ProjectStatus, 0, len(area.Projects)),
 		}
 
-		for j, p := range projects {
-			tasks, _ := s.client.ListTasks(ctx, p.ID)
+		for _, p := range area.Projects {
+			// Someday projects are only counted, like in 'reorg status'
+			if p.Project.IsSomeday() {
+				somedayProjects++
+				continue
+			}
+
 			ps := ProjectStatus{
-				Title:      p.Title,
-				Status:     string(p.Status),
-				TotalTasks: len(tasks),
+				Title:      p.Project.Title,
+				Status:     string(p.Project.Status),
+				TotalTasks: len(p.Tasks),
 			}
 
-			for _, t := range tasks {
+			for _, t := range p.Tasks {
 				switch t.Status {
 				case domain.TaskStatusPending:
 					ps.PendingTasks++
@@ -511,17 +812,68 @@ func (s *Server) getStatus(ctx context.Context, req *mcp.CallToolRequest, input
 				}
 			}
 
-			areaStatus.Projects[j] = ps
-			totalTasks += len(tasks)
+			areaStatus.Projects = append(areaStatus.Projects, ps)
+			totalTasks += len(p.Tasks)
+			totalProjects++
 		}
 
 		output.Areas[i] = areaStatus
-		totalProjects += len(projects)
 	}
 
 	output.Summary = fmt.Sprintf("%d areas, %d projects, %d tasks (%d pending, %d in progress)",
-		len(areas), totalProjects, totalTasks, totalPending, totalInProgress)
+		len(overview.Areas), totalProjects, totalTasks, totalPending, totalInProgress)
+	if somedayProjects > 0 {
+		output.Summary += fmt.Sprintf(", %d someday projects", somedayProjects)
+	}
 
 	return nil, output, nil
 }
 
+type NoteInfo struct {
+	ID       string `json:"id"`
+	ParentID string `json:"parent_id"`
+	Created  string `json:"created"`
+	Content  string `json:"content"`
+}
+
+func noteInfos(notes []*domain.Note) []NoteInfo {
+	infos := make([]NoteInfo, len(notes))
+	for i, n := range notes {
+		infos[i] = NoteInfo{
+			ID:       n.ID,
+			ParentID: n.ParentID,
+			Created:  n.Created.Format(time.RFC3339),
+			Content:  n.Content,
+		}
+	}
+	return infos
+}
+
+type AddNoteInput struct {
+	ParentID string `json:"parent_id" jsonschema:"required,description=The ID of the project or task"`
+	Content  string `json:"content" jsonschema:"required,description=The note text (markdown)"`
+}
+
+func (s *Server) addNote(ctx context.Context, req *mcp.CallToolRequest, input AddNoteInput) (*mcp.CallToolResult, NoteInfo, error) {
+	note, err := s.client.AddNote(ctx, domain.NewNote(input.ParentID, input.Content))
+	if err != nil {
+		return nil, NoteInfo{}, err
+	}
+	return nil, noteInfos([]*domain.Note{note})[0], nil
+}
+
+type SearchNotesInput struct {
+	Text string `json:"text" jsonschema:"required,description=Text to search for (case-insensitive)"`
+}
+
+type SearchNotesOutput struct {
+	Notes []NoteInfo `json:"notes"`
+}
+
+func (s *Server) searchNotes(ctx context.Context, req *mcp.CallToolRequest, input SearchNotesInput) (*mcp.CallToolResult, SearchNotesOutput, error) {
+	notes, err := s.client.SearchNotes(ctx, input.Text)
+	if err != nil {
+		return nil, SearchNotesOutput{}, err
+	}
+	return nil, SearchNotesOutput{Notes: noteInfos(notes)}, nil
+}
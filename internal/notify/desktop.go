@@ -0,0 +1,77 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Desktop shows notifications through the operating system
+type Desktop struct{}
+
+// NewDesktop creates a new desktop notifier
+func NewDesktop() *Desktop {
+	return &Desktop{}
+}
+
+// Notify shows a desktop notification using osascript on macOS and
+// notify-send on Linux
+func (d *Desktop) Notify(ctx context.Context, msg Message) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s",
+			appleScriptString(msg.Body), appleScriptString(msg.Title))
+		cmd = exec.CommandContext(ctx, "osascript", "-e", script)
+	case "linux":
+		cmd = exec.CommandContext(ctx, "notify-send", msg.Title, msg.Body)
+	default:
+		return fmt.Errorf("desktop notifications are not supported on %s", runtime.GOOS)
+	}
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("desktop notification failed: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// Ask shows a notification with a button labelled action and reports
+// whether it was clicked before the notification went away. On macOS it is
+// a dialog that gives up after a minute; on Linux it needs a notification
+// daemon that supports actions.
+func (d *Desktop) Ask(ctx context.Context, msg Message, action string) (bool, error) {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display dialog %s with title %s buttons {\"Ignore\", %s} default button %s giving up after 60",
+			appleScriptString(msg.Body), appleScriptString(msg.Title), appleScriptString(action), appleScriptString(action))
+		cmd = exec.CommandContext(ctx, "osascript", "-e", script)
+	case "linux":
+		cmd = exec.CommandContext(ctx, "notify-send", "--wait", "--expire-time=60000", "--action=yes="+action, msg.Title, msg.Body)
+	default:
+		return false, fmt.Errorf("desktop notifications are not supported on %s", runtime.GOOS)
+	}
+
+	output, err := cmd.CombinedOutput()
+	out := strings.TrimSpace(string(output))
+	if err != nil {
+		// Pressing Ignore in the dialog exits with an error too
+		if strings.Contains(out, "User canceled") || strings.Contains(out, "(-128)") {
+			return false, nil
+		}
+		return false, fmt.Errorf("desktop notification failed: %w: %s", err, out)
+	}
+	if runtime.GOOS == "darwin" {
+		return strings.Contains(out, "button returned:"+action), nil
+	}
+	return out == "yes", nil
+}
+
+// appleScriptString quotes s as an AppleScript string literal
+func appleScriptString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,70 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EmailConfig is the SMTP server and addresses email is sent with
+type EmailConfig struct {
+	Host     string
+	Port     int // defaults to 587
+	Username string
+	Password string
+	From     string
+	To       []string
+}
+
+// Email sends messages as plain text email over SMTP
+type Email struct {
+	cfg EmailConfig
+}
+
+// NewEmail creates a notifier that mails messages to cfg.To
+func NewEmail(cfg EmailConfig) (*Email, error) {
+	if cfg.Host == "" || cfg.From == "" || len(cfg.To) == 0 {
+		return nil, fmt.Errorf("email needs an SMTP host, a from address and at least one recipient")
+	}
+	if cfg.Port == 0 {
+		cfg.Port = 587
+	}
+	return &Email{cfg: cfg}, nil
+}
+
+// Notify sends the message, with its title as the subject. STARTTLS is
+// used when the server offers it, and the login only when a username is
+// set.
+func (e *Email) Notify(ctx context.Context, msg Message) error {
+	var body strings.Builder
+	fmt.Fprintf(&body, "From: %s\r\n", e.cfg.From)
+	fmt.Fprintf(&body, "To: %s\r\n", strings.Join(e.cfg.To, ", "))
+	fmt.Fprintf(&body, "Subject: %s\r\n", msg.Title)
+	fmt.Fprintf(&body, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	body.WriteString("MIME-Version: 1.0\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n")
+	body.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+
+	var auth smtp.Auth
+	if e.cfg.Username != "" {
+		auth = smtp.PlainAuth("", e.cfg.Username, e.cfg.Password, e.cfg.Host)
+	}
+
+	addr := net.JoinHostPort(e.cfg.Host, strconv.Itoa(e.cfg.Port))
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(addr, auth, e.cfg.From, e.cfg.To, []byte(body.String()))
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("failed to send email: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
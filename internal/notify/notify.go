@@ -0,0 +1,84 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Channel names that are not webhook URLs
+const (
+	ChannelDesktop = "desktop"
+	ChannelNone    = "none"
+)
+
+// Message is a notification about a change to a task or project
+type Message struct {
+	Title string
+	Body  string
+}
+
+// Notifier delivers messages to a single channel
+type Notifier interface {
+	Notify(ctx context.Context, msg Message) error
+}
+
+// ForChannel returns a notifier for a channel: "desktop", "none", "ntfy:<topic>",
+// or an http(s) webhook URL. Slack incoming webhooks get Slack-formatted
+// payloads and ntfy.sh URLs are published as ntfy messages.
+func ForChannel(channel string) (Notifier, error) {
+	channel = strings.TrimSpace(channel)
+	switch strings.ToLower(channel) {
+	case "", ChannelNone:
+		return nopNotifier{}, nil
+	case ChannelDesktop:
+		return NewDesktop(), nil
+	}
+
+	if topic, ok := strings.CutPrefix(channel, ntfyPrefix); ok && topic != "" {
+		return NewNtfy(ntfyServer + "/" + topic), nil
+	}
+
+	u, err := url.Parse(channel)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid notification channel: %s (use desktop, none, ntfy:<topic>, or a webhook URL)", channel)
+	}
+
+	switch u.Host {
+	case "hooks.slack.com":
+		return NewSlack(channel), nil
+	case "ntfy.sh":
+		return NewNtfy(channel), nil
+	}
+	return NewWebhook(channel), nil
+}
+
+// Router sends messages to a per-project channel, falling back to a default
+type Router struct {
+	defaultChannel string
+}
+
+// NewRouter creates a router that uses defaultChannel when a project has none
+func NewRouter(defaultChannel string) *Router {
+	return &Router{defaultChannel: defaultChannel}
+}
+
+// Send delivers a message to channel, or to the default channel if channel is empty
+func (r *Router) Send(ctx context.Context, channel string, msg Message) error {
+	if channel == "" {
+		channel = r.defaultChannel
+	}
+
+	n, err := ForChannel(channel)
+	if err != nil {
+		return err
+	}
+	return n.Notify(ctx, msg)
+}
+
+type nopNotifier struct{}
+
+func (nopNotifier) Notify(ctx context.Context, msg Message) error {
+	return nil
+}
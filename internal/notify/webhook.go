@@ -0,0 +1,120 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Webhook posts messages as JSON to an HTTP endpoint
+type Webhook struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewWebhook creates a notifier that posts {"title", "body"} to url
+func NewWebhook(url string) *Webhook {
+	return &Webhook{
+		url:        url,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Notify posts the message to the webhook
+func (w *Webhook) Notify(ctx context.Context, msg Message) error {
+	return postJSON(ctx, w.httpClient, w.url, map[string]string{
+		"title": msg.Title,
+		"body":  msg.Body,
+	})
+}
+
+// Slack posts messages to a Slack incoming webhook
+type Slack struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewSlack creates a notifier for a Slack incoming webhook URL
+func NewSlack(url string) *Slack {
+	return &Slack{
+		url:        url,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Notify posts the message to Slack
+func (s *Slack) Notify(ctx context.Context, msg Message) error {
+	text := fmt.Sprintf("*%s*", msg.Title)
+	if msg.Body != "" {
+		text += "\n" + msg.Body
+	}
+	return postJSON(ctx, s.httpClient, s.url, map[string]string{"text": text})
+}
+
+// ntfyPrefix selects an ntfy.sh topic as a channel, e.g. ntfy:my-reminders
+const (
+	ntfyPrefix = "ntfy:"
+	ntfyServer = "https://ntfy.sh"
+)
+
+// Ntfy publishes messages to an ntfy topic URL
+type Ntfy struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewNtfy creates a notifier for an ntfy topic URL
+func NewNtfy(url string) *Ntfy {
+	return &Ntfy{
+		url:        url,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Notify publishes the message body with the title as a header
+func (n *Ntfy) Notify(ctx context.Context, msg Message) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, strings.NewReader(msg.Body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Title", msg.Title)
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("ntfy returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("notification webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
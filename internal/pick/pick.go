@@ -0,0 +1,284 @@
+// Package pick suggests what to work on now: the open tasks that fit the
+// time and energy at hand, ranked by due date, priority and context.
+package pick
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/llm"
+)
+
+// Energy is how much energy there is for work
+type Energy string
+
+const (
+	EnergyLow    Energy = "low"
+	EnergyMedium Energy = "medium"
+	EnergyHigh   Energy = "high"
+)
+
+// ParseEnergy parses an energy level. An empty string means any.
+func ParseEnergy(s string) (Energy, error) {
+	switch e := Energy(strings.ToLower(strings.TrimSpace(s))); e {
+	case "", EnergyLow, EnergyMedium, EnergyHigh:
+		return e, nil
+	default:
+		return "", fmt.Errorf("invalid energy %q (use low, medium or high)", s)
+	}
+}
+
+// maxEffort is the largest effort worth starting with this much energy
+func (e Energy) maxEffort() domain.Effort {
+	switch e {
+	case EnergyLow:
+		return domain.EffortSmall
+	case EnergyMedium:
+		return domain.EffortMedium
+	default:
+		return domain.EffortLarge
+	}
+}
+
+// Options describe the situation to pick tasks for
+type Options struct {
+	// Time is how long there is, or 0 for no limit
+	Time time.Duration
+	// Energy leaves out tasks too big to face, if set
+	Energy Energy
+	// Context leaves out tasks that need another context, if set
+	Context string
+	Now     time.Time
+}
+
+// Suggestion is a task worth doing now
+type Suggestion struct {
+	Task    *domain.Task
+	Project string
+	Score   int
+	Reasons []string
+	Note    string // why the AI put it here, if ranked by the AI
+}
+
+// Suggest picks the open, unblocked tasks that fit o, best first, leaving
+// out those scheduled to start on a later day. Tasks
+// need to fit the time by their remaining estimate or, without one, by
+// the typical length of their effort; tasks of unknown size are kept but
+// ranked lower when time is short. lookup finds dependencies.
+// projectTitles maps project IDs to titles for display.
+func Suggest(tasks []*domain.Task, projectTitles map[string]string, lookup func(id string) *domain.Task, o Options) []Suggestion {
+	now := o.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	want := domain.NormalizeContext(o.Context)
+
+	var suggestions []Suggestion
+	for _, t := range tasks {
+		if t.Status != domain.TaskStatusPending && t.Status != domain.TaskStatusInProgress {
+			continue
+		}
+		if t.BlockedReason(lookup) != "" || t.IsScheduledLater(now) {
+			continue
+		}
+		if want != "" && t.Context != "" && t.Context != want {
+			continue
+		}
+		if o.Energy != "" && t.Effort.Rank() > o.Energy.maxEffort().Rank() {
+			continue
+		}
+		needed, sized := Needed(t, now)
+		if o.Time > 0 && sized && needed > o.Time {
+			continue
+		}
+
+		s := Suggestion{Task: t, Project: projectTitles[t.ProjectID], Score: t.Priority.Rank() * 10}
+		add := func(score int, reason string) {
+			s.Score += score
+			if reason != "" {
+				s.Reasons = append(s.Reasons, reason)
+			}
+		}
+		switch {
+		case t.DueDate != nil && t.DueDate.Before(today):
+			add(40, "overdue")
+		case t.DueDate != nil && t.DueDate.Before(today.AddDate(0, 0, 1)):
+			add(30, "due today")
+		case t.DueDate != nil && t.DueDate.Before(today.AddDate(0, 0, 4)):
+			add(15, "due "+t.DueDate.Format("Mon"))
+		}
+		switch {
+		case t.ScheduledDate == nil:
+		case t.ScheduledDate.Before(today):
+			add(10, "scheduled")
+		default:
+			add(20, "scheduled today")
+		}
+		if t.Priority.Rank() >= domain.PriorityHigh.Rank() {
+			s.Reasons = append(s.Reasons, string(t.Priority)+" priority")
+		}
+		if t.Status == domain.TaskStatusInProgress {
+			add(10, "in progress")
+		}
+		if want != "" && t.Context == want {
+			add(5, "@"+want)
+		}
+		if o.Energy == EnergyLow && t.Effort == domain.EffortSmall {
+			add(5, "small")
+		}
+		switch {
+		case o.Time > 0 && sized:
+			add(5, "fits in "+domain.FormatTimeSpec(o.Time))
+		case o.Time > 0:
+			add(-5, "")
+		}
+		suggestions = append(suggestions, s)
+	}
+
+	slices.SortStableFunc(suggestions, func(a, b Suggestion) int {
+		if a.Score != b.Score {
+			return b.Score - a.Score
+		}
+		if c := compareDue(a.Task.DueDate, b.Task.DueDate); c != 0 {
+			return c
+		}
+		return a.Task.Created.Compare(b.Task.Created)
+	})
+	return suggestions
+}
+
+// Needed returns how long the task still needs: what is left of its
+// estimate, or the typical length of its effort. It returns false if the
+// task has neither.
+func Needed(t *domain.Task, now time.Time) (time.Duration, bool) {
+	if estimate, ok := t.Estimate(); ok {
+		return max(estimate-t.Spent(now), 0), true
+	}
+	if typical := t.Effort.Typical(); typical > 0 {
+		return typical, true
+	}
+	return 0, false
+}
+
+// compareDue orders due dates soonest first, with undated tasks last
+func compareDue(a, b *time.Time) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	return a.Compare(*b)
+}
+
+// Rank asks the language model to re-rank the suggestions for the
+// situation in o. The suggestions are left unchanged if the response can't
+// be used.
+func Rank(ctx context.Context, client llm.Client, suggestions []Suggestion, o Options) error {
+	if len(suggestions) < 2 {
+		return nil
+	}
+
+	var list strings.Builder
+	for _, s := range suggestions {
+		details := fmt.Sprintf("%s priority", s.Task.Priority)
+		if s.Task.DueDate != nil {
+			details += ", due " + s.Task.DueDate.Format("2006-01-02")
+		}
+		if s.Task.ScheduledDate != nil {
+			details += ", scheduled " + s.Task.ScheduledDate.Format("2006-01-02")
+		}
+		if s.Task.Effort != "" {
+			details += ", " + string(s.Task.Effort) + " effort"
+		}
+		if s.Task.TimeEstimate != "" {
+			details += ", estimated " + s.Task.TimeEstimate
+		}
+		if s.Task.Context != "" {
+			details += ", context @" + s.Task.Context
+		}
+		if s.Task.Status == domain.TaskStatusInProgress {
+			details += ", in progress"
+		}
+		fmt.Fprintf(&list, "- %s: %q (project %q, %s)\n", s.Task.ID, s.Task.Title, s.Project, details)
+	}
+
+	prompt := fmt.Sprintf(`I want to pick something to work on right now (%s). Rank these tasks from most to least suitable, considering deadlines, priority, how well each fits the time and energy I have, and momentum.
+
+%s
+Respond with JSON only: {"order": ["task-id", ...], "notes": {"task-id": "a few words on why it suits now"}}`, o.describe(), list.String())
+
+	response, err := client.Chat(ctx, prompt)
+	if err != nil {
+		return err
+	}
+
+	start := strings.Index(response, "{")
+	end := strings.LastIndex(response, "}")
+	if start < 0 || end <= start {
+		return fmt.Errorf("unexpected response from %s", client.Provider())
+	}
+
+	var result struct {
+		Order []string          `json:"order"`
+		Notes map[string]string `json:"notes"`
+	}
+	if err := json.Unmarshal([]byte(response[start:end+1]), &result); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	for i := range suggestions {
+		suggestions[i].Note = result.Notes[suggestions[i].Task.ID]
+	}
+
+	position := make(map[string]int, len(result.Order))
+	for i, id := range result.Order {
+		if _, ok := position[id]; !ok {
+			position[id] = i
+		}
+	}
+
+	// Tasks the model left out keep their place after the ranked ones
+	slices.SortStableFunc(suggestions, func(a, b Suggestion) int {
+		pa, aok := position[a.Task.ID]
+		pb, bok := position[b.Task.ID]
+		switch {
+		case aok && bok:
+			return pa - pb
+		case aok:
+			return -1
+		case bok:
+			return 1
+		}
+		return 0
+	})
+	return nil
+}
+
+// describe sums up the options for the prompt
+func (o Options) describe() string {
+	var parts []string
+	if o.Time > 0 {
+		parts = append(parts, domain.FormatTimeSpec(o.Time)+" available")
+	}
+	if o.Energy != "" {
+		parts = append(parts, string(o.Energy)+" energy")
+	}
+	if c := domain.NormalizeContext(o.Context); c != "" {
+		parts = append(parts, "at @"+c)
+	}
+	now := o.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	parts = append(parts, now.Format("Monday 15:04"))
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,121 @@
+// Package pipeline runs slow per-item work, such as language model calls
+// during an import, on a pool of workers. Results come back in the order
+// of the items, so whatever consumes them (prompts, writes) stays serial
+// and predictable.
+package pipeline
+
+import (
+	"context"
+	"iter"
+	"sync"
+	"time"
+)
+
+// DefaultWorkers is how many items are processed at once unless configured
+const DefaultWorkers = 4
+
+// Options configures a pipeline run
+type Options struct {
+	// Workers is the number of items processed at once; below 1 means 1
+	Workers int
+
+	// Rate limits how many items are started per second across all
+	// workers; 0 means no limit
+	Rate float64
+}
+
+// Run calls fn for each item on opts.Workers goroutines and yields the
+// index and result of each item in order. Workers run ahead of the
+// consumer; stopping the iteration cancels the context passed to fn and
+// waits for calls in progress to return.
+func Run[In, Out any](ctx context.Context, items []In, opts Options, fn func(ctx context.Context, item In) Out) iter.Seq2[int, Out] {
+	return func(yield func(int, Out) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		defer func() {
+			cancel()
+			wg.Wait()
+		}()
+
+		results := make([]chan Out, len(items))
+		for i := range results {
+			results[i] = make(chan Out, 1)
+		}
+
+		jobs := make(chan int)
+		go func() {
+			defer close(jobs)
+			for i := range items {
+				select {
+				case jobs <- i:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		limit := newLimiter(opts.Rate)
+		for range max(opts.Workers, 1) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := range jobs {
+					if limit.wait(ctx) != nil {
+						return
+					}
+					results[i] <- fn(ctx, items[i])
+				}
+			}()
+		}
+
+		for i := range items {
+			select {
+			case out := <-results[i]:
+				if !yield(i, out) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// limiter spaces out starts to a rate per second
+type limiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newLimiter(rate float64) *limiter {
+	if rate <= 0 {
+		return &limiter{}
+	}
+	return &limiter{interval: time.Duration(float64(time.Second) / rate)}
+}
+
+// wait blocks until the next start is allowed
+func (l *limiter) wait(ctx context.Context) error {
+	if l.interval == 0 {
+		return ctx.Err()
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	timer := time.NewTimer(time.Until(at))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
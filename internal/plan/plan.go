@@ -0,0 +1,272 @@
+// Package plan assembles a daily or weekly plan: the tasks worth looking at,
+// drawn from due and scheduled dates, work in progress and high priority
+// items.
+package plan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/llm"
+)
+
+// Reason explains why a task is on the plan
+type Reason string
+
+const (
+	ReasonOverdue    Reason = "overdue"
+	ReasonDue        Reason = "due"
+	ReasonScheduled  Reason = "scheduled"
+	ReasonInProgress Reason = "in progress"
+	ReasonPriority   Reason = "priority"
+)
+
+// Item is a task on the plan
+type Item struct {
+	Task    *domain.Task
+	Project string
+	Reason  Reason
+	Note    string // why the AI put it here, if ordered by the AI
+}
+
+// Plan is the list of candidate tasks for a day, or for the week starting
+// on Day
+type Plan struct {
+	Day   time.Time
+	Days  int
+	Items []Item
+	// Goals describes the active goals the week's work should move
+	// forward, e.g. "Launch the website (2026-Q2, 40% done)". Only weekly
+	// plans have them.
+	Goals []string
+}
+
+// Build selects candidate tasks for day. Tasks scheduled to start after it
+// are left out unless they are due or already in progress. projectTitles maps project IDs to
+// titles for display.
+func Build(tasks []*domain.Task, projectTitles map[string]string, day time.Time) *Plan {
+	return build(tasks, projectTitles, day, 1)
+}
+
+// BuildWeek selects candidate tasks for the seven days starting on day
+func BuildWeek(tasks []*domain.Task, projectTitles map[string]string, day time.Time) *Plan {
+	return build(tasks, projectTitles, day, 7)
+}
+
+func build(tasks []*domain.Task, projectTitles map[string]string, day time.Time, days int) *Plan {
+	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	end := start.AddDate(0, 0, days)
+
+	p := &Plan{Day: start, Days: days}
+	for _, t := range tasks {
+		// Delegated tasks are someone else's to do
+		if t.IsComplete() || t.Status == domain.TaskStatusCancelled || t.Status == domain.TaskStatusWaiting {
+			continue
+		}
+
+		var reason Reason
+		switch {
+		case t.DueDate != nil && t.DueDate.Before(start):
+			reason = ReasonOverdue
+		case t.DueDate != nil && t.DueDate.Before(end):
+			reason = ReasonDue
+		case t.ScheduledDate != nil && t.ScheduledDate.Before(end):
+			reason = ReasonScheduled
+		case t.Status == domain.TaskStatusInProgress:
+			reason = ReasonInProgress
+		case t.ScheduledDate != nil:
+			continue
+		case t.Priority.Rank() >= domain.PriorityHigh.Rank() && !t.IsBlocked():
+			reason = ReasonPriority
+		default:
+			continue
+		}
+
+		p.Items = append(p.Items, Item{Task: t, Project: projectTitles[t.ProjectID], Reason: reason})
+	}
+
+	sort.SliceStable(p.Items, func(i, j int) bool {
+		a, b := p.Items[i], p.Items[j]
+		if ra, rb := reasonRank(a.Reason), reasonRank(b.Reason); ra != rb {
+			return ra < rb
+		}
+		return a.Task.Priority.Rank() > b.Task.Priority.Rank()
+	})
+
+	return p
+}
+
+func reasonRank(r Reason) int {
+	switch r {
+	case ReasonOverdue:
+		return 0
+	case ReasonDue:
+		return 1
+	case ReasonScheduled:
+		return 2
+	case ReasonInProgress:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// Order asks the language model to put the plan in a sensible working order.
+// The plan is left unchanged if the response can't be used.
+func (p *Plan) Order(ctx context.Context, client llm.Client) error {
+	if len(p.Items) < 2 {
+		return nil
+	}
+
+	var list strings.Builder
+	for _, item := range p.Items {
+		details := ""
+		if item.Task.DueDate != nil {
+			details += ", due " + item.Task.DueDate.Format("2006-01-02")
+		}
+		if item.Task.ScheduledDate != nil {
+			details += ", scheduled " + item.Task.ScheduledDate.Format("2006-01-02")
+		}
+		if item.Task.TimeEstimate != "" {
+			details += ", estimated " + item.Task.TimeEstimate
+		}
+		if item.Task.Context != "" {
+			details += ", context @" + item.Task.Context
+		}
+		fmt.Fprintf(&list, "- %s: %q (project %q, %s priority, %s%s)\n",
+			item.Task.ID, item.Task.Title, item.Project, item.Task.Priority, item.Reason, details)
+	}
+
+	goals := ""
+	if len(p.Goals) > 0 {
+		goals = "\nCurrent goals, which the work should move forward:\n- " + strings.Join(p.Goals, "\n- ") + "\n"
+	}
+
+	prompt := fmt.Sprintf(`Here are candidate tasks for %s. Order them in the sequence they should be worked on, considering deadlines, priority, estimates, momentum and progress towards the goals.
+%s
+%s
+Respond with JSON only: {"order": ["task-id", ...], "notes": {"task-id": "a few words on why it is placed there"}}`, p.Period(), goals, list.String())
+
+	response, err := client.Chat(ctx, prompt)
+	if err != nil {
+		return err
+	}
+
+	start := strings.Index(response, "{")
+	end := strings.LastIndex(response, "}")
+	if start < 0 || end <= start {
+		return fmt.Errorf("unexpected response from %s", client.Provider())
+	}
+
+	var result struct {
+		Order []string          `json:"order"`
+		Notes map[string]string `json:"notes"`
+	}
+	if err := json.Unmarshal([]byte(response[start:end+1]), &result); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	for i := range p.Items {
+		p.Items[i].Note = result.Notes[p.Items[i].Task.ID]
+	}
+
+	position := make(map[string]int, len(result.Order))
+	for i, id := range result.Order {
+		if _, ok := position[id]; !ok {
+			position[id] = i
+		}
+	}
+
+	// Tasks the model left out keep their place after the ordered ones
+	sort.SliceStable(p.Items, func(i, j int) bool {
+		pi, iok := position[p.Items[i].Task.ID]
+		pj, jok := position[p.Items[j].Task.ID]
+		if iok != jok {
+			return iok
+		}
+		return iok && pi < pj
+	})
+	return nil
+}
+
+// Markdown renders the plan as a daily note
+func (p *Plan) Markdown() string {
+	var b strings.Builder
+	heading := p.Day.Format("Monday, January 2 2006")
+	if p.Days > 1 {
+		heading = "the week of " + heading
+	}
+	fmt.Fprintf(&b, "# Plan for %s\n\n", heading)
+
+	if len(p.Goals) > 0 {
+		b.WriteString("Goals:\n")
+		for _, g := range p.Goals {
+			fmt.Fprintf(&b, "- %s\n", g)
+		}
+		b.WriteString("\n")
+	}
+
+	if len(p.Items) == 0 {
+		b.WriteString("Nothing scheduled.\n")
+		return b.String()
+	}
+
+	for _, item := range p.Items {
+		fmt.Fprintf(&b, "- [ ] %s", item.Task.Title)
+		if item.Task.Context != "" {
+			fmt.Fprintf(&b, " @%s", item.Task.Context)
+		}
+		var details []string
+		if item.Project != "" {
+			details = append(details, item.Project)
+		}
+		details = append(details, string(item.Reason))
+		if item.Task.Priority.Rank() >= domain.PriorityHigh.Rank() {
+			details = append(details, string(item.Task.Priority))
+		}
+		if item.Note != "" {
+			details = append(details, item.Note)
+		}
+		fmt.Fprintf(&b, " (%s) <!-- %s -->\n", strings.Join(details, ", "), item.Task.ID)
+	}
+	return b.String()
+}
+
+// Period describes the time the plan covers, e.g. "Monday, January 2" or
+// "the week of Monday, January 2"
+func (p *Plan) Period() string {
+	if p.Days > 1 {
+		return "the week of " + p.Day.Format("Monday, January 2")
+	}
+	return p.Day.Format("Monday, January 2")
+}
+
+// Name is the file name of the plan, without extension
+func (p *Plan) Name() string {
+	if p.Days > 1 {
+		return p.Day.Format("2006-01-02") + "-week"
+	}
+	return p.Day.Format("2006-01-02")
+}
+
+// Summary returns a one-line description for notifications
+func (p *Plan) Summary() string {
+	counts := make(map[Reason]int)
+	for _, item := range p.Items {
+		counts[item.Reason]++
+	}
+
+	parts := []string{fmt.Sprintf("%d task(s)", len(p.Items))}
+	if n := counts[ReasonOverdue]; n > 0 {
+		parts = append(parts, fmt.Sprintf("%d overdue", n))
+	}
+	if n := counts[ReasonDue]; n > 0 {
+		parts = append(parts, fmt.Sprintf("%d due", n))
+	}
+	return strings.Join(parts, ", ")
+}
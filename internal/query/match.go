@@ -0,0 +1,289 @@
+package query
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// Env provides the context needed to evaluate a query. Projects and areas
+// are stored by ID, so the slug maps let users refer to them by name.
+type Env struct {
+	Now          time.Time
+	ProjectSlugs map[string]string // project ID -> slug
+	AreaSlugs    map[string]string // area ID -> slug
+}
+
+// hasValues lists the values accepted by the has: field
+var hasValues = map[string]bool{
+	"due":       true,
+	"scheduled": true,
+	"tags":      true,
+	"assignee":  true,
+	"context":   true,
+	"effort":    true,
+	"project":   true,
+}
+
+// entity is the common view of tasks and projects used during evaluation
+type entity struct {
+	title     string
+	status    string
+	priority  domain.Priority
+	tags      []string
+	project   string
+	area      string
+	assignee  string
+	context   string
+	effort    string
+	due       *time.Time
+	scheduled *time.Time
+	created   time.Time
+	updated   time.Time
+	overdue   bool
+	metadata  map[string]string
+}
+
+// MatchTask returns true if the task matches every term of the query
+func (q *Query) MatchTask(task *domain.Task, env Env) bool {
+	return q.match(entity{
+		title:     task.Title,
+		status:    string(task.Status),
+		priority:  task.Priority,
+		tags:      task.Tags,
+		project:   task.ProjectID,
+		area:      task.AreaID,
+		assignee:  task.Assignee,
+		context:   task.Context,
+		effort:    string(task.Effort),
+		due:       task.DueDate,
+		scheduled: task.ScheduledDate,
+		created:   task.Created,
+		updated:   task.Updated,
+		overdue:   task.IsOverdueAt(env.now()),
+		metadata:  task.Metadata,
+	}, env)
+}
+
+// MatchProject returns true if the project matches every term of the query
+func (q *Query) MatchProject(project *domain.Project, env Env) bool {
+	return q.match(entity{
+		title:    project.Title,
+		status:   string(project.Status),
+		priority: project.Priority,
+		tags:     project.Tags,
+		area:     project.AreaID,
+		due:      project.DueDate,
+		created:  project.Created,
+		updated:  project.Updated,
+		overdue:  project.IsOverdueAt(env.now()),
+		metadata: project.Metadata,
+	}, env)
+}
+
+func (q *Query) match(e entity, env Env) bool {
+	if q == nil {
+		return true
+	}
+	for _, term := range q.Terms {
+		if term.match(e, env) == term.Negate {
+			return false
+		}
+	}
+	return true
+}
+
+func (t Term) match(e entity, env Env) bool {
+	switch t.Field {
+	case "status":
+		return t.matchAny(func(v string) bool { return strings.EqualFold(e.status, v) })
+	case "tag":
+		return t.matchAny(func(v string) bool {
+			for _, tag := range e.tags {
+				if strings.EqualFold(tag, v) {
+					return true
+				}
+			}
+			return false
+		})
+	case "title":
+		return t.matchAny(func(v string) bool {
+			return strings.Contains(strings.ToLower(e.title), strings.ToLower(v))
+		})
+	case "assignee":
+		return t.matchAny(func(v string) bool { return strings.EqualFold(e.assignee, v) })
+	case "context":
+		return t.matchAny(func(v string) bool { return e.context == domain.NormalizeContext(v) })
+	case "effort":
+		return t.matchAny(func(v string) bool { return strings.EqualFold(e.effort, v) })
+	case "project":
+		return t.matchAny(func(v string) bool { return matchRef(e.project, env.ProjectSlugs, v) })
+	case "area":
+		return t.matchAny(func(v string) bool { return matchRef(e.area, env.AreaSlugs, v) })
+	case "has":
+		return t.matchAny(func(v string) bool {
+			switch strings.ToLower(v) {
+			case "due":
+				return e.due != nil
+			case "scheduled":
+				return e.scheduled != nil
+			case "tags":
+				return len(e.tags) > 0
+			case "assignee":
+				return e.assignee != ""
+			case "context":
+				return e.context != ""
+			case "effort":
+				return e.effort != ""
+			case "project":
+				return e.project != ""
+			}
+			return false
+		})
+	case "overdue":
+		return t.matchAny(func(v string) bool { return parseBool(v) == e.overdue })
+	case "meta":
+		return t.matchMeta(e.metadata)
+	case "priority":
+		return t.matchPriority(e.priority)
+	case "due":
+		return t.matchDate(e.due)
+	case "scheduled":
+		return t.matchDate(e.scheduled)
+	case "created":
+		return t.matchDate(&e.created)
+	case "updated":
+		return t.matchDate(&e.updated)
+	}
+	return false
+}
+
+// matchAny applies fn to each alternative; != inverts the result
+func (t Term) matchAny(fn func(string) bool) bool {
+	matched := false
+	for _, v := range t.Values {
+		if fn(v) {
+			matched = true
+			break
+		}
+	}
+	if t.Operator == OpNotEqual {
+		return !matched
+	}
+	return matched
+}
+
+// matchMeta compares a metadata value case-insensitively. Keys are looked
+// up exactly first, then ignoring case.
+func (t Term) matchMeta(meta map[string]string) bool {
+	value, ok := meta[t.Key]
+	if !ok {
+		for k, v := range meta {
+			if strings.EqualFold(k, t.Key) {
+				value, ok = v, true
+				break
+			}
+		}
+	}
+
+	return t.matchAny(func(v string) bool {
+		switch strings.ToLower(v) {
+		case "any":
+			return ok
+		case "none":
+			return !ok
+		}
+		return ok && strings.EqualFold(value, v)
+	})
+}
+
+func (t Term) matchPriority(p domain.Priority) bool {
+	rank := p.Rank()
+	switch t.Operator {
+	case OpLess, OpLessEqual, OpGreater, OpGreaterEqual:
+		want, _ := priorityRank(t.Values[0])
+		return compare(rank, want, t.Operator)
+	}
+	return t.matchAny(func(v string) bool {
+		want, _ := priorityRank(v)
+		return rank == want
+	})
+}
+
+// matchDate compares by calendar day, so due<=2025-02-01 includes that day
+func (t Term) matchDate(date *time.Time) bool {
+	value := strings.ToLower(t.Values[0])
+	if value == "none" || value == "any" {
+		matched := (date == nil) == (value == "none")
+		if t.Operator == OpNotEqual {
+			return !matched
+		}
+		return matched
+	}
+
+	if date == nil {
+		return false
+	}
+
+	day := date.Local()
+	start := t.date
+	end := start.AddDate(0, 0, 1)
+
+	switch t.Operator {
+	case OpLess:
+		return day.Before(start)
+	case OpLessEqual:
+		return day.Before(end)
+	case OpGreater:
+		return !day.Before(end)
+	case OpGreaterEqual:
+		return !day.Before(start)
+	case OpNotEqual:
+		return day.Before(start) || !day.Before(end)
+	default:
+		return !day.Before(start) && day.Before(end)
+	}
+}
+
+func compare(a, b int, op Operator) bool {
+	switch op {
+	case OpLess:
+		return a < b
+	case OpLessEqual:
+		return a <= b
+	case OpGreater:
+		return a > b
+	case OpGreaterEqual:
+		return a >= b
+	}
+	return a == b
+}
+
+// matchRef matches an entity ID against a value given as an ID or slug
+func matchRef(id string, slugs map[string]string, value string) bool {
+	if id == "" {
+		return strings.EqualFold(value, "none")
+	}
+	return strings.EqualFold(id, value) || strings.EqualFold(slugs[id], value)
+}
+
+func priorityRank(s string) (int, bool) {
+	rank := domain.Priority(strings.ToLower(s)).Rank()
+	return rank, rank > 0
+}
+
+func parseBool(s string) bool {
+	switch strings.ToLower(s) {
+	case "true", "yes", "1":
+		return true
+	}
+	return false
+}
+
+func (env Env) now() time.Time {
+	if env.Now.IsZero() {
+		return time.Now()
+	}
+	return env.Now
+}
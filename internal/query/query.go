@@ -0,0 +1,312 @@
+// Package query implements the filter expression language used by list
+// commands, e.g.
+//
+//	status:pending priority>=high due<2025-02-01 tag:client
+//
+// An expression is a list of terms that must all match. A term is either
+// field, an operator and a value, or a bare word matched against the title.
+// Prefix a term with "-" to negate it. Values containing spaces can be
+// quoted, and ":" accepts a comma-separated list of alternatives.
+// Metadata is matched with meta.<key>, e.g. meta.jira:PROJ-12 or
+// meta.github:any.
+package query
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ihavespoons/reorg/internal/dateparse"
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// Kind identifies what a query is evaluated against
+type Kind string
+
+const (
+	KindTask    Kind = "task"
+	KindProject Kind = "project"
+)
+
+// Operator is a comparison in a term
+type Operator string
+
+const (
+	OpMatch        Operator = ":"
+	OpEqual        Operator = "="
+	OpNotEqual     Operator = "!="
+	OpLess         Operator = "<"
+	OpLessEqual    Operator = "<="
+	OpGreater      Operator = ">"
+	OpGreaterEqual Operator = ">="
+)
+
+// operators ordered so longer operators are tried first
+var operators = []Operator{OpNotEqual, OpLessEqual, OpGreaterEqual, OpMatch, OpEqual, OpLess, OpGreater}
+
+// fieldType determines which operators a field supports
+type fieldType int
+
+const (
+	fieldText fieldType = iota
+	fieldOrdered
+	fieldDate
+	fieldBool
+)
+
+// fields lists the fields available for each kind
+var fields = map[Kind]map[string]fieldType{
+	KindTask: {
+		"status":    fieldText,
+		"priority":  fieldOrdered,
+		"tag":       fieldText,
+		"project":   fieldText,
+		"area":      fieldText,
+		"assignee":  fieldText,
+		"context":   fieldText,
+		"effort":    fieldText,
+		"title":     fieldText,
+		"due":       fieldDate,
+		"scheduled": fieldDate,
+		"created":   fieldDate,
+		"updated":   fieldDate,
+		"overdue":   fieldBool,
+		"has":       fieldText,
+	},
+	KindProject: {
+		"status":   fieldText,
+		"priority": fieldOrdered,
+		"tag":      fieldText,
+		"area":     fieldText,
+		"title":    fieldText,
+		"due":      fieldDate,
+		"created":  fieldDate,
+		"updated":  fieldDate,
+		"overdue":  fieldBool,
+		"has":      fieldText,
+	},
+}
+
+// metaPrefix starts fields that match a metadata key
+const metaPrefix = "meta."
+
+// Term is a single condition in a query
+type Term struct {
+	Negate   bool
+	Field    string
+	Key      string // metadata key for the meta field
+	Operator Operator
+	Values   []string
+
+	// date holds the parsed value of date fields
+	date time.Time
+}
+
+// Query is a parsed filter expression
+type Query struct {
+	Kind  Kind
+	Terms []Term
+}
+
+// Parse parses a filter expression for the given kind. Relative dates such
+// as "today" or "+1w" are resolved against now.
+func Parse(input string, kind Kind, now time.Time) (*Query, error) {
+	known, ok := fields[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown query kind: %s", kind)
+	}
+
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &Query{Kind: kind}
+	for _, token := range tokens {
+		term, err := parseTerm(token, known, now)
+		if err != nil {
+			return nil, err
+		}
+		q.Terms = append(q.Terms, term)
+	}
+
+	return q, nil
+}
+
+// IsEmpty returns true if the query has no terms and matches everything
+func (q *Query) IsEmpty() bool {
+	return q == nil || len(q.Terms) == 0
+}
+
+func parseTerm(token string, known map[string]fieldType, now time.Time) (Term, error) {
+	var term Term
+	if strings.HasPrefix(token, "-") && len(token) > 1 {
+		term.Negate = true
+		token = token[1:]
+	}
+
+	field, op, value, ok := splitTerm(token)
+	if !ok {
+		// Bare words match the title
+		term.Field = "title"
+		term.Operator = OpMatch
+		term.Values = []string{unquote(token)}
+		return term, nil
+	}
+
+	if len(field) > len(metaPrefix) && strings.EqualFold(field[:len(metaPrefix)], metaPrefix) {
+		return parseMetaTerm(term, field[len(metaPrefix):], op, unquote(value))
+	}
+
+	field = strings.ToLower(field)
+	ft, ok := known[field]
+	if !ok {
+		return term, fmt.Errorf("unknown field %q (use %s or meta.<key>)", field, strings.Join(fieldNames(known), ", "))
+	}
+
+	term.Field = field
+	term.Operator = op
+	value = unquote(value)
+	if value == "" {
+		return term, fmt.Errorf("missing value for %s", field)
+	}
+
+	switch ft {
+	case fieldText, fieldBool:
+		if op != OpMatch && op != OpEqual && op != OpNotEqual {
+			return term, fmt.Errorf("%s only supports :, = and !=", field)
+		}
+		if field == "has" {
+			for _, v := range strings.Split(value, ",") {
+				if !hasValues[strings.ToLower(strings.TrimSpace(v))] {
+					return term, fmt.Errorf("invalid value for has: %s (use due, scheduled, tags, assignee, context, effort, project)", v)
+				}
+			}
+		}
+		if ft == fieldBool {
+			switch strings.ToLower(value) {
+			case "true", "false", "yes", "no", "1", "0":
+			default:
+				return term, fmt.Errorf("invalid value for %s: %s (use true or false)", field, value)
+			}
+		}
+	case fieldOrdered:
+		if _, ok := priorityRank(value); !ok {
+			return term, fmt.Errorf("invalid priority: %s", value)
+		}
+	case fieldDate:
+		if value != "none" && value != "any" {
+			date, err := dateparse.Parse(value, now)
+			if err != nil {
+				return term, err
+			}
+			term.date = date
+		} else if op != OpMatch && op != OpEqual && op != OpNotEqual {
+			return term, fmt.Errorf("%s:%s cannot be used with %s", field, value, op)
+		}
+	}
+
+	if op == OpMatch {
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				term.Values = append(term.Values, v)
+			}
+		}
+	} else {
+		term.Values = []string{value}
+	}
+
+	return term, nil
+}
+
+// parseMetaTerm parses meta.<key><op>value. Values are compared as text;
+// "any" and "none" test whether the key is set.
+func parseMetaTerm(term Term, key string, op Operator, value string) (Term, error) {
+	term.Field = "meta"
+	term.Key = key
+	term.Operator = op
+	if value == "" {
+		return term, fmt.Errorf("missing value for %s%s", metaPrefix, key)
+	}
+	if op != OpMatch && op != OpEqual && op != OpNotEqual {
+		return term, fmt.Errorf("%s%s only supports :, = and !=", metaPrefix, key)
+	}
+
+	if op == OpMatch {
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				term.Values = append(term.Values, v)
+			}
+		}
+	} else {
+		term.Values = []string{value}
+	}
+	return term, nil
+}
+
+// splitTerm splits field<op>value, returning ok=false for bare words
+func splitTerm(token string) (field string, op Operator, value string, ok bool) {
+	isField := func(r rune) bool { return unicode.IsLetter(r) || r == '_' }
+	if len(token) > len(metaPrefix) && strings.EqualFold(token[:len(metaPrefix)], metaPrefix) {
+		isField = domain.IsMetaKeyRune
+	}
+	end := strings.IndexFunc(token, func(r rune) bool {
+		return !isField(r)
+	})
+	if end <= 0 {
+		return "", "", "", false
+	}
+
+	rest := token[end:]
+	for _, candidate := range operators {
+		if strings.HasPrefix(rest, string(candidate)) {
+			return token[:end], candidate, rest[len(candidate):], true
+		}
+	}
+	return "", "", "", false
+}
+
+// tokenize splits input on whitespace, keeping double-quoted sections together
+func tokenize(input string) ([]string, error) {
+	var tokens []string
+	var current strings.Builder
+	inQuotes := false
+
+	for _, r := range input {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			current.WriteRune(r)
+		case unicode.IsSpace(r) && !inQuotes:
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	if inQuotes {
+		return nil, fmt.Errorf("unterminated quote in query")
+	}
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+	return tokens, nil
+}
+
+func unquote(s string) string {
+	return strings.ReplaceAll(s, `"`, "")
+}
+
+func fieldNames(known map[string]fieldType) []string {
+	names := make([]string, 0, len(known))
+	for name := range known {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,271 @@
+// Package reminder finds tasks that are due soon or overdue, or delegated
+// and due a follow-up, and sends notifications for them, remembering what
+// was already sent so each reminder goes out once.
+package reminder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/notify"
+	"github.com/ihavespoons/reorg/internal/service"
+)
+
+// Kind is the reason a reminder is sent
+type Kind string
+
+const (
+	KindDueSoon Kind = "due_soon"
+	KindOverdue Kind = "overdue"
+	// KindFollowUp is for a task that has been waiting on its assignee
+	// for another follow-up interval
+	KindFollowUp Kind = "follow_up"
+)
+
+// DefaultLeadTimes returns how long before the due date a task is "due soon",
+// by priority
+func DefaultLeadTimes() map[domain.Priority]time.Duration {
+	return map[domain.Priority]time.Duration{
+		domain.PriorityUrgent: 48 * time.Hour,
+		domain.PriorityHigh:   24 * time.Hour,
+		domain.PriorityMedium: 24 * time.Hour,
+		domain.PriorityLow:    4 * time.Hour,
+	}
+}
+
+// Reminder is a notification due for a task
+type Reminder struct {
+	Task *domain.Task
+	Kind Kind
+	// FollowUp counts the follow-up intervals a waiting task has waited
+	FollowUp int
+	// Since is when a waiting task started waiting, in the timezone of
+	// the check
+	Since time.Time
+}
+
+// key identifies a reminder so it is only sent once per due date, or once
+// per follow-up interval
+func (r Reminder) key() string {
+	if r.Kind == KindFollowUp {
+		return fmt.Sprintf("%s@%s#%d", r.Kind, r.Task.WaitingSince().UTC().Format(time.RFC3339), r.FollowUp)
+	}
+	return fmt.Sprintf("%s@%s", r.Kind, r.Task.DueDate.UTC().Format(time.RFC3339))
+}
+
+// stateKey is where the last reminder of its kind for the task is
+// recorded; follow-ups are kept apart from due date reminders
+func (r Reminder) stateKey() string {
+	if r.Kind == KindFollowUp {
+		return r.Task.ID + "/" + string(KindFollowUp)
+	}
+	return r.Task.ID
+}
+
+// Message returns the notification for the reminder
+func (r Reminder) Message(projectTitle string) notify.Message {
+	var title, body string
+	switch r.Kind {
+	case KindFollowUp:
+		title = fmt.Sprintf("Follow up with %s: %s", r.Task.Assignee, r.Task.Title)
+		body = "Waiting since " + r.Since.Format("Mon Jan 2")
+	case KindOverdue:
+		title = "Task overdue: " + r.Task.Title
+		body = "Due " + r.due()
+	default:
+		title = "Task due soon: " + r.Task.Title
+		body = "Due " + r.due()
+	}
+	if projectTitle != "" {
+		body += " · Project: " + projectTitle
+	}
+	return notify.Message{Title: title, Body: body}
+}
+
+// due formats the task's due date, with the time of day unless it's all-day
+func (r Reminder) due() string {
+	if r.Task.AllDay {
+		return r.Task.DueDate.Format("Mon Jan 2")
+	}
+	return r.Task.DueDate.Format("Mon Jan 2 15:04")
+}
+
+// Find returns the reminders due for tasks at now. A task is due soon once
+// now is within its priority's lead time of the due date.
+func Find(tasks []*domain.Task, leadTimes map[domain.Priority]time.Duration, now time.Time) []Reminder {
+	var reminders []Reminder
+	for _, t := range tasks {
+		if t.DueDate == nil || t.IsComplete() || t.Status == domain.TaskStatusCancelled {
+			continue
+		}
+
+		switch {
+		case t.IsOverdueAt(now):
+			reminders = append(reminders, Reminder{Task: t, Kind: KindOverdue})
+		case now.Add(leadTimes[t.Priority]).After(*t.DueDate):
+			reminders = append(reminders, Reminder{Task: t, Kind: KindDueSoon})
+		}
+	}
+	return reminders
+}
+
+// FindFollowUps returns a follow-up reminder for each task that has been
+// waiting on its assignee for at least every, and again after each
+// further interval
+func FindFollowUps(tasks []*domain.Task, every time.Duration, now time.Time) []Reminder {
+	var reminders []Reminder
+	if every <= 0 {
+		return nil
+	}
+	for _, t := range tasks {
+		since := t.WaitingSince()
+		if since == nil {
+			continue
+		}
+		if n := int(now.Sub(*since) / every); n > 0 {
+			reminders = append(reminders, Reminder{Task: t, Kind: KindFollowUp, FollowUp: n, Since: since.In(now.Location())})
+		}
+	}
+	return reminders
+}
+
+// Checker sends reminders through a notification router
+type Checker struct {
+	client    service.ReorgClient
+	router    *notify.Router
+	leadTimes map[domain.Priority]time.Duration
+	followUp  time.Duration
+	statePath string
+}
+
+// SetFollowUp sends a follow-up reminder for tasks waiting on someone each
+// time another interval of every has passed. Zero turns them off.
+func (c *Checker) SetFollowUp(every time.Duration) {
+	c.followUp = every
+}
+
+// NewChecker creates a checker that records sent reminders in statePath
+func NewChecker(client service.ReorgClient, router *notify.Router, leadTimes map[domain.Priority]time.Duration, statePath string) *Checker {
+	return &Checker{
+		client:    client,
+		router:    router,
+		leadTimes: leadTimes,
+		statePath: statePath,
+	}
+}
+
+// Pending returns reminders that are due and have not been sent yet.
+// Tasks in someday projects don't get reminders.
+func (c *Checker) Pending(ctx context.Context, now time.Time) ([]Reminder, error) {
+	tasks, err := c.client.ListAllTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := c.client.ListAllProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tasks = service.WithoutSomeday(tasks, projects)
+
+	sent, err := c.loadState()
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Reminder
+	found := append(Find(tasks, c.leadTimes, now), FindFollowUps(tasks, c.followUp, now)...)
+	for _, r := range found {
+		if sent[r.stateKey()] != r.key() {
+			pending = append(pending, r)
+		}
+	}
+	return pending, nil
+}
+
+// Check sends all pending reminders and returns how many were sent
+func (c *Checker) Check(ctx context.Context, now time.Time) (int, error) {
+	pending, err := c.Pending(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+	if len(pending) == 0 {
+		return 0, nil
+	}
+
+	sent, err := c.loadState()
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	var sendErr error
+	for _, r := range pending {
+		var channel, projectTitle string
+		if project, err := c.client.GetProject(ctx, r.Task.ProjectID); err == nil {
+			channel, projectTitle = project.Notify, project.Title
+		}
+
+		if err := c.router.Send(ctx, channel, r.Message(projectTitle)); err != nil {
+			sendErr = err
+			continue
+		}
+		sent[r.stateKey()] = r.key()
+		count++
+	}
+
+	if err := c.saveState(sent); err != nil {
+		return count, err
+	}
+	return count, sendErr
+}
+
+// Run checks for reminders every interval until ctx is cancelled. Errors are
+// passed to onError so a failing channel doesn't stop the schedule.
+func (c *Checker) Run(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := c.Check(ctx, time.Now()); err != nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Checker) loadState() (map[string]string, error) {
+	sent := make(map[string]string)
+	data, err := os.ReadFile(c.statePath)
+	if os.IsNotExist(err) {
+		return sent, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reminder state: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &sent); err != nil {
+		return nil, fmt.Errorf("failed to parse reminder state: %w", err)
+	}
+	return sent, nil
+}
+
+func (c *Checker) saveState(sent map[string]string) error {
+	if err := os.MkdirAll(filepath.Dir(c.statePath), 0755); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+	data, err := yaml.Marshal(sent)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.statePath, data, 0644)
+}
@@ -0,0 +1,99 @@
+// Package review helps go through parked work: it describes the projects
+// in the someday/maybe backlog and asks the AI what to do with each.
+package review
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/llm"
+)
+
+// Action is what to do with a someday project
+type Action string
+
+const (
+	ActionActivate Action = "activate"
+	ActionKeep     Action = "keep"
+	ActionDrop     Action = "drop"
+)
+
+// Item is a someday project up for review
+type Item struct {
+	Project   *domain.Project
+	Area      string
+	OpenTasks int
+	// Idle is how long the project has gone without a change
+	Idle time.Duration
+}
+
+// Advice is the AI's suggestion for an item
+type Advice struct {
+	Action Action
+	Reason string
+}
+
+// Advise asks the language model whether each someday project should be
+// activated, kept for later or dropped, given what else is going on.
+// situation describes the current workload, e.g. the active projects and
+// goals. Items the model leaves out get no advice.
+func Advise(ctx context.Context, client llm.Client, items []Item, situation string) (map[string]Advice, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	var list strings.Builder
+	for _, item := range items {
+		p := item.Project
+		details := fmt.Sprintf("area %q, %d open tasks, untouched for %d days", item.Area, item.OpenTasks, int(item.Idle.Hours()/24))
+		if p.DueDate != nil {
+			details += ", due " + p.DueDate.Format("2006-01-02")
+		}
+		if len(p.Tags) > 0 {
+			details += ", tags " + strings.Join(p.Tags, ", ")
+		}
+		fmt.Fprintf(&list, "- %s: %q (%s)\n", p.ID, p.Title, details)
+	}
+
+	prompt := fmt.Sprintf(`I'm reviewing my someday/maybe backlog. For each project below, suggest whether to activate it now, keep it for later, or drop it, considering how long it has been parked and what I'm already working on.
+
+%s
+Someday projects:
+%s
+Respond with JSON only: {"advice": {"project-id": {"action": "activate|keep|drop", "reason": "a few words"}}}`, situation, list.String())
+
+	response, err := client.Chat(ctx, prompt)
+	if err != nil {
+		return nil, err
+	}
+
+	start := strings.Index(response, "{")
+	end := strings.LastIndex(response, "}")
+	if start < 0 || end <= start {
+		return nil, fmt.Errorf("unexpected response from %s", client.Provider())
+	}
+
+	var result struct {
+		Advice map[string]struct {
+			Action string `json:"action"`
+			Reason string `json:"reason"`
+		} `json:"advice"`
+	}
+	if err := json.Unmarshal([]byte(response[start:end+1]), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	advice := make(map[string]Advice, len(result.Advice))
+	for id, a := range result.Advice {
+		action := Action(strings.ToLower(strings.TrimSpace(a.Action)))
+		switch action {
+		case ActionActivate, ActionKeep, ActionDrop:
+			advice[id] = Advice{Action: action, Reason: a.Reason}
+		}
+	}
+	return advice, nil
+}
@@ -0,0 +1,161 @@
+// Package rules holds automation rules: when a task is created, imported or
+// completed and matches a query, a rule tags it, sets its priority, moves
+// it to another project or sends a notification. Rules come from the rules
+// key of the config and from YAML files in the rules/ folder of the data
+// directory; the service layer runs them, so they apply to the CLI, the
+// server and the MCP server alike.
+package rules
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/query"
+)
+
+// Trigger is the event a rule runs on
+type Trigger string
+
+const (
+	TriggerCreate   Trigger = "create"   // any new task, imported ones included
+	TriggerImport   Trigger = "import"   // a new task created by an import
+	TriggerComplete Trigger = "complete" // a task that was just completed
+)
+
+// Triggers are the events rules can run on
+var Triggers = []Trigger{TriggerCreate, TriggerImport, TriggerComplete}
+
+// Rule runs its actions on tasks matching If when one of its triggers
+// happens
+type Rule struct {
+	Name string    `yaml:"name" mapstructure:"name"`
+	On   []Trigger `yaml:"on" mapstructure:"on"`
+	If   string    `yaml:"if" mapstructure:"if"` // query expression, empty matches every task
+	Then Actions   `yaml:"then" mapstructure:"then"`
+
+	// File is where the rule was read from, "" for the config
+	File string `yaml:"-" mapstructure:"-"`
+}
+
+// Actions are what a rule does to a matching task
+type Actions struct {
+	AddTags    []string `yaml:"add_tags" mapstructure:"add_tags"`
+	RemoveTags []string `yaml:"remove_tags" mapstructure:"remove_tags"`
+	Priority   string   `yaml:"priority" mapstructure:"priority"`
+	Project    string   `yaml:"project" mapstructure:"project"` // slug of the project to move the task to
+	Notify     string   `yaml:"notify" mapstructure:"notify"`   // message for the task's project channel; {title} is the task's title
+	Plugin     string   `yaml:"plugin" mapstructure:"plugin"`
+}
+
+// IsEmpty returns true if the actions change nothing and send nothing
+func (a Actions) IsEmpty() bool {
+	return len(a.AddTags) == 0 && len(a.RemoveTags) == 0 && a.Priority == "" && a.Project == "" && a.Notify == ""
+}
+
+// Message returns the notification text for a task
+func (a Actions) Message(task *domain.Task) string {
+	return strings.ReplaceAll(a.Notify, "{title}", task.Title)
+}
+
+// Validate checks a rule's triggers, query and actions
+func (r Rule) Validate() error {
+	if len(r.On) == 0 {
+		return fmt.Errorf("no triggers (on: create, import or complete)")
+	}
+	for _, t := range r.On {
+		if !slices.Contains(Triggers, t) {
+			return fmt.Errorf("unknown trigger %q (use create, import or complete)", t)
+		}
+	}
+	if _, err := query.Parse(r.If, query.KindTask, time.Now()); err != nil {
+		return fmt.Errorf("invalid if: %w", err)
+	}
+	if r.Then.Plugin != "" {
+		return fmt.Errorf("plugin actions aren't supported: reorg has no plugins to run")
+	}
+	if r.Then.IsEmpty() {
+		return fmt.Errorf("no actions (then: add_tags, remove_tags, priority, project or notify)")
+	}
+	if r.Then.Priority != "" && domain.Priority(r.Then.Priority).Rank() == 0 {
+		return fmt.Errorf("invalid priority %q (use low, medium, high or urgent)", r.Then.Priority)
+	}
+	return nil
+}
+
+// Matches returns true if the rule runs on trigger and the task matches
+// its query
+func (r Rule) Matches(trigger Trigger, task *domain.Task, env query.Env) bool {
+	if !slices.Contains(r.On, trigger) {
+		return false
+	}
+	q, err := query.Parse(r.If, query.KindTask, env.Now)
+	if err != nil {
+		return false
+	}
+	return q.MatchTask(task, env)
+}
+
+// Label names the rule for messages and commits
+func (r Rule) Label() string {
+	if r.Name != "" {
+		return r.Name
+	}
+	if r.If != "" {
+		return r.If
+	}
+	return "unnamed rule"
+}
+
+// Load returns the rules from the config followed by those in the YAML
+// files of dir, by file name. A missing dir has no rules. Each file holds
+// a list of rules.
+func Load(config []Rule, dir string) ([]Rule, error) {
+	all := slices.Clone(config)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+	var files []string
+	for _, e := range entries {
+		ext := filepath.Ext(e.Name())
+		if !e.IsDir() && (ext == ".yaml" || ext == ".yml") {
+			files = append(files, filepath.Join(dir, e.Name()))
+		}
+	}
+	sort.Strings(files)
+
+	for _, path := range files {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var rules []Rule
+		if err := yaml.Unmarshal(data, &rules); err != nil {
+			return nil, fmt.Errorf("invalid rules in %s: %w", filepath.Base(path), err)
+		}
+		for i := range rules {
+			rules[i].File = path
+		}
+		all = append(all, rules...)
+	}
+
+	for _, r := range all {
+		if err := r.Validate(); err != nil {
+			where := "rules"
+			if r.File != "" {
+				where = filepath.Base(r.File)
+			}
+			return nil, fmt.Errorf("%s: %s: %w", where, r.Label(), err)
+		}
+	}
+	return all, nil
+}
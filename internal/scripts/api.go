@@ -0,0 +1,332 @@
+package scripts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+
+	"github.com/ihavespoons/reorg/internal/dateparse"
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/service"
+)
+
+// newModule returns the reorg module scripts use to reach their data:
+//
+//	reorg.tasks(query="")          tasks matching a query, as in 'task list -q'
+//	reorg.projects(query="")       projects matching a query
+//	reorg.task(id)                 a task by ID
+//	reorg.create_task(title, project, priority="", due="", tags=[], context="")
+//	reorg.update_task(id, title=, status=, priority=, due=, context=, add_tags=[], remove_tags=[])
+//	reorg.complete_task(id)
+//
+// Tasks and projects are read-only structs; changes go through the
+// functions, so rules, hooks and notifications run as for any other change.
+func newModule(ctx context.Context, c service.ReorgClient) *starlarkstruct.Module {
+	a := &api{ctx: ctx, client: c}
+	return &starlarkstruct.Module{
+		Name: "reorg",
+		Members: starlark.StringDict{
+			"tasks":         starlark.NewBuiltin("tasks", a.tasks),
+			"projects":      starlark.NewBuiltin("projects", a.projects),
+			"task":          starlark.NewBuiltin("task", a.task),
+			"create_task":   starlark.NewBuiltin("create_task", a.createTask),
+			"update_task":   starlark.NewBuiltin("update_task", a.updateTask),
+			"complete_task": starlark.NewBuiltin("complete_task", a.completeTask),
+		},
+	}
+}
+
+type api struct {
+	ctx    context.Context
+	client service.ReorgClient
+}
+
+func (a *api) tasks(_ *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var expr string
+	if err := starlark.UnpackArgs(fn.Name(), args, kwargs, "query?", &expr); err != nil {
+		return nil, err
+	}
+	tasks, err := a.client.QueryTasks(a.ctx, expr)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]starlark.Value, len(tasks))
+	for i, t := range tasks {
+		list[i] = taskValue(t)
+	}
+	return starlark.NewList(list), nil
+}
+
+func (a *api) projects(_ *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var expr string
+	if err := starlark.UnpackArgs(fn.Name(), args, kwargs, "query?", &expr); err != nil {
+		return nil, err
+	}
+	projects, err := a.client.QueryProjects(a.ctx, expr)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]starlark.Value, len(projects))
+	for i, p := range projects {
+		list[i] = projectValue(p)
+	}
+	return starlark.NewList(list), nil
+}
+
+func (a *api) task(_ *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var id string
+	if err := starlark.UnpackArgs(fn.Name(), args, kwargs, "id", &id); err != nil {
+		return nil, err
+	}
+	task, err := a.client.GetTask(a.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return taskValue(task), nil
+}
+
+func (a *api) createTask(_ *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var title, project, priority, due, taskContext string
+	var tags *starlark.List
+	if err := starlark.UnpackArgs(fn.Name(), args, kwargs,
+		"title", &title, "project", &project, "priority?", &priority,
+		"due?", &due, "tags?", &tags, "context?", &taskContext); err != nil {
+		return nil, err
+	}
+
+	p, err := a.findProject(project)
+	if err != nil {
+		return nil, err
+	}
+	task := domain.NewTask(title, p.ID, p.AreaID)
+	if priority != "" {
+		if task.Priority, err = parsePriority(priority); err != nil {
+			return nil, err
+		}
+	}
+	if due != "" {
+		date, allDay, err := dateparse.ParseMoment(due, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		task.DueDate = &date
+		task.AllDay = allDay
+	}
+	names, err := stringItems(tags)
+	if err != nil {
+		return nil, fmt.Errorf("tags: %w", err)
+	}
+	for _, tag := range names {
+		task.AddTag(tag)
+	}
+	task.SetContext(taskContext)
+
+	created, err := a.client.CreateTask(a.ctx, task)
+	if err != nil {
+		return nil, err
+	}
+	return taskValue(created), nil
+}
+
+func (a *api) updateTask(_ *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var id string
+	var title, status, priority, due, taskContext starlark.Value = starlark.None, starlark.None, starlark.None, starlark.None, starlark.None
+	var addTags, removeTags *starlark.List
+	if err := starlark.UnpackArgs(fn.Name(), args, kwargs,
+		"id", &id, "title?", &title, "status?", &status, "priority?", &priority,
+		"due?", &due, "context?", &taskContext, "add_tags?", &addTags, "remove_tags?", &removeTags); err != nil {
+		return nil, err
+	}
+
+	task, err := a.client.GetTask(a.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyUpdate(task, title, status, priority, due, taskContext, addTags, removeTags); err != nil {
+		return nil, err
+	}
+	task.UpdateTimestamp()
+	if err := a.client.UpdateTask(a.ctx, task); err != nil {
+		return nil, err
+	}
+	return taskValue(task), nil
+}
+
+func (a *api) completeTask(_ *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var id string
+	if err := starlark.UnpackArgs(fn.Name(), args, kwargs, "id", &id); err != nil {
+		return nil, err
+	}
+	if err := a.client.CompleteTask(a.ctx, id); err != nil {
+		return nil, err
+	}
+	task, err := a.client.GetTask(a.ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return taskValue(task), nil
+}
+
+// findProject returns the project with an ID or slug
+func (a *api) findProject(ref string) (*domain.Project, error) {
+	if p, err := a.client.GetProject(a.ctx, ref); err == nil {
+		return p, nil
+	}
+	projects, err := a.client.ListAllProjects(a.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range projects {
+		if p.Slug() == ref {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("project not found: %s", ref)
+}
+
+// applyUpdate sets the fields of a task that aren't None
+func applyUpdate(task *domain.Task, title, status, priority, due, taskContext starlark.Value, addTags, removeTags *starlark.List) error {
+	if title != starlark.None {
+		s, ok := starlark.AsString(title)
+		if !ok || s == "" {
+			return fmt.Errorf("title must be a non-empty string")
+		}
+		task.Title = s
+	}
+	if status != starlark.None {
+		s, _ := starlark.AsString(status)
+		switch domain.TaskStatus(s) {
+		case domain.TaskStatusPending:
+			task.Reopen()
+		case domain.TaskStatusInProgress:
+			task.Start()
+		case domain.TaskStatusBlocked:
+			task.Block()
+		case domain.TaskStatusCompleted:
+			task.Complete()
+		case domain.TaskStatusCancelled:
+			task.Cancel()
+		default:
+			return fmt.Errorf("invalid status %q (use pending, in_progress, blocked, completed or cancelled)", s)
+		}
+	}
+	if priority != starlark.None {
+		s, _ := starlark.AsString(priority)
+		p, err := parsePriority(s)
+		if err != nil {
+			return err
+		}
+		task.Priority = p
+	}
+	if due != starlark.None {
+		s, _ := starlark.AsString(due)
+		if s == "" {
+			task.DueDate = nil
+		} else {
+			date, allDay, err := dateparse.ParseMoment(s, time.Now())
+			if err != nil {
+				return err
+			}
+			task.DueDate = &date
+			task.AllDay = allDay
+		}
+	}
+	if taskContext != starlark.None {
+		s, _ := starlark.AsString(taskContext)
+		task.SetContext(s)
+	}
+
+	add, err := stringItems(addTags)
+	if err != nil {
+		return fmt.Errorf("add_tags: %w", err)
+	}
+	for _, tag := range add {
+		task.AddTag(tag)
+	}
+	remove, err := stringItems(removeTags)
+	if err != nil {
+		return fmt.Errorf("remove_tags: %w", err)
+	}
+	for _, tag := range remove {
+		task.RemoveTag(tag)
+	}
+	return nil
+}
+
+func parsePriority(s string) (domain.Priority, error) {
+	p := domain.Priority(s)
+	if p.Rank() == 0 {
+		return "", fmt.Errorf("invalid priority %q (use low, medium, high or urgent)", s)
+	}
+	return p, nil
+}
+
+// stringItems returns the items of a list of strings, nil for a nil list
+func stringItems(list *starlark.List) ([]string, error) {
+	if list == nil {
+		return nil, nil
+	}
+	out := make([]string, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		s, ok := starlark.AsString(list.Index(i))
+		if !ok {
+			return nil, fmt.Errorf("got %s, want string", list.Index(i).Type())
+		}
+		out = append(out, s)
+	}
+	return out, nil
+}
+
+// taskValue converts a task for scripts
+func taskValue(t *domain.Task) starlark.Value {
+	due := starlark.Value(starlark.None)
+	if t.DueDate != nil && t.AllDay {
+		due = starlark.String(t.DueDate.Format("2006-01-02"))
+	} else if t.DueDate != nil {
+		due = starlark.String(t.DueDate.Format(time.RFC3339))
+	}
+	scheduled := starlark.Value(starlark.None)
+	if t.ScheduledDate != nil {
+		scheduled = starlark.String(t.ScheduledDate.Format("2006-01-02"))
+	}
+	return starlarkstruct.FromStringDict(starlark.String("task"), starlark.StringDict{
+		"id":         starlark.String(t.ID),
+		"title":      starlark.String(t.Title),
+		"status":     starlark.String(t.Status),
+		"priority":   starlark.String(t.Priority),
+		"project_id": starlark.String(t.ProjectID),
+		"area_id":    starlark.String(t.AreaID),
+		"due":        due,
+		"scheduled":  scheduled,
+		"context":    starlark.String(t.Context),
+		"assignee":   starlark.String(t.Assignee),
+		"tags":       stringList(t.Tags),
+		"created":    starlark.String(t.Created.Format(time.RFC3339)),
+		"updated":    starlark.String(t.Updated.Format(time.RFC3339)),
+	})
+}
+
+// projectValue converts a project for scripts
+func projectValue(p *domain.Project) starlark.Value {
+	return starlarkstruct.FromStringDict(starlark.String("project"), starlark.StringDict{
+		"id":                starlark.String(p.ID),
+		"slug":              starlark.String(p.Slug()),
+		"title":             starlark.String(p.Title),
+		"status":            starlark.String(p.Status),
+		"priority":          starlark.String(p.Priority),
+		"area_id":           starlark.String(p.AreaID),
+		"parent_project_id": starlark.String(p.ParentProjectID),
+		"tags":              stringList(p.Tags),
+	})
+}
+
+func stringList(items []string) *starlark.List {
+	list := make([]starlark.Value, len(items))
+	for i, s := range items {
+		list[i] = starlark.String(s)
+	}
+	return starlark.NewList(list)
+}
@@ -0,0 +1,154 @@
+// Package scripts runs Starlark scripts from the scripts/ folder of the data
+// directory. Scripts list tasks and projects and create and update tasks
+// through the reorg module, and can do nothing else: they have no file,
+// network or process access, can't load other files, and are stopped after
+// a number of steps or a timeout.
+package scripts
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	startime "go.starlark.net/lib/time"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+
+	"github.com/ihavespoons/reorg/internal/audit"
+	"github.com/ihavespoons/reorg/internal/service"
+	"github.com/ihavespoons/reorg/internal/tracing"
+)
+
+// Ext is the file extension of scripts
+const Ext = ".star"
+
+const (
+	DefaultTimeout  = 30 * time.Second
+	DefaultMaxSteps = 10_000_000
+)
+
+// Script is a Starlark file in the scripts folder
+type Script struct {
+	Name string // file name without the extension
+	Path string
+}
+
+// List returns the scripts in dir by name. A missing dir has none.
+func List(dir string) ([]Script, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+
+	var all []Script
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != Ext {
+			continue
+		}
+		all = append(all, Script{
+			Name: strings.TrimSuffix(e.Name(), Ext),
+			Path: filepath.Join(dir, e.Name()),
+		})
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+	return all, nil
+}
+
+// Find returns the script in dir with a name, ignoring case
+func Find(dir, name string) (Script, error) {
+	all, err := List(dir)
+	if err != nil {
+		return Script{}, err
+	}
+	name = strings.TrimSuffix(name, Ext)
+	for _, s := range all {
+		if strings.EqualFold(s.Name, name) {
+			return s, nil
+		}
+	}
+	return Script{}, fmt.Errorf("script not found: %s (looked in %s)", name, dir)
+}
+
+// Runner runs scripts against a client
+type Runner struct {
+	Client   service.ReorgClient
+	Timeout  time.Duration
+	MaxSteps uint64
+
+	// Output receives what scripts print. Defaults to stdout.
+	Output io.Writer
+}
+
+// fileOptions let scripts loop and branch at the top level, as most are
+// a few lines without functions
+var fileOptions = &syntax.FileOptions{
+	Set:             true,
+	While:           true,
+	TopLevelControl: true,
+	GlobalReassign:  true,
+}
+
+// Run runs a script to the end, or until it fails, ctx is cancelled, or it
+// runs out of time or steps
+func (r *Runner) Run(ctx context.Context, s Script) (err error) {
+	ctx, span := tracing.Start(ctx, "script "+s.Name)
+	defer func() { tracing.End(span, err) }()
+
+	src, err := os.ReadFile(s.Path)
+	if err != nil {
+		return fmt.Errorf("failed to read script: %w", err)
+	}
+
+	timeout := r.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	maxSteps := r.MaxSteps
+	if maxSteps == 0 {
+		maxSteps = DefaultMaxSteps
+	}
+	out := r.Output
+	if out == nil {
+		out = os.Stdout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ctx = audit.WithActor(ctx, "script:"+s.Name)
+
+	thread := &starlark.Thread{
+		Name: s.Name,
+		Print: func(_ *starlark.Thread, msg string) {
+			_, _ = fmt.Fprintln(out, msg)
+		},
+	}
+	thread.SetMaxExecutionSteps(maxSteps)
+	stop := context.AfterFunc(ctx, func() {
+		if ctx.Err() == context.DeadlineExceeded {
+			thread.Cancel(fmt.Sprintf("timed out after %s", timeout))
+		} else {
+			thread.Cancel("cancelled")
+		}
+	})
+	defer stop()
+
+	predeclared := starlark.StringDict{
+		"reorg": newModule(ctx, r.Client),
+		"time":  startime.Module,
+	}
+	if _, err := starlark.ExecFileOptions(fileOptions, thread, s.Path, src, predeclared); err != nil {
+		if evalErr, ok := err.(*starlark.EvalError); ok {
+			return fmt.Errorf("%s", evalErr.Backtrace())
+		}
+		return err
+	}
+	return nil
+}
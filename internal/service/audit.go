@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ihavespoons/reorg/internal/audit"
+)
+
+// SetAudit records every change made through the client in log
+func (c *LocalClient) SetAudit(log *audit.Log) {
+	c.audit = log
+}
+
+// record adds a change to the audit log, if there is one
+func (c *LocalClient) record(ctx context.Context, action audit.Action, kind, id, title string, before, after any) {
+	c.audit.Record(ctx, UserFromContext(ctx), action, kind, id, title, before, after)
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// BatchResult is the outcome for one task of a batch call: the task as
+// saved, or why it wasn't. One task failing doesn't stop the others.
+type BatchResult struct {
+	Task *domain.Task
+	Err  error
+}
+
+// BatchErrors returns the errors of the results that failed
+func BatchErrors(results []BatchResult) []error {
+	var errs []error
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, r.Err)
+		}
+	}
+	return errs
+}
+
+// BatchCreateTasks creates tasks in one commit, returning a result for each
+// in order. Rules run on each task created, as with CreateTask.
+func (c *LocalClient) BatchCreateTasks(ctx context.Context, tasks []*domain.Task) ([]BatchResult, error) {
+	results := make([]BatchResult, len(tasks))
+	err := c.store.Batch(ctx, fmt.Sprintf("create %d tasks", len(tasks)), func(ctx context.Context) error {
+		for i, task := range tasks {
+			if err := c.tasks().Create(ctx, task); err != nil {
+				results[i].Err = fmt.Errorf("failed to create task %q: %w", task.Title, err)
+				continue
+			}
+			results[i].Task = task
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range results {
+		if r.Task != nil {
+			c.runCreateRules(ctx, r.Task)
+		}
+	}
+	return results, nil
+}
+
+// BatchUpdateTasks saves tasks in one commit, returning a result for each
+// in order. Status changes are announced and completions run rules and
+// hooks, as with UpdateTask.
+func (c *LocalClient) BatchUpdateTasks(ctx context.Context, tasks []*domain.Task) ([]BatchResult, error) {
+	results := make([]BatchResult, len(tasks))
+	previous := make([]domain.TaskStatus, len(tasks))
+	err := c.store.Batch(ctx, fmt.Sprintf("update %d tasks", len(tasks)), func(ctx context.Context) error {
+		for i, task := range tasks {
+			if existing, err := c.tasks().Get(ctx, task.ID); err == nil {
+				previous[i] = existing.Status
+			}
+			if err := c.tasks().Update(ctx, task); err != nil {
+				results[i].Err = fmt.Errorf("failed to update task %q: %w", task.Title, err)
+				continue
+			}
+			results[i].Task = task
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, r := range results {
+		if r.Task == nil || previous[i] == r.Task.Status {
+			continue
+		}
+		c.notifyTaskStatus(ctx, r.Task)
+		if r.Task.IsComplete() {
+			c.taskCompleted(ctx, r.Task)
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// TaskUpdate describes the changes a bulk operation applies to every matched task.
+// Nil and empty fields are left unchanged.
+type TaskUpdate struct {
+	Status             *domain.TaskStatus
+	Priority           *domain.Priority
+	ProjectID          *string
+	DueDate            *time.Time
+	DueAllDay          bool // DueDate is a whole day, not a moment
+	ClearDueDate       bool
+	ScheduledDate      *time.Time
+	ClearScheduledDate bool
+	Context            *string        // an empty context clears it
+	Effort             *domain.Effort // an empty effort clears it
+	AddTags            []string
+	RemoveTags         []string
+	Metadata           map[string]string // empty values remove the key
+}
+
+// IsEmpty returns true if the update would not change anything
+func (u TaskUpdate) IsEmpty() bool {
+	return u.Status == nil && u.Priority == nil && u.ProjectID == nil &&
+		u.DueDate == nil && !u.ClearDueDate && u.ScheduledDate == nil && !u.ClearScheduledDate &&
+		u.Context == nil && u.Effort == nil && len(u.AddTags) == 0 && len(u.RemoveTags) == 0 &&
+		len(u.Metadata) == 0
+}
+
+// Apply applies the update to a task. Moving to another project also requires
+// the task's area to be updated, which is left to the caller.
+func (u TaskUpdate) Apply(task *domain.Task) {
+	if u.Status != nil {
+		switch *u.Status {
+		case domain.TaskStatusCompleted:
+			task.Complete()
+		case domain.TaskStatusInProgress:
+			task.Start()
+		case domain.TaskStatusBlocked:
+			task.Block()
+		case domain.TaskStatusCancelled:
+			task.Cancel()
+		case domain.TaskStatusWaiting:
+			task.Delegate(task.Assignee, time.Now())
+		default:
+			task.Reopen()
+		}
+	}
+	if u.Priority != nil {
+		task.Priority = *u.Priority
+	}
+	if u.ProjectID != nil {
+		task.ProjectID = *u.ProjectID
+	}
+	if u.ClearDueDate {
+		task.DueDate = nil
+		task.AllDay = false
+	}
+	if u.DueDate != nil {
+		due := *u.DueDate
+		task.DueDate = &due
+		task.AllDay = u.DueAllDay
+	}
+	if u.ClearScheduledDate {
+		task.ScheduledDate = nil
+	}
+	if u.ScheduledDate != nil {
+		scheduled := *u.ScheduledDate
+		task.ScheduledDate = &scheduled
+	}
+	if u.Context != nil {
+		task.SetContext(*u.Context)
+	}
+	if u.Effort != nil {
+		task.Effort = *u.Effort
+	}
+	for _, tag := range u.AddTags {
+		task.AddTag(tag)
+	}
+	for _, tag := range u.RemoveTags {
+		task.RemoveTag(tag)
+	}
+	domain.SetMetadata(&task.Metadata, u.Metadata)
+	task.UpdateTimestamp()
+}
@@ -0,0 +1,403 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ihavespoons/reorg/internal/audit"
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/storage/markdown"
+)
+
+// cache keeps the parsed areas, projects and tasks in memory, so looking
+// items up by ID doesn't parse every file again. Each list is read from the
+// store on first use and everything is dropped on any write through the
+// client, or on any change to the files when the client is watching them.
+// Callers get copies, so changing an item never changes the cache.
+type cache struct {
+	mu       sync.Mutex
+	areas    *cached[*domain.Area]
+	projects *cached[*domain.Project]
+	tasks    *cached[*domain.Task]
+}
+
+// cached is a list of items read from the store, indexed by ID
+type cached[T any] struct {
+	items []T
+	byID  map[string]T
+}
+
+func newCached[T any](items []T, id func(T) string) *cached[T] {
+	c := &cached[T]{items: items, byID: make(map[string]T, len(items))}
+	for _, item := range items {
+		c.byID[id(item)] = item
+	}
+	return c
+}
+
+// invalidate drops everything, to be read again on next use
+func (c *cache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.areas, c.projects, c.tasks = nil, nil, nil
+}
+
+func (c *cache) getAreas(ctx context.Context, store *markdown.Store) (*cached[*domain.Area], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.areas == nil {
+		areas, err := store.Areas().List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.areas = newCached(areas, func(a *domain.Area) string { return a.ID })
+	}
+	return c.areas, nil
+}
+
+func (c *cache) getProjects(ctx context.Context, store *markdown.Store) (*cached[*domain.Project], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.projects == nil {
+		projects, err := store.Projects().ListAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.projects = newCached(projects, func(p *domain.Project) string { return p.ID })
+	}
+	return c.projects, nil
+}
+
+func (c *cache) getTasks(ctx context.Context, store *markdown.Store) (*cached[*domain.Task], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.tasks == nil {
+		tasks, err := store.Tasks().ListAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.tasks = newCached(tasks, func(t *domain.Task) string { return t.ID })
+	}
+	return c.tasks, nil
+}
+
+// cloneAll copies items that match keep
+func cloneAll[T interface{ Clone() T }](items []T, keep func(T) bool) []T {
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		if keep == nil || keep(item) {
+			result = append(result, item.Clone())
+		}
+	}
+	return result
+}
+
+// areas returns the areas, read through the cache
+func (c *LocalClient) areas() cachedAreas {
+	return cachedAreas{c}
+}
+
+// projects returns the projects, read through the cache
+func (c *LocalClient) projects() cachedProjects {
+	return cachedProjects{c}
+}
+
+// tasks returns the tasks, read through the cache
+func (c *LocalClient) tasks() cachedTasks {
+	return cachedTasks{c}
+}
+
+type cachedAreas struct {
+	c *LocalClient
+}
+
+func (r cachedAreas) Create(ctx context.Context, area *domain.Area) error {
+	defer r.c.cache.invalidate()
+	if err := r.c.store.Areas().Create(ctx, area); err != nil {
+		return err
+	}
+	r.c.record(ctx, audit.Create, "area", area.ID, area.Title, nil, area)
+	return nil
+}
+
+func (r cachedAreas) Get(ctx context.Context, id string) (*domain.Area, error) {
+	areas, err := r.c.cache.getAreas(ctx, r.c.store)
+	if err != nil {
+		return nil, err
+	}
+	area, ok := areas.byID[id]
+	if !ok {
+		return nil, domain.Errorf(domain.ErrNotFound, "area not found: %s", id)
+	}
+	return area.Clone(), nil
+}
+
+func (r cachedAreas) GetBySlug(ctx context.Context, slug string) (*domain.Area, error) {
+	return r.c.store.Areas().GetBySlug(ctx, slug)
+}
+
+func (r cachedAreas) List(ctx context.Context) ([]*domain.Area, error) {
+	areas, err := r.c.cache.getAreas(ctx, r.c.store)
+	if err != nil {
+		return nil, err
+	}
+	return cloneAll(areas.items, nil), nil
+}
+
+func (r cachedAreas) Update(ctx context.Context, area *domain.Area) error {
+	defer r.c.cache.invalidate()
+	var before *domain.Area
+	if r.c.audit != nil {
+		before, _ = r.Get(ctx, area.ID)
+	}
+	if err := r.c.store.Areas().Update(ctx, area); err != nil {
+		return err
+	}
+	r.c.record(ctx, audit.Update, "area", area.ID, area.Title, before, area)
+	return nil
+}
+
+func (r cachedAreas) Delete(ctx context.Context, id string) error {
+	defer r.c.cache.invalidate()
+	var title string
+	if r.c.audit != nil {
+		if existing, err := r.Get(ctx, id); err == nil {
+			title = existing.Title
+		}
+	}
+	if err := r.c.store.Areas().Delete(ctx, id); err != nil {
+		return err
+	}
+	r.c.record(ctx, audit.Delete, "area", id, title, nil, nil)
+	return nil
+}
+
+type cachedProjects struct {
+	c *LocalClient
+}
+
+func (r cachedProjects) Create(ctx context.Context, project *domain.Project) error {
+	defer r.c.cache.invalidate()
+	if err := r.c.store.Projects().Create(ctx, project); err != nil {
+		return err
+	}
+	r.c.record(ctx, audit.Create, "project", project.ID, project.Title, nil, project)
+	return nil
+}
+
+func (r cachedProjects) Get(ctx context.Context, id string) (*domain.Project, error) {
+	projects, err := r.c.cache.getProjects(ctx, r.c.store)
+	if err != nil {
+		return nil, err
+	}
+	project, ok := projects.byID[id]
+	if !ok {
+		return nil, domain.Errorf(domain.ErrNotFound, "project not found: %s", id)
+	}
+	return project.Clone(), nil
+}
+
+// GetBySlug finds a project by the slug of its title rather than by its
+// path, as projects may be stored under their ID
+func (r cachedProjects) GetBySlug(ctx context.Context, areaSlug, projectSlug string) (*domain.Project, error) {
+	area, err := r.c.areas().GetBySlug(ctx, areaSlug)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := r.c.cache.getProjects(ctx, r.c.store)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range projects.items {
+		if p.AreaID == area.ID && p.Slug() == projectSlug {
+			return p.Clone(), nil
+		}
+	}
+	return nil, domain.Errorf(domain.ErrNotFound, "project not found: %s/%s", areaSlug, projectSlug)
+}
+
+func (r cachedProjects) List(ctx context.Context, areaID string) ([]*domain.Project, error) {
+	if _, err := r.c.areas().Get(ctx, areaID); err != nil {
+		return nil, err
+	}
+	projects, err := r.c.cache.getProjects(ctx, r.c.store)
+	if err != nil {
+		return nil, err
+	}
+	return cloneAll(projects.items, func(p *domain.Project) bool { return p.AreaID == areaID }), nil
+}
+
+func (r cachedProjects) ListAll(ctx context.Context) ([]*domain.Project, error) {
+	projects, err := r.c.cache.getProjects(ctx, r.c.store)
+	if err != nil {
+		return nil, err
+	}
+	return cloneAll(projects.items, nil), nil
+}
+
+func (r cachedProjects) Update(ctx context.Context, project *domain.Project) error {
+	defer r.c.cache.invalidate()
+	var before *domain.Project
+	if r.c.audit != nil {
+		before, _ = r.Get(ctx, project.ID)
+	}
+	if err := r.c.store.Projects().Update(ctx, project); err != nil {
+		return err
+	}
+	r.c.record(ctx, audit.Update, "project", project.ID, project.Title, before, project)
+	return nil
+}
+
+func (r cachedProjects) Modify(ctx context.Context, id string, change func(*domain.Project) error) (*domain.Project, error) {
+	defer r.c.cache.invalidate()
+	var before *domain.Project
+	project, err := r.c.store.Projects().Modify(ctx, id, func(project *domain.Project) error {
+		before = project.Clone()
+		return change(project)
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.c.record(ctx, audit.Update, "project", project.ID, project.Title, before, project)
+	return project, nil
+}
+
+func (r cachedProjects) Delete(ctx context.Context, id string) error {
+	defer r.c.cache.invalidate()
+	var title string
+	if r.c.audit != nil {
+		if existing, err := r.Get(ctx, id); err == nil {
+			title = existing.Title
+		}
+	}
+	if err := r.c.store.Projects().Delete(ctx, id); err != nil {
+		return err
+	}
+	r.c.record(ctx, audit.Delete, "project", id, title, nil, nil)
+	return nil
+}
+
+type cachedTasks struct {
+	c *LocalClient
+}
+
+func (r cachedTasks) Create(ctx context.Context, task *domain.Task) error {
+	defer r.c.cache.invalidate()
+	if err := r.c.store.Tasks().Create(ctx, task); err != nil {
+		return err
+	}
+	r.c.record(ctx, audit.Create, "task", task.ID, task.Title, nil, task)
+	return nil
+}
+
+func (r cachedTasks) Get(ctx context.Context, id string) (*domain.Task, error) {
+	tasks, err := r.c.cache.getTasks(ctx, r.c.store)
+	if err != nil {
+		return nil, err
+	}
+	task, ok := tasks.byID[id]
+	if !ok {
+		return nil, domain.Errorf(domain.ErrNotFound, "task not found: %s", id)
+	}
+	return task.Clone(), nil
+}
+
+// GetBySlug finds a task by the slug of its title rather than by its path,
+// as tasks may be stored under their ID
+func (r cachedTasks) GetBySlug(ctx context.Context, areaSlug, projectSlug, taskSlug string) (*domain.Task, error) {
+	project, err := r.c.projects().GetBySlug(ctx, areaSlug, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := r.c.cache.getTasks(ctx, r.c.store)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tasks.items {
+		if t.ProjectID == project.ID && t.Slug() == taskSlug {
+			return t.Clone(), nil
+		}
+	}
+	return nil, domain.Errorf(domain.ErrNotFound, "task not found: %s/%s/%s", areaSlug, projectSlug, taskSlug)
+}
+
+func (r cachedTasks) List(ctx context.Context, projectID string) ([]*domain.Task, error) {
+	if _, err := r.c.projects().Get(ctx, projectID); err != nil {
+		return nil, err
+	}
+	tasks, err := r.c.cache.getTasks(ctx, r.c.store)
+	if err != nil {
+		return nil, err
+	}
+	return cloneAll(tasks.items, func(t *domain.Task) bool { return t.ProjectID == projectID }), nil
+}
+
+func (r cachedTasks) ListByArea(ctx context.Context, areaID string) ([]*domain.Task, error) {
+	projects, err := r.c.projects().List(ctx, areaID)
+	if err != nil {
+		return nil, err
+	}
+	inArea := make(map[string]bool, len(projects))
+	for _, p := range projects {
+		inArea[p.ID] = true
+	}
+	tasks, err := r.c.cache.getTasks(ctx, r.c.store)
+	if err != nil {
+		return nil, err
+	}
+	return cloneAll(tasks.items, func(t *domain.Task) bool { return inArea[t.ProjectID] }), nil
+}
+
+func (r cachedTasks) ListAll(ctx context.Context) ([]*domain.Task, error) {
+	tasks, err := r.c.cache.getTasks(ctx, r.c.store)
+	if err != nil {
+		return nil, err
+	}
+	return cloneAll(tasks.items, nil), nil
+}
+
+func (r cachedTasks) Update(ctx context.Context, task *domain.Task) error {
+	defer r.c.cache.invalidate()
+	var before *domain.Task
+	if r.c.audit != nil {
+		before, _ = r.Get(ctx, task.ID)
+	}
+	if err := r.c.store.Tasks().Update(ctx, task); err != nil {
+		return err
+	}
+	r.c.record(ctx, audit.Update, "task", task.ID, task.Title, before, task)
+	return nil
+}
+
+func (r cachedTasks) Modify(ctx context.Context, id string, change func(*domain.Task) error) (*domain.Task, error) {
+	defer r.c.cache.invalidate()
+	var before *domain.Task
+	task, err := r.c.store.Tasks().Modify(ctx, id, func(task *domain.Task) error {
+		before = task.Clone()
+		return change(task)
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.c.record(ctx, audit.Update, "task", task.ID, task.Title, before, task)
+	return task, nil
+}
+
+func (r cachedTasks) Delete(ctx context.Context, id string) error {
+	defer r.c.cache.invalidate()
+	var title string
+	if r.c.audit != nil {
+		if existing, err := r.Get(ctx, id); err == nil {
+			title = existing.Title
+		}
+	}
+	if err := r.c.store.Tasks().Delete(ctx, id); err != nil {
+		return err
+	}
+	r.c.record(ctx, audit.Delete, "task", id, title, nil, nil)
+	return nil
+}
+
+func (r cachedTasks) CopyAsset(ctx context.Context, task *domain.Task, src string) (string, error) {
+	return r.c.store.Tasks().CopyAsset(ctx, task, src)
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// changeDelay is how long Watch gathers edits before announcing them, so a
+// write that touches several files, or an import, is one Change
+const changeDelay = 250 * time.Millisecond
+
+// Change is a batch of edits to the data directory, made through any
+// client or outside reorg
+type Change struct {
+	Time  time.Time
+	Paths []string // relative to the data directory; none if edits may have been missed
+}
+
+// ChangeService defines the stream of changes to the data, for views that
+// stay open and should reflect what other clients do
+type ChangeService interface {
+	// Changes sends a Change for each batch of edits until ctx is done,
+	// then closes the channel. A slow reader misses changes rather than
+	// holding up the others; each Change means "reload".
+	Changes(ctx context.Context) (<-chan Change, error)
+}
+
+// changeFeed hands the changes seen by Watch to subscribers
+type changeFeed struct {
+	mu          sync.Mutex
+	subscribers map[chan Change]struct{}
+}
+
+func (f *changeFeed) subscribe(ctx context.Context) <-chan Change {
+	ch := make(chan Change, 16)
+	f.mu.Lock()
+	if f.subscribers == nil {
+		f.subscribers = make(map[chan Change]struct{})
+	}
+	f.subscribers[ch] = struct{}{}
+	f.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		f.mu.Lock()
+		delete(f.subscribers, ch)
+		f.mu.Unlock()
+		close(ch)
+	}()
+	return ch
+}
+
+func (f *changeFeed) publish(change Change) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for ch := range f.subscribers {
+		select {
+		case ch <- change:
+		default:
+		}
+	}
+}
+
+// Changes streams the edits Watch sees, starting it if it isn't running
+func (c *LocalClient) Changes(ctx context.Context) (<-chan Change, error) {
+	if err := c.Watch(context.Background()); err != nil {
+		return nil, err
+	}
+	return c.changes.subscribe(ctx), nil
+}
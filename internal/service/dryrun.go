@@ -0,0 +1,360 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/audit"
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/storage"
+)
+
+// DryRunChange is a change a DryRunClient was asked to make
+type DryRunChange struct {
+	Action  audit.Action
+	Kind    string // area, project, task, goal or note
+	ID      string
+	Title   string
+	Changes map[string]audit.Change // fields an update would change
+}
+
+// DryRunClient reads through to another client, and checks and records the
+// changes it is asked to make instead of making them. Calls that change
+// data return what the item would look like afterwards.
+type DryRunClient struct {
+	ReorgClient
+
+	// Record is called with each change as it is asked for
+	Record func(DryRunChange)
+}
+
+// NewDryRunClient wraps a client so that it changes nothing
+func NewDryRunClient(c ReorgClient, record func(DryRunChange)) *DryRunClient {
+	return &DryRunClient{ReorgClient: c, Record: record}
+}
+
+func (c *DryRunClient) record(action audit.Action, kind, id, title string, before, after any) {
+	if c.Record == nil {
+		return
+	}
+	change := DryRunChange{Action: action, Kind: kind, ID: id, Title: title}
+	if action == audit.Update {
+		change.Changes = audit.Diff(before, after)
+	}
+	c.Record(change)
+}
+
+// changeTask records the update fn makes to a copy of a task
+func (c *DryRunClient) changeTask(ctx context.Context, id string, fn func(*domain.Task) error) (*domain.Task, error) {
+	before, err := c.ReorgClient.GetTask(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	after := before.Clone()
+	if err := fn(after); err != nil {
+		return nil, err
+	}
+	c.record(audit.Update, "task", after.ID, after.Title, before, after)
+	return after, nil
+}
+
+func (c *DryRunClient) CreateArea(ctx context.Context, area *domain.Area) (*domain.Area, error) {
+	if err := area.Validate(); err != nil {
+		return nil, err
+	}
+	c.record(audit.Create, "area", area.ID, area.Title, nil, area)
+	return area, nil
+}
+
+func (c *DryRunClient) UpdateArea(ctx context.Context, area *domain.Area) error {
+	if err := area.Validate(); err != nil {
+		return err
+	}
+	before, err := c.ReorgClient.GetArea(ctx, area.ID)
+	if err != nil {
+		return err
+	}
+	c.record(audit.Update, "area", area.ID, area.Title, before, area)
+	return nil
+}
+
+func (c *DryRunClient) DeleteArea(ctx context.Context, id string) error {
+	area, err := c.ReorgClient.GetArea(ctx, id)
+	if err != nil {
+		return err
+	}
+	c.record(audit.Delete, "area", area.ID, area.Title, nil, nil)
+	return nil
+}
+
+func (c *DryRunClient) CreateProject(ctx context.Context, project *domain.Project) (*domain.Project, error) {
+	if err := project.Validate(); err != nil {
+		return nil, err
+	}
+	c.record(audit.Create, "project", project.ID, project.Title, nil, project)
+	return project, nil
+}
+
+func (c *DryRunClient) UpdateProject(ctx context.Context, project *domain.Project) error {
+	if err := project.Validate(); err != nil {
+		return err
+	}
+	before, err := c.ReorgClient.GetProject(ctx, project.ID)
+	if err != nil {
+		return err
+	}
+	c.record(audit.Update, "project", project.ID, project.Title, before, project)
+	return nil
+}
+
+func (c *DryRunClient) DeleteProject(ctx context.Context, id string) error {
+	project, err := c.ReorgClient.GetProject(ctx, id)
+	if err != nil {
+		return err
+	}
+	c.record(audit.Delete, "project", project.ID, project.Title, nil, nil)
+	return nil
+}
+
+func (c *DryRunClient) CompleteProject(ctx context.Context, id string) error {
+	before, err := c.ReorgClient.GetProject(ctx, id)
+	if err != nil {
+		return err
+	}
+	after := before.Clone()
+	after.Complete()
+	c.record(audit.Update, "project", after.ID, after.Title, before, after)
+	return nil
+}
+
+func (c *DryRunClient) MoveProject(ctx context.Context, id, areaID string) error {
+	before, err := c.ReorgClient.GetProject(ctx, id)
+	if err != nil {
+		return err
+	}
+	if _, err := c.ReorgClient.GetArea(ctx, areaID); err != nil {
+		return err
+	}
+	after := before.Clone()
+	after.AreaID = areaID
+	c.record(audit.Update, "project", after.ID, after.Title, before, after)
+	return nil
+}
+
+func (c *DryRunClient) CreateTask(ctx context.Context, task *domain.Task) (*domain.Task, error) {
+	if err := task.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := c.ReorgClient.GetProject(ctx, task.ProjectID); err != nil {
+		return nil, err
+	}
+	c.record(audit.Create, "task", task.ID, task.Title, nil, task)
+	return task, nil
+}
+
+func (c *DryRunClient) UpdateTask(ctx context.Context, task *domain.Task) error {
+	if err := task.Validate(); err != nil {
+		return err
+	}
+	before, err := c.ReorgClient.GetTask(ctx, task.ID)
+	if err != nil {
+		return err
+	}
+	c.record(audit.Update, "task", task.ID, task.Title, before, task)
+	return nil
+}
+
+func (c *DryRunClient) DeleteTask(ctx context.Context, id string) error {
+	task, err := c.ReorgClient.GetTask(ctx, id)
+	if err != nil {
+		return err
+	}
+	c.record(audit.Delete, "task", task.ID, task.Title, nil, nil)
+	return nil
+}
+
+func (c *DryRunClient) StartTask(ctx context.Context, id string) error {
+	_, err := c.changeTask(ctx, id, func(t *domain.Task) error {
+		t.Start()
+		return nil
+	})
+	return err
+}
+
+func (c *DryRunClient) CompleteTask(ctx context.Context, id string) error {
+	_, err := c.changeTask(ctx, id, func(t *domain.Task) error {
+		t.Complete()
+		return nil
+	})
+	return err
+}
+
+func (c *DryRunClient) MoveTask(ctx context.Context, id, projectID string) error {
+	project, err := c.ReorgClient.GetProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	_, err = c.changeTask(ctx, id, func(t *domain.Task) error {
+		t.ProjectID = project.ID
+		t.AreaID = project.AreaID
+		return nil
+	})
+	return err
+}
+
+func (c *DryRunClient) StartTaskTimer(ctx context.Context, id string) error {
+	_, err := c.changeTask(ctx, id, func(t *domain.Task) error {
+		if err := t.StartTimer(time.Now()); err != nil {
+			return err
+		}
+		if t.IsPending() {
+			t.Start()
+		}
+		return nil
+	})
+	return err
+}
+
+func (c *DryRunClient) StopTaskTimer(ctx context.Context, id string) (time.Duration, error) {
+	var elapsed time.Duration
+	_, err := c.changeTask(ctx, id, func(t *domain.Task) error {
+		var err error
+		elapsed, err = t.StopTimer(time.Now())
+		return err
+	})
+	return elapsed, err
+}
+
+func (c *DryRunClient) BulkUpdateTasks(ctx context.Context, filter storage.TaskFilter, update TaskUpdate) ([]*domain.Task, error) {
+	var target *domain.Project
+	if update.ProjectID != nil {
+		project, err := c.ReorgClient.GetProject(ctx, *update.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		target = project
+	}
+	tasks, err := c.ReorgClient.ListAllTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*domain.Task
+	for _, before := range tasks {
+		if !filter.Matches(before) {
+			continue
+		}
+		after := before.Clone()
+		update.Apply(after)
+		if target != nil {
+			after.AreaID = target.AreaID
+		}
+		c.record(audit.Update, "task", after.ID, after.Title, before, after)
+		matched = append(matched, after)
+	}
+	return matched, nil
+}
+
+func (c *DryRunClient) BatchCreateTasks(ctx context.Context, tasks []*domain.Task) ([]BatchResult, error) {
+	results := make([]BatchResult, len(tasks))
+	for i, task := range tasks {
+		results[i].Task, results[i].Err = c.CreateTask(ctx, task)
+	}
+	return results, nil
+}
+
+func (c *DryRunClient) BatchUpdateTasks(ctx context.Context, tasks []*domain.Task) ([]BatchResult, error) {
+	results := make([]BatchResult, len(tasks))
+	for i, task := range tasks {
+		results[i] = BatchResult{Task: task, Err: c.UpdateTask(ctx, task)}
+	}
+	return results, nil
+}
+
+func (c *DryRunClient) AttachToTask(ctx context.Context, id, attachment string) (*domain.Task, error) {
+	return c.changeTask(ctx, id, func(t *domain.Task) error {
+		t.AddAttachment(attachment)
+		return nil
+	})
+}
+
+func (c *DryRunClient) AddTaskComment(ctx context.Context, id, author, text string) (*domain.Task, error) {
+	return c.changeTask(ctx, id, func(t *domain.Task) error {
+		_, err := t.AddComment(author, text, time.Now())
+		return err
+	})
+}
+
+func (c *DryRunClient) UpsertTask(ctx context.Context, task *domain.Task) (*domain.Task, bool, error) {
+	if task.ExternalRef == nil {
+		return nil, false, fmt.Errorf("upsert requires an external reference")
+	}
+	if err := task.ExternalRef.Validate(); err != nil {
+		return nil, false, err
+	}
+
+	existing, err := c.ReorgClient.FindTaskByExternalRef(ctx, task.ExternalRef.Source, task.ExternalRef.ID)
+	if err != nil {
+		created, err := c.CreateTask(ctx, task)
+		return created, err == nil, err
+	}
+	update := TaskUpdate{
+		Status:       &task.Status,
+		Priority:     &task.Priority,
+		DueDate:      task.DueDate,
+		DueAllDay:    task.AllDay,
+		ClearDueDate: task.DueDate == nil,
+		Metadata:     task.Metadata,
+	}
+	if existing.Status == task.Status {
+		update.Status = nil
+	}
+	updated, err := c.changeTask(ctx, existing.ID, func(t *domain.Task) error {
+		update.Apply(t)
+		return nil
+	})
+	return updated, false, err
+}
+
+func (c *DryRunClient) AddNote(ctx context.Context, note *domain.Note) (*domain.Note, error) {
+	if err := note.Validate(); err != nil {
+		return nil, err
+	}
+	c.record(audit.Create, "note", note.ID, "", nil, note)
+	return note, nil
+}
+
+func (c *DryRunClient) DeleteNote(ctx context.Context, id string) error {
+	c.record(audit.Delete, "note", id, "", nil, nil)
+	return nil
+}
+
+func (c *DryRunClient) CreateGoal(ctx context.Context, goal *domain.Goal) (*domain.Goal, error) {
+	if err := goal.Validate(); err != nil {
+		return nil, err
+	}
+	c.record(audit.Create, "goal", goal.ID, goal.Title, nil, goal)
+	return goal, nil
+}
+
+func (c *DryRunClient) UpdateGoal(ctx context.Context, goal *domain.Goal) error {
+	if err := goal.Validate(); err != nil {
+		return err
+	}
+	before, err := c.ReorgClient.GetGoal(ctx, goal.ID)
+	if err != nil {
+		return err
+	}
+	c.record(audit.Update, "goal", goal.ID, goal.Title, before, goal)
+	return nil
+}
+
+func (c *DryRunClient) DeleteGoal(ctx context.Context, id string) error {
+	goal, err := c.ReorgClient.GetGoal(ctx, id)
+	if err != nil {
+		return err
+	}
+	c.record(audit.Delete, "goal", goal.ID, goal.Title, nil, nil)
+	return nil
+}
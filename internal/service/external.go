@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// FindTaskByExternalRef returns the task linked to an item in another system
+func (c *LocalClient) FindTaskByExternalRef(ctx context.Context, source, id string) (*domain.Task, error) {
+	tasks, err := c.tasks().ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tasks {
+		if t.ExternalRef.Is(source, id) {
+			return t, nil
+		}
+	}
+	return nil, domain.Errorf(domain.ErrNotFound, "task not found: %s:%s", source, id)
+}
+
+// FindProjectByExternalRef returns the project linked to an item in another system
+func (c *LocalClient) FindProjectByExternalRef(ctx context.Context, source, id string) (*domain.Project, error) {
+	projects, err := c.projects().ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range projects {
+		if p.ExternalRef.Is(source, id) {
+			c.withHealth(ctx, p)
+			return p, nil
+		}
+	}
+	return nil, domain.Errorf(domain.ErrNotFound, "project not found: %s:%s", source, id)
+}
+
+// UpsertTask creates a task, or updates the task with the same external
+// reference and reports created=false. An update replaces the title,
+// status, priority, due date and tags with the incoming values, merges
+// metadata, and replaces the content and link unless the incoming task has
+// none. The task keeps its project, notes, attachments and time tracking.
+func (c *LocalClient) UpsertTask(ctx context.Context, task *domain.Task) (*domain.Task, bool, error) {
+	if task.ExternalRef == nil {
+		return nil, false, fmt.Errorf("upsert requires an external reference")
+	}
+	if err := task.ExternalRef.Validate(); err != nil {
+		return nil, false, err
+	}
+
+	existing, err := c.FindTaskByExternalRef(ctx, task.ExternalRef.Source, task.ExternalRef.ID)
+	if err != nil {
+		created, err := c.CreateTask(ctx, task)
+		return created, err == nil, err
+	}
+
+	update := TaskUpdate{
+		Status:       &task.Status,
+		Priority:     &task.Priority,
+		DueDate:      task.DueDate,
+		DueAllDay:    task.AllDay,
+		ClearDueDate: task.DueDate == nil,
+		Metadata:     task.Metadata,
+	}
+	if existing.Status == task.Status {
+		update.Status = nil // keep the existing completion and start times
+	}
+	update.Apply(existing)
+
+	existing.Title = task.Title
+	existing.Tags = task.Tags
+	if task.ExternalRef.URL != "" {
+		existing.ExternalRef.URL = task.ExternalRef.URL
+	}
+	if task.Content != "" {
+		existing.Content = task.Content
+	}
+
+	if err := c.UpdateTask(ctx, existing); err != nil {
+		return nil, false, err
+	}
+	return existing, false, nil
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ihavespoons/reorg/internal/audit"
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// GoalProgress computes the rollup of a goal from its linked projects and
+// their tasks. Each project counts equally: a completed project counts as
+// done, and any other as the share of its tasks that are complete.
+// Archived projects and cancelled tasks don't count.
+func GoalProgress(projects []*domain.Project, tasksByProject map[string][]*domain.Task) *domain.GoalProgress {
+	p := &domain.GoalProgress{}
+	percent := 0
+	for _, project := range projects {
+		if project.Status == domain.ProjectStatusArchived {
+			continue
+		}
+		p.Projects++
+
+		var total, completed int
+		for _, t := range tasksByProject[project.ID] {
+			if t.Status == domain.TaskStatusCancelled {
+				continue
+			}
+			total++
+			if t.IsComplete() {
+				completed++
+			}
+		}
+		p.TotalTasks += total
+		p.CompletedTasks += completed
+
+		switch {
+		case project.IsComplete():
+			p.CompletedProjects++
+			percent += 100
+		case total > 0:
+			percent += completed * 100 / total
+		}
+	}
+	if p.Projects > 0 {
+		p.PercentComplete = percent / p.Projects
+	}
+	return p
+}
+
+// withProgress fills in the progress of each goal
+func (c *LocalClient) withProgress(ctx context.Context, goals ...*domain.Goal) error {
+	if len(goals) == 0 {
+		return nil
+	}
+
+	projects, err := c.projects().ListAll(ctx)
+	if err != nil {
+		return err
+	}
+	tasks, err := c.tasks().ListAll(ctx)
+	if err != nil {
+		return err
+	}
+	byGoal := make(map[string][]*domain.Project)
+	for _, p := range projects {
+		if p.GoalID != "" {
+			byGoal[p.GoalID] = append(byGoal[p.GoalID], p)
+		}
+	}
+	byProject := make(map[string][]*domain.Task)
+	for _, t := range tasks {
+		byProject[t.ProjectID] = append(byProject[t.ProjectID], t)
+	}
+
+	for _, g := range goals {
+		g.Progress = GoalProgress(byGoal[g.ID], byProject)
+	}
+	return nil
+}
+
+// CreateGoal stores a new goal
+func (c *LocalClient) CreateGoal(ctx context.Context, goal *domain.Goal) (*domain.Goal, error) {
+	if err := c.store.Goals().Create(ctx, goal); err != nil {
+		return nil, err
+	}
+	c.record(ctx, audit.Create, "goal", goal.ID, goal.Title, nil, goal)
+	return goal, c.withProgress(ctx, goal)
+}
+
+// GetGoal returns a goal with its progress
+func (c *LocalClient) GetGoal(ctx context.Context, id string) (*domain.Goal, error) {
+	goal, err := c.store.Goals().Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return goal, c.withProgress(ctx, goal)
+}
+
+// GetGoalBySlug returns a goal by its slug, with its progress
+func (c *LocalClient) GetGoalBySlug(ctx context.Context, slug string) (*domain.Goal, error) {
+	goal, err := c.store.Goals().GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	return goal, c.withProgress(ctx, goal)
+}
+
+// ListGoals returns every goal with its progress
+func (c *LocalClient) ListGoals(ctx context.Context) ([]*domain.Goal, error) {
+	goals, err := c.store.Goals().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return goals, c.withProgress(ctx, goals...)
+}
+
+// UpdateGoal saves changes to a goal
+func (c *LocalClient) UpdateGoal(ctx context.Context, goal *domain.Goal) error {
+	var before *domain.Goal
+	if c.audit != nil {
+		before, _ = c.store.Goals().Get(ctx, goal.ID)
+	}
+	if err := c.store.Goals().Update(ctx, goal); err != nil {
+		return err
+	}
+	c.record(ctx, audit.Update, "goal", goal.ID, goal.Title, before, goal)
+	return nil
+}
+
+// DeleteGoal removes a goal and unlinks its projects, in one commit
+func (c *LocalClient) DeleteGoal(ctx context.Context, id string) error {
+	goal, err := c.store.Goals().Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	projects, err := c.projects().ListAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.store.Batch(ctx, fmt.Sprintf("delete goal: %s", goal.Title), func(ctx context.Context) error {
+		for _, p := range projects {
+			if p.GoalID != id {
+				continue
+			}
+			p.GoalID = ""
+			if err := c.projects().Update(ctx, p); err != nil {
+				return err
+			}
+		}
+		if err := c.store.Goals().Delete(ctx, id); err != nil {
+			return err
+		}
+		c.record(ctx, audit.Delete, "goal", id, goal.Title, nil, nil)
+		return nil
+	})
+}
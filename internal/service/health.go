@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// ProjectHealth computes the progress rollup of a project from its tasks.
+// Cancelled tasks don't count towards the total. Activity is the latest
+// change to the project or any of its tasks, including timer sessions.
+func ProjectHealth(project *domain.Project, tasks []*domain.Task, now time.Time) *domain.ProjectHealth {
+	h := &domain.ProjectHealth{LastActivity: project.Updated}
+
+	for _, t := range tasks {
+		if t.Updated.After(h.LastActivity) {
+			h.LastActivity = t.Updated
+		}
+		for _, s := range t.TimeLog {
+			if s.End != nil && s.End.After(h.LastActivity) {
+				h.LastActivity = *s.End
+			} else if s.Start.After(h.LastActivity) {
+				h.LastActivity = s.Start
+			}
+		}
+
+		if t.Status == domain.TaskStatusCancelled {
+			continue
+		}
+		h.TotalTasks++
+		if t.IsComplete() {
+			h.CompletedTasks++
+		} else if t.IsOverdueAt(now) {
+			h.OverdueTasks++
+		}
+	}
+
+	if h.TotalTasks > 0 {
+		h.PercentComplete = h.CompletedTasks * 100 / h.TotalTasks
+	}
+	if !h.LastActivity.IsZero() && now.After(h.LastActivity) {
+		h.DaysSinceActivity = int(now.Sub(h.LastActivity).Hours() / 24)
+	}
+
+	pastDue := project.DueDate != nil && domain.DayOver(*project.DueDate, now)
+	switch {
+	case project.Status == domain.ProjectStatusCompleted || project.Status == domain.ProjectStatusArchived:
+		h.Status = domain.HealthDone
+	case project.Status == domain.ProjectStatusSomeday:
+		h.Status = domain.HealthSomeday
+	case h.DaysSinceActivity >= domain.StalledAfterDays:
+		h.Status = domain.HealthStalled
+	case h.OverdueTasks > 0 || pastDue:
+		h.Status = domain.HealthAtRisk
+	default:
+		h.Status = domain.HealthOnTrack
+	}
+	return h
+}
+
+// withHealth fills in the health of each project
+func (c *LocalClient) withHealth(ctx context.Context, projects ...*domain.Project) error {
+	if len(projects) == 0 {
+		return nil
+	}
+
+	tasks, err := c.tasks().ListAll(ctx)
+	if err != nil {
+		return err
+	}
+	byProject := make(map[string][]*domain.Task)
+	for _, t := range tasks {
+		byProject[t.ProjectID] = append(byProject[t.ProjectID], t)
+	}
+
+	// A parent's health counts the tasks of its sub-projects too
+	all, err := c.projects().ListAll(ctx)
+	if err != nil {
+		return err
+	}
+	rollup := make(map[string][]*domain.Task)
+	for _, p := range all {
+		rollup[p.ID] = append(rollup[p.ID], byProject[p.ID]...)
+		if p.IsSubProject() {
+			rollup[p.ParentProjectID] = append(rollup[p.ParentProjectID], byProject[p.ID]...)
+		}
+	}
+
+	now := c.now()
+	for _, p := range projects {
+		p.Health = ProjectHealth(p, rollup[p.ID], now)
+	}
+	return nil
+}
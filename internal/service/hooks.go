@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/hooks"
+	"github.com/ihavespoons/reorg/internal/rules"
+)
+
+// SetHooks makes the client run shell hooks when tasks are completed and
+// projects created through it
+func (c *LocalClient) SetHooks(runner *hooks.Runner) {
+	c.hooks = runner
+}
+
+// taskCompleted runs the complete rules and then the on_task_complete hook
+// for a task that was just completed, so the hook sees what the rules did
+func (c *LocalClient) taskCompleted(ctx context.Context, task *domain.Task) {
+	c.runRules(ctx, rules.TriggerComplete, task)
+	c.hooks.Run(ctx, hooks.TaskComplete, task)
+}
@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/storage"
 )
 
 // ReorgClient is the key abstraction enabling embedded/remote modes.
@@ -12,6 +14,10 @@ type ReorgClient interface {
 	AreaService
 	ProjectService
 	TaskService
+	NoteService
+	GoalService
+	OverviewService
+	ChangeService
 }
 
 // AreaService defines area operations
@@ -31,9 +37,12 @@ type ProjectService interface {
 	GetProjectBySlug(ctx context.Context, areaID, slug string) (*domain.Project, error)
 	ListProjects(ctx context.Context, areaID string) ([]*domain.Project, error)
 	ListAllProjects(ctx context.Context) ([]*domain.Project, error)
+	QueryProjects(ctx context.Context, query string) ([]*domain.Project, error)
 	UpdateProject(ctx context.Context, project *domain.Project) error
 	DeleteProject(ctx context.Context, id string) error
 	CompleteProject(ctx context.Context, id string) error
+	MoveProject(ctx context.Context, id, areaID string) error
+	FindProjectByExternalRef(ctx context.Context, source, id string) (*domain.Project, error)
 }
 
 // TaskService defines task operations
@@ -44,8 +53,45 @@ type TaskService interface {
 	ListTasks(ctx context.Context, projectID string) ([]*domain.Task, error)
 	ListTasksByArea(ctx context.Context, areaID string) ([]*domain.Task, error)
 	ListAllTasks(ctx context.Context) ([]*domain.Task, error)
+	QueryTasks(ctx context.Context, query string) ([]*domain.Task, error)
 	UpdateTask(ctx context.Context, task *domain.Task) error
 	DeleteTask(ctx context.Context, id string) error
 	StartTask(ctx context.Context, id string) error
 	CompleteTask(ctx context.Context, id string) error
+	MoveTask(ctx context.Context, id, projectID string) error
+	StartTaskTimer(ctx context.Context, id string) error
+	StopTaskTimer(ctx context.Context, id string) (time.Duration, error)
+	BulkUpdateTasks(ctx context.Context, filter storage.TaskFilter, update TaskUpdate) ([]*domain.Task, error)
+	BatchCreateTasks(ctx context.Context, tasks []*domain.Task) ([]BatchResult, error)
+	BatchUpdateTasks(ctx context.Context, tasks []*domain.Task) ([]BatchResult, error)
+	AttachToTask(ctx context.Context, id, attachment string) (*domain.Task, error)
+	AddTaskComment(ctx context.Context, id, author, text string) (*domain.Task, error)
+	FindTaskByExternalRef(ctx context.Context, source, id string) (*domain.Task, error)
+	UpsertTask(ctx context.Context, task *domain.Task) (*domain.Task, bool, error) // true if created
+}
+
+// NoteService defines note operations
+type NoteService interface {
+	AddNote(ctx context.Context, note *domain.Note) (*domain.Note, error)
+	ListNotes(ctx context.Context, parentID string) ([]*domain.Note, error)
+	SearchNotes(ctx context.Context, text string) ([]*domain.Note, error)
+	DeleteNote(ctx context.Context, id string) error
+}
+
+// GoalService defines goal operations. Goals are returned with their
+// progress filled in.
+type GoalService interface {
+	CreateGoal(ctx context.Context, goal *domain.Goal) (*domain.Goal, error)
+	GetGoal(ctx context.Context, id string) (*domain.Goal, error)
+	GetGoalBySlug(ctx context.Context, slug string) (*domain.Goal, error)
+	ListGoals(ctx context.Context) ([]*domain.Goal, error)
+	UpdateGoal(ctx context.Context, goal *domain.Goal) error
+	DeleteGoal(ctx context.Context, id string) error
+}
+
+// OverviewService defines operations that return areas, projects and tasks
+// joined together, so views of everything need a single call
+type OverviewService interface {
+	GetOverview(ctx context.Context) (*Overview, error)
+	ListTasksWithRefs(ctx context.Context, query string) ([]*TaskRef, error)
 }
@@ -2,15 +2,36 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/ihavespoons/reorg/internal/audit"
 	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/hooks"
+	"github.com/ihavespoons/reorg/internal/notify"
+	"github.com/ihavespoons/reorg/internal/rules"
+	"github.com/ihavespoons/reorg/internal/storage"
 	"github.com/ihavespoons/reorg/internal/storage/markdown"
 )
 
 // LocalClient implements ReorgClient by embedding services directly.
 // This is used in embedded mode where no network calls are needed.
 type LocalClient struct {
-	store *markdown.Store
+	store    *markdown.Store
+	notifier *notify.Router
+	rules    []rules.Rule
+	hooks    *hooks.Runner
+	audit    *audit.Log
+	cache    cache
+
+	// commitExternal makes Watch commit edits made outside reorg
+	commitExternal bool
+
+	watchMu  sync.Mutex
+	watching bool
+	changes  changeFeed
 }
 
 // NewLocalClient creates a new local client with direct access to storage
@@ -18,149 +39,442 @@ func NewLocalClient(store *markdown.Store) *LocalClient {
 	return &LocalClient{store: store}
 }
 
+// now returns the current time in the store's timezone, which days are
+// counted in
+func (c *LocalClient) now() time.Time {
+	return time.Now().In(c.store.Location())
+}
+
 // Store returns the underlying store for direct access when needed
 func (c *LocalClient) Store() *markdown.Store {
 	return c.store
 }
 
+// SetNotifier enables notifications for task and project status changes
+func (c *LocalClient) SetNotifier(router *notify.Router) {
+	c.notifier = router
+}
+
+// SetCommitExternalEdits makes Watch commit edits made to the files
+// outside reorg, once they settle
+func (c *LocalClient) SetCommitExternalEdits(enabled bool) {
+	c.commitExternal = enabled
+}
+
+// notifyProject sends a message to the project's notification channel.
+// Notifications are best effort and never fail the operation.
+func (c *LocalClient) notifyProject(ctx context.Context, project *domain.Project, msg notify.Message) {
+	if c.notifier == nil || project == nil {
+		return
+	}
+	_ = c.notifier.Send(ctx, project.Notify, msg)
+}
+
+// notifyTaskStatus announces a task's new status on its project's channel
+func (c *LocalClient) notifyTaskStatus(ctx context.Context, task *domain.Task) {
+	if c.notifier == nil {
+		return
+	}
+	project, err := c.projects().Get(ctx, task.ProjectID)
+	if err != nil {
+		return
+	}
+	c.notifyProject(ctx, project, notify.Message{
+		Title: fmt.Sprintf("Task %s: %s", strings.ReplaceAll(string(task.Status), "_", " "), task.Title),
+		Body:  fmt.Sprintf("Project: %s", project.Title),
+	})
+}
+
+// notifyProjectStatus announces a project's new status on its channel
+func (c *LocalClient) notifyProjectStatus(ctx context.Context, project *domain.Project) {
+	c.notifyProject(ctx, project, notify.Message{
+		Title: fmt.Sprintf("Project %s: %s", strings.ReplaceAll(string(project.Status), "_", " "), project.Title),
+	})
+}
+
 // AreaService implementation
 
 func (c *LocalClient) CreateArea(ctx context.Context, area *domain.Area) (*domain.Area, error) {
-	if err := c.store.Areas().Create(ctx, area); err != nil {
+	if err := c.areas().Create(ctx, area); err != nil {
 		return nil, err
 	}
 	return area, nil
 }
 
 func (c *LocalClient) GetArea(ctx context.Context, id string) (*domain.Area, error) {
-	return c.store.Areas().Get(ctx, id)
+	return c.areas().Get(ctx, id)
 }
 
 func (c *LocalClient) GetAreaBySlug(ctx context.Context, slug string) (*domain.Area, error) {
-	return c.store.Areas().GetBySlug(ctx, slug)
+	return c.areas().GetBySlug(ctx, slug)
 }
 
 func (c *LocalClient) ListAreas(ctx context.Context) ([]*domain.Area, error) {
-	return c.store.Areas().List(ctx)
+	return c.areas().List(ctx)
 }
 
 func (c *LocalClient) UpdateArea(ctx context.Context, area *domain.Area) error {
-	return c.store.Areas().Update(ctx, area)
+	return c.areas().Update(ctx, area)
 }
 
 func (c *LocalClient) DeleteArea(ctx context.Context, id string) error {
-	return c.store.Areas().Delete(ctx, id)
+	return c.areas().Delete(ctx, id)
 }
 
 // ProjectService implementation
 
 func (c *LocalClient) CreateProject(ctx context.Context, project *domain.Project) (*domain.Project, error) {
-	if err := c.store.Projects().Create(ctx, project); err != nil {
+	if err := c.projects().Create(ctx, project); err != nil {
 		return nil, err
 	}
+	c.hooks.Run(ctx, hooks.ProjectCreate, project)
 	return project, nil
 }
 
 func (c *LocalClient) GetProject(ctx context.Context, id string) (*domain.Project, error) {
-	return c.store.Projects().Get(ctx, id)
+	project, err := c.projects().Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return project, c.withHealth(ctx, project)
 }
 
 func (c *LocalClient) GetProjectBySlug(ctx context.Context, areaID, slug string) (*domain.Project, error) {
 	// First get the area to find its slug
-	area, err := c.store.Areas().Get(ctx, areaID)
+	area, err := c.areas().Get(ctx, areaID)
 	if err != nil {
 		return nil, err
 	}
-	return c.store.Projects().GetBySlug(ctx, area.Slug(), slug)
+	project, err := c.projects().GetBySlug(ctx, area.Slug(), slug)
+	if err != nil {
+		return nil, err
+	}
+	return project, c.withHealth(ctx, project)
 }
 
 func (c *LocalClient) ListProjects(ctx context.Context, areaID string) ([]*domain.Project, error) {
-	return c.store.Projects().List(ctx, areaID)
+	projects, err := c.projects().List(ctx, areaID)
+	if err != nil {
+		return nil, err
+	}
+	return projects, c.withHealth(ctx, projects...)
 }
 
 func (c *LocalClient) ListAllProjects(ctx context.Context) ([]*domain.Project, error) {
-	return c.store.Projects().ListAll(ctx)
+	projects, err := c.projects().ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return projects, c.withHealth(ctx, projects...)
 }
 
 func (c *LocalClient) UpdateProject(ctx context.Context, project *domain.Project) error {
-	return c.store.Projects().Update(ctx, project)
+	var previous domain.ProjectStatus
+	if c.notifier != nil {
+		if existing, err := c.projects().Get(ctx, project.ID); err == nil {
+			previous = existing.Status
+		}
+	}
+
+	if err := c.projects().Update(ctx, project); err != nil {
+		return err
+	}
+
+	if c.notifier != nil && previous != project.Status {
+		c.notifyProjectStatus(ctx, project)
+	}
+	return nil
 }
 
 func (c *LocalClient) DeleteProject(ctx context.Context, id string) error {
-	return c.store.Projects().Delete(ctx, id)
+	return c.projects().Delete(ctx, id)
 }
 
 func (c *LocalClient) CompleteProject(ctx context.Context, id string) error {
-	project, err := c.store.Projects().Get(ctx, id)
+	project, err := c.projects().Get(ctx, id)
 	if err != nil {
 		return err
 	}
 	project.Complete()
-	return c.store.Projects().Update(ctx, project)
+	if err := c.projects().Update(ctx, project); err != nil {
+		return err
+	}
+
+	c.notifyProjectStatus(ctx, project)
+	return nil
+}
+
+func (c *LocalClient) MoveProject(ctx context.Context, id, areaID string) error {
+	project, err := c.projects().Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	area, err := c.areas().Get(ctx, areaID)
+	if err != nil {
+		return err
+	}
+	if project.AreaID == area.ID {
+		return nil
+	}
+
+	// A sub-project moved to another area leaves its parent behind
+	project.AreaID = area.ID
+	project.ParentProjectID = ""
+	return c.store.Batch(ctx, fmt.Sprintf("move project: %s to %s", project.Title, area.Title), func(ctx context.Context) error {
+		return c.projects().Update(ctx, project)
+	})
 }
 
 // TaskService implementation
 
 func (c *LocalClient) CreateTask(ctx context.Context, task *domain.Task) (*domain.Task, error) {
-	if err := c.store.Tasks().Create(ctx, task); err != nil {
+	if err := c.tasks().Create(ctx, task); err != nil {
 		return nil, err
 	}
+	c.runCreateRules(ctx, task)
 	return task, nil
 }
 
 func (c *LocalClient) GetTask(ctx context.Context, id string) (*domain.Task, error) {
-	return c.store.Tasks().Get(ctx, id)
+	return c.tasks().Get(ctx, id)
 }
 
 func (c *LocalClient) GetTaskBySlug(ctx context.Context, projectID, slug string) (*domain.Task, error) {
 	// Get the project and area to find slugs
-	project, err := c.store.Projects().Get(ctx, projectID)
+	project, err := c.projects().Get(ctx, projectID)
 	if err != nil {
 		return nil, err
 	}
-	area, err := c.store.Areas().Get(ctx, project.AreaID)
+	area, err := c.areas().Get(ctx, project.AreaID)
 	if err != nil {
 		return nil, err
 	}
-	return c.store.Tasks().GetBySlug(ctx, area.Slug(), project.Slug(), slug)
+	return c.tasks().GetBySlug(ctx, area.Slug(), project.Slug(), slug)
 }
 
 func (c *LocalClient) ListTasks(ctx context.Context, projectID string) ([]*domain.Task, error) {
-	return c.store.Tasks().List(ctx, projectID)
+	return c.tasks().List(ctx, projectID)
 }
 
 func (c *LocalClient) ListTasksByArea(ctx context.Context, areaID string) ([]*domain.Task, error) {
-	return c.store.Tasks().ListByArea(ctx, areaID)
+	return c.tasks().ListByArea(ctx, areaID)
 }
 
 func (c *LocalClient) ListAllTasks(ctx context.Context) ([]*domain.Task, error) {
-	return c.store.Tasks().ListAll(ctx)
+	return c.tasks().ListAll(ctx)
 }
 
 func (c *LocalClient) UpdateTask(ctx context.Context, task *domain.Task) error {
-	return c.store.Tasks().Update(ctx, task)
+	var previous domain.TaskStatus
+	if c.notifier != nil || len(c.rules) > 0 || c.hooks.Has(hooks.TaskComplete) {
+		if existing, err := c.tasks().Get(ctx, task.ID); err == nil {
+			previous = existing.Status
+		}
+	}
+
+	if err := c.tasks().Update(ctx, task); err != nil {
+		return err
+	}
+
+	if c.notifier != nil && previous != task.Status {
+		c.notifyTaskStatus(ctx, task)
+	}
+	if previous != task.Status && task.IsComplete() {
+		c.taskCompleted(ctx, task)
+	}
+	return nil
 }
 
 func (c *LocalClient) DeleteTask(ctx context.Context, id string) error {
-	return c.store.Tasks().Delete(ctx, id)
+	return c.tasks().Delete(ctx, id)
 }
 
 func (c *LocalClient) StartTask(ctx context.Context, id string) error {
-	task, err := c.store.Tasks().Get(ctx, id)
+	task, err := c.tasks().Modify(ctx, id, func(task *domain.Task) error {
+		task.Start()
+		return nil
+	})
 	if err != nil {
 		return err
 	}
-	task.Start()
-	return c.store.Tasks().Update(ctx, task)
+
+	c.notifyTaskStatus(ctx, task)
+	return nil
 }
 
 func (c *LocalClient) CompleteTask(ctx context.Context, id string) error {
-	task, err := c.store.Tasks().Get(ctx, id)
+	var wasComplete bool
+	task, err := c.tasks().Modify(ctx, id, func(task *domain.Task) error {
+		wasComplete = task.IsComplete()
+		task.Complete()
+		return nil
+	})
 	if err != nil {
 		return err
 	}
-	task.Complete()
-	return c.store.Tasks().Update(ctx, task)
+
+	c.notifyTaskStatus(ctx, task)
+	if !wasComplete {
+		c.taskCompleted(ctx, task)
+	}
+	return nil
+}
+
+func (c *LocalClient) StartTaskTimer(ctx context.Context, id string) error {
+	var started bool
+	task, err := c.tasks().Modify(ctx, id, func(task *domain.Task) error {
+		if err := task.StartTimer(time.Now()); err != nil {
+			return err
+		}
+		// Working on a pending task starts it
+		started = task.IsPending()
+		if started {
+			task.Start()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if started {
+		c.notifyTaskStatus(ctx, task)
+	}
+	return nil
+}
+
+func (c *LocalClient) StopTaskTimer(ctx context.Context, id string) (time.Duration, error) {
+	var elapsed time.Duration
+	_, err := c.tasks().Modify(ctx, id, func(task *domain.Task) error {
+		var err error
+		elapsed, err = task.StopTimer(time.Now())
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return elapsed, nil
+}
+
+// AttachToTask attaches a URL or a local file to a task. Files are copied
+// into the assets directory of the task's project.
+func (c *LocalClient) AttachToTask(ctx context.Context, id, attachment string) (*domain.Task, error) {
+	task, err := c.tasks().Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.store.Batch(ctx, fmt.Sprintf("attach to task: %s", task.Title), func(ctx context.Context) error {
+		ref := attachment
+		if !domain.IsLink(attachment) {
+			if ref, err = c.tasks().CopyAsset(ctx, task, attachment); err != nil {
+				return err
+			}
+		}
+		if !task.AddAttachment(ref) {
+			return nil
+		}
+		return c.tasks().Update(ctx, task)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// AddTaskComment adds a comment by author to the task's Comments section
+func (c *LocalClient) AddTaskComment(ctx context.Context, id, author, text string) (*domain.Task, error) {
+	task, err := c.tasks().Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.store.Batch(ctx, fmt.Sprintf("comment on task: %s", task.Title), func(ctx context.Context) error {
+		task, err = c.tasks().Modify(ctx, id, func(task *domain.Task) error {
+			_, err := task.AddComment(author, text, time.Now())
+			return err
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+func (c *LocalClient) MoveTask(ctx context.Context, id, projectID string) error {
+	task, err := c.tasks().Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	project, err := c.projects().Get(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if task.ProjectID == project.ID {
+		return nil
+	}
+
+	task.ProjectID = project.ID
+	task.AreaID = project.AreaID
+	return c.store.Batch(ctx, fmt.Sprintf("move task: %s to %s", task.Title, project.Title), func(ctx context.Context) error {
+		return c.tasks().Update(ctx, task)
+	})
+}
+
+func (c *LocalClient) BulkUpdateTasks(ctx context.Context, filter storage.TaskFilter, update TaskUpdate) ([]*domain.Task, error) {
+	tasks, err := c.tasks().ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve the destination once so a bad project fails before anything is written
+	var target *domain.Project
+	if update.ProjectID != nil {
+		target, err = c.projects().Get(ctx, *update.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var matched []*domain.Task
+	for _, task := range tasks {
+		if filter.Matches(task) {
+			matched = append(matched, task)
+		}
+	}
+	if len(matched) == 0 {
+		return matched, nil
+	}
+
+	wasComplete := make(map[string]bool)
+	err = c.store.Batch(ctx, fmt.Sprintf("bulk update %d tasks", len(matched)), func(ctx context.Context) error {
+		for _, task := range matched {
+			wasComplete[task.ID] = task.IsComplete()
+			update.Apply(task)
+			if target != nil {
+				task.AreaID = target.AreaID
+			}
+			if err := c.tasks().Update(ctx, task); err != nil {
+				return fmt.Errorf("failed to update task %s: %w", task.ID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if update.Status != nil {
+		for _, task := range matched {
+			c.notifyTaskStatus(ctx, task)
+			if task.IsComplete() && !wasComplete[task.ID] {
+				c.taskCompleted(ctx, task)
+			}
+		}
+	}
+
+	return matched, nil
 }
 
 // Ensure LocalClient implements ReorgClient
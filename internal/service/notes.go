@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ihavespoons/reorg/internal/audit"
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// AddNote attaches a note to a project or task
+func (c *LocalClient) AddNote(ctx context.Context, note *domain.Note) (*domain.Note, error) {
+	if err := c.store.Notes().Create(ctx, note); err != nil {
+		return nil, err
+	}
+	c.record(ctx, audit.Create, "note", note.ID, "", nil, note)
+	return note, nil
+}
+
+// ListNotes returns the notes of a project or task, oldest first
+func (c *LocalClient) ListNotes(ctx context.Context, parentID string) ([]*domain.Note, error) {
+	return c.store.Notes().List(ctx, parentID)
+}
+
+// SearchNotes returns every note containing text, ignoring case
+func (c *LocalClient) SearchNotes(ctx context.Context, text string) ([]*domain.Note, error) {
+	notes, err := c.store.Notes().ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*domain.Note
+	for _, n := range notes {
+		if n.Matches(text) {
+			matched = append(matched, n)
+		}
+	}
+	return matched, nil
+}
+
+// DeleteNote removes a note
+func (c *LocalClient) DeleteNote(ctx context.Context, id string) error {
+	if err := c.store.Notes().Delete(ctx, id); err != nil {
+		return err
+	}
+	c.record(ctx, audit.Delete, "note", id, "", nil, nil)
+	return nil
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// Overview is every area with its projects and their tasks
+type Overview struct {
+	Areas []*AreaOverview
+}
+
+// AreaOverview is an area with its projects
+type AreaOverview struct {
+	Area     *domain.Area
+	Projects []*ProjectOverview
+}
+
+// ProjectOverview is a project, with its health, and its tasks
+type ProjectOverview struct {
+	Project *domain.Project
+	Tasks   []*domain.Task
+}
+
+// TaskRef is a task with the project and area it belongs to. Either may be
+// nil if the task refers to one that doesn't exist.
+type TaskRef struct {
+	Task    *domain.Task
+	Project *domain.Project
+	Area    *domain.Area
+}
+
+// GetOverview returns every area, project and task, read in one pass
+func (c *LocalClient) GetOverview(ctx context.Context) (*Overview, error) {
+	areas, err := c.areas().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := c.projects().ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := c.tasks().ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byProject := make(map[string]*ProjectOverview, len(projects))
+	byArea := make(map[string][]*ProjectOverview, len(areas))
+	for _, p := range projects {
+		po := &ProjectOverview{Project: p}
+		byProject[p.ID] = po
+		byArea[p.AreaID] = append(byArea[p.AreaID], po)
+	}
+	for _, t := range tasks {
+		if po, ok := byProject[t.ProjectID]; ok {
+			po.Tasks = append(po.Tasks, t)
+		}
+	}
+
+	now := c.now()
+	overview := &Overview{Areas: make([]*AreaOverview, len(areas))}
+	for i, a := range areas {
+		overview.Areas[i] = &AreaOverview{Area: a, Projects: byArea[a.ID]}
+	}
+	for _, po := range byProject {
+		po.Project.Health = ProjectHealth(po.Project, po.Tasks, now)
+	}
+	return overview, nil
+}
+
+// ListTasksWithRefs returns the tasks matching a query expression, or all
+// tasks for an empty one, each with its project and area
+func (c *LocalClient) ListTasksWithRefs(ctx context.Context, expr string) ([]*TaskRef, error) {
+	var tasks []*domain.Task
+	var err error
+	if expr != "" {
+		tasks, err = c.QueryTasks(ctx, expr)
+	} else {
+		tasks, err = c.tasks().ListAll(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	areas, err := c.areas().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := c.projects().ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return joinTaskRefs(tasks, projects, areas), nil
+}
+
+// joinTaskRefs pairs each task with its project and area
+func joinTaskRefs(tasks []*domain.Task, projects []*domain.Project, areas []*domain.Area) []*TaskRef {
+	projectsByID := make(map[string]*domain.Project, len(projects))
+	for _, p := range projects {
+		projectsByID[p.ID] = p
+	}
+	areasByID := make(map[string]*domain.Area, len(areas))
+	for _, a := range areas {
+		areasByID[a.ID] = a
+	}
+
+	refs := make([]*TaskRef, len(tasks))
+	for i, t := range tasks {
+		ref := &TaskRef{Task: t, Project: projectsByID[t.ProjectID]}
+		if ref.Project != nil {
+			ref.Area = areasByID[ref.Project.AreaID]
+		} else {
+			ref.Area = areasByID[t.AreaID]
+		}
+		refs[i] = ref
+	}
+	return refs
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/query"
+)
+
+// QueryTasks returns all tasks matching a query expression
+func (c *LocalClient) QueryTasks(ctx context.Context, expr string) ([]*domain.Task, error) {
+	q, err := query.Parse(expr, query.KindTask, c.now())
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := c.tasks().ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if q.IsEmpty() {
+		return tasks, nil
+	}
+
+	env, err := c.queryEnv(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*domain.Task
+	for _, t := range tasks {
+		if q.MatchTask(t, env) {
+			matched = append(matched, t)
+		}
+	}
+	return matched, nil
+}
+
+// QueryProjects returns all projects matching a query expression
+func (c *LocalClient) QueryProjects(ctx context.Context, expr string) ([]*domain.Project, error) {
+	q, err := query.Parse(expr, query.KindProject, c.now())
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := c.projects().ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if q.IsEmpty() {
+		return projects, c.withHealth(ctx, projects...)
+	}
+
+	env, err := c.queryEnv(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*domain.Project
+	for _, p := range projects {
+		if q.MatchProject(p, env) {
+			matched = append(matched, p)
+		}
+	}
+	return matched, c.withHealth(ctx, matched...)
+}
+
+// queryEnv builds the slug lookups used to evaluate project: and area: terms
+func (c *LocalClient) queryEnv(ctx context.Context) (query.Env, error) {
+	env := query.Env{
+		Now:          c.now(),
+		ProjectSlugs: make(map[string]string),
+		AreaSlugs:    make(map[string]string),
+	}
+
+	areas, err := c.areas().List(ctx)
+	if err != nil {
+		return env, err
+	}
+	for _, a := range areas {
+		env.AreaSlugs[a.ID] = a.Slug()
+	}
+
+	projects, err := c.projects().ListAll(ctx)
+	if err != nil {
+		return env, err
+	}
+	for _, p := range projects {
+		env.ProjectSlugs[p.ID] = p.Slug()
+	}
+
+	return env, nil
+}
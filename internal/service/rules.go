@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/notify"
+	"github.com/ihavespoons/reorg/internal/rules"
+)
+
+// SetRules makes the client run automation rules on tasks that are
+// created, imported or completed through it
+func (c *LocalClient) SetRules(r []rules.Rule) {
+	c.rules = r
+}
+
+// Rules returns the automation rules the client runs
+func (c *LocalClient) Rules() []rules.Rule {
+	return c.rules
+}
+
+// runRules applies the rules matching a trigger to a task that was just
+// saved. Each rule sees the changes of the ones before it. Like
+// notifications, rules can't fail the call that triggered them: a rule
+// whose project doesn't exist is skipped.
+func (c *LocalClient) runRules(ctx context.Context, trigger rules.Trigger, task *domain.Task) {
+	if len(c.rules) == 0 {
+		return
+	}
+	env, err := c.queryEnv(ctx)
+	if err != nil {
+		return
+	}
+	env.Now = c.now()
+
+	var applied []string
+	var messages []string
+	changed := false
+	for _, r := range c.rules {
+		if !r.Matches(trigger, task, env) {
+			continue
+		}
+		a := r.Then
+		for _, tag := range a.AddTags {
+			if !task.HasTag(tag) {
+				task.AddTag(tag)
+				changed = true
+			}
+		}
+		for _, tag := range a.RemoveTags {
+			if task.HasTag(tag) {
+				task.RemoveTag(tag)
+				changed = true
+			}
+		}
+		if p := domain.Priority(a.Priority); p != "" && p != task.Priority {
+			task.Priority = p
+			changed = true
+		}
+		if a.Project != "" {
+			if project := c.projectBySlug(ctx, a.Project); project != nil && project.ID != task.ProjectID {
+				task.ProjectID, task.AreaID = project.ID, project.AreaID
+				changed = true
+			}
+		}
+		if a.Notify != "" {
+			messages = append(messages, a.Message(task))
+		}
+		applied = append(applied, r.Label())
+	}
+
+	if changed {
+		action := fmt.Sprintf("rule %s: %s", strings.Join(applied, ", "), task.Title)
+		_ = c.store.Batch(ctx, action, func(ctx context.Context) error {
+			return c.tasks().Update(ctx, task)
+		})
+	}
+	if len(messages) > 0 && c.notifier != nil {
+		project, _ := c.projects().Get(ctx, task.ProjectID)
+		for _, msg := range messages {
+			c.notifyProject(ctx, project, notify.Message{Title: task.Title, Body: msg})
+		}
+	}
+}
+
+// runCreateRules runs the create rules on a new task, and the import rules
+// too when an import created it
+func (c *LocalClient) runCreateRules(ctx context.Context, task *domain.Task) {
+	c.runRules(ctx, rules.TriggerCreate, task)
+	if domain.ProvenanceFromMetadata(task.Metadata).ImportedAt != nil {
+		c.runRules(ctx, rules.TriggerImport, task)
+	}
+}
+
+// projectBySlug finds a project by slug in any area
+func (c *LocalClient) projectBySlug(ctx context.Context, slug string) *domain.Project {
+	projects, err := c.projects().ListAll(ctx)
+	if err != nil {
+		return nil
+	}
+	for _, p := range projects {
+		if p.Slug() == slug || p.ID == slug {
+			return p
+		}
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"slices"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// WithoutSomeday drops the tasks of someday projects, which the default
+// views leave out. projects must include every project the tasks belong to.
+func WithoutSomeday(tasks []*domain.Task, projects []*domain.Project) []*domain.Task {
+	someday := make(map[string]bool)
+	for _, p := range projects {
+		if p.IsSomeday() {
+			someday[p.ID] = true
+		}
+	}
+	return slices.DeleteFunc(tasks, func(t *domain.Task) bool { return someday[t.ProjectID] })
+}
@@ -0,0 +1,18 @@
+package service
+
+import "context"
+
+type userKey struct{}
+
+// WithUser returns a context for a request made on behalf of a user of a
+// server hosting several
+func WithUser(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// UserFromContext returns the user a request is made for, or "" on a
+// server with a single user
+func UserFromContext(ctx context.Context) string {
+	user, _ := ctx.Value(userKey{}).(string)
+	return user
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// externalEditDelay is how long files must stay unchanged before edits
+// made outside reorg are committed, so an editor's save, or a git pull,
+// ends up in one commit
+const externalEditDelay = 2 * time.Second
+
+// Watch keeps the cache in step with edits made outside the client, such
+// as a text editor, git pull or another reorg process, until ctx is done.
+// Long-running servers should call it; commands that exit straight away
+// don't need to. With SetCommitExternalEdits, edits are committed once no
+// file has changed for externalEditDelay. Edits are also sent to Changes
+// subscribers. Calling Watch again while watching does nothing.
+func (c *LocalClient) Watch(ctx context.Context) error {
+	c.watchMu.Lock()
+	defer c.watchMu.Unlock()
+	if c.watching {
+		return nil
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to watch data directory: %w", err)
+	}
+
+	// Watches aren't recursive, so every directory is watched, and new
+	// ones as they appear
+	root := c.store.RootDir()
+	add := func(dir string) {
+		_ = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || !d.IsDir() {
+				return nil
+			}
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			_ = watcher.Add(path)
+			return nil
+		})
+	}
+	add(root)
+
+	c.watching = true
+
+	go func() {
+		defer func() {
+			_ = watcher.Close()
+			c.watchMu.Lock()
+			c.watching = false
+			c.watchMu.Unlock()
+		}()
+		var commit, publish <-chan time.Time
+		var changed []string
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				// The store's lock and temporary files; the rename that
+				// completes a write is seen on the file itself
+				if strings.HasSuffix(event.Name, "~") {
+					continue
+				}
+				if event.Has(fsnotify.Create) {
+					add(event.Name)
+				}
+				c.cache.invalidate()
+				if c.commitExternal {
+					commit = time.After(externalEditDelay)
+				}
+				if rel, err := filepath.Rel(root, event.Name); err == nil && !slices.Contains(changed, rel) {
+					changed = append(changed, rel)
+				}
+				if publish == nil {
+					publish = time.After(changeDelay)
+				}
+			case <-publish:
+				publish = nil
+				c.changes.publish(Change{Time: time.Now(), Paths: changed})
+				changed = nil
+			case <-commit:
+				commit = nil
+				// Best effort, like notifications; the edits stay for the
+				// next commit
+				_ = c.store.CommitExternal(ctx)
+			case _, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				// Events may have been lost
+				c.cache.invalidate()
+				c.changes.publish(Change{Time: time.Now()})
+			}
+		}
+	}()
+	return nil
+}
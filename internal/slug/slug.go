@@ -0,0 +1,60 @@
+// Package slug makes the slugs that name the files of areas, projects,
+// tasks and goals and that commands accept in place of their IDs.
+package slug
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// spelled are letters that don't decompose into an ASCII letter and a
+// mark, written out the way they usually are in ASCII
+var spelled = map[rune]string{
+	'ß': "ss",
+	'æ': "ae",
+	'œ': "oe",
+	'ø': "o",
+	'ł': "l",
+	'đ': "d",
+	'ð': "d",
+	'þ': "th",
+	'ı': "i",
+}
+
+// Make returns the slug of a title: lower case, with spaces as hyphens and
+// without punctuation. Accented letters lose their accents ("Ärzte" is
+// "arzte") and letters of scripts without an ASCII spelling are kept, so a
+// title is never lost entirely. Titles in plain ASCII have the slugs they
+// always had.
+func Make(title string) string {
+	var b strings.Builder
+	for _, r := range norm.NFKD.String(title) {
+		r = unicode.ToLower(r)
+		switch {
+		case r == ' ' || r == '-':
+			b.WriteRune('-')
+		case r >= 'a' && r <= 'z' || r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r < unicode.MaxASCII || unicode.Is(unicode.Mn, r):
+			// Punctuation, and the accents split off their letters
+		case spelled[r] != "":
+			b.WriteString(spelled[r])
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Unique returns s, or if taken says it is in use, s with the lowest
+// suffix from -2 up that isn't
+func Unique(s string, taken func(string) bool) string {
+	name := s
+	for n := 2; taken(name); n++ {
+		name = fmt.Sprintf("%s-%d", s, n)
+	}
+	return name
+}
@@ -2,6 +2,9 @@ package git
 
 import (
 	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-git/go-git/v5"
@@ -100,6 +103,32 @@ func (c *Client) AddAll() error {
 	return nil
 }
 
+// Changes returns the paths of the files that differ from the last
+// commit, relative to the repository root and sorted
+func (c *Client) Changes() ([]string, error) {
+	if !c.enabled {
+		return nil, nil
+	}
+
+	worktree, err := c.repo.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worktree: %w", err)
+	}
+	status, err := worktree.Status()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status: %w", err)
+	}
+
+	var paths []string
+	for path, s := range status {
+		if s.Worktree != git.Unmodified || s.Staging != git.Unmodified {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
 // Commit creates a commit with the given message
 func (c *Client) Commit(message string) error {
 	if !c.enabled {
@@ -171,3 +200,110 @@ func (c *Client) Status() (string, error) {
 
 	return status.String(), nil
 }
+
+// CommitInfo summarizes a commit
+type CommitInfo struct {
+	Hash    string
+	Message string
+	Author  string
+	When    time.Time
+}
+
+// History returns the commits that changed a file, newest first, following
+// first parents. The path is relative to the repository root. When marker is
+// set, the file is followed back through moves by looking for a deleted file
+// whose content contains marker (such as its "id: ..." line).
+func (c *Client) History(path, marker string) ([]CommitInfo, error) {
+	if !c.enabled {
+		return nil, nil
+	}
+
+	head, err := c.repo.Head()
+	if err != nil {
+		return nil, nil // No commits yet
+	}
+
+	commit, err := c.repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+
+	// Walk first parents, recording commits where the file's blob changed
+	var commits []CommitInfo
+	path = filepath.ToSlash(path)
+	for commit != nil {
+		current := fileHash(commit, path)
+		parent, _ := commit.Parent(0)
+
+		var previous plumbing.Hash
+		if parent != nil {
+			previous = fileHash(parent, path)
+		}
+
+		if current != previous && !current.IsZero() {
+			commits = append(commits, CommitInfo{
+				Hash:    commit.Hash.String(),
+				Message: strings.TrimSpace(commit.Message),
+				Author:  commit.Author.Name,
+				When:    commit.Author.When,
+			})
+
+			// The file was added here; follow it back if it was moved
+			if previous.IsZero() && parent != nil && marker != "" {
+				if moved := c.movedFrom(parent, commit, marker); moved != "" {
+					path = moved
+				}
+			}
+		}
+
+		commit = parent
+	}
+
+	return commits, nil
+}
+
+// fileHash returns the blob hash of path in a commit, or the zero hash
+func fileHash(commit *object.Commit, path string) plumbing.Hash {
+	tree, err := commit.Tree()
+	if err != nil {
+		return plumbing.ZeroHash
+	}
+	entry, err := tree.FindEntry(path)
+	if err != nil {
+		return plumbing.ZeroHash
+	}
+	return entry.Hash
+}
+
+// movedFrom finds a file deleted between parent and commit whose content
+// contains marker, returning its path in parent
+func (c *Client) movedFrom(parent, commit *object.Commit, marker string) string {
+	parentTree, err := parent.Tree()
+	if err != nil {
+		return ""
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return ""
+	}
+	changes, err := object.DiffTree(parentTree, tree)
+	if err != nil {
+		return ""
+	}
+
+	for _, change := range changes {
+		if change.From.Name == "" || change.To.Name != "" {
+			continue // Only deletions
+		}
+		file, err := parentTree.File(change.From.Name)
+		if err != nil {
+			continue
+		}
+		contents, err := file.Contents()
+		if err == nil && strings.Contains(contents, marker) {
+			return change.From.Name
+		}
+	}
+
+	return ""
+}
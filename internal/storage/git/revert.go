@@ -0,0 +1,202 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// Changeset is a commit made by reorg. Operations that touch many files,
+// like imports and bulk edits, are recorded as a single changeset.
+type Changeset struct {
+	CommitInfo
+	// Name identifies the changeset by operation and time, such as
+	// "import:2025-06-01T09:00"
+	Name string
+	// Action is the commit message without the "reorg: " prefix
+	Action string
+}
+
+// ChangesetName returns the name for a reorg commit action made at when
+func ChangesetName(action string, when time.Time) string {
+	op := action
+	if i := strings.IndexAny(op, " :"); i >= 0 {
+		op = op[:i]
+	}
+	return op + ":" + when.Local().Format("2006-01-02T15:04")
+}
+
+// Changesets returns up to limit commits made by reorg, newest first,
+// following first parents
+func (c *Client) Changesets(limit int) ([]Changeset, error) {
+	if !c.enabled {
+		return nil, nil
+	}
+
+	head, err := c.repo.Head()
+	if err != nil {
+		return nil, nil // No commits yet
+	}
+
+	commit, err := c.repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+
+	var changesets []Changeset
+	for commit != nil && len(changesets) < limit {
+		message := strings.TrimSpace(commit.Message)
+		if action, ok := strings.CutPrefix(message, "reorg: "); ok {
+			info := CommitInfo{
+				Hash:    commit.Hash.String(),
+				Message: message,
+				Author:  commit.Author.Name,
+				When:    commit.Author.When,
+			}
+			changesets = append(changesets, Changeset{
+				CommitInfo: info,
+				Name:       ChangesetName(action, info.When),
+				Action:     action,
+			})
+		}
+		commit, _ = commit.Parent(0)
+	}
+
+	return changesets, nil
+}
+
+// RevertResult lists the files a revert restored and the ones it left alone
+// because they were changed again afterwards
+type RevertResult struct {
+	Reverted  []string
+	Conflicts []string
+}
+
+// Revert undoes the changes a commit made to the working tree. Only files
+// that still match the commit are restored to their previous content, so
+// later edits to other files, or to the same files, are kept. Nothing is
+// written when dryRun is set.
+func (c *Client) Revert(hash string, dryRun bool) (*RevertResult, error) {
+	if !c.enabled {
+		return nil, fmt.Errorf("git is not enabled for %s", c.rootDir)
+	}
+
+	commit, err := c.repo.CommitObject(plumbing.NewHash(hash))
+	if err != nil {
+		return nil, fmt.Errorf("commit not found: %w", err)
+	}
+	parent, err := commit.Parent(0)
+	if err != nil {
+		return nil, fmt.Errorf("cannot undo the first commit")
+	}
+
+	parentTree, err := parent.Tree()
+	if err != nil {
+		return nil, err
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+	changes, err := object.DiffTree(parentTree, tree)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff commit: %w", err)
+	}
+
+	// A move shows up as a deletion and an addition; handle each path once
+	seen := make(map[string]bool)
+	var paths []string
+	for _, change := range changes {
+		for _, name := range []string{change.From.Name, change.To.Name} {
+			if name != "" && !seen[name] {
+				seen[name] = true
+				paths = append(paths, name)
+			}
+		}
+	}
+
+	result := &RevertResult{}
+	for _, path := range paths {
+		before, hadBefore := treeContents(parentTree, path)
+		after, hadAfter := treeContents(tree, path)
+
+		full := filepath.Join(c.rootDir, filepath.FromSlash(path))
+		current, err := os.ReadFile(full)
+		hasCurrent := err == nil
+
+		switch {
+		case hasCurrent == hadBefore && bytes.Equal(current, before):
+			continue // Already as it was before
+		case hasCurrent != hadAfter || !bytes.Equal(current, after):
+			result.Conflicts = append(result.Conflicts, path)
+			continue
+		}
+
+		if !dryRun {
+			if hadBefore {
+				if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+					return result, err
+				}
+				if err := os.WriteFile(full, before, 0644); err != nil {
+					return result, err
+				}
+			} else {
+				if err := os.Remove(full); err != nil {
+					return result, err
+				}
+				c.removeEmptyDirs(filepath.Dir(full))
+			}
+		}
+		result.Reverted = append(result.Reverted, path)
+	}
+
+	return result, nil
+}
+
+// treeContents returns the content of path in tree and whether it exists
+func treeContents(tree *object.Tree, path string) ([]byte, bool) {
+	file, err := tree.File(path)
+	if err != nil {
+		return nil, false
+	}
+	contents, err := file.Contents()
+	if err != nil {
+		return nil, false
+	}
+	return []byte(contents), true
+}
+
+// removeEmptyDirs removes dir and its parents while they are empty, stopping
+// at the repository root. Directories that only hold empty directories (such
+// as a new area's projects folder) count as empty.
+func (c *Client) removeEmptyDirs(dir string) {
+	root := filepath.Clean(c.rootDir)
+	for dir != root && strings.HasPrefix(dir, root) {
+		if !isEmptyTree(dir) {
+			return
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			return
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+func isEmptyTree(dir string) bool {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+	for _, e := range entries {
+		if !e.IsDir() || !isEmptyTree(filepath.Join(dir, e.Name())) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,112 @@
+package markdown
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// assetsDir is the directory, relative to a project, that attached files
+// are copied into. Task attachments refer to files as "assets/<name>".
+const assetsDir = "assets"
+
+// projectDirOf returns the project directory a task file lives in
+func projectDirOf(taskFile string) string {
+	return filepath.Dir(filepath.Dir(taskFile))
+}
+
+// CopyAsset copies a file into the assets directory of a task's project and
+// returns the path to attach, relative to the project. A file with the same
+// name and content is reused; a different one gets a numbered name.
+func (r *TaskRepo) CopyAsset(ctx context.Context, task *domain.Task, src string) (string, error) {
+	ctx, unlock := r.store.lock(ctx)
+	defer unlock()
+
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return "", fmt.Errorf("failed to read attachment: %w", err)
+	}
+
+	taskFile, err := r.pathFor(ctx, task)
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(projectDirOf(taskFile), assetsDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create assets directory: %w", err)
+	}
+
+	base := filepath.Base(src)
+	ext := filepath.Ext(base)
+	name := base
+	for i := 1; ; i++ {
+		existing, err := os.ReadFile(filepath.Join(dir, name))
+		if os.IsNotExist(err) {
+			break
+		}
+		if err == nil && bytes.Equal(existing, data) {
+			return assetsDir + "/" + name, nil
+		}
+		name = fmt.Sprintf("%s-%d%s", strings.TrimSuffix(base, ext), i, ext)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+		return "", fmt.Errorf("failed to copy attachment: %w", err)
+	}
+	return assetsDir + "/" + name, nil
+}
+
+// AssetPath returns the absolute path of a file attached to a task
+func (r *TaskRepo) AssetPath(ctx context.Context, task *domain.Task, attachment string) (string, error) {
+	taskFile, err := r.pathFor(ctx, task)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(projectDirOf(taskFile), filepath.FromSlash(attachment)), nil
+}
+
+// copyAssets copies a task's attached files from one project directory to
+// another when the task moves. The originals stay, as other tasks in the
+// old project may refer to them.
+func copyAssets(task *domain.Task, fromDir, toDir string) error {
+	for _, a := range task.Attachments {
+		if domain.IsLink(a) || !strings.HasPrefix(a, assetsDir+"/") {
+			continue
+		}
+
+		dest := filepath.Join(toDir, filepath.FromSlash(a))
+		if _, err := os.Stat(dest); err == nil {
+			continue // never overwrite another task's file
+		}
+
+		src, err := os.Open(filepath.Join(fromDir, filepath.FromSlash(a)))
+		if err != nil {
+			continue // already gone; keep the reference as it is
+		}
+
+		if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			_ = src.Close()
+			return fmt.Errorf("failed to create assets directory: %w", err)
+		}
+		out, err := os.Create(dest)
+		if err != nil {
+			_ = src.Close()
+			return fmt.Errorf("failed to copy attachment: %w", err)
+		}
+		_, err = io.Copy(out, src)
+		_ = src.Close()
+		if cerr := out.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return fmt.Errorf("failed to copy attachment: %w", err)
+		}
+	}
+	return nil
+}
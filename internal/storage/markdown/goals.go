@@ -0,0 +1,186 @@
+package markdown
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/storage"
+)
+
+// GoalRepository implementation
+//
+// Goals sit above areas rather than inside them, since their projects can
+// come from any area, so each goal is a single file in goals/ named by its
+// slug.
+
+// GoalRepo implements storage.GoalRepository
+type GoalRepo struct {
+	store *Store
+}
+
+// Goals returns the goal repository
+func (s *Store) Goals() *GoalRepo {
+	return &GoalRepo{store: s}
+}
+
+func (r *GoalRepo) goalsDir() string {
+	return filepath.Join(r.store.rootDir, "goals")
+}
+
+func (r *GoalRepo) goalFile(slug string) string {
+	return filepath.Join(r.goalsDir(), slug+".md")
+}
+
+// fileName returns the name of a goal's file, less .md: its slug unless it
+// was written when the title made a different one
+func (r *GoalRepo) fileName(goal *domain.Goal) string {
+	return r.store.currentName(r.goalsDir(), goal.ID, goal.Slug(), ".md")
+}
+
+// Create stores a new goal
+func (r *GoalRepo) Create(ctx context.Context, goal *domain.Goal) error {
+	ctx, unlock := r.store.lock(ctx)
+	defer unlock()
+
+	if err := goal.Validate(); err != nil {
+		return err
+	}
+
+	path := r.goalFile(goal.Slug())
+	if _, err := os.Stat(path); err == nil {
+		return domain.Errorf(domain.ErrAlreadyExists, "goal '%s' already exists", goal.Slug())
+	}
+
+	if err := r.store.writer.WriteGoalToFile(path, goal); err != nil {
+		return err
+	}
+	r.store.commit(ctx, fmt.Sprintf("create goal: %s", goal.Title))
+	return nil
+}
+
+// Get retrieves a goal by ID
+func (r *GoalRepo) Get(ctx context.Context, id string) (*domain.Goal, error) {
+	goals, err := r.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, goal := range goals {
+		if goal.ID == id {
+			return goal, nil
+		}
+	}
+	return nil, domain.Errorf(domain.ErrNotFound, "goal not found: %s", id)
+}
+
+// GetBySlug retrieves a goal by its slug
+func (r *GoalRepo) GetBySlug(ctx context.Context, slug string) (*domain.Goal, error) {
+	path := r.goalFile(slug)
+	if _, err := os.Stat(path); err == nil {
+		return r.store.parser.ParseGoalFromFile(path)
+	}
+
+	// A goal written when its title made a different slug
+	goals, err := r.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, goal := range goals {
+		if goal.Slug() == slug {
+			return goal, nil
+		}
+	}
+	return nil, domain.Errorf(domain.ErrNotFound, "goal not found: %s", slug)
+}
+
+// List returns all goals, by quarter and then title
+func (r *GoalRepo) List(ctx context.Context) ([]*domain.Goal, error) {
+	entries, err := os.ReadDir(r.goalsDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*domain.Goal{}, nil
+		}
+		return nil, fmt.Errorf("failed to read goals directory: %w", err)
+	}
+
+	var goals []*domain.Goal
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".md") {
+			continue
+		}
+		goal, err := r.store.parser.ParseGoalFromFile(filepath.Join(r.goalsDir(), entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse goal %s: %w", entry.Name(), err)
+		}
+		goals = append(goals, goal)
+	}
+
+	sort.SliceStable(goals, func(i, j int) bool {
+		if goals[i].Quarter != goals[j].Quarter {
+			return goals[i].Quarter < goals[j].Quarter
+		}
+		return goals[i].Title < goals[j].Title
+	})
+	return goals, nil
+}
+
+// Update saves changes to an existing goal, renaming its file if the title
+// changed
+func (r *GoalRepo) Update(ctx context.Context, goal *domain.Goal) error {
+	ctx, unlock := r.store.lock(ctx)
+	defer unlock()
+
+	if err := goal.Validate(); err != nil {
+		return err
+	}
+
+	existing, err := r.Get(ctx, goal.ID)
+	if err != nil {
+		return err
+	}
+
+	goal.UpdateTimestamp()
+
+	oldSlug, newSlug := r.fileName(existing), goal.Slug()
+	if oldSlug != newSlug {
+		if _, err := os.Stat(r.goalFile(newSlug)); err == nil {
+			return domain.Errorf(domain.ErrAlreadyExists, "goal '%s' already exists", newSlug)
+		}
+	}
+
+	// Only remove the old file once the new one is written
+	if err := r.store.writer.WriteGoalToFile(r.goalFile(newSlug), goal); err != nil {
+		return err
+	}
+	if oldSlug != newSlug {
+		if err := os.Remove(r.goalFile(oldSlug)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to rename goal file: %w", err)
+		}
+	}
+	r.store.commit(ctx, fmt.Sprintf("update goal: %s", goal.Title))
+	return nil
+}
+
+// Delete removes a goal by ID
+func (r *GoalRepo) Delete(ctx context.Context, id string) error {
+	ctx, unlock := r.store.lock(ctx)
+	defer unlock()
+
+	goal, err := r.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(r.goalFile(r.fileName(goal))); err != nil {
+		return err
+	}
+	r.store.commit(ctx, fmt.Sprintf("delete goal: %s", goal.Title))
+	return nil
+}
+
+var _ storage.GoalRepository = (*GoalRepo)(nil)
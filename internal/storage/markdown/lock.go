@@ -0,0 +1,134 @@
+package markdown
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/filelock"
+)
+
+// Other processes, such as an import running next to 'reorg serve', write
+// to the same files. Writes take an advisory lock on a lock file next to
+// the file and replace it in one rename, so readers never see half a file
+// and two writers don't interleave. The operating system drops the lock of
+// a process that dies, so no lock is ever left behind. Lock and temporary
+// files end in ~, which the data directory's .gitignore leaves out of
+// commits.
+
+// lockTimeout is how long a write waits for another process's lock
+const lockTimeout = 5 * time.Second
+
+// ErrLocked is returned when a file stays locked by another process
+var ErrLocked = errors.New("file is locked by another process")
+
+// lockPath is the lock file for path
+func lockPath(path string) string {
+	return filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".lock~")
+}
+
+// lockFile takes the lock on path, retrying with backoff while another
+// process holds it, and returns the function that releases it
+func lockFile(path string) (func(), error) {
+	lock := lockPath(path)
+	deadline := time.Now().Add(lockTimeout)
+	wait := 10 * time.Millisecond
+	for {
+		f, err := os.OpenFile(lock, os.O_RDWR|os.O_CREATE, 0644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to lock %s: %w", path, err)
+		}
+		err = filelock.TryLock(f)
+		if err == nil {
+			// The lock file is removed on release, so the one locked may
+			// be gone already, with a new one taken by someone else
+			if isFile(f, lock) {
+				return func() {
+					_ = os.Remove(lock)
+					_ = f.Close()
+				}, nil
+			}
+			_ = f.Close()
+			continue
+		}
+		_ = f.Close()
+		if !errors.Is(err, filelock.ErrLocked) {
+			return nil, fmt.Errorf("failed to lock %s: %w", path, err)
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("%s: %w", path, ErrLocked)
+		}
+		time.Sleep(wait)
+		wait = min(wait*2, 200*time.Millisecond)
+	}
+}
+
+// isFile reports whether f is the file at path
+func isFile(f *os.File, path string) bool {
+	opened, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	current, err := os.Stat(path)
+	return err == nil && os.SameFile(opened, current)
+}
+
+// writeFile replaces the file at path with what write produces, holding
+// the file's lock. The content goes to a temporary file first, which is
+// renamed over path once complete.
+func writeFile(path string, write func(io.Writer) error) error {
+	return replaceFile(path, "", write)
+}
+
+// replaceFile is writeFile for a file that was read at revision read. It
+// fails with errChanged if the file is at another revision once locked, so
+// a write by another process since it was read isn't overwritten. An empty
+// revision, or a file that doesn't exist, is written without the check.
+func replaceFile(path, read string, write func(io.Writer) error) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	unlock, err := lockFile(path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if read != "" {
+		if data, err := os.ReadFile(path); err == nil && revision(data) != read {
+			return errChanged
+		}
+	}
+
+	f, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*~")
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	tmp := f.Name()
+	defer func() { _ = os.Remove(tmp) }()
+
+	if err := write(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := os.Chmod(tmp, 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to replace file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package markdown
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/adrg/frontmatter"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// SchemaVersion is the frontmatter layout this build writes, recorded in
+// every file as schema_version. Files without one are version 0.
+const SchemaVersion = 2
+
+// migration upgrades the frontmatter of a file from the version before it
+type migration struct {
+	version     int
+	description string
+	// apply changes the frontmatter of an area, project, task, note or
+	// goal, as told by its "type"
+	apply func(fm map[string]any)
+}
+
+// migrations are applied in order to files older than their version
+var migrations = []migration{
+	{
+		version:     1,
+		description: "record the source of items imported from Things and OmniFocus as an external reference",
+		apply: func(fm map[string]any) {
+			links := map[string]string{"things": "things:///show?id=%s", "omnifocus": "omnifocus:///task/%s"}
+			if fm["type"] != "project" && fm["type"] != "task" || fm["external_ref"] != nil {
+				return
+			}
+			source := metadataValue(fm, domain.MetaSource)
+			ref := metadataValue(fm, domain.MetaSourceRef)
+			if link, ok := links[source]; ok && ref != "" {
+				fm["external_ref"] = map[string]any{"source": source, "id": ref, "url": fmt.Sprintf(link, ref)}
+			}
+		},
+	},
+	{
+		version:     2,
+		description: "mark task due dates without a time of day as all-day",
+		apply: func(fm map[string]any) {
+			if fm["type"] != "task" || fm["all_day"] != nil {
+				return
+			}
+			var due time.Time
+			switch v := fm["due_date"].(type) {
+			case time.Time:
+				due = v
+			case string:
+				var err error
+				if due, err = time.Parse(time.RFC3339Nano, v); err != nil {
+					if due, err = time.Parse(time.DateOnly, v); err != nil {
+						return
+					}
+				}
+			default:
+				return
+			}
+			if domain.IsMidnight(due) {
+				fm["all_day"] = true
+			}
+		},
+	},
+}
+
+// metadataValue returns a metadata entry of parsed frontmatter, whose
+// nested maps may be keyed by string or by any
+func metadataValue(fm map[string]any, key string) string {
+	var v any
+	switch meta := fm["metadata"].(type) {
+	case map[string]any:
+		v = meta[key]
+	case map[any]any:
+		v = meta[key]
+	}
+	s, _ := v.(string)
+	return s
+}
+
+// Migration is a file whose frontmatter is older than SchemaVersion, with
+// its content before and after migrating
+type Migration struct {
+	Path    string // relative to the data directory
+	From    int
+	Applied []string // descriptions of the migrations that changed it
+	Before  []byte
+	After   []byte
+}
+
+// PlanMigrations finds the files older than SchemaVersion and works out
+// what they become, without writing anything. Files from a newer version
+// are an error, as this build would lose what it doesn't know.
+func (s *Store) PlanMigrations(ctx context.Context) ([]Migration, error) {
+	var planned []Migration
+	err := filepath.WalkDir(s.rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if name := d.Name(); path != s.rootDir && (strings.HasPrefix(name, ".") || name == "inbox") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".md") {
+			return nil
+		}
+
+		m, err := s.planMigration(path)
+		if err != nil {
+			rel, _ := filepath.Rel(s.rootDir, path)
+			return fmt.Errorf("%s: %w", rel, err)
+		}
+		if m != nil {
+			planned = append(planned, *m)
+		}
+		return nil
+	})
+	return planned, err
+}
+
+// planMigration migrates one file in memory. Files that aren't areas,
+// projects, tasks, notes or goals are left alone.
+func (s *Store) planMigration(path string) (*Migration, error) {
+	before, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	fm := make(map[string]any)
+	body, err := frontmatter.Parse(bytes.NewReader(before), &fm)
+	if err != nil {
+		return nil, nil
+	}
+	kind, _ := fm["type"].(string)
+	switch kind {
+	case "area", "project", "task", "note", "goal":
+	default:
+		return nil, nil
+	}
+
+	from, _ := fm["schema_version"].(int)
+	if from > SchemaVersion {
+		return nil, fmt.Errorf("written with schema version %d, newer than this reorg's %d; upgrade reorg", from, SchemaVersion)
+	}
+	if from == SchemaVersion {
+		return nil, nil
+	}
+
+	m := &Migration{From: from, Before: before}
+	m.Path, _ = filepath.Rel(s.rootDir, path)
+	for _, mg := range migrations {
+		if mg.version <= from {
+			continue
+		}
+		was, _ := yaml.Marshal(fm)
+		mg.apply(fm)
+		if now, _ := yaml.Marshal(fm); !bytes.Equal(was, now) {
+			m.Applied = append(m.Applied, mg.description)
+		}
+	}
+
+	// Writing the file through its domain type gives it the current layout
+	data, err := yaml.Marshal(fm)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	content := strings.TrimSpace(string(body))
+	switch kind {
+	case "area":
+		var v domain.Area
+		if err = yaml.Unmarshal(data, &v); err == nil {
+			v.Content = content
+			err = s.writer.WriteArea(&buf, &v)
+		}
+	case "project":
+		var v domain.Project
+		if err = yaml.Unmarshal(data, &v); err == nil {
+			v.Content = content
+			err = s.writer.WriteProject(&buf, &v)
+		}
+	case "task":
+		var v domain.Task
+		if err = yaml.Unmarshal(data, &v); err == nil {
+			v.Content = content
+			err = s.writer.WriteTask(&buf, &v)
+		}
+	case "note":
+		var v domain.Note
+		if err = yaml.Unmarshal(data, &v); err == nil {
+			v.Content = content
+			err = s.writer.WriteNote(&buf, &v)
+		}
+	case "goal":
+		var v domain.Goal
+		if err = yaml.Unmarshal(data, &v); err == nil {
+			v.Content = content
+			err = s.writer.WriteGoal(&buf, &v)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	m.After = buf.Bytes()
+	return m, nil
+}
+
+// Migrate writes migrated files and commits them together
+func (s *Store) Migrate(ctx context.Context, planned []Migration) error {
+	return s.Batch(ctx, fmt.Sprintf("migrate: %d file(s) to schema version %d", len(planned), SchemaVersion), func(ctx context.Context) error {
+		for _, m := range planned {
+			after := m.After
+			err := writeFile(filepath.Join(s.rootDir, m.Path), func(out io.Writer) error {
+				_, err := out.Write(after)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("failed to migrate %s: %w", m.Path, err)
+			}
+		}
+		return nil
+	})
+}
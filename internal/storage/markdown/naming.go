@@ -0,0 +1,303 @@
+package markdown
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/adrg/frontmatter"
+
+	"github.com/ihavespoons/reorg/internal/slug"
+)
+
+// FileNaming is how the directories of projects and the files of tasks are
+// named. Areas are always named by slug.
+type FileNaming string
+
+const (
+	// NameBySlug names files after the title, so renaming an item moves
+	// its file
+	NameBySlug FileNaming = "slug"
+
+	// NameByID names files after the ID and records the slug in the
+	// frontmatter, so files stay put when items are renamed
+	NameByID FileNaming = "id"
+)
+
+// SetFileNaming sets how files are named. Both namings are always read:
+// files move to the new naming as their items are updated, or all at once
+// with RenameFiles.
+func (s *Store) SetFileNaming(naming FileNaming) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.naming = naming
+	s.writer.Slugs = naming == NameByID
+}
+
+// FileNaming returns how files are named
+func (s *Store) FileNaming() FileNaming {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.naming
+}
+
+// currentName returns the name, less suffix, of the entry in dir holding
+// an item that is already stored. An entry named by ID can only be the
+// item's own, so it is looked for first. An item found under neither name
+// was written when its title made a different slug, and is looked for by
+// the ID in its frontmatter.
+func (s *Store) currentName(dir, id, slug, suffix string) string {
+	if _, err := os.Stat(filepath.Join(dir, id+suffix)); err == nil {
+		return id
+	}
+	if _, err := os.Stat(filepath.Join(dir, slug+suffix)); err == nil {
+		return slug
+	}
+	if name, ok := findByID(dir, id, suffix); ok {
+		return name
+	}
+	return slug
+}
+
+// newName returns the name, less suffix, an item is to be written under in
+// dir. Naming by slug leaves files already named by ID alone, as two items
+// with the same title can't both take the slug.
+func (s *Store) newName(dir, id, slug, suffix string) string {
+	if s.naming == NameByID {
+		return id
+	}
+	if _, err := os.Stat(filepath.Join(dir, id+suffix)); err == nil {
+		return id
+	}
+	return slug
+}
+
+// findByID looks in dir for the file, or with no suffix the directory, of
+// the item with an ID
+func findByID(dir, id, suffix string) (string, bool) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", false
+	}
+	for _, entry := range entries {
+		var name, path string
+		switch {
+		case suffix == "" && entry.IsDir():
+			name = entry.Name()
+			path = filepath.Join(dir, name, name+".md")
+		case suffix != "" && !entry.IsDir() && strings.HasSuffix(entry.Name(), suffix):
+			name = strings.TrimSuffix(entry.Name(), suffix)
+			path = filepath.Join(dir, entry.Name())
+		default:
+			continue
+		}
+		if item, err := readItem(path); err == nil && item.ID == id {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// item is what naming needs of the frontmatter of any file
+type item struct {
+	ID    string `yaml:"id"`
+	Title string `yaml:"title"`
+}
+
+// readItem reads the ID and title of a file
+func readItem(path string) (item, error) {
+	var it item
+	f, err := os.Open(path)
+	if err != nil {
+		return it, err
+	}
+	defer func() { _ = f.Close() }()
+	_, err = frontmatter.Parse(f, &it)
+	return it, err
+}
+
+// fileName returns the name an item has under the store's naming
+func (s *Store) fileName(id, slug string) string {
+	if s.naming == NameByID {
+		return id
+	}
+	return slug
+}
+
+// Rename is a file or directory that isn't named the way the store names
+// files, such as one written before the title's slug changed
+type Rename struct {
+	Kind  string // area, project, task or goal
+	Title string
+	From  string // relative to the data directory
+	To    string
+}
+
+// PlanRenames finds the areas, projects, tasks and goals whose files aren't
+// named the way the store names them, without renaming anything. An item
+// whose new name is taken keeps its old one.
+func (s *Store) PlanRenames(ctx context.Context) ([]Rename, error) {
+	var planned []Rename
+	areas := filepath.Join(s.rootDir, "areas")
+	if err := s.planDirs("area", areas, areas, &planned); err != nil {
+		return nil, err
+	}
+	goals := filepath.Join(s.rootDir, "goals")
+	if err := s.planFiles("goal", goals, goals, &planned); err != nil {
+		return nil, err
+	}
+	return planned, nil
+}
+
+// planDirs plans the renames of the areas or projects in the directory now
+// at from, which is to be at to, and of what is in them. Each comes before
+// what is inside it.
+func (s *Store) planDirs(kind, from, to string, planned *[]Rename) error {
+	entries, err := os.ReadDir(from)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read %ss directory: %w", kind, err)
+	}
+	taken := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		taken[entry.Name()] = true
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() {
+			continue
+		}
+		it, err := readItem(filepath.Join(from, name, name+".md"))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to parse %s %s: %w", kind, name, err)
+		}
+
+		newName := slug.Make(it.Title)
+		if kind == "project" {
+			newName = s.fileName(it.ID, newName)
+		}
+		if newName != "" && newName != name && !taken[newName] {
+			taken[newName] = true
+			s.planRename(planned, kind, it.Title, filepath.Join(from, name), filepath.Join(to, newName))
+		} else {
+			newName = name
+		}
+
+		fromDir, toDir := filepath.Join(from, name), filepath.Join(to, newName)
+		if kind == "project" {
+			if err := s.planFiles("task", filepath.Join(fromDir, "tasks"), filepath.Join(toDir, "tasks"), planned); err != nil {
+				return err
+			}
+		}
+		if err := s.planDirs("project", filepath.Join(fromDir, "projects"), filepath.Join(toDir, "projects"), planned); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// planFiles plans the renames of the task or goal files in the directory
+// now at from, which is to be at to
+func (s *Store) planFiles(kind, from, to string, planned *[]Rename) error {
+	entries, err := os.ReadDir(from)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read %ss directory: %w", kind, err)
+	}
+	taken := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		taken[entry.Name()] = true
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != ".md" {
+			continue
+		}
+		it, err := readItem(filepath.Join(from, name))
+		if err != nil {
+			return fmt.Errorf("failed to parse %s %s: %w", kind, name, err)
+		}
+
+		newName := slug.Make(it.Title)
+		if kind == "task" {
+			newName = s.fileName(it.ID, newName)
+		}
+		if newName != "" && newName+".md" != name && !taken[newName+".md"] {
+			taken[newName+".md"] = true
+			s.planRename(planned, kind, it.Title, filepath.Join(from, name), filepath.Join(to, newName+".md"))
+		}
+	}
+	return nil
+}
+
+func (s *Store) planRename(planned *[]Rename, kind, title, from, to string) {
+	from, _ = filepath.Rel(s.rootDir, from)
+	to, _ = filepath.Rel(s.rootDir, to)
+	*planned = append(*planned, Rename{Kind: kind, Title: title, From: from, To: to})
+}
+
+// RenameFiles renames planned files and commits them together. Renames
+// are made innermost first, so each one is in a directory that is still
+// where the plan found it. Projects and tasks are then written again, which
+// records the slug of those named by ID and drops it from the others.
+func (s *Store) RenameFiles(ctx context.Context, planned []Rename) error {
+	return s.Batch(ctx, fmt.Sprintf("rename: %d file(s) by %s", len(planned), s.naming), func(ctx context.Context) error {
+		for _, r := range slices.Backward(planned) {
+			from := filepath.Join(s.rootDir, r.From)
+			to := filepath.Join(filepath.Dir(from), filepath.Base(r.To))
+			if err := os.Rename(from, to); err != nil {
+				return fmt.Errorf("failed to rename %s: %w", r.From, err)
+			}
+
+			switch r.Kind {
+			case "area", "project":
+				oldFile := filepath.Join(to, filepath.Base(from)+".md")
+				if err := os.Rename(oldFile, filepath.Join(to, filepath.Base(to)+".md")); err != nil {
+					return fmt.Errorf("failed to rename %s: %w", r.From, err)
+				}
+			case "task":
+				if _, err := os.Stat(taskNotesDir(from)); err == nil {
+					if err := os.Rename(taskNotesDir(from), taskNotesDir(to)); err != nil {
+						return fmt.Errorf("failed to move task notes: %w", err)
+					}
+				}
+			}
+		}
+
+		for _, r := range planned {
+			path := filepath.Join(s.rootDir, r.To)
+			switch r.Kind {
+			case "project":
+				path = filepath.Join(path, filepath.Base(path)+".md")
+				project, err := s.parser.ParseProjectFromFile(path)
+				if err != nil {
+					return err
+				}
+				if err := s.writer.WriteProjectToFile(path, project); err != nil {
+					return err
+				}
+			case "task":
+				task, err := s.parser.ParseTaskFromFile(path)
+				if err != nil {
+					return err
+				}
+				if err := s.writer.WriteTaskToFile(path, task); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,204 @@
+package markdown
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+	"github.com/ihavespoons/reorg/internal/storage"
+)
+
+// NoteRepository implementation
+//
+// Notes live next to the item they belong to: a project's notes in a
+// notes/ directory inside the project directory, and a task's notes in a
+// <task-slug>.notes/ directory beside the task file. Each note is its own
+// file, so notes added from different machines merge cleanly in git.
+
+// NoteRepo implements storage.NoteRepository
+type NoteRepo struct {
+	store *Store
+}
+
+// Notes returns the note repository
+func (s *Store) Notes() *NoteRepo {
+	return &NoteRepo{store: s}
+}
+
+// notesDir resolves the directory holding the notes of a project or task
+func (r *NoteRepo) notesDir(ctx context.Context, parentID string) (string, error) {
+	if task, err := r.store.Tasks().Get(ctx, parentID); err == nil {
+		path, err := r.store.Tasks().pathFor(ctx, task)
+		if err != nil {
+			return "", err
+		}
+		return taskNotesDir(path), nil
+	}
+
+	path, err := r.store.Projects().Path(ctx, parentID)
+	if err != nil {
+		return "", fmt.Errorf("no project or task with ID %s", parentID)
+	}
+	return filepath.Join(filepath.Dir(path), "notes"), nil
+}
+
+// taskNotesDir returns the notes directory that belongs to a task file
+func taskNotesDir(taskFile string) string {
+	return strings.TrimSuffix(taskFile, ".md") + ".notes"
+}
+
+// Create stores a new note
+func (r *NoteRepo) Create(ctx context.Context, note *domain.Note) error {
+	ctx, unlock := r.store.lock(ctx)
+	defer unlock()
+
+	if err := note.Validate(); err != nil {
+		return err
+	}
+
+	dir, err := r.notesDir(ctx, note.ParentID)
+	if err != nil {
+		return err
+	}
+
+	if err := r.store.writer.WriteNoteToFile(filepath.Join(dir, note.ID+".md"), note); err != nil {
+		return err
+	}
+	r.store.commit(ctx, fmt.Sprintf("add note: %s", note.Summary()))
+	return nil
+}
+
+// Get retrieves a note by ID
+func (r *NoteRepo) Get(ctx context.Context, id string) (*domain.Note, error) {
+	path, err := r.find(id)
+	if err != nil {
+		return nil, err
+	}
+	return r.store.parser.ParseNoteFromFile(path)
+}
+
+// List returns the notes of a project or task, oldest first
+func (r *NoteRepo) List(ctx context.Context, parentID string) ([]*domain.Note, error) {
+	dir, err := r.notesDir(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*domain.Note{}, nil
+		}
+		return nil, fmt.Errorf("failed to read notes directory: %w", err)
+	}
+
+	var notes []*domain.Note
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".md") {
+			continue
+		}
+		note, err := r.store.parser.ParseNoteFromFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse note %s: %w", entry.Name(), err)
+		}
+		if note.ParentID == parentID {
+			notes = append(notes, note)
+		}
+	}
+
+	sortNotes(notes)
+	return notes, nil
+}
+
+// ListAll returns all notes, oldest first
+func (r *NoteRepo) ListAll(ctx context.Context) ([]*domain.Note, error) {
+	var notes []*domain.Note
+	err := r.walk(func(path string) error {
+		note, err := r.store.parser.ParseNoteFromFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to parse note %s: %w", path, err)
+		}
+		notes = append(notes, note)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sortNotes(notes)
+	return notes, nil
+}
+
+// Delete removes a note by ID
+func (r *NoteRepo) Delete(ctx context.Context, id string) error {
+	ctx, unlock := r.store.lock(ctx)
+	defer unlock()
+
+	path, err := r.find(id)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil {
+		return err
+	}
+
+	// Leave no empty notes directory behind
+	_ = os.Remove(filepath.Dir(path))
+
+	r.store.commit(ctx, fmt.Sprintf("delete note: %s", id))
+	return nil
+}
+
+// find returns the file of a note. Note files are named by ID.
+func (r *NoteRepo) find(id string) (string, error) {
+	var found string
+	err := r.walk(func(path string) error {
+		if filepath.Base(path) == id+".md" {
+			found = path
+			return fs.SkipAll
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if found == "" {
+		return "", domain.Errorf(domain.ErrNotFound, "note not found: %s", id)
+	}
+	return found, nil
+}
+
+// walk calls fn for every file in a notes directory
+func (r *NoteRepo) walk(fn func(path string) error) error {
+	root := filepath.Join(r.store.rootDir, "areas")
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasPrefix(d.Name(), "note-") || !strings.HasSuffix(d.Name(), ".md") {
+			return nil
+		}
+		parent := filepath.Base(filepath.Dir(path))
+		if parent != "notes" && !strings.HasSuffix(parent, ".notes") {
+			return nil
+		}
+		return fn(path)
+	})
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func sortNotes(notes []*domain.Note) {
+	sort.SliceStable(notes, func(i, j int) bool {
+		return notes[i].Created.Before(notes[j].Created)
+	})
+}
+
+var _ storage.NoteRepository = (*NoteRepo)(nil)
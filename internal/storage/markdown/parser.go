@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/adrg/frontmatter"
 	"gopkg.in/yaml.v3"
@@ -14,11 +16,14 @@ import (
 )
 
 // Parser handles reading and writing markdown files with YAML frontmatter
-type Parser struct{}
+type Parser struct {
+	// loc is the timezone dates are read into
+	loc *time.Location
+}
 
-// NewParser creates a new Parser instance
-func NewParser() *Parser {
-	return &Parser{}
+// NewParser creates a new Parser that reads dates into loc
+func NewParser(loc *time.Location) *Parser {
+	return &Parser{loc: loc}
 }
 
 // ParseArea reads a markdown file and parses it into an Area
@@ -50,43 +55,124 @@ func (p *Parser) ParseProject(r io.Reader) (*domain.Project, error) {
 		return nil, fmt.Errorf("failed to parse project frontmatter: %w", err)
 	}
 	project.Content = strings.TrimSpace(string(content))
+	project.LocalizeDates(p.loc)
 	return &project, nil
 }
 
-// ParseProjectFromFile reads a file and parses it into a Project
+// ParseProjectFromFile reads a file and parses it into a Project, with the
+// file's revision
 func (p *Parser) ParseProjectFromFile(path string) (*domain.Project, error) {
-	f, err := os.Open(path)
+	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open project file: %w", err)
 	}
-	defer func() { _ = f.Close() }()
-	return p.ParseProject(f)
+	project, err := p.ParseProject(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	project.Revision = revision(data)
+	return project, nil
 }
 
-// ParseTask reads a markdown file and parses it into a Task
+// ParseTask reads a markdown file and parses it into a Task, with its
+// dates in the parser's timezone
 func (p *Parser) ParseTask(r io.Reader) (*domain.Task, error) {
-	var task domain.Task
-	content, err := frontmatter.Parse(r, &task)
+	var fm struct {
+		domain.Task   `yaml:",inline"`
+		SchemaVersion int `yaml:"schema_version"`
+	}
+	content, err := frontmatter.Parse(r, &fm)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse task frontmatter: %w", err)
 	}
+	task := fm.Task
 	task.Content = strings.TrimSpace(string(content))
+
+	// Before all_day was recorded, every due date was a day, written as
+	// its midnight
+	if task.DueDate != nil && !task.AllDay && domain.IsMidnight(*task.DueDate) && fm.SchemaVersion < 2 {
+		task.AllDay = true
+	}
+	task.LocalizeDates(p.loc)
 	return &task, nil
 }
 
-// ParseTaskFromFile reads a file and parses it into a Task
+// ParseTaskFromFile reads a file and parses it into a Task, with the
+// file's revision
 func (p *Parser) ParseTaskFromFile(path string) (*domain.Task, error) {
-	f, err := os.Open(path)
+	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open task file: %w", err)
 	}
+	task, err := p.ParseTask(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	task.Revision = revision(data)
+	return task, nil
+}
+
+// ParseNote reads a markdown file and parses it into a Note
+func (p *Parser) ParseNote(r io.Reader) (*domain.Note, error) {
+	var note domain.Note
+	content, err := frontmatter.Parse(r, &note)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse note frontmatter: %w", err)
+	}
+	note.Content = strings.TrimSpace(string(content))
+	return &note, nil
+}
+
+// ParseNoteFromFile reads a file and parses it into a Note
+func (p *Parser) ParseNoteFromFile(path string) (*domain.Note, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open note file: %w", err)
+	}
 	defer func() { _ = f.Close() }()
-	return p.ParseTask(f)
+	return p.ParseNote(f)
 }
 
-// marshalFrontmatter creates the YAML frontmatter block
-func marshalFrontmatter(v interface{}) ([]byte, error) {
-	yamlData, err := yaml.Marshal(v)
+// ParseGoal reads a markdown file and parses it into a Goal
+func (p *Parser) ParseGoal(r io.Reader) (*domain.Goal, error) {
+	var goal domain.Goal
+	content, err := frontmatter.Parse(r, &goal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse goal frontmatter: %w", err)
+	}
+	goal.Content = strings.TrimSpace(string(content))
+	goal.LocalizeDates(p.loc)
+	return &goal, nil
+}
+
+// ParseGoalFromFile reads a file and parses it into a Goal
+func (p *Parser) ParseGoalFromFile(path string) (*domain.Goal, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open goal file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+	return p.ParseGoal(f)
+}
+
+// marshalFrontmatter creates the YAML frontmatter block, followed by the
+// key and value pairs of fields and ending with the schema version
+func marshalFrontmatter(v interface{}, fields ...string) ([]byte, error) {
+	var node yaml.Node
+	if err := node.Encode(v); err != nil {
+		return nil, err
+	}
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(fields); i += 2 {
+			node.Content = append(node.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: fields[i]},
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: fields[i+1]})
+		}
+		node.Content = append(node.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: "schema_version"},
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.Itoa(SchemaVersion)})
+	}
+	yamlData, err := yaml.Marshal(&node)
 	if err != nil {
 		return nil, err
 	}
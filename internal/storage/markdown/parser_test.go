@@ -0,0 +1,43 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseTaskDueDates(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("no zoneinfo for Europe/Berlin")
+	}
+	tests := []struct {
+		name       string
+		fields     string
+		wantAllDay bool
+		wantDue    string
+	}{
+		{"midnight before schema 2 is all day", "due_date: 2025-02-01T00:00:00-08:00\n", true, "2025-02-01 00:00 CET"},
+		{"midnight from schema 2 is a moment", "due_date: 2025-02-01T00:00:00-08:00\nschema_version: 2\n", false, "2025-02-01 09:00 CET"},
+		{"time of day before schema 2 is a moment", "due_date: 2025-02-01T17:00:00Z\n", false, "2025-02-01 18:00 CET"},
+		{"all day keeps its day", "due_date: 2025-02-01T00:00:00-08:00\nall_day: true\nschema_version: 2\n", true, "2025-02-01 00:00 CET"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := "---\nid: task-1\ntitle: Pay rent\n" + tt.fields + "---\n\nBody\n"
+			task, err := NewParser(berlin).ParseTask(strings.NewReader(file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if task.ID != "task-1" || task.Title != "Pay rent" || task.Content != "Body" {
+				t.Errorf("ParseTask() = %q %q %q, want the file's fields", task.ID, task.Title, task.Content)
+			}
+			if task.AllDay != tt.wantAllDay {
+				t.Errorf("AllDay = %v, want %v", task.AllDay, tt.wantAllDay)
+			}
+			if got := task.DueDate.Format("2006-01-02 15:04 MST"); got != tt.wantDue {
+				t.Errorf("DueDate = %s, want %s", got, tt.wantDue)
+			}
+		})
+	}
+}
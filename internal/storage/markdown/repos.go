@@ -6,10 +6,13 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/ihavespoons/reorg/internal/domain"
 	"github.com/ihavespoons/reorg/internal/storage"
 	"github.com/ihavespoons/reorg/internal/storage/git"
+	"github.com/ihavespoons/reorg/internal/tracing"
 )
 
 // Store provides file-based storage for all domain objects
@@ -19,35 +22,113 @@ type Store struct {
 	writer     *Writer
 	git        *git.Client
 	autoCommit bool
+	naming     FileNaming
+
+	// mu serializes writes, so that requests served at the same time don't
+	// end up in each other's commits
+	mu sync.Mutex
 }
 
+// writeKey marks a context as holding the store's write lock. Its value
+// is true inside a batch, where writes leave committing to the batch.
+type writeKey struct{}
+
 // NewStore creates a new file-based store
 func NewStore(rootDir string) *Store {
 	gitClient, _ := git.NewClient(rootDir)
 	return &Store{
 		rootDir:    rootDir,
-		parser:     NewParser(),
+		parser:     NewParser(time.Local),
 		writer:     NewWriter(),
 		git:        gitClient,
 		autoCommit: true, // Enable by default
+		naming:     NameBySlug,
 	}
 }
 
 // SetAutoCommit enables or disables automatic git commits
 func (s *Store) SetAutoCommit(enabled bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.autoCommit = enabled
 }
 
+// SetLocation sets the timezone dates are read into, the system's by
+// default. All-day dates keep their day and timed ones their moment.
+func (s *Store) SetLocation(loc *time.Location) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.parser = NewParser(loc)
+}
+
+// Location returns the timezone dates are read into
+func (s *Store) Location() *time.Location {
+	return s.parser.loc
+}
+
 // Git returns the git client
 func (s *Store) Git() *git.Client {
 	return s.git
 }
 
-// commit performs an auto-commit if enabled
-func (s *Store) commit(action string) {
+// lock takes the write lock unless ctx already holds it, and returns a
+// context that marks it as held along with the function to release it
+func (s *Store) lock(ctx context.Context) (context.Context, func()) {
+	if _, held := ctx.Value(writeKey{}).(bool); held {
+		return ctx, func() {}
+	}
+	s.mu.Lock()
+	return context.WithValue(ctx, writeKey{}, false), s.mu.Unlock
+}
+
+// commit performs an auto-commit if enabled, unless ctx belongs to a batch
+func (s *Store) commit(ctx context.Context, action string) {
+	if batch, _ := ctx.Value(writeKey{}).(bool); batch {
+		return
+	}
 	if s.autoCommit && s.git != nil {
+		_, span := tracing.Start(ctx, "storage.commit")
 		_ = s.git.AutoCommit(action)
+		span.End()
+	}
+}
+
+// Batch runs fn holding the write lock and records everything it changed
+// in a single commit. Writes in fn must use the context passed to it; a
+// batch started inside another one is part of the outer commit.
+func (s *Store) Batch(ctx context.Context, action string, fn func(ctx context.Context) error) error {
+	if batch, _ := ctx.Value(writeKey{}).(bool); batch {
+		return fn(ctx)
 	}
+
+	ctx, unlock := s.lock(ctx)
+	defer unlock()
+	err := fn(context.WithValue(ctx, writeKey{}, true))
+
+	// Commit whatever was written, even if fn stopped part way through
+	s.commit(ctx, action)
+	return err
+}
+
+// CommitExternal commits changes made to the files outside reorg, such as
+// in a text editor, as an "external edit" listing the files. It does
+// nothing when auto-commit is off or nothing changed.
+func (s *Store) CommitExternal(ctx context.Context) error {
+	_, unlock := s.lock(ctx)
+	defer unlock()
+	if !s.autoCommit || s.git == nil {
+		return nil
+	}
+
+	changed, err := s.git.Changes()
+	if err != nil || len(changed) == 0 {
+		return err
+	}
+	files := changed
+	if len(files) > 3 {
+		files = append(files[:3:3], fmt.Sprintf("and %d more", len(changed)-3))
+	}
+	return s.git.AutoCommit("external edit: " + strings.Join(files, ", "))
 }
 
 // RootDir returns the root directory of the store
@@ -93,8 +174,19 @@ func (r *AreaRepo) areaFile(slug string) string {
 	return filepath.Join(r.areaDir(slug), slug+".md")
 }
 
+// dirName returns the name of an area's directory, its slug unless it was
+// written when the title made a different one
+func (r *AreaRepo) dirName(area *domain.Area) string {
+	return r.store.currentName(filepath.Join(r.store.rootDir, "areas"), area.ID, area.Slug(), "")
+}
+
 // Create stores a new area
 func (r *AreaRepo) Create(ctx context.Context, area *domain.Area) error {
+	ctx, span := tracing.Start(ctx, "storage.areas.Create")
+	defer span.End()
+	ctx, unlock := r.store.lock(ctx)
+	defer unlock()
+
 	if err := area.Validate(); err != nil {
 		return err
 	}
@@ -104,7 +196,7 @@ func (r *AreaRepo) Create(ctx context.Context, area *domain.Area) error {
 
 	// Check if area already exists
 	if _, err := os.Stat(areaDir); err == nil {
-		return fmt.Errorf("area '%s' already exists", slug)
+		return domain.Errorf(domain.ErrAlreadyExists, "area '%s' already exists", slug)
 	}
 
 	// Create area directory structure
@@ -120,12 +212,14 @@ func (r *AreaRepo) Create(ctx context.Context, area *domain.Area) error {
 		return err
 	}
 
-	r.store.commit(fmt.Sprintf("create area: %s", area.Title))
+	r.store.commit(ctx, fmt.Sprintf("create area: %s", area.Title))
 	return nil
 }
 
 // Get retrieves an area by ID
 func (r *AreaRepo) Get(ctx context.Context, id string) (*domain.Area, error) {
+	ctx, span := tracing.Start(ctx, "storage.areas.Get")
+	defer span.End()
 	areas, err := r.List(ctx)
 	if err != nil {
 		return nil, err
@@ -137,21 +231,33 @@ func (r *AreaRepo) Get(ctx context.Context, id string) (*domain.Area, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("area not found: %s", id)
+	return nil, domain.Errorf(domain.ErrNotFound, "area not found: %s", id)
 }
 
 // GetBySlug retrieves an area by its slug
 func (r *AreaRepo) GetBySlug(ctx context.Context, slug string) (*domain.Area, error) {
 	areaFile := r.areaFile(slug)
-	if _, err := os.Stat(areaFile); os.IsNotExist(err) {
-		return nil, fmt.Errorf("area not found: %s", slug)
+	if _, err := os.Stat(areaFile); err == nil {
+		return r.store.parser.ParseAreaFromFile(areaFile)
 	}
 
-	return r.store.parser.ParseAreaFromFile(areaFile)
+	// An area written when its title made a different slug
+	areas, err := r.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, area := range areas {
+		if area.Slug() == slug {
+			return area, nil
+		}
+	}
+	return nil, domain.Errorf(domain.ErrNotFound, "area not found: %s", slug)
 }
 
 // List returns all areas
 func (r *AreaRepo) List(ctx context.Context) ([]*domain.Area, error) {
+	ctx, span := tracing.Start(ctx, "storage.areas.List")
+	defer span.End()
 	areasDir := filepath.Join(r.store.rootDir, "areas")
 	entries, err := os.ReadDir(areasDir)
 	if err != nil {
@@ -187,6 +293,11 @@ func (r *AreaRepo) List(ctx context.Context) ([]*domain.Area, error) {
 
 // Update saves changes to an existing area
 func (r *AreaRepo) Update(ctx context.Context, area *domain.Area) error {
+	ctx, span := tracing.Start(ctx, "storage.areas.Update")
+	defer span.End()
+	ctx, unlock := r.store.lock(ctx)
+	defer unlock()
+
 	if err := area.Validate(); err != nil {
 		return err
 	}
@@ -200,7 +311,7 @@ func (r *AreaRepo) Update(ctx context.Context, area *domain.Area) error {
 	area.UpdateTimestamp()
 
 	// If title changed, we might need to rename the directory
-	oldSlug := existing.Slug()
+	oldSlug := r.dirName(existing)
 	newSlug := area.Slug()
 
 	if oldSlug != newSlug {
@@ -210,28 +321,34 @@ func (r *AreaRepo) Update(ctx context.Context, area *domain.Area) error {
 		if err := os.Rename(oldDir, newDir); err != nil {
 			return fmt.Errorf("failed to rename area directory: %w", err)
 		}
+		_ = os.Remove(filepath.Join(newDir, oldSlug+".md"))
 	}
 
 	// Write updated area file
 	if err := r.store.writer.WriteAreaToFile(r.areaFile(newSlug), area); err != nil {
 		return err
 	}
-	r.store.commit(fmt.Sprintf("update area: %s", area.Title))
+	r.store.commit(ctx, fmt.Sprintf("update area: %s", area.Title))
 	return nil
 }
 
 // Delete removes an area by ID
 func (r *AreaRepo) Delete(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "storage.areas.Delete")
+	defer span.End()
+	ctx, unlock := r.store.lock(ctx)
+	defer unlock()
+
 	area, err := r.Get(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	areaDir := r.areaDir(area.Slug())
+	areaDir := r.areaDir(r.dirName(area))
 	if err := os.RemoveAll(areaDir); err != nil {
 		return err
 	}
-	r.store.commit(fmt.Sprintf("delete area: %s", area.Title))
+	r.store.commit(ctx, fmt.Sprintf("delete area: %s", area.Title))
 	return nil
 }
 
@@ -242,21 +359,104 @@ type ProjectRepo struct {
 	store *Store
 }
 
+// Path returns the file an area is stored in
+func (r *AreaRepo) Path(ctx context.Context, id string) (string, error) {
+	area, err := r.Get(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return r.areaFile(r.dirName(area)), nil
+}
+
 // NewProjectRepo creates a new ProjectRepo
 func (s *Store) Projects() *ProjectRepo {
 	return &ProjectRepo{store: s}
 }
 
-func (r *ProjectRepo) projectDir(areaSlug, projectSlug string) string {
-	return filepath.Join(r.store.rootDir, "areas", areaSlug, "projects", projectSlug)
+// projectDir returns a project's directory. rel is the project's slug, or
+// "<parent>/projects/<slug>" for a sub-project, as returned by relPath.
+func (r *ProjectRepo) projectDir(areaSlug, rel string) string {
+	return filepath.Join(r.store.rootDir, "areas", areaSlug, "projects", rel)
+}
+
+func (r *ProjectRepo) projectFile(areaSlug, rel string) string {
+	return filepath.Join(r.projectDir(areaSlug, rel), filepath.Base(rel)+".md")
+}
+
+// relPath returns where a project lives under its area's projects
+// directory: sub-projects are nested in their parent's directory
+func (r *ProjectRepo) relPath(ctx context.Context, project *domain.Project) (string, error) {
+	return r.resolveRel(ctx, project, r.store.currentName)
 }
 
-func (r *ProjectRepo) projectFile(areaSlug, projectSlug string) string {
-	return filepath.Join(r.projectDir(areaSlug, projectSlug), projectSlug+".md")
+// newRelPath returns where a project is to be written, which differs from
+// relPath when its name or parent changes
+func (r *ProjectRepo) newRelPath(ctx context.Context, project *domain.Project) (string, error) {
+	return r.resolveRel(ctx, project, r.store.newName)
+}
+
+// resolveRel returns the path of a project under its area's projects
+// directory, naming the project's own directory with name
+func (r *ProjectRepo) resolveRel(ctx context.Context, project *domain.Project, name func(dir, id, slug, suffix string) string) (string, error) {
+	area, err := r.store.Areas().Get(ctx, project.AreaID)
+	if err != nil {
+		return "", err
+	}
+	areaDir := r.store.Areas().dirName(area)
+	if !project.IsSubProject() {
+		return name(r.projectDir(areaDir, ""), project.ID, project.Slug(), ""), nil
+	}
+	parent, err := r.Get(ctx, project.ParentProjectID)
+	if err != nil {
+		return "", fmt.Errorf("parent project not found: %w", err)
+	}
+	parentRel := r.store.currentName(r.projectDir(areaDir, ""), parent.ID, parent.Slug(), "")
+	dir := filepath.Join(r.projectDir(areaDir, parentRel), "projects")
+	return filepath.Join(parentRel, "projects", name(dir, project.ID, project.Slug(), "")), nil
+}
+
+// checkParent makes sure a sub-project's parent is a top-level project in
+// the same area, as projects only nest one level
+func (r *ProjectRepo) checkParent(ctx context.Context, project *domain.Project) error {
+	if !project.IsSubProject() {
+		return nil
+	}
+	parent, err := r.Get(ctx, project.ParentProjectID)
+	if err != nil {
+		return fmt.Errorf("parent project not found: %w", err)
+	}
+	if parent.IsSubProject() {
+		return domain.Errorf(domain.ErrInvalid, "'%s' is a sub-project itself; projects nest only one level", parent.Title)
+	}
+	if parent.AreaID != project.AreaID {
+		return domain.Errorf(domain.ErrInvalid, "a sub-project must be in the same area as its parent '%s'", parent.Title)
+	}
+	return nil
+}
+
+// Children returns the sub-projects of a project
+func (r *ProjectRepo) Children(ctx context.Context, id string) ([]*domain.Project, error) {
+	projects, err := r.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var children []*domain.Project
+	for _, p := range projects {
+		if p.ParentProjectID == id {
+			children = append(children, p)
+		}
+	}
+	return children, nil
 }
 
 // Create stores a new project
 func (r *ProjectRepo) Create(ctx context.Context, project *domain.Project) error {
+	ctx, span := tracing.Start(ctx, "storage.projects.Create")
+	defer span.End()
+	ctx, unlock := r.store.lock(ctx)
+	defer unlock()
+
 	if err := project.Validate(); err != nil {
 		return err
 	}
@@ -266,14 +466,20 @@ func (r *ProjectRepo) Create(ctx context.Context, project *domain.Project) error
 	if err != nil {
 		return fmt.Errorf("area not found: %w", err)
 	}
+	if err := r.checkParent(ctx, project); err != nil {
+		return err
+	}
 
-	areaSlug := area.Slug()
-	projectSlug := project.Slug()
-	projectDir := r.projectDir(areaSlug, projectSlug)
+	areaSlug := r.store.Areas().dirName(area)
+	rel, err := r.newRelPath(ctx, project)
+	if err != nil {
+		return err
+	}
+	projectDir := r.projectDir(areaSlug, rel)
 
 	// Check if project already exists
 	if _, err := os.Stat(projectDir); err == nil {
-		return fmt.Errorf("project '%s' already exists in area '%s'", projectSlug, areaSlug)
+		return domain.Errorf(domain.ErrAlreadyExists, "project '%s' already exists in area '%s'", project.Slug(), areaSlug)
 	}
 
 	// Create project directory structure
@@ -283,17 +489,19 @@ func (r *ProjectRepo) Create(ctx context.Context, project *domain.Project) error
 	}
 
 	// Write project file
-	if err := r.store.writer.WriteProjectToFile(r.projectFile(areaSlug, projectSlug), project); err != nil {
+	if err := r.store.writer.WriteProjectToFile(r.projectFile(areaSlug, rel), project); err != nil {
 		_ = os.RemoveAll(projectDir)
 		return err
 	}
 
-	r.store.commit(fmt.Sprintf("create project: %s", project.Title))
+	r.store.commit(ctx, fmt.Sprintf("create project: %s", project.Title))
 	return nil
 }
 
 // Get retrieves a project by ID
 func (r *ProjectRepo) Get(ctx context.Context, id string) (*domain.Project, error) {
+	ctx, span := tracing.Start(ctx, "storage.projects.Get")
+	defer span.End()
 	projects, err := r.ListAll(ctx)
 	if err != nil {
 		return nil, err
@@ -305,31 +513,74 @@ func (r *ProjectRepo) Get(ctx context.Context, id string) (*domain.Project, erro
 		}
 	}
 
-	return nil, fmt.Errorf("project not found: %s", id)
+	return nil, domain.Errorf(domain.ErrNotFound, "project not found: %s", id)
 }
 
-// GetBySlug retrieves a project by its slug within an area
+// GetBySlug retrieves a project by its slug within an area, looking in
+// sub-projects and projects named by ID when no top-level directory has it
 func (r *ProjectRepo) GetBySlug(ctx context.Context, areaSlug, projectSlug string) (*domain.Project, error) {
-	projectFile := r.projectFile(areaSlug, projectSlug)
-	if _, err := os.Stat(projectFile); os.IsNotExist(err) {
-		return nil, fmt.Errorf("project not found: %s/%s", areaSlug, projectSlug)
+	area, err := r.store.Areas().GetBySlug(ctx, areaSlug)
+	if err != nil {
+		return nil, err
 	}
+	areaDir := r.store.Areas().dirName(area)
 
-	return r.store.parser.ParseProjectFromFile(projectFile)
+	projectFile := r.projectFile(areaDir, projectSlug)
+	if _, err := os.Stat(projectFile); err == nil {
+		return r.store.parser.ParseProjectFromFile(projectFile)
+	}
+
+	projects, err := r.listByAreaSlug(ctx, areaDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range projects {
+		if p.Slug() == projectSlug {
+			return p, nil
+		}
+	}
+	return nil, domain.Errorf(domain.ErrNotFound, "project not found: %s/%s", areaSlug, projectSlug)
 }
 
 // List returns all projects for an area
 func (r *ProjectRepo) List(ctx context.Context, areaID string) ([]*domain.Project, error) {
+	ctx, span := tracing.Start(ctx, "storage.projects.List")
+	defer span.End()
 	area, err := r.store.Areas().Get(ctx, areaID)
 	if err != nil {
 		return nil, err
 	}
 
-	return r.listByAreaSlug(ctx, area.Slug())
+	return r.listByAreaSlug(ctx, r.store.Areas().dirName(area))
 }
 
+// listByAreaSlug returns the projects of an area, each top-level project
+// followed by its sub-projects
 func (r *ProjectRepo) listByAreaSlug(ctx context.Context, areaSlug string) ([]*domain.Project, error) {
-	projectsDir := filepath.Join(r.store.rootDir, "areas", areaSlug, "projects")
+	top, err := r.listDir(areaSlug, "")
+	if err != nil {
+		return nil, err
+	}
+
+	projects := []*domain.Project{}
+	for _, p := range top {
+		projects = append(projects, p)
+		sub, err := r.listDir(areaSlug, r.store.currentName(r.projectDir(areaSlug, ""), p.ID, p.Slug(), ""))
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, sub...)
+	}
+	return projects, nil
+}
+
+// listDir parses the projects in an area's projects directory, or in the
+// projects directory of the project with the parent slug
+func (r *ProjectRepo) listDir(areaSlug, parent string) ([]*domain.Project, error) {
+	projectsDir := r.projectDir(areaSlug, "")
+	if parent != "" {
+		projectsDir = filepath.Join(r.projectDir(areaSlug, parent), "projects")
+	}
 	entries, err := os.ReadDir(projectsDir)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -345,7 +596,11 @@ func (r *ProjectRepo) listByAreaSlug(ctx context.Context, areaSlug string) ([]*d
 		}
 
 		projectSlug := entry.Name()
-		projectFile := r.projectFile(areaSlug, projectSlug)
+		rel := projectSlug
+		if parent != "" {
+			rel = filepath.Join(parent, "projects", projectSlug)
+		}
+		projectFile := r.projectFile(areaSlug, rel)
 
 		if _, err := os.Stat(projectFile); os.IsNotExist(err) {
 			continue
@@ -364,6 +619,8 @@ func (r *ProjectRepo) listByAreaSlug(ctx context.Context, areaSlug string) ([]*d
 
 // ListAll returns all projects across all areas
 func (r *ProjectRepo) ListAll(ctx context.Context) ([]*domain.Project, error) {
+	ctx, span := tracing.Start(ctx, "storage.projects.ListAll")
+	defer span.End()
 	areas, err := r.store.Areas().List(ctx)
 	if err != nil {
 		return nil, err
@@ -371,7 +628,7 @@ func (r *ProjectRepo) ListAll(ctx context.Context) ([]*domain.Project, error) {
 
 	var allProjects []*domain.Project
 	for _, area := range areas {
-		projects, err := r.listByAreaSlug(ctx, area.Slug())
+		projects, err := r.listByAreaSlug(ctx, r.store.Areas().dirName(area))
 		if err != nil {
 			return nil, err
 		}
@@ -381,8 +638,24 @@ func (r *ProjectRepo) ListAll(ctx context.Context) ([]*domain.Project, error) {
 	return allProjects, nil
 }
 
+// Modify applies change to the project with the ID as stored and saves
+// it, applying it again to a newer version if another process writes the
+// project in between
+func (r *ProjectRepo) Modify(ctx context.Context, id string, change func(*domain.Project) error) (*domain.Project, error) {
+	return modify(
+		func() (*domain.Project, error) { return r.Get(ctx, id) },
+		change,
+		func(project *domain.Project) error { return r.Update(ctx, project) },
+	)
+}
+
 // Update saves changes to an existing project
 func (r *ProjectRepo) Update(ctx context.Context, project *domain.Project) error {
+	ctx, span := tracing.Start(ctx, "storage.projects.Update")
+	defer span.End()
+	ctx, unlock := r.store.lock(ctx)
+	defer unlock()
+
 	if err := project.Validate(); err != nil {
 		return err
 	}
@@ -391,37 +664,100 @@ func (r *ProjectRepo) Update(ctx context.Context, project *domain.Project) error
 	if err != nil {
 		return err
 	}
+	if err := checkRevision("project", project.Title, project.Revision, existing.Revision); err != nil {
+		return err
+	}
+	if err := r.checkParent(ctx, project); err != nil {
+		return err
+	}
+	if project.IsSubProject() {
+		children, err := r.Children(ctx, project.ID)
+		if err != nil {
+			return err
+		}
+		if len(children) > 0 {
+			return domain.Errorf(domain.ErrInvalid, "project '%s' has sub-projects, so it can't become one", project.Title)
+		}
+	}
 
 	project.UpdateTimestamp()
 
-	// Get area slug
+	// Get area slugs
+	oldArea, err := r.store.Areas().Get(ctx, existing.AreaID)
+	if err != nil {
+		return err
+	}
 	area, err := r.store.Areas().Get(ctx, project.AreaID)
 	if err != nil {
 		return err
 	}
-	areaSlug := area.Slug()
+	areaSlug := r.store.Areas().dirName(area)
 
-	// Handle potential slug change
-	oldSlug := existing.Slug()
-	newSlug := project.Slug()
+	// Handle potential slug change, move to another area or parent
+	oldRel, err := r.relPath(ctx, existing)
+	if err != nil {
+		return err
+	}
+	newRel, err := r.newRelPath(ctx, project)
+	if err != nil {
+		return err
+	}
+	oldDir := r.projectDir(r.store.Areas().dirName(oldArea), oldRel)
+	newDir := r.projectDir(areaSlug, newRel)
 
-	if oldSlug != newSlug {
-		oldDir := r.projectDir(areaSlug, oldSlug)
-		newDir := r.projectDir(areaSlug, newSlug)
+	if oldDir != newDir {
+		if _, err := os.Stat(newDir); err == nil {
+			return domain.Errorf(domain.ErrAlreadyExists, "project '%s' already exists in area '%s'", project.Slug(), areaSlug)
+		}
+		if err := os.MkdirAll(filepath.Dir(newDir), 0755); err != nil {
+			return fmt.Errorf("failed to create projects directory: %w", err)
+		}
 		if err := os.Rename(oldDir, newDir); err != nil {
 			return fmt.Errorf("failed to rename project directory: %w", err)
 		}
+		if filepath.Base(oldRel) != filepath.Base(newRel) {
+			_ = os.Remove(filepath.Join(newDir, filepath.Base(oldRel)+".md"))
+		}
 	}
 
-	if err := r.store.writer.WriteProjectToFile(r.projectFile(areaSlug, newSlug), project); err != nil {
+	if err := r.store.writer.WriteProjectToFile(r.projectFile(areaSlug, newRel), project); err != nil {
 		return err
 	}
-	r.store.commit(fmt.Sprintf("update project: %s", project.Title))
+
+	// Tasks record their area too, so keep them in step with the project,
+	// and sub-projects move along in its directory
+	if existing.AreaID != project.AreaID {
+		if err := r.store.Tasks().setArea(areaSlug, newRel, project.AreaID); err != nil {
+			return err
+		}
+		children, err := r.listDir(areaSlug, newRel)
+		if err != nil {
+			return err
+		}
+		childrenDir := filepath.Join(newDir, "projects")
+		for _, child := range children {
+			child.AreaID = project.AreaID
+			childRel := filepath.Join(newRel, "projects", r.store.currentName(childrenDir, child.ID, child.Slug(), ""))
+			if err := r.store.writer.WriteProjectToFile(r.projectFile(areaSlug, childRel), child); err != nil {
+				return err
+			}
+			if err := r.store.Tasks().setArea(areaSlug, childRel, project.AreaID); err != nil {
+				return err
+			}
+		}
+	}
+
+	r.store.commit(ctx, fmt.Sprintf("update project: %s", project.Title))
 	return nil
 }
 
 // Delete removes a project by ID
 func (r *ProjectRepo) Delete(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "storage.projects.Delete")
+	defer span.End()
+	ctx, unlock := r.store.lock(ctx)
+	defer unlock()
+
 	project, err := r.Get(ctx, id)
 	if err != nil {
 		return err
@@ -432,11 +768,23 @@ func (r *ProjectRepo) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
-	projectDir := r.projectDir(area.Slug(), project.Slug())
+	children, err := r.Children(ctx, project.ID)
+	if err != nil {
+		return err
+	}
+	if len(children) > 0 {
+		return fmt.Errorf("project '%s' has sub-projects; move or delete them first", project.Title)
+	}
+
+	rel, err := r.relPath(ctx, project)
+	if err != nil {
+		return err
+	}
+	projectDir := r.projectDir(r.store.Areas().dirName(area), rel)
 	if err := os.RemoveAll(projectDir); err != nil {
 		return err
 	}
-	r.store.commit(fmt.Sprintf("delete project: %s", project.Title))
+	r.store.commit(ctx, fmt.Sprintf("delete project: %s", project.Title))
 	return nil
 }
 
@@ -447,48 +795,120 @@ type TaskRepo struct {
 	store *Store
 }
 
+// Path returns the file a project is stored in
+func (r *ProjectRepo) Path(ctx context.Context, id string) (string, error) {
+	project, err := r.Get(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	area, err := r.store.Areas().Get(ctx, project.AreaID)
+	if err != nil {
+		return "", err
+	}
+	rel, err := r.relPath(ctx, project)
+	if err != nil {
+		return "", err
+	}
+	return r.projectFile(r.store.Areas().dirName(area), rel), nil
+}
+
 // NewTaskRepo creates a new TaskRepo
 func (s *Store) Tasks() *TaskRepo {
 	return &TaskRepo{store: s}
 }
 
-func (r *TaskRepo) taskFile(areaSlug, projectSlug, taskSlug string) string {
-	return filepath.Join(r.store.rootDir, "areas", areaSlug, "projects", projectSlug, "tasks", taskSlug+".md")
+// taskFile returns a task's file; projectRel is where its project lives,
+// as returned by ProjectRepo.relPath, and name is the task's slug or ID
+func (r *TaskRepo) taskFile(areaSlug, projectRel, name string) string {
+	return filepath.Join(r.store.Projects().projectDir(areaSlug, projectRel), "tasks", name+".md")
 }
 
-// Create stores a new task
-func (r *TaskRepo) Create(ctx context.Context, task *domain.Task) error {
-	if err := task.Validate(); err != nil {
-		return err
+// tasksDir returns the tasks directory of a project
+func (r *TaskRepo) tasksDir(areaSlug, projectRel string) string {
+	return filepath.Join(r.store.Projects().projectDir(areaSlug, projectRel), "tasks")
+}
+
+// Path returns the file a task is stored in
+func (r *TaskRepo) Path(ctx context.Context, id string) (string, error) {
+	task, err := r.Get(ctx, id)
+	if err != nil {
+		return "", err
 	}
+	return r.pathFor(ctx, task)
+}
+
+// pathFor resolves the file a task is stored in from its project and area
+func (r *TaskRepo) pathFor(ctx context.Context, task *domain.Task) (string, error) {
+	return r.resolvePath(ctx, task, r.store.currentName)
+}
 
-	// Get project and area slugs
+// newPathFor resolves the file a task is to be written to, which differs
+// from pathFor when its name or project changes
+func (r *TaskRepo) newPathFor(ctx context.Context, task *domain.Task) (string, error) {
+	return r.resolvePath(ctx, task, r.store.newName)
+}
+
+// resolvePath returns the path of a task's file, naming it with name
+func (r *TaskRepo) resolvePath(ctx context.Context, task *domain.Task, name func(dir, id, slug, suffix string) string) (string, error) {
 	project, err := r.store.Projects().Get(ctx, task.ProjectID)
 	if err != nil {
-		return fmt.Errorf("project not found: %w", err)
+		return "", err
 	}
 
 	area, err := r.store.Areas().Get(ctx, task.AreaID)
 	if err != nil {
+		return "", err
+	}
+	rel, err := r.store.Projects().relPath(ctx, project)
+	if err != nil {
+		return "", err
+	}
+
+	areaDir := r.store.Areas().dirName(area)
+	tasksDir := r.tasksDir(areaDir, rel)
+	return r.taskFile(areaDir, rel, name(tasksDir, task.ID, task.Slug(), ".md")), nil
+}
+
+// Create stores a new task
+func (r *TaskRepo) Create(ctx context.Context, task *domain.Task) error {
+	ctx, span := tracing.Start(ctx, "storage.tasks.Create")
+	defer span.End()
+	ctx, unlock := r.store.lock(ctx)
+	defer unlock()
+
+	if err := task.Validate(); err != nil {
+		return err
+	}
+
+	// Make sure the project and area exist
+	if _, err := r.store.Projects().Get(ctx, task.ProjectID); err != nil {
+		return fmt.Errorf("project not found: %w", err)
+	}
+	if _, err := r.store.Areas().Get(ctx, task.AreaID); err != nil {
 		return fmt.Errorf("area not found: %w", err)
 	}
 
-	taskFile := r.taskFile(area.Slug(), project.Slug(), task.Slug())
+	taskFile, err := r.newPathFor(ctx, task)
+	if err != nil {
+		return err
+	}
 
 	// Check if task already exists
 	if _, err := os.Stat(taskFile); err == nil {
-		return fmt.Errorf("task '%s' already exists", task.Slug())
+		return domain.Errorf(domain.ErrAlreadyExists, "task '%s' already exists", task.Slug())
 	}
 
 	if err := r.store.writer.WriteTaskToFile(taskFile, task); err != nil {
 		return err
 	}
-	r.store.commit(fmt.Sprintf("create task: %s", task.Title))
+	r.store.commit(ctx, fmt.Sprintf("create task: %s", task.Title))
 	return nil
 }
 
 // Get retrieves a task by ID
 func (r *TaskRepo) Get(ctx context.Context, id string) (*domain.Task, error) {
+	ctx, span := tracing.Start(ctx, "storage.tasks.Get")
+	defer span.End()
 	tasks, err := r.ListAll(ctx)
 	if err != nil {
 		return nil, err
@@ -500,21 +920,47 @@ func (r *TaskRepo) Get(ctx context.Context, id string) (*domain.Task, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("task not found: %s", id)
+	return nil, domain.Errorf(domain.ErrNotFound, "task not found: %s", id)
 }
 
 // GetBySlug retrieves a task by its slug within a project
 func (r *TaskRepo) GetBySlug(ctx context.Context, areaSlug, projectSlug, taskSlug string) (*domain.Task, error) {
-	taskFile := r.taskFile(areaSlug, projectSlug, taskSlug)
-	if _, err := os.Stat(taskFile); os.IsNotExist(err) {
-		return nil, fmt.Errorf("task not found: %s/%s/%s", areaSlug, projectSlug, taskSlug)
+	project, err := r.store.Projects().GetBySlug(ctx, areaSlug, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+	area, err := r.store.Areas().Get(ctx, project.AreaID)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := r.store.Projects().relPath(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	areaDir := r.store.Areas().dirName(area)
+
+	taskFile := r.taskFile(areaDir, rel, taskSlug)
+	if _, err := os.Stat(taskFile); err == nil {
+		return r.store.parser.ParseTaskFromFile(taskFile)
 	}
 
-	return r.store.parser.ParseTaskFromFile(taskFile)
+	// Files named by ID are found by the slug of their title
+	tasks, err := r.listByProjectPath(ctx, areaDir, rel)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tasks {
+		if t.Slug() == taskSlug {
+			return t, nil
+		}
+	}
+	return nil, domain.Errorf(domain.ErrNotFound, "task not found: %s/%s/%s", areaSlug, projectSlug, taskSlug)
 }
 
 // List returns all tasks for a project
 func (r *TaskRepo) List(ctx context.Context, projectID string) ([]*domain.Task, error) {
+	ctx, span := tracing.Start(ctx, "storage.tasks.List")
+	defer span.End()
 	project, err := r.store.Projects().Get(ctx, projectID)
 	if err != nil {
 		return nil, err
@@ -524,12 +970,16 @@ func (r *TaskRepo) List(ctx context.Context, projectID string) ([]*domain.Task,
 	if err != nil {
 		return nil, err
 	}
+	rel, err := r.store.Projects().relPath(ctx, project)
+	if err != nil {
+		return nil, err
+	}
 
-	return r.listByProjectSlug(ctx, area.Slug(), project.Slug())
+	return r.listByProjectPath(ctx, r.store.Areas().dirName(area), rel)
 }
 
-func (r *TaskRepo) listByProjectSlug(ctx context.Context, areaSlug, projectSlug string) ([]*domain.Task, error) {
-	tasksDir := filepath.Join(r.store.rootDir, "areas", areaSlug, "projects", projectSlug, "tasks")
+func (r *TaskRepo) listByProjectPath(ctx context.Context, areaSlug, projectRel string) ([]*domain.Task, error) {
+	tasksDir := r.tasksDir(areaSlug, projectRel)
 	entries, err := os.ReadDir(tasksDir)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -544,12 +994,12 @@ func (r *TaskRepo) listByProjectSlug(ctx context.Context, areaSlug, projectSlug
 			continue
 		}
 
-		taskSlug := strings.TrimSuffix(entry.Name(), ".md")
-		taskFile := r.taskFile(areaSlug, projectSlug, taskSlug)
+		name := strings.TrimSuffix(entry.Name(), ".md")
+		taskFile := r.taskFile(areaSlug, projectRel, name)
 
 		task, err := r.store.parser.ParseTaskFromFile(taskFile)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse task %s: %w", taskSlug, err)
+			return nil, fmt.Errorf("failed to parse task %s: %w", name, err)
 		}
 
 		tasks = append(tasks, task)
@@ -558,8 +1008,41 @@ func (r *TaskRepo) listByProjectSlug(ctx context.Context, areaSlug, projectSlug
 	return tasks, nil
 }
 
+// setArea rewrites the area of every task file in a project directory
+func (r *TaskRepo) setArea(areaSlug, projectRel, areaID string) error {
+	tasksDir := r.tasksDir(areaSlug, projectRel)
+	entries, err := os.ReadDir(tasksDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read tasks directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".md") {
+			continue
+		}
+
+		taskFile := filepath.Join(tasksDir, entry.Name())
+		task, err := r.store.parser.ParseTaskFromFile(taskFile)
+		if err != nil {
+			return fmt.Errorf("failed to parse task %s: %w", entry.Name(), err)
+		}
+
+		task.AreaID = areaID
+		if err := r.store.writer.WriteTaskToFile(taskFile, task); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ListByArea returns all tasks for an area
 func (r *TaskRepo) ListByArea(ctx context.Context, areaID string) ([]*domain.Task, error) {
+	ctx, span := tracing.Start(ctx, "storage.tasks.ListByArea")
+	defer span.End()
 	projects, err := r.store.Projects().List(ctx, areaID)
 	if err != nil {
 		return nil, err
@@ -579,6 +1062,8 @@ func (r *TaskRepo) ListByArea(ctx context.Context, areaID string) ([]*domain.Tas
 
 // ListAll returns all tasks
 func (r *TaskRepo) ListAll(ctx context.Context) ([]*domain.Task, error) {
+	ctx, span := tracing.Start(ctx, "storage.tasks.ListAll")
+	defer span.End()
 	areas, err := r.store.Areas().List(ctx)
 	if err != nil {
 		return nil, err
@@ -596,8 +1081,24 @@ func (r *TaskRepo) ListAll(ctx context.Context) ([]*domain.Task, error) {
 	return allTasks, nil
 }
 
+// Modify applies change to the task with the ID as stored and saves it,
+// applying it again to a newer version if another process writes the task
+// in between
+func (r *TaskRepo) Modify(ctx context.Context, id string, change func(*domain.Task) error) (*domain.Task, error) {
+	return modify(
+		func() (*domain.Task, error) { return r.Get(ctx, id) },
+		change,
+		func(task *domain.Task) error { return r.Update(ctx, task) },
+	)
+}
+
 // Update saves changes to an existing task
 func (r *TaskRepo) Update(ctx context.Context, task *domain.Task) error {
+	ctx, span := tracing.Start(ctx, "storage.tasks.Update")
+	defer span.End()
+	ctx, unlock := r.store.lock(ctx)
+	defer unlock()
+
 	if err := task.Validate(); err != nil {
 		return err
 	}
@@ -606,61 +1107,81 @@ func (r *TaskRepo) Update(ctx context.Context, task *domain.Task) error {
 	if err != nil {
 		return err
 	}
+	if err := checkRevision("task", task.Title, task.Revision, existing.Revision); err != nil {
+		return err
+	}
 
 	task.UpdateTimestamp()
 
-	project, err := r.store.Projects().Get(ctx, task.ProjectID)
+	oldFile, err := r.pathFor(ctx, existing)
 	if err != nil {
 		return err
 	}
 
-	area, err := r.store.Areas().Get(ctx, task.AreaID)
+	newFile, err := r.newPathFor(ctx, task)
 	if err != nil {
 		return err
 	}
 
-	areaSlug := area.Slug()
-	projectSlug := project.Slug()
+	if oldFile != newFile {
+		if _, err := os.Stat(newFile); err == nil {
+			return domain.Errorf(domain.ErrAlreadyExists, "task '%s' already exists", task.Slug())
+		}
+	}
+
+	// Write the new file before touching the old one, so a failed write
+	// leaves the task where it was
+	if err := r.store.writer.WriteTaskToFile(newFile, task); err != nil {
+		return err
+	}
+
+	// Handle slug changes and moves between projects
+	if oldFile != newFile {
+		if projectDirOf(oldFile) != projectDirOf(newFile) {
+			if err := copyAssets(task, projectDirOf(oldFile), projectDirOf(newFile)); err != nil {
+				return err
+			}
+		}
 
-	oldSlug := existing.Slug()
-	newSlug := task.Slug()
+		// Notes follow the task
+		if _, err := os.Stat(taskNotesDir(oldFile)); err == nil {
+			if err := os.Rename(taskNotesDir(oldFile), taskNotesDir(newFile)); err != nil {
+				return fmt.Errorf("failed to move task notes: %w", err)
+			}
+		}
 
-	if oldSlug != newSlug {
-		oldFile := r.taskFile(areaSlug, projectSlug, oldSlug)
 		if err := os.Remove(oldFile); err != nil && !os.IsNotExist(err) {
 			return fmt.Errorf("failed to remove old task file: %w", err)
 		}
 	}
 
-	if err := r.store.writer.WriteTaskToFile(r.taskFile(areaSlug, projectSlug, newSlug), task); err != nil {
-		return err
-	}
-	r.store.commit(fmt.Sprintf("update task: %s", task.Title))
+	r.store.commit(ctx, fmt.Sprintf("update task: %s", task.Title))
 	return nil
 }
 
 // Delete removes a task by ID
 func (r *TaskRepo) Delete(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "storage.tasks.Delete")
+	defer span.End()
+	ctx, unlock := r.store.lock(ctx)
+	defer unlock()
+
 	task, err := r.Get(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	project, err := r.store.Projects().Get(ctx, task.ProjectID)
+	taskFile, err := r.pathFor(ctx, task)
 	if err != nil {
 		return err
 	}
-
-	area, err := r.store.Areas().Get(ctx, task.AreaID)
-	if err != nil {
+	if err := os.Remove(taskFile); err != nil {
 		return err
 	}
-
-	taskFile := r.taskFile(area.Slug(), project.Slug(), task.Slug())
-	if err := os.Remove(taskFile); err != nil {
+	if err := os.RemoveAll(taskNotesDir(taskFile)); err != nil {
 		return err
 	}
-	r.store.commit(fmt.Sprintf("delete task: %s", task.Title))
+	r.store.commit(ctx, fmt.Sprintf("delete task: %s", task.Title))
 	return nil
 }
 
@@ -0,0 +1,76 @@
+package markdown
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"math/rand/v2"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// A revision identifies what a task or project file held when it was read:
+// the start of the file's SHA-256. Updates that carry the revision they read
+// are refused with domain.ErrConflict when the file has changed since, so a
+// client never overwrites a change it hasn't seen. Updates without one
+// overwrite as before.
+const revisionLength = 16
+
+// revision returns the revision of a file's contents
+func revision(data []byte) string {
+	h := sha256.New()
+	_, _ = h.Write(data)
+	return revisionSum(h)
+}
+
+// revisionSum returns the revision of what was written to h
+func revisionSum(h hash.Hash) string {
+	return hex.EncodeToString(h.Sum(nil))[:revisionLength]
+}
+
+// errChanged is returned by replaceFile when the file changed since it was
+// read; the Writer reports it as a conflict of the task or project
+var errChanged = errors.New("file changed since it was read")
+
+// checkRevision returns domain.ErrConflict when an update read an older
+// revision than the stored one
+func checkRevision(kind, title, read, stored string) error {
+	if read == "" || read == stored {
+		return nil
+	}
+	return conflictError(kind, title)
+}
+
+// conflictError is the domain.ErrConflict of a task or project
+func conflictError(kind, title string) error {
+	return fmt.Errorf("%s '%s' %w; read it again and reapply your change", kind, title, domain.ErrConflict)
+}
+
+// conflictRetries is how many times Modify applies a change again after
+// losing to a write by another process. Each retry waits a little longer,
+// at random, so writers racing on one file spread out.
+const conflictRetries = 10
+
+// modify reads an item with get, applies change to it and saves it with
+// update. When another process wrote the item after it was read, it is
+// read again and the change reapplied, so neither write is lost.
+func modify[T any](get func() (T, error), change func(T) error, update func(T) error) (T, error) {
+	for attempt := 0; ; attempt++ {
+		item, err := get()
+		if err != nil {
+			return item, err
+		}
+		if err := change(item); err != nil {
+			return item, err
+		}
+		err = update(item)
+		if errors.Is(err, domain.ErrConflict) && attempt < conflictRetries {
+			time.Sleep(rand.N(time.Duration(attempt+1) * 20 * time.Millisecond))
+			continue
+		}
+		return item, err
+	}
+}
@@ -2,16 +2,20 @@ package markdown
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
-	"os"
-	"path/filepath"
 
 	"github.com/ihavespoons/reorg/internal/domain"
 )
 
 // Writer handles writing domain objects to markdown files
-type Writer struct{}
+type Writer struct {
+	// Slugs records the slug of projects and tasks in their frontmatter,
+	// for files named by ID
+	Slugs bool
+}
 
 // NewWriter creates a new Writer instance
 func NewWriter() *Writer {
@@ -46,24 +50,12 @@ func (w *Writer) WriteArea(out io.Writer, area *domain.Area) error {
 
 // WriteAreaToFile writes an Area to a file
 func (w *Writer) WriteAreaToFile(path string, area *domain.Area) error {
-	// Ensure directory exists
-	dir := filepath.Dir(path)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return fmt.Errorf("failed to create directory: %w", err)
-	}
-
-	f, err := os.Create(path)
-	if err != nil {
-		return fmt.Errorf("failed to create file: %w", err)
-	}
-	defer func() { _ = f.Close() }()
-
-	return w.WriteArea(f, area)
+	return writeFile(path, func(out io.Writer) error { return w.WriteArea(out, area) })
 }
 
 // WriteProject writes a Project to a writer as markdown with YAML frontmatter
 func (w *Writer) WriteProject(out io.Writer, project *domain.Project) error {
-	fm, err := marshalFrontmatter(project)
+	fm, err := marshalFrontmatter(project, w.slugField(project.Slug())...)
 	if err != nil {
 		return fmt.Errorf("failed to marshal project frontmatter: %w", err)
 	}
@@ -87,25 +79,25 @@ func (w *Writer) WriteProject(out io.Writer, project *domain.Project) error {
 	return nil
 }
 
-// WriteProjectToFile writes a Project to a file
+// WriteProjectToFile writes a Project to a file and sets its revision to the
+// new file's. A project carrying the revision it was read at isn't written
+// over a file that has changed since, failing with domain.ErrConflict.
 func (w *Writer) WriteProjectToFile(path string, project *domain.Project) error {
-	dir := filepath.Dir(path)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return fmt.Errorf("failed to create directory: %w", err)
+	h := sha256.New()
+	err := replaceFile(path, project.Revision, func(out io.Writer) error { return w.WriteProject(io.MultiWriter(out, h), project) })
+	if errors.Is(err, errChanged) {
+		return conflictError("project", project.Title)
 	}
-
-	f, err := os.Create(path)
 	if err != nil {
-		return fmt.Errorf("failed to create file: %w", err)
+		return err
 	}
-	defer func() { _ = f.Close() }()
-
-	return w.WriteProject(f, project)
+	project.Revision = revisionSum(h)
+	return nil
 }
 
 // WriteTask writes a Task to a writer as markdown with YAML frontmatter
 func (w *Writer) WriteTask(out io.Writer, task *domain.Task) error {
-	fm, err := marshalFrontmatter(task)
+	fm, err := marshalFrontmatter(task, w.slugField(task.Slug())...)
 	if err != nil {
 		return fmt.Errorf("failed to marshal task frontmatter: %w", err)
 	}
@@ -129,20 +121,67 @@ func (w *Writer) WriteTask(out io.Writer, task *domain.Task) error {
 	return nil
 }
 
-// WriteTaskToFile writes a Task to a file
+// WriteTaskToFile writes a Task to a file and sets its revision to the
+// new file's. A task carrying the revision it was read at isn't written
+// over a file that has changed since, failing with domain.ErrConflict.
 func (w *Writer) WriteTaskToFile(path string, task *domain.Task) error {
-	dir := filepath.Dir(path)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return fmt.Errorf("failed to create directory: %w", err)
+	h := sha256.New()
+	err := replaceFile(path, task.Revision, func(out io.Writer) error { return w.WriteTask(io.MultiWriter(out, h), task) })
+	if errors.Is(err, errChanged) {
+		return conflictError("task", task.Title)
+	}
+	if err != nil {
+		return err
+	}
+	task.Revision = revisionSum(h)
+	return nil
+}
+
+// WriteNote writes a Note to a writer as markdown with YAML frontmatter
+func (w *Writer) WriteNote(out io.Writer, note *domain.Note) error {
+	fm, err := marshalFrontmatter(note)
+	if err != nil {
+		return fmt.Errorf("failed to marshal note frontmatter: %w", err)
+	}
+
+	if _, err := out.Write(fm); err != nil {
+		return fmt.Errorf("failed to write frontmatter: %w", err)
+	}
+	if _, err := out.Write([]byte("\n" + note.Content + "\n")); err != nil {
+		return fmt.Errorf("failed to write content: %w", err)
 	}
+	return nil
+}
 
-	f, err := os.Create(path)
+// WriteNoteToFile writes a Note to a file
+func (w *Writer) WriteNoteToFile(path string, note *domain.Note) error {
+	return writeFile(path, func(out io.Writer) error { return w.WriteNote(out, note) })
+}
+
+// WriteGoal writes a Goal to a writer as markdown with YAML frontmatter
+func (w *Writer) WriteGoal(out io.Writer, goal *domain.Goal) error {
+	fm, err := marshalFrontmatter(goal)
 	if err != nil {
-		return fmt.Errorf("failed to create file: %w", err)
+		return fmt.Errorf("failed to marshal goal frontmatter: %w", err)
+	}
+
+	if _, err := out.Write(fm); err != nil {
+		return fmt.Errorf("failed to write frontmatter: %w", err)
 	}
-	defer func() { _ = f.Close() }()
 
-	return w.WriteTask(f, task)
+	content := goal.Content
+	if content == "" {
+		content = fmt.Sprintf("# %s\n\nWhat does achieving this look like?", goal.Title)
+	}
+	if _, err := out.Write([]byte("\n" + content + "\n")); err != nil {
+		return fmt.Errorf("failed to write content: %w", err)
+	}
+	return nil
+}
+
+// WriteGoalToFile writes a Goal to a file
+func (w *Writer) WriteGoalToFile(path string, goal *domain.Goal) error {
+	return writeFile(path, func(out io.Writer) error { return w.WriteGoal(out, goal) })
 }
 
 // MarshalArea returns the markdown representation of an Area
@@ -172,3 +211,10 @@ func (w *Writer) MarshalTask(task *domain.Task) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// slugField returns the frontmatter field recording a slug, if any
+func (w *Writer) slugField(slug string) []string {
+	if !w.Slugs {
+		return nil
+	}
+	return []string{"slug", slug}
+}
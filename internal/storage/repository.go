@@ -47,6 +47,10 @@ type ProjectRepository interface {
 	// Update saves changes to an existing project
 	Update(ctx context.Context, project *domain.Project) error
 
+	// Modify applies change to the stored project and saves it, retrying
+	// with the newer version when the save loses to another write
+	Modify(ctx context.Context, id string, change func(*domain.Project) error) (*domain.Project, error)
+
 	// Delete removes a project by ID
 	Delete(ctx context.Context, id string) error
 }
@@ -74,14 +78,91 @@ type TaskRepository interface {
 	// Update saves changes to an existing task
 	Update(ctx context.Context, task *domain.Task) error
 
+	// Modify applies change to the stored task and saves it, retrying with
+	// the newer version when the save loses to another write
+	Modify(ctx context.Context, id string, change func(*domain.Task) error) (*domain.Task, error)
+
 	// Delete removes a task by ID
 	Delete(ctx context.Context, id string) error
 }
 
+// NoteRepository defines operations for storing and retrieving Notes
+type NoteRepository interface {
+	// Create stores a new note next to its project or task
+	Create(ctx context.Context, note *domain.Note) error
+
+	// Get retrieves a note by ID
+	Get(ctx context.Context, id string) (*domain.Note, error)
+
+	// List returns the notes of a project or task, oldest first
+	List(ctx context.Context, parentID string) ([]*domain.Note, error)
+
+	// ListAll returns all notes
+	ListAll(ctx context.Context) ([]*domain.Note, error)
+
+	// Delete removes a note by ID
+	Delete(ctx context.Context, id string) error
+}
+
+// GoalRepository defines operations for storing and retrieving Goals
+type GoalRepository interface {
+	// Create stores a new goal
+	Create(ctx context.Context, goal *domain.Goal) error
+
+	// Get retrieves a goal by ID
+	Get(ctx context.Context, id string) (*domain.Goal, error)
+
+	// GetBySlug retrieves a goal by its slug
+	GetBySlug(ctx context.Context, slug string) (*domain.Goal, error)
+
+	// List returns all goals
+	List(ctx context.Context) ([]*domain.Goal, error)
+
+	// Update saves changes to an existing goal
+	Update(ctx context.Context, goal *domain.Goal) error
+
+	// Delete removes a goal by ID
+	Delete(ctx context.Context, id string) error
+}
+
 // TaskFilter defines filtering options for listing tasks
 type TaskFilter struct {
-	Status   *domain.TaskStatus
-	Priority *domain.Priority
-	Tags     []string
-	Overdue  *bool
+	ProjectID string
+	AreaID    string
+	Status    *domain.TaskStatus
+	Priority  *domain.Priority
+	Tags      []string
+	Overdue   *bool
+}
+
+// IsEmpty returns true if the filter has no criteria set
+func (f TaskFilter) IsEmpty() bool {
+	return f.ProjectID == "" && f.AreaID == "" && f.Status == nil &&
+		f.Priority == nil && len(f.Tags) == 0 && f.Overdue == nil
+}
+
+// Matches returns true if the task satisfies every criterion of the filter.
+// Tags must all be present on the task.
+func (f TaskFilter) Matches(task *domain.Task) bool {
+	if f.ProjectID != "" && task.ProjectID != f.ProjectID {
+		return false
+	}
+	if f.AreaID != "" && task.AreaID != f.AreaID {
+		return false
+	}
+	if f.Status != nil && task.Status != *f.Status {
+		return false
+	}
+	if f.Priority != nil && task.Priority != *f.Priority {
+		return false
+	}
+	for _, tag := range f.Tags {
+		if !task.HasTag(tag) {
+			return false
+		}
+	}
+	if f.Overdue != nil && task.IsOverdue() != *f.Overdue {
+		return false
+	}
+	return true
 }
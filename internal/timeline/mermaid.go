@@ -0,0 +1,65 @@
+package timeline
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// WriteMermaid writes the timeline as a Mermaid gantt chart. Finished
+// tasks are marked done, started ones active and overdue ones crit, and
+// tasks placed after their dependencies use Mermaid's after so the chart
+// keeps following them when edited.
+func (tl *Timeline) WriteMermaid(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("gantt\n")
+	fmt.Fprintf(&b, "    title %s\n", mermaidText(tl.Project.Title))
+	b.WriteString("    dateFormat YYYY-MM-DD\n")
+	b.WriteString("    axisFormat %b %d\n")
+	b.WriteString("    section Tasks\n")
+
+	for i, bar := range tl.Bars {
+		var tags []string
+		switch {
+		case bar.Done():
+			tags = append(tags, "done")
+		case bar.Task.IsOverdue():
+			tags = append(tags, "crit")
+		case bar.Task.Status == domain.TaskStatusInProgress:
+			tags = append(tags, "active")
+		}
+		tags = append(tags, mermaidID(i))
+
+		start := bar.Start.Format(time.DateOnly)
+		if bar.Scheduled {
+			after := make([]string, len(bar.After))
+			for j, dep := range bar.After {
+				after[j] = mermaidID(dep)
+			}
+			start = "after " + strings.Join(after, " ")
+		}
+		fmt.Fprintf(&b, "    %s :%s, %s, %dd\n",
+			mermaidText(bar.Task.Title), strings.Join(tags, ", "), start, days(bar.Start, bar.End))
+	}
+
+	if tl.Project.DueDate != nil {
+		fmt.Fprintf(&b, "    Due :milestone, %s, 0d\n", tl.Project.DueDate.Format(time.DateOnly))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// mermaidID names a bar for after references
+func mermaidID(i int) string {
+	return fmt.Sprintf("t%d", i+1)
+}
+
+// mermaidText drops the characters that end a Mermaid task name or
+// statement
+func mermaidText(s string) string {
+	return strings.NewReplacer(":", " -", ";", ",", "#", "", "\n", " ").Replace(s)
+}
@@ -0,0 +1,124 @@
+package timeline
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// Layout of the SVG chart, in pixels
+const (
+	svgLabelWidth = 220
+	svgDayWidth   = 28
+	svgRowHeight  = 26
+	svgBarHeight  = 16
+	svgHeader     = 56
+	svgPadding    = 12
+)
+
+// Bar colours by state
+const (
+	svgColorPending  = "#9ca3af"
+	svgColorActive   = "#3b82f6"
+	svgColorDone     = "#22c55e"
+	svgColorOverdue  = "#ef4444"
+	svgColorToday    = "#f59e0b"
+	svgColorGrid     = "#e5e7eb"
+	svgColorText     = "#111827"
+	svgColorDimText  = "#6b7280"
+	svgColorArrow    = "#6b7280"
+	svgColorDeadline = "#7c3aed"
+)
+
+// WriteSVG draws the timeline as a standalone SVG image: a row per task
+// with its bar over a day grid, lines from each task's dependencies, and
+// markers for today and the project's due date.
+func (tl *Timeline) WriteSVG(w io.Writer, now time.Time) error {
+	numDays := max(tl.Days(), 1)
+	width := svgLabelWidth + numDays*svgDayWidth + 2*svgPadding
+	height := svgHeader + len(tl.Bars)*svgRowHeight + 2*svgPadding
+
+	x := func(t time.Time) int {
+		return svgPadding + svgLabelWidth + days(tl.Start, day(t))*svgDayWidth
+	}
+	rowY := func(i int) int {
+		return svgPadding + svgHeader + i*svgRowHeight
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="12">`+"\n",
+		width, height, width, height)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="white"/>`+"\n", width, height)
+	fmt.Fprintf(&b, `<text x="%d" y="%d" font-size="16" font-weight="bold" fill="%s">%s</text>`+"\n",
+		svgPadding, svgPadding+16, svgColorText, html.EscapeString(tl.Project.Title))
+
+	// Day grid, labelled on Mondays and the first day
+	for i := range numDays {
+		d := tl.Start.AddDate(0, 0, i)
+		dx := x(d)
+		fmt.Fprintf(&b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="%s"/>`+"\n",
+			dx, svgPadding+svgHeader-8, dx, height-svgPadding, svgColorGrid)
+		if i == 0 || d.Weekday() == time.Monday {
+			fmt.Fprintf(&b, `<text x="%d" y="%d" fill="%s">%s</text>`+"\n",
+				dx+2, svgPadding+svgHeader-14, svgColorDimText, d.Format("Jan 2"))
+		}
+	}
+
+	// Dependency lines go under the bars
+	for i, bar := range tl.Bars {
+		for _, dep := range bar.After {
+			from := tl.Bars[dep]
+			x1, y1 := x(from.End), rowY(dep)+svgRowHeight/2
+			x2, y2 := x(bar.Start), rowY(i)+svgRowHeight/2
+			fmt.Fprintf(&b, `<path d="M%d %d H%d V%d H%d" fill="none" stroke="%s" stroke-width="1"/>`+"\n",
+				x1, y1, x1+6, y2, x2, svgColorArrow)
+		}
+	}
+
+	for i, bar := range tl.Bars {
+		y := rowY(i)
+		label := bar.Task.Title
+		if r := []rune(label); len(r) > 32 {
+			label = string(r[:31]) + "…"
+		}
+		fmt.Fprintf(&b, `<text x="%d" y="%d" fill="%s">%s</text>`+"\n",
+			svgPadding, y+svgRowHeight/2+4, svgColorText, html.EscapeString(label))
+
+		color := svgColorPending
+		switch {
+		case bar.Done():
+			color = svgColorDone
+		case bar.Task.IsOverdue():
+			color = svgColorOverdue
+		case bar.Task.Status == domain.TaskStatusInProgress:
+			color = svgColorActive
+		}
+		fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%d" height="%d" rx="3" fill="%s"><title>%s</title></rect>`+"\n",
+			x(bar.Start), y+(svgRowHeight-svgBarHeight)/2, days(bar.Start, bar.End)*svgDayWidth-2, svgBarHeight,
+			color, html.EscapeString(fmt.Sprintf("%s (%s, %s – %s)", bar.Task.Title, bar.Task.Status,
+				bar.Start.Format("Jan 2"), bar.End.AddDate(0, 0, -1).Format("Jan 2"))))
+	}
+
+	marker := func(t time.Time, color, label string) {
+		if t.Before(tl.Start) || !t.Before(tl.End) {
+			return
+		}
+		mx := x(t) + svgDayWidth/2
+		fmt.Fprintf(&b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="%s" stroke-dasharray="4 3"/>`+"\n",
+			mx, svgPadding+svgHeader-8, mx, height-svgPadding, color)
+		fmt.Fprintf(&b, `<text x="%d" y="%d" fill="%s" text-anchor="middle">%s</text>`+"\n",
+			mx, svgPadding+svgHeader-28, color, label)
+	}
+	marker(day(now), svgColorToday, "today")
+	if tl.Project.DueDate != nil {
+		marker(day(*tl.Project.DueDate), svgColorDeadline, "due")
+	}
+
+	b.WriteString("</svg>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
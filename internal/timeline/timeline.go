@@ -0,0 +1,185 @@
+// Package timeline lays a project's tasks out over days and renders them
+// as a Mermaid gantt chart or an SVG image, for sharing a project's status.
+package timeline
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/domain"
+)
+
+// workday is the time an estimate is spread over per day
+const workday = 8 * time.Hour
+
+// Bar is a task placed on the timeline, from the start of Start to the
+// start of End
+type Bar struct {
+	Task  *domain.Task
+	Start time.Time
+	End   time.Time
+	// After lists the bars this one depends on, by index
+	After []int
+	// Scheduled is true when the bar was placed after its dependencies
+	// rather than by a due or created date
+	Scheduled bool
+}
+
+// Done reports whether the task is finished
+func (b Bar) Done() bool {
+	return b.Task.Status == domain.TaskStatusCompleted
+}
+
+// Timeline is a project's tasks laid out over days
+type Timeline struct {
+	Project *domain.Project
+	Bars    []Bar
+	Start   time.Time
+	End     time.Time
+}
+
+// Build lays out the project's tasks, leaving out cancelled ones. Each
+// task takes its estimate, at eight hours a day, or a day without one.
+// Tasks with a due date end on it, tasks that depend on others start
+// after them, and the rest start on the day they were created, or today
+// if that has passed and they are still open.
+func Build(project *domain.Project, tasks []*domain.Task, now time.Time) *Timeline {
+	today := day(now)
+
+	tasks = slices.DeleteFunc(slices.Clone(tasks), func(t *domain.Task) bool {
+		return t.Status == domain.TaskStatusCancelled
+	})
+	index := make(map[string]int, len(tasks))
+	for i, t := range tasks {
+		index[t.ID] = i
+	}
+
+	bars := make([]Bar, len(tasks))
+	placed := make([]bool, len(tasks))
+	visiting := make([]bool, len(tasks))
+	var place func(i int)
+	place = func(i int) {
+		if placed[i] || visiting[i] {
+			return
+		}
+		visiting[i] = true
+		t := tasks[i]
+		b := Bar{Task: t}
+		for _, dep := range t.Dependencies {
+			if j, ok := index[dep]; ok && j != i {
+				place(j)
+				b.After = append(b.After, j)
+			}
+		}
+
+		length := duration(t)
+		switch {
+		case t.DueDate != nil:
+			b.End = day(*t.DueDate).AddDate(0, 0, 1)
+			b.Start = b.End.AddDate(0, 0, -length)
+		case len(b.After) > 0:
+			for _, j := range b.After {
+				if bars[j].End.After(b.Start) {
+					b.Start = bars[j].End
+				}
+			}
+			b.End = b.Start.AddDate(0, 0, length)
+			b.Scheduled = true
+		default:
+			b.Start = day(t.Created)
+			if t.Created.IsZero() || (!b.Done() && b.Start.Before(today)) {
+				b.Start = today
+			}
+			b.End = b.Start.AddDate(0, 0, length)
+		}
+		bars[i] = b
+		placed[i] = true
+		visiting[i] = false
+	}
+	for i := range tasks {
+		place(i)
+	}
+
+	// Show the bars in the order they start
+	order := make([]int, len(bars))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(i, j int) int {
+		if c := bars[i].Start.Compare(bars[j].Start); c != 0 {
+			return c
+		}
+		return bars[i].End.Compare(bars[j].End)
+	})
+	position := make([]int, len(bars))
+	for pos, i := range order {
+		position[i] = pos
+	}
+	sorted := make([]Bar, len(bars))
+	for i, b := range bars {
+		for k, j := range b.After {
+			b.After[k] = position[j]
+		}
+		sorted[position[i]] = b
+	}
+	bars = sorted
+
+	tl := &Timeline{Project: project, Bars: bars}
+	for i, b := range bars {
+		if i == 0 || b.Start.Before(tl.Start) {
+			tl.Start = b.Start
+		}
+		if b.End.After(tl.End) {
+			tl.End = b.End
+		}
+	}
+	if project.DueDate != nil {
+		if end := day(*project.DueDate).AddDate(0, 0, 1); end.After(tl.End) {
+			tl.End = end
+		}
+	}
+	return tl
+}
+
+// Days returns how many days the timeline spans
+func (tl *Timeline) Days() int {
+	return days(tl.Start, tl.End)
+}
+
+// duration is how many days a task's estimate takes, at least one
+func duration(t *domain.Task) int {
+	estimate, ok := t.Estimate()
+	if !ok || estimate <= 0 {
+		return 1
+	}
+	return max(int(math.Ceil(float64(estimate)/float64(workday))), 1)
+}
+
+// day returns midnight at the start of t's day
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// days counts the days from start to end, which are both midnight
+func days(start, end time.Time) int {
+	return int(math.Round(end.Sub(start).Hours() / 24))
+}
+
+// Format is an output format for a timeline
+type Format string
+
+const (
+	FormatMermaid Format = "mermaid"
+	FormatSVG     Format = "svg"
+)
+
+// ParseFormat checks a format name
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(s); f {
+	case FormatMermaid, FormatSVG:
+		return f, nil
+	}
+	return "", fmt.Errorf("unknown format %q (use mermaid or svg)", s)
+}
@@ -0,0 +1,227 @@
+// Package usage keeps a ledger of language model requests: tokens used and
+// their estimated cost, by provider, model and the command that made them.
+// A monthly budget pauses paid requests once it is spent.
+package usage
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ihavespoons/reorg/internal/llm"
+)
+
+// Price is the cost of a model in US dollars per million tokens
+type Price struct {
+	Input  float64 `mapstructure:"input"`
+	Output float64 `mapstructure:"output"`
+}
+
+// DefaultPrices are the list prices of Claude models, matched by the
+// longest model name prefix. Claude Code aliases are included.
+var DefaultPrices = map[string]Price{
+	"claude-opus-4":     {Input: 15, Output: 75},
+	"claude-sonnet-4":   {Input: 3, Output: 15},
+	"claude-haiku-4":    {Input: 1, Output: 5},
+	"claude-3-7-sonnet": {Input: 3, Output: 15},
+	"claude-3-5-sonnet": {Input: 3, Output: 15},
+	"claude-3-5-haiku":  {Input: 0.8, Output: 4},
+	"opus":              {Input: 15, Output: 75},
+	"sonnet":            {Input: 3, Output: 15},
+	"haiku":             {Input: 1, Output: 5},
+}
+
+// Entry is one recorded request
+type Entry struct {
+	Time         time.Time    `json:"time"`
+	Provider     llm.Provider `json:"provider"`
+	Model        string       `json:"model,omitempty"`
+	Caller       string       `json:"caller,omitempty"`
+	InputTokens  int64        `json:"input_tokens"`
+	OutputTokens int64        `json:"output_tokens"`
+	Cost         float64      `json:"cost"`
+	Estimated    bool         `json:"estimated,omitempty"`
+}
+
+// Ledger appends entries to a JSON lines file. It implements
+// llm.UsageRecorder.
+type Ledger struct {
+	// Caller is recorded with every entry, e.g. the CLI command
+	Caller string
+
+	// Prices override DefaultPrices by model prefix
+	Prices map[string]Price
+
+	// MonthlyBudget in US dollars pauses paid requests once spent in the
+	// current month; zero means no budget
+	MonthlyBudget float64
+
+	path string
+	mu   sync.Mutex
+}
+
+// NewLedger creates a ledger stored at path
+func NewLedger(path string) *Ledger {
+	return &Ledger{path: path}
+}
+
+// Allow refuses requests to paid models once the monthly budget is spent
+func (l *Ledger) Allow(provider llm.Provider, model string) error {
+	if l.MonthlyBudget <= 0 || l.price(provider, model) == (Price{}) {
+		return nil
+	}
+	spent, err := l.MonthSpend(time.Now())
+	if err != nil {
+		return err
+	}
+	if spent >= l.MonthlyBudget {
+		return fmt.Errorf("%w: $%.2f of $%.2f spent this month", llm.ErrBudgetExceeded, spent, l.MonthlyBudget)
+	}
+	return nil
+}
+
+// Record appends a request to the ledger. Failing to write it doesn't fail
+// the request it describes.
+func (l *Ledger) Record(u llm.Usage) {
+	price := l.price(u.Provider, u.Model)
+	entry := Entry{
+		Time:         time.Now().UTC(),
+		Provider:     u.Provider,
+		Model:        u.Model,
+		Caller:       l.Caller,
+		InputTokens:  u.InputTokens,
+		OutputTokens: u.OutputTokens,
+		Cost:         (float64(u.InputTokens)*price.Input + float64(u.OutputTokens)*price.Output) / 1e6,
+		Estimated:    u.Estimated,
+	}
+	_ = l.append(entry)
+}
+
+// Entries returns the entries recorded at or after since, oldest first
+func (l *Ledger) Entries(since time.Time) ([]Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := os.Open(l.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read usage ledger: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		if !e.Time.Before(since) {
+			entries = append(entries, e)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read usage ledger: %w", err)
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Time.Before(entries[j].Time) })
+	return entries, nil
+}
+
+// MonthSpend returns the cost recorded in the calendar month of now
+func (l *Ledger) MonthSpend(now time.Time) (float64, error) {
+	now = now.Local()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	entries, err := l.Entries(start)
+	if err != nil {
+		return 0, err
+	}
+	var spent float64
+	for _, e := range entries {
+		spent += e.Cost
+	}
+	return spent, nil
+}
+
+func (l *Ledger) append(e Entry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+// price returns the price of a model. Only Claude models have default
+// prices; others are free unless priced in Prices.
+func (l *Ledger) price(provider llm.Provider, model string) Price {
+	tables := []map[string]Price{l.Prices}
+	switch provider {
+	case llm.ProviderClaude, llm.ProviderClaudeCode:
+		tables = []map[string]Price{DefaultPrices, l.Prices}
+		if model == "" {
+			model = "sonnet"
+		}
+	}
+
+	var best string
+	var price Price
+	for _, prices := range tables {
+		for prefix, p := range prices {
+			if strings.HasPrefix(model, prefix) && len(prefix) >= len(best) {
+				best, price = prefix, p
+			}
+		}
+	}
+	return price
+}
+
+// Total sums the entries sharing a key
+type Total struct {
+	Key          string
+	Calls        int
+	InputTokens  int64
+	OutputTokens int64
+	Cost         float64
+	Estimated    bool
+}
+
+// Summarize totals entries by key, in order of first appearance
+func Summarize(entries []Entry, key func(Entry) string) []Total {
+	var totals []Total
+	index := make(map[string]int)
+	for _, e := range entries {
+		k := key(e)
+		i, ok := index[k]
+		if !ok {
+			i = len(totals)
+			index[k] = i
+			totals = append(totals, Total{Key: k})
+		}
+		t := &totals[i]
+		t.Calls++
+		t.InputTokens += e.InputTokens
+		t.OutputTokens += e.OutputTokens
+		t.Cost += e.Cost
+		t.Estimated = t.Estimated || e.Estimated
+	}
+	return totals
+}